@@ -0,0 +1,112 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/k8s-monitor/internal/app"
+	"github.com/yourusername/k8s-monitor/internal/diagnostic"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the RBAC permissions k8s-monitor needs",
+	Long: `Run read-only SelfSubjectAccessReviews for every resource k8s-monitor
+reads (nodes, pods, events, services, PVs, deployments, jobs, kubelet proxy,
+metrics.k8s.io and Volcano CRDs) and print which are allowed or denied,
+followed by a ClusterRole granting the missing permissions.
+
+Exits with code 1 when a required permission is denied. Optional permissions
+only disable the feature that uses them.`,
+	RunE:          runDoctor,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	config, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	fullVersion := fmt.Sprintf("%s (built: %s)", Version, BuildTime)
+	application, err := app.New(config, fullVersion)
+	if err != nil {
+		return fmt.Errorf("failed to create application: %w", err)
+	}
+	defer func() {
+		if err := application.Shutdown(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error during shutdown: %v\n", err)
+		}
+	}()
+
+	// Cancel on timeout or interrupt
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), timeout)
+	defer cancel()
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	results, err := application.Doctor(ctx)
+	if err != nil {
+		return err
+	}
+
+	printAccessChecklist(os.Stdout, results, config.Namespace, config.AsUser)
+
+	if role := diagnostic.SuggestedClusterRole(results); role != "" {
+		fmt.Fprintln(os.Stdout)
+		fmt.Fprintln(os.Stdout, "Suggested RBAC (bind it with a ClusterRoleBinding to your user or service account):")
+		fmt.Fprintln(os.Stdout)
+		fmt.Fprint(os.Stdout, role)
+	}
+
+	denied := 0
+	for _, result := range results {
+		if !result.Allowed && !result.Check.Optional {
+			denied++
+		}
+	}
+	if denied > 0 {
+		return fmt.Errorf("%d required permission(s) denied", denied)
+	}
+	return nil
+}
+
+// printAccessChecklist prints one line per access check with its outcome.
+// asUser names the impersonated user the checks were run as, if any.
+func printAccessChecklist(out io.Writer, results []diagnostic.AccessResult, namespace, asUser string) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	scope := "all namespaces"
+	if namespace != "" {
+		scope = "namespace " + namespace
+	}
+	fmt.Fprintf(w, "Scope:\t%s\n", scope)
+	if asUser != "" {
+		fmt.Fprintf(w, "As:\t%s\n", asUser)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "STATUS\tVERB\tRESOURCE\tUSED FOR\tREASON")
+	for _, result := range results {
+		status := "✓ allowed"
+		if !result.Allowed {
+			status = "✗ denied"
+			if result.Check.Optional {
+				status = "! denied (optional)"
+			}
+		}
+		reason := result.Reason
+		if reason == "" {
+			reason = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", status, result.Check.Verb, result.Check.Name(), result.Check.Feature, reason)
+	}
+}
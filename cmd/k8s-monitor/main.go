@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -26,6 +27,8 @@ var (
 	kubeconfig string
 	context    string
 	namespace  string
+	asUser     string
+	asGroups   []string
 	verbose    bool
 	locale     string
 )
@@ -66,29 +69,65 @@ func init() {
 
 	// Add subcommands
 	rootCmd.AddCommand(consoleCmd)
+	rootCmd.AddCommand(snapshotCmd)
+	rootCmd.AddCommand(serveCmd)
+	rootCmd.AddCommand(recordCmd)
+	rootCmd.AddCommand(doctorCmd)
 
 	// Global persistent flags
 	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "config file path (default: ./config/config.yaml)")
 	rootCmd.PersistentFlags().StringVarP(&kubeconfig, "kubeconfig", "k", "", "path to kubeconfig file (default: $HOME/.kube/config)")
 	rootCmd.PersistentFlags().StringVarP(&context, "context", "c", "", "kubernetes context to use")
 	rootCmd.PersistentFlags().StringVarP(&namespace, "namespace", "n", "", "namespace to monitor (default: all namespaces)")
+	rootCmd.PersistentFlags().StringVar(&asUser, "as", "", "user to impersonate, e.g. to preview what a limited user can see")
+	rootCmd.PersistentFlags().StringArrayVar(&asGroups, "as-group", nil, "group to impersonate (repeatable, requires --as)")
 	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "enable verbose logging")
-	rootCmd.PersistentFlags().StringVarP(&locale, "locale", "l", "en", "interface language (en, zh)")
+	rootCmd.PersistentFlags().StringVarP(&locale, "locale", "l", "en", "interface language (en, zh, ja, ko)")
 
 	// Console command flags
 	consoleCmd.Flags().IntP("refresh", "r", 2, "refresh interval in seconds")
 	consoleCmd.Flags().BoolP("no-color", "", false, "disable color output")
+	consoleCmd.Flags().StringP("theme", "", "", "color theme: dark, light, high-contrast (default: dark)")
 	consoleCmd.Flags().BoolP("insecure-kubelet", "", false, "skip TLS verification for kubelet metrics (use in test environments)")
 	consoleCmd.Flags().IntP("max-concurrent", "m", 10, "maximum concurrent kubelet queries (default: 10)")
 	consoleCmd.Flags().IntP("log-tail-lines", "", 200, "number of log lines to fetch (default: 200)")
+	consoleCmd.Flags().BoolP("allow-mutations", "", false, "enable write actions such as cordon/uncordon, rollout restart and kubectl edit (default: read-only)")
+	consoleCmd.Flags().IntP("history-size", "", 10, "number of metric snapshots kept for trends (default: 10)")
+	consoleCmd.Flags().StringP("history-file", "", "", "file to persist metric history across restarts (default: disabled)")
 	consoleCmd.Flags().StringP("npu-exporter", "", "", "NPU-Exporter endpoint URL (e.g., http://npu-exporter.kube-system:8082)")
+	consoleCmd.Flags().StringP("replay", "", "", "replay a file written by 'record' instead of connecting to a cluster")
+	consoleCmd.Flags().StringP("export-dir", "", "", "directory exports are written to (default: ~/.config/k8s-monitor/exports)")
+
+	// Snapshot command flags
+	snapshotCmd.Flags().StringP("output", "o", "table", "output format (table, json, yaml)")
+	snapshotCmd.Flags().BoolP("insecure-kubelet", "", false, "skip TLS verification for kubelet metrics (use in test environments)")
+	snapshotCmd.Flags().IntP("max-concurrent", "m", 10, "maximum concurrent kubelet queries (default: 10)")
+	snapshotCmd.Flags().DurationP("timeout", "", 60*time.Second, "maximum time to wait for cluster data")
+
+	// Serve command flags
+	serveCmd.Flags().StringP("metrics-addr", "", ":9090", "address to expose Prometheus metrics on")
+	serveCmd.Flags().IntP("refresh", "r", 2, "refresh interval in seconds")
+	serveCmd.Flags().BoolP("insecure-kubelet", "", false, "skip TLS verification for kubelet metrics (use in test environments)")
+	serveCmd.Flags().IntP("max-concurrent", "m", 10, "maximum concurrent kubelet queries (default: 10)")
+	serveCmd.Flags().StringP("npu-exporter", "", "", "NPU-Exporter endpoint URL (e.g., http://npu-exporter.kube-system:8082)")
+
+	// Record command flags
+	recordCmd.Flags().StringP("out", "o", "", "file to write the recorded snapshots to (required)")
+	recordCmd.Flags().IntP("count", "", 0, "number of snapshots to record (default: until interrupted)")
+	recordCmd.Flags().IntP("refresh", "r", 2, "interval between snapshots in seconds")
+	recordCmd.Flags().BoolP("insecure-kubelet", "", false, "skip TLS verification for kubelet metrics (use in test environments)")
+	recordCmd.Flags().IntP("max-concurrent", "m", 10, "maximum concurrent kubelet queries (default: 10)")
+	recordCmd.Flags().StringP("npu-exporter", "", "", "NPU-Exporter endpoint URL (e.g., http://npu-exporter.kube-system:8082)")
+
+	// Doctor command flags
+	doctorCmd.Flags().DurationP("timeout", "", 30*time.Second, "maximum time to wait for the access reviews")
 }
 
-func runConsole(cmd *cobra.Command, args []string) error {
-	// Load configuration
+// loadConfig loads configuration and applies the global command-line flags
+func loadConfig(cmd *cobra.Command) (*app.Config, error) {
 	config, err := app.LoadConfig(configFile)
 	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
 	// Override config with command-line flags
@@ -101,6 +140,12 @@ func runConsole(cmd *cobra.Command, args []string) error {
 	if namespace != "" {
 		config.Namespace = namespace
 	}
+	if asUser != "" {
+		config.AsUser = asUser
+	}
+	if len(asGroups) > 0 {
+		config.AsGroups = asGroups
+	}
 	// Only override locale if user explicitly specified it
 	if cmd.Flags().Changed("locale") {
 		config.Locale = locale
@@ -109,6 +154,16 @@ func runConsole(cmd *cobra.Command, args []string) error {
 		config.LogLevel = "debug"
 	}
 
+	return config, nil
+}
+
+func runConsole(cmd *cobra.Command, args []string) error {
+	// Load configuration
+	config, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+
 	// Override refresh interval from flag
 	if refresh, _ := cmd.Flags().GetInt("refresh"); refresh > 0 {
 		config.RefreshInterval = time.Duration(refresh) * time.Second
@@ -120,11 +175,21 @@ func runConsole(cmd *cobra.Command, args []string) error {
 		config.ColorMode = "never"
 	}
 
+	// Override theme flag only if user explicitly specified it
+	if cmd.Flags().Changed("theme") {
+		config.Theme, _ = cmd.Flags().GetString("theme")
+	}
+
 	// Override insecure-kubelet flag
 	if insecureKubelet, _ := cmd.Flags().GetBool("insecure-kubelet"); insecureKubelet {
 		config.InsecureKubelet = true
 	}
 
+	// Override allow-mutations flag
+	if allowMutations, _ := cmd.Flags().GetBool("allow-mutations"); allowMutations {
+		config.AllowMutations = true
+	}
+
 	// Override max-concurrent flag only if user explicitly specified it
 	if cmd.Flags().Changed("max-concurrent") {
 		if maxConcurrent, _ := cmd.Flags().GetInt("max-concurrent"); maxConcurrent > 0 {
@@ -139,6 +204,18 @@ func runConsole(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Override history-size flag only if user explicitly specified it
+	if cmd.Flags().Changed("history-size") {
+		if historySize, _ := cmd.Flags().GetInt("history-size"); historySize > 0 {
+			config.HistorySize = historySize
+		}
+	}
+
+	// Override history-file flag only if user explicitly specified it
+	if cmd.Flags().Changed("history-file") {
+		config.HistoryFile, _ = cmd.Flags().GetString("history-file")
+	}
+
 	// Override npu-exporter endpoint flag only if user explicitly specified it
 	if cmd.Flags().Changed("npu-exporter") {
 		if npuExporter, _ := cmd.Flags().GetString("npu-exporter"); npuExporter != "" {
@@ -146,6 +223,11 @@ func runConsole(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Override export-dir flag only if user explicitly specified it
+	if cmd.Flags().Changed("export-dir") {
+		config.ExportDir, _ = cmd.Flags().GetString("export-dir")
+	}
+
 	// Create application instance with full version info
 	fullVersion := fmt.Sprintf("%s (built: %s)", Version, BuildTime)
 	application, err := app.New(config, fullVersion)
@@ -162,9 +244,14 @@ func runConsole(cmd *cobra.Command, args []string) error {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
-	// Run application in goroutine
+	// Run application in goroutine, against a recording when --replay is set
+	replayFile, _ := cmd.Flags().GetString("replay")
 	errChan := make(chan error, 1)
 	go func() {
+		if replayFile != "" {
+			errChan <- application.Replay(replayFile)
+			return
+		}
 		errChan <- application.Run()
 	}()
 
@@ -184,6 +271,10 @@ func runConsole(cmd *cobra.Command, args []string) error {
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
+		var exitErr *exitCodeError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.code)
+		}
 		os.Exit(1)
 	}
 }
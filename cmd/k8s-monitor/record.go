@@ -0,0 +1,90 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/k8s-monitor/internal/app"
+)
+
+var recordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "Record cluster snapshots to a file for offline replay",
+	Long: `Fetch cluster data every refresh interval and append each snapshot, with its
+timestamp, to a file (one JSON document per line). Stop with Ctrl+C or --count.
+
+Replay the recording without a live cluster:
+  k8s-monitor console --replay cluster.json`,
+	RunE:         runRecord,
+	SilenceUsage: true,
+}
+
+func runRecord(cmd *cobra.Command, args []string) error {
+	outPath, _ := cmd.Flags().GetString("out")
+	if outPath == "" {
+		return fmt.Errorf("--out is required")
+	}
+	count, _ := cmd.Flags().GetInt("count")
+
+	config, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Override refresh interval from flag only if user explicitly specified it
+	if cmd.Flags().Changed("refresh") {
+		if refresh, _ := cmd.Flags().GetInt("refresh"); refresh > 0 {
+			config.RefreshInterval = time.Duration(refresh) * time.Second
+		}
+	}
+
+	// Override insecure-kubelet flag
+	if insecureKubelet, _ := cmd.Flags().GetBool("insecure-kubelet"); insecureKubelet {
+		config.InsecureKubelet = true
+	}
+
+	// Override max-concurrent flag only if user explicitly specified it
+	if cmd.Flags().Changed("max-concurrent") {
+		if maxConcurrent, _ := cmd.Flags().GetInt("max-concurrent"); maxConcurrent > 0 {
+			config.MaxConcurrent = maxConcurrent
+		}
+	}
+
+	// Override npu-exporter endpoint flag only if user explicitly specified it
+	if cmd.Flags().Changed("npu-exporter") {
+		if npuExporter, _ := cmd.Flags().GetString("npu-exporter"); npuExporter != "" {
+			config.NPUExporterEndpoint = npuExporter
+		}
+	}
+
+	out, err := os.Create(outPath)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer out.Close()
+
+	fullVersion := fmt.Sprintf("%s (built: %s)", Version, BuildTime)
+	application, err := app.New(config, fullVersion)
+	if err != nil {
+		return fmt.Errorf("failed to create application: %w", err)
+	}
+	defer func() {
+		if err := application.Shutdown(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error during shutdown: %v\n", err)
+		}
+	}()
+
+	// Record until interrupted or --count frames were written
+	ctx, stop := signal.NotifyContext(gocontext.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "Recording to %s every %s (Ctrl+C to stop)\n", outPath, config.RefreshInterval)
+	recorded, err := application.Record(ctx, out, count)
+	fmt.Fprintf(os.Stderr, "Recorded %d snapshot(s) to %s\n", recorded, outPath)
+	return err
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	gocontext "context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/k8s-monitor/internal/app"
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Expose the cluster summary as Prometheus metrics",
+	Long: `Run the data collector without the TUI and expose the cluster summary
+(CPU/memory utilization, pod phase counts, NPU allocation, alert counts)
+as Prometheus metrics on /metrics.`,
+	RunE:         runServe,
+	SilenceUsage: true,
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	config, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Override refresh interval from flag only if user explicitly specified it
+	if cmd.Flags().Changed("refresh") {
+		if refresh, _ := cmd.Flags().GetInt("refresh"); refresh > 0 {
+			config.RefreshInterval = time.Duration(refresh) * time.Second
+		}
+	}
+
+	// Override insecure-kubelet flag
+	if insecureKubelet, _ := cmd.Flags().GetBool("insecure-kubelet"); insecureKubelet {
+		config.InsecureKubelet = true
+	}
+
+	// Override max-concurrent flag only if user explicitly specified it
+	if cmd.Flags().Changed("max-concurrent") {
+		if maxConcurrent, _ := cmd.Flags().GetInt("max-concurrent"); maxConcurrent > 0 {
+			config.MaxConcurrent = maxConcurrent
+		}
+	}
+
+	// Override npu-exporter endpoint flag only if user explicitly specified it
+	if cmd.Flags().Changed("npu-exporter") {
+		if npuExporter, _ := cmd.Flags().GetString("npu-exporter"); npuExporter != "" {
+			config.NPUExporterEndpoint = npuExporter
+		}
+	}
+
+	fullVersion := fmt.Sprintf("%s (built: %s)", Version, BuildTime)
+	application, err := app.New(config, fullVersion)
+	if err != nil {
+		return fmt.Errorf("failed to create application: %w", err)
+	}
+	defer func() {
+		if err := application.Shutdown(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error during shutdown: %v\n", err)
+		}
+	}()
+
+	// Serve until interrupted
+	ctx, stop := signal.NotifyContext(gocontext.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	metricsAddr, _ := cmd.Flags().GetString("metrics-addr")
+	fmt.Fprintf(os.Stderr, "Serving metrics on %s/metrics\n", metricsAddr)
+
+	return application.Serve(ctx, metricsAddr)
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yourusername/k8s-monitor/internal/app"
+	"sigs.k8s.io/yaml"
+)
+
+// exitCodeCriticalAlerts is returned by snapshot when critical alerts are present
+const exitCodeCriticalAlerts = 2
+
+// exitCodeError carries a specific process exit code through cobra
+type exitCodeError struct {
+	code int
+	msg  string
+}
+
+func (e *exitCodeError) Error() string {
+	return e.msg
+}
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Print a one-shot cluster summary and exit",
+	Long: `Fetch cluster data once and print the cluster summary and alerts without
+launching the TUI. Useful for scripts and CI health checks.
+
+Exits with code 2 when critical alerts are present.`,
+	RunE:          runSnapshot,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+func runSnapshot(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+	if output != "table" && output != "json" && output != "yaml" {
+		return fmt.Errorf("unsupported output format %q (use table, json or yaml)", output)
+	}
+
+	config, err := loadConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Override insecure-kubelet flag
+	if insecureKubelet, _ := cmd.Flags().GetBool("insecure-kubelet"); insecureKubelet {
+		config.InsecureKubelet = true
+	}
+
+	// Override max-concurrent flag only if user explicitly specified it
+	if cmd.Flags().Changed("max-concurrent") {
+		if maxConcurrent, _ := cmd.Flags().GetInt("max-concurrent"); maxConcurrent > 0 {
+			config.MaxConcurrent = maxConcurrent
+		}
+	}
+
+	fullVersion := fmt.Sprintf("%s (built: %s)", Version, BuildTime)
+	application, err := app.New(config, fullVersion)
+	if err != nil {
+		return fmt.Errorf("failed to create application: %w", err)
+	}
+	defer func() {
+		if err := application.Shutdown(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error during shutdown: %v\n", err)
+		}
+	}()
+
+	// Cancel on timeout or interrupt
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	ctx, cancel := gocontext.WithTimeout(gocontext.Background(), timeout)
+	defer cancel()
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	report, err := application.Snapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch output {
+	case "json":
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+		fmt.Fprintln(os.Stdout, string(data))
+	case "yaml":
+		data, err := yaml.Marshal(report)
+		if err != nil {
+			return fmt.Errorf("failed to encode YAML: %w", err)
+		}
+		fmt.Fprint(os.Stdout, string(data))
+	default:
+		printSnapshotTable(os.Stdout, report)
+	}
+
+	if report.CriticalAlerts > 0 {
+		return &exitCodeError{
+			code: exitCodeCriticalAlerts,
+			msg:  fmt.Sprintf("%d critical alert(s) present", report.CriticalAlerts),
+		}
+	}
+	return nil
+}
+
+// printSnapshotTable prints a human-readable summary and alert table
+func printSnapshotTable(out io.Writer, report *app.SnapshotReport) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "Snapshot:\t%s\n", report.Timestamp.Format(time.RFC3339))
+	if report.Context != "" {
+		fmt.Fprintf(w, "Context:\t%s\n", report.Context)
+	}
+	if report.Namespace != "" {
+		fmt.Fprintf(w, "Namespace:\t%s\n", report.Namespace)
+	}
+
+	if s := report.Summary; s != nil {
+		fmt.Fprintf(w, "Nodes:\t%d/%d ready\n", s.ReadyNodes, s.TotalNodes)
+		fmt.Fprintf(w, "Pods:\t%d total, %d running, %d pending, %d failed, %d unknown\n",
+			s.TotalPods, s.RunningPods, s.PendingPods, s.FailedPods, s.UnknownPods)
+		fmt.Fprintf(w, "CPU:\t%.1f%% used, %.1f%% requested\n", s.CPUUsageUtilization, s.CPURequestUtilization)
+		fmt.Fprintf(w, "Memory:\t%.1f%% used, %.1f%% requested\n", s.MemUsageUtilization, s.MemRequestUtilization)
+		if s.NPUCapacity > 0 {
+			fmt.Fprintf(w, "NPU:\t%d/%d allocated (%.1f%%)\n", s.NPUAllocated, s.NPUAllocatable, s.NPUUtilization)
+		}
+	}
+	fmt.Fprintf(w, "Alerts:\t%d critical, %d warning, %d total\n",
+		report.CriticalAlerts, report.WarningAlerts, len(report.Alerts))
+
+	if len(report.Alerts) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "SEVERITY\tCATEGORY\tRESOURCE\tMESSAGE")
+	for _, alert := range report.Alerts {
+		resource := alert.ResourceName
+		if alert.Namespace != "" {
+			resource = alert.Namespace + "/" + resource
+		}
+		if alert.ResourceType != "" {
+			resource = alert.ResourceType + " " + resource
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", alert.Severity, alert.Category, resource, alert.Message)
+	}
+}
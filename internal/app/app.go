@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/yourusername/k8s-monitor/internal/cache"
@@ -12,14 +13,18 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"gopkg.in/natefinch/lumberjack.v2"
+	"k8s.io/client-go/rest"
 )
 
 // App represents the main application
 type App struct {
-	ctx        context.Context
-	logger     *zap.Logger
-	config     *Config
-	version    string
+	ctx     context.Context
+	logger  *zap.Logger
+	config  *Config
+	version string
+
+	// Data source stack, swapped as a whole when switching kubeconfig context
+	mu         sync.RWMutex
 	dataSource *datasource.AggregatedDataSource
 	cache      *cache.TTLCache
 	refresher  *cache.Refresher
@@ -33,6 +38,10 @@ func New(config *Config, version string) (*App, error) {
 		return nil, fmt.Errorf("failed to initialize logger: %w", err)
 	}
 
+	if err := datasource.SetNPUResourcePatterns(config.NPUResourcePatterns); err != nil {
+		return nil, fmt.Errorf("invalid npu.resource_patterns: %w", err)
+	}
+
 	return &App{
 		ctx:     context.Background(),
 		logger:  logger,
@@ -55,6 +64,7 @@ func (a *App) Run() error {
 	a.logger.Debug("Application configuration loaded",
 		zap.Duration("cache_ttl", a.config.CacheTTL),
 		zap.Int("max_concurrent", a.config.MaxConcurrent),
+		zap.Int("history_size", a.config.HistorySize),
 		zap.String("log_level", a.config.LogLevel),
 		zap.String("log_file", a.config.LogFile),
 	)
@@ -70,7 +80,7 @@ func (a *App) Run() error {
 	}
 
 	// Start Bubble Tea UI
-	if err := a.startUI(); err != nil {
+	if err := a.startUI(a); err != nil {
 		return fmt.Errorf("failed to start UI: %w", err)
 	}
 
@@ -78,11 +88,64 @@ func (a *App) Run() error {
 	return nil
 }
 
-// startUI starts the Bubble Tea UI
-func (a *App) startUI() error {
-	a.logger.Info("Starting UI", zap.String("locale", a.config.Locale))
+// startUI starts the Bubble Tea UI on top of provider, which is the App itself
+// for a live cluster or a recording in replay mode
+func (a *App) startUI(provider ui.DataProvider) error {
+	a.logger.Info("Starting UI", zap.String("locale", a.config.Locale), zap.String("theme", a.config.Theme))
 
-	uiModel := ui.NewModel(a, a.logger, a.config.RefreshInterval, a.config.Locale, a.version, a.config.LogTailLines)
+	theme, err := ui.NewTheme(a.config.Theme, a.config.ThemeColors)
+	if err != nil {
+		return fmt.Errorf("invalid theme: %w", err)
+	}
+	ui.ApplyTheme(theme)
+	ui.ApplyColorMode(a.config.ColorMode, a.config.NoColor)
+
+	live := provider == ui.DataProvider(a)
+	uiModel := ui.NewModel(provider, a.logger, a.config.RefreshInterval, a.config.Locale, a.version, a.config.LogTailLines)
+	uiModel.SetAllowMutations(a.config.AllowMutations)
+	if err := uiModel.SetColumns(a.config.Columns); err != nil {
+		return fmt.Errorf("invalid columns: %w", err)
+	}
+	if err := uiModel.SetDefaultSorts(a.config.DefaultSort); err != nil {
+		return fmt.Errorf("invalid default sort: %w", err)
+	}
+	if err := uiModel.SetViewRefreshIntervals(a.config.RefreshViews); err != nil {
+		return fmt.Errorf("invalid refresh views: %w", err)
+	}
+	if live {
+		if _, current, err := a.ListContexts(); err == nil {
+			uiModel.SetActiveContext(current)
+		}
+		uiModel.SetKubectlFlags(a.config.kubectlFlags())
+		uiModel.SetNamespaceScope(a.activeNamespace())
+	}
+	uiModel.SetHistorySize(a.config.HistorySize)
+	uiModel.SetNetworkRateWindow(a.config.NetworkRateWindow)
+	uiModel.SetRecentRestartWindow(a.config.RecentRestartWindow)
+	uiModel.SetPinnedNamespaces(a.config.PinnedNamespaces)
+	uiModel.SetHideCompletedPods(a.config.HideCompletedPods, a.config.HideFailedPodsAfter)
+	uiModel.SetExportDir(a.config.ExportDir)
+	uiModel.SetEventLimit(a.config.EventLimit)
+	uiModel.SetHiddenAnnotations(a.config.HiddenAnnotations)
+	uiModel.SetRawUnits(a.config.RawUnits)
+	uiModel.SetNodeFlapThreshold(a.config.NodeFlapThreshold)
+	// Replayed data must not be mixed into the live metric history
+	if live && a.config.HistoryFile != "" {
+		if err := uiModel.SetHistoryFile(a.config.HistoryFile); err != nil {
+			a.logger.Warn("Failed to load metric history file",
+				zap.String("file", a.config.HistoryFile),
+				zap.Error(err),
+			)
+		}
+	}
+	if a.config.StateFile != "" {
+		if err := uiModel.SetStateFile(a.config.StateFile); err != nil {
+			a.logger.Warn("Failed to load UI state file",
+				zap.String("file", a.config.StateFile),
+				zap.Error(err),
+			)
+		}
+	}
 	p := tea.NewProgram(uiModel, tea.WithAltScreen())
 
 	if _, err := p.Run(); err != nil {
@@ -94,12 +157,46 @@ func (a *App) startUI() error {
 
 // initDataSources initializes all data sources
 func (a *App) initDataSources() error {
-	a.logger.Info("Initializing data sources")
+	dataSource, ttlCache, refresher, err := a.newSources(a.config.Context)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	a.dataSource, a.cache, a.refresher = dataSource, ttlCache, refresher
+	a.mu.Unlock()
+	return nil
+}
+
+// impersonation returns the user and groups API requests impersonate
+func (c *Config) impersonation() rest.ImpersonationConfig {
+	return rest.ImpersonationConfig{UserName: c.AsUser, Groups: c.AsGroups}
+}
+
+// kubectlFlags returns the kubectl flags selecting the same kubeconfig and
+// impersonated identity as the monitor
+func (c *Config) kubectlFlags() []string {
+	var flags []string
+	if c.Kubeconfig != "" {
+		flags = append(flags, "--kubeconfig", c.Kubeconfig)
+	}
+	if c.AsUser != "" {
+		flags = append(flags, "--as", c.AsUser)
+	}
+	for _, group := range c.AsGroups {
+		flags = append(flags, "--as-group", group)
+	}
+	return flags
+}
+
+// newSources builds the data source, cache and refresher for a kubeconfig context
+func (a *App) newSources(kubeContext string) (*datasource.AggregatedDataSource, *cache.TTLCache, *cache.Refresher, error) {
+	a.logger.Info("Initializing data sources", zap.String("context", kubeContext))
 
 	// Create API Server client
-	apiServer, err := datasource.NewAPIServerClient(a.config.Kubeconfig, a.config.Context, a.logger)
+	apiServer, err := datasource.NewAPIServerClient(a.config.Kubeconfig, kubeContext, a.config.impersonation(), a.logger)
 	if err != nil {
-		return fmt.Errorf("failed to create API Server client: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create API Server client: %w", err)
 	}
 
 	// Create kubelet client (using proxy mode)
@@ -109,10 +206,17 @@ func (a *App) initDataSources() error {
 			zap.Error(err),
 		)
 		kubeletClient = nil
+	} else {
+		kubeletClient.SetQueryPolicy(a.config.KubeletTimeout, a.config.KubeletRetries)
+		if err := kubeletClient.SetInsecureNodes(a.config.InsecureNodes); err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid kubelet insecure_nodes: %w", err)
+		}
 	}
 
 	// Create aggregated data source
-	a.dataSource = datasource.NewAggregatedDataSource(apiServer, kubeletClient, a.logger, a.config.MaxConcurrent)
+	dataSource := datasource.NewAggregatedDataSource(apiServer, kubeletClient, a.logger, a.config.MaxConcurrent)
+	dataSource.SetEventLimit(a.eventLimit())
+	dataSource.SetQuotaAlertThresholds(a.config.QuotaAlertWarning, a.config.QuotaAlertCritical)
 
 	// Create Volcano client (optional - will work without it)
 	volcanoClient, err := datasource.NewVolcanoClient(apiServer.GetConfig(), a.logger)
@@ -121,7 +225,22 @@ func (a *App) initDataSources() error {
 			zap.Error(err),
 		)
 	} else {
-		a.dataSource.SetVolcanoClient(volcanoClient)
+		dataSource.SetVolcanoClient(volcanoClient)
+	}
+
+	// Create CRD client (opt-in - lists every CRD on each refresh)
+	if a.config.CRDsEnabled {
+		crdClient, err := datasource.NewCRDClient(apiServer.GetConfig(), a.logger)
+		if err != nil {
+			a.logger.Warn("Failed to create CRD client, CRD summary disabled",
+				zap.Error(err),
+			)
+		} else if err := crdClient.SetCountedCRDs(a.config.CountedCRDs); err != nil {
+			dataSource.Close()
+			return nil, nil, nil, fmt.Errorf("invalid crds.count: %w", err)
+		} else {
+			dataSource.SetCRDClient(crdClient)
+		}
 	}
 
 	// Create NPU-Exporter client (optional - for Huawei Ascend NPU metrics)
@@ -135,70 +254,190 @@ func (a *App) initDataSources() error {
 		if a.config.NPUExporterEndpoint != "" {
 			npuExporterClient.SetEndpoint(a.config.NPUExporterEndpoint)
 		}
-		a.dataSource.SetNPUExporterClient(npuExporterClient)
+		dataSource.SetNPUExporterClient(npuExporterClient)
 	}
 
 	// Create cache
-	a.cache = cache.NewTTLCache(a.config.CacheTTL, a.logger)
+	ttlCache := cache.NewTTLCache(a.config.CacheTTL, a.logger)
 
 	// Create refresher
-	a.refresher = cache.NewRefresher(
-		a.dataSource,
-		a.cache,
-		a.config.RefreshInterval,
-		a.config.Namespace,
+	refresher := cache.NewRefresher(
+		dataSource,
+		ttlCache,
+		a.activeRefreshInterval(),
+		a.activeNamespace(),
 		a.logger,
 	)
+	refresher.SetTimeout(a.config.Timeout)
 
 	a.logger.Info("Data sources initialized successfully")
-	return nil
+	return dataSource, ttlCache, refresher, nil
+}
+
+// sources returns the current data source stack
+func (a *App) sources() (*datasource.AggregatedDataSource, *cache.TTLCache, *cache.Refresher) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.dataSource, a.cache, a.refresher
+}
+
+// closeSources stops a refresher and closes its data source
+func (a *App) closeSources(dataSource *datasource.AggregatedDataSource, refresher *cache.Refresher) {
+	// Stop refresher (not started for one-shot commands such as snapshot)
+	if refresher != nil && refresher.GetStatus().IsRunning {
+		if err := refresher.Stop(); err != nil {
+			a.logger.Error("Failed to stop refresher", zap.Error(err))
+		}
+	}
+
+	// Close data sources
+	if dataSource != nil {
+		if err := dataSource.Close(); err != nil {
+			a.logger.Error("Failed to close data source", zap.Error(err))
+		}
+	}
 }
 
 // GetClusterData retrieves cluster data (from cache or fresh)
 func (a *App) GetClusterData() (*model.ClusterData, error) {
+	// A failed or timed out refresh leaves the previous data cached; report
+	// the failure rather than serving that data as current
+	dataSource, ttlCache, refresher := a.sources()
+	if refresher != nil {
+		if err := refresher.GetStatus().LastError; err != nil {
+			return nil, err
+		}
+	}
+
 	// Try cache first
-	if data, ok := a.cache.Get(a.ctx); ok {
+	if data, ok := ttlCache.Get(a.ctx); ok {
 		return data, nil
 	}
 
 	// Cache miss, fetch fresh data
 	a.logger.Debug("Cache miss, fetching fresh data")
-	return a.dataSource.GetClusterData(a.ctx, a.config.Namespace)
+	return dataSource.GetClusterDataWithTimeout(a.ctx, a.activeNamespace(), a.config.Timeout)
 }
 
 // GetPodLogs retrieves logs for a specific pod and container
-func (a *App) GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64) (string, error) {
-	if a.dataSource == nil {
+func (a *App) GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64, timestamps bool) (string, error) {
+	dataSource, _, _ := a.sources()
+	if dataSource == nil {
+		return "", fmt.Errorf("data source not initialized")
+	}
+	return dataSource.GetPodLogs(ctx, namespace, podName, containerName, tailLines, timestamps)
+}
+
+// GetNode fetches a single node, bypassing the cluster cache
+func (a *App) GetNode(ctx context.Context, name string) (*model.NodeData, error) {
+	dataSource, _, _ := a.sources()
+	if dataSource == nil {
+		return nil, fmt.Errorf("data source not initialized")
+	}
+	return dataSource.GetNode(ctx, name)
+}
+
+// GetPod fetches a single pod, bypassing the cluster cache
+func (a *App) GetPod(ctx context.Context, namespace, name string) (*model.PodData, error) {
+	dataSource, _, _ := a.sources()
+	if dataSource == nil {
+		return nil, fmt.Errorf("data source not initialized")
+	}
+	return dataSource.GetPod(ctx, namespace, name)
+}
+
+// GetObjectEvents returns the most recent events of a single object
+func (a *App) GetObjectEvents(ctx context.Context, kind, namespace, name string, limit int) ([]*model.EventData, error) {
+	dataSource, _, _ := a.sources()
+	if dataSource == nil {
+		return nil, fmt.Errorf("data source not initialized")
+	}
+	return dataSource.GetObjectEvents(ctx, kind, namespace, name, limit)
+}
+
+// GetResourceYAML returns a live object (pod, node, deployment, ...) as YAML
+func (a *App) GetResourceYAML(ctx context.Context, kind, namespace, name string) (string, error) {
+	dataSource, _, _ := a.sources()
+	if dataSource == nil {
 		return "", fmt.Errorf("data source not initialized")
 	}
-	return a.dataSource.GetPodLogs(ctx, namespace, podName, containerName, tailLines)
+	return dataSource.GetResourceYAML(ctx, kind, namespace, name)
+}
+
+// CordonNode marks a node as unschedulable (requires --allow-mutations)
+func (a *App) CordonNode(ctx context.Context, nodeName string) error {
+	if !a.config.AllowMutations {
+		return fmt.Errorf("cluster mutations are disabled (restart with --allow-mutations)")
+	}
+	dataSource, _, _ := a.sources()
+	if dataSource == nil {
+		return fmt.Errorf("data source not initialized")
+	}
+	return dataSource.CordonNode(ctx, nodeName)
+}
+
+// UncordonNode marks a node as schedulable again (requires --allow-mutations)
+func (a *App) UncordonNode(ctx context.Context, nodeName string) error {
+	if !a.config.AllowMutations {
+		return fmt.Errorf("cluster mutations are disabled (restart with --allow-mutations)")
+	}
+	dataSource, _, _ := a.sources()
+	if dataSource == nil {
+		return fmt.Errorf("data source not initialized")
+	}
+	return dataSource.UncordonNode(ctx, nodeName)
+}
+
+// RolloutRestart restarts the pods of a deployment, statefulset or daemonset
+// (requires --allow-mutations)
+func (a *App) RolloutRestart(ctx context.Context, kind, namespace, name string) error {
+	if !a.config.AllowMutations {
+		return fmt.Errorf("cluster mutations are disabled (restart with --allow-mutations)")
+	}
+	dataSource, _, _ := a.sources()
+	if dataSource == nil {
+		return fmt.Errorf("data source not initialized")
+	}
+	return dataSource.RolloutRestart(ctx, kind, namespace, name)
 }
 
 // ForceRefresh triggers an immediate data refresh
 func (a *App) ForceRefresh() error {
-	if a.refresher == nil {
+	_, _, refresher := a.sources()
+	if refresher == nil {
 		return fmt.Errorf("refresher not initialized")
 	}
-	return a.refresher.RefreshNow()
+	return refresher.RefreshNow()
+}
+
+// PauseBackgroundRefresh stops the periodic cluster refresh, while the UI
+// only follows a single resource it fetches itself
+func (a *App) PauseBackgroundRefresh() {
+	if _, _, refresher := a.sources(); refresher != nil {
+		refresher.Pause()
+	}
+}
+
+// ResumeBackgroundRefresh restarts the periodic cluster refresh. The cached
+// data went stale while paused, so it is dropped and the next GetClusterData
+// call fetches fresh data.
+func (a *App) ResumeBackgroundRefresh() error {
+	_, ttlCache, refresher := a.sources()
+	if refresher != nil {
+		refresher.Resume()
+	}
+	if ttlCache != nil {
+		return ttlCache.Invalidate()
+	}
+	return nil
 }
 
 // Shutdown gracefully stops the application
 func (a *App) Shutdown() error {
 	a.logger.Info("Shutting down application...")
 
-	// Stop refresher
-	if a.refresher != nil {
-		if err := a.refresher.Stop(); err != nil {
-			a.logger.Error("Failed to stop refresher", zap.Error(err))
-		}
-	}
-
-	// Close data sources
-	if a.dataSource != nil {
-		if err := a.dataSource.Close(); err != nil {
-			a.logger.Error("Failed to close data source", zap.Error(err))
-		}
-	}
+	dataSource, _, refresher := a.sources()
+	a.closeSources(dataSource, refresher)
 
 	// Sync only flushes buffered log entries, ignore stderr sync errors
 	_ = a.logger.Sync()
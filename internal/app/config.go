@@ -16,11 +16,29 @@ type Config struct {
 	Context    string `mapstructure:"context"`
 	Namespace  string `mapstructure:"namespace"`
 
-	// Refresh configuration
+	// AsUser and AsGroups impersonate a user and groups (kubectl --as/--as-group)
+	AsUser   string   `mapstructure:"as"`
+	AsGroups []string `mapstructure:"as_groups"`
+
+	// AllowMutations enables write actions such as cordon/uncordon (read-only by default)
+	AllowMutations bool `mapstructure:"allow_mutations"`
+
+	// Refresh configuration; Timeout bounds a whole cluster data refresh
 	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
 	Timeout         time.Duration `mapstructure:"timeout"`
 	MaxConcurrent   int           `mapstructure:"max_concurrent"`
 
+	// RefreshViews overrides RefreshInterval for individual views (view -> duration)
+	RefreshViews map[string]string `mapstructure:"refresh_views"`
+
+	// EventLimit caps the most recent events fetched per refresh (0 = all events)
+	EventLimit int `mapstructure:"event_limit"`
+
+	// QuotaAlertWarning and QuotaAlertCritical are the percentages of a
+	// ResourceQuota hard limit at which namespace CPU/memory requests alert
+	QuotaAlertWarning  float64 `mapstructure:"quota_alert_warning"`
+	QuotaAlertCritical float64 `mapstructure:"quota_alert_critical"`
+
 	// Cache configuration
 	CacheTTL        time.Duration `mapstructure:"cache_ttl"`
 	MaxCacheEntries int           `mapstructure:"max_cache_entries"`
@@ -32,13 +50,69 @@ type Config struct {
 	NoColor      bool   `mapstructure:"no_color"`
 	Locale       string `mapstructure:"locale"`
 	LogTailLines int    `mapstructure:"log_tail_lines"`
+	HistorySize  int    `mapstructure:"history_size"` // Metric snapshots kept for trends
+	HistoryFile  string `mapstructure:"history_file"` // Optional file persisting snapshots across restarts
+	StateFile    string `mapstructure:"state_file"`   // File persisting UI state such as muted alert types
+
+	// NetworkRateWindow is the sliding window network rates are averaged over
+	NetworkRateWindow time.Duration `mapstructure:"network_rate_window"`
+
+	// RecentRestartWindow is how long after a restart a pod is highlighted
+	RecentRestartWindow time.Duration `mapstructure:"recent_restart_window"`
+
+	// PinnedNamespaces are listed first in the namespace filter and marked in lists
+	PinnedNamespaces []string `mapstructure:"pinned_namespaces"`
+
+	// HideCompletedPods starts the Pods view with Succeeded pods (and Failed
+	// pods older than HideFailedPodsAfter) hidden
+	HideCompletedPods   bool          `mapstructure:"hide_completed_pods"`
+	HideFailedPodsAfter time.Duration `mapstructure:"hide_failed_pods_after"`
+
+	// RawUnits shows exact millicores and bytes instead of humanized units
+	RawUnits bool `mapstructure:"raw_units"`
+
+	// NodeFlapThreshold is how many readiness changes within the metric
+	// history window flag a node as flapping
+	NodeFlapThreshold int `mapstructure:"node_flap_threshold"`
+
+	// HiddenAnnotations are annotation keys or glob patterns hidden in detail
+	// views until all annotations are expanded
+	HiddenAnnotations []string `mapstructure:"hidden_annotations"`
+
+	// ExportDir is where exports are written ("" = ~/.config/k8s-monitor/exports)
+	ExportDir string `mapstructure:"export_dir"`
+
+	// Theme selects a built-in color theme; ThemeColors overrides individual colors
+	Theme       string            `mapstructure:"theme"`
+	ThemeColors map[string]string `mapstructure:"theme_colors"`
+
+	// Columns maps a list view (pods, nodes, deployments) to its visible columns in order
+	Columns map[string][]string `mapstructure:"columns"`
+
+	// DefaultSort maps a list view to the sort it starts with, as "field" or "field:asc|desc"
+	DefaultSort map[string]string `mapstructure:"default_sort"`
 
 	// Kubelet configuration
 	InsecureKubelet bool `mapstructure:"insecure_kubelet"`
 
+	// InsecureNodes skips kubelet TLS verification only for matching nodes (name globs, IPs or CIDRs)
+	InsecureNodes []string `mapstructure:"kubelet_insecure_nodes"`
+
+	// KubeletTimeout bounds each kubelet query; failed queries are retried KubeletRetries times
+	KubeletTimeout time.Duration `mapstructure:"kubelet_timeout"`
+	KubeletRetries int           `mapstructure:"kubelet_retries"`
+
+	// CRDsEnabled shows the CRD summary in the overview; instances are counted
+	// for the CRDs matching CountedCRDs (globs of CRD names)
+	CRDsEnabled bool     `mapstructure:"crds_enabled"`
+	CountedCRDs []string `mapstructure:"crds_count"`
+
 	// NPU-Exporter configuration
 	NPUExporterEndpoint string `mapstructure:"npu_exporter_endpoint"`
 
+	// NPUResourcePatterns are glob patterns of the extended resources counted as NPUs
+	NPUResourcePatterns []string `mapstructure:"npu_resource_patterns"`
+
 	// Logging configuration
 	LogLevel string `mapstructure:"log_level"`
 	LogFile  string `mapstructure:"log_file"`
@@ -50,11 +124,20 @@ func LoadConfig(configFile string) (*Config, error) {
 	viper.SetDefault("cluster.kubeconfig", "")
 	viper.SetDefault("cluster.context", "")
 	viper.SetDefault("cluster.namespace", "")
+	viper.SetDefault("cluster.as", "")
+	viper.SetDefault("cluster.as_groups", []string{})
+	viper.SetDefault("cluster.allow_mutations", false)
 
 	viper.SetDefault("refresh.interval", "2s")
-	viper.SetDefault("refresh.timeout", "5s")
+	viper.SetDefault("refresh.views", map[string]string{})
+	viper.SetDefault("refresh.timeout", "15s")
 	viper.SetDefault("refresh.max_concurrent", 10)
 
+	viper.SetDefault("events.limit", 100)
+
+	viper.SetDefault("alerts.quota_warning", 80.0)
+	viper.SetDefault("alerts.quota_critical", 95.0)
+
 	viper.SetDefault("cache.ttl", "60s")
 	viper.SetDefault("cache.max_entries", 1000)
 
@@ -64,10 +147,29 @@ func LoadConfig(configFile string) (*Config, error) {
 	viper.SetDefault("ui.no_color", false)
 	viper.SetDefault("ui.locale", "en")
 	viper.SetDefault("ui.log_tail_lines", 200)
+	viper.SetDefault("ui.history_size", 10)
+	viper.SetDefault("ui.history_file", "")
+	viper.SetDefault("ui.network_rate_window", "20s")
+	viper.SetDefault("ui.recent_restart_window", "5m")
+	viper.SetDefault("ui.pinned_namespaces", []string{})
+	viper.SetDefault("ui.hide_completed_pods", false)
+	viper.SetDefault("ui.hide_failed_pods_after", "0s")
+	viper.SetDefault("ui.raw_units", false)
+	viper.SetDefault("ui.node_flap_threshold", 2)
+	viper.SetDefault("ui.hidden_annotations", []string{"kubectl.kubernetes.io/last-applied-configuration"})
+	viper.SetDefault("ui.export_dir", "")
+	viper.SetDefault("ui.theme", "dark")
 
 	viper.SetDefault("kubelet.insecure", false)
+	viper.SetDefault("kubelet.insecure_nodes", []string{})
+	viper.SetDefault("kubelet.timeout", "3s")
+	viper.SetDefault("kubelet.retries", 1)
+
+	viper.SetDefault("crds.enabled", false)
+	viper.SetDefault("crds.count", []string{})
 
 	viper.SetDefault("npu_exporter.endpoint", "")
+	viper.SetDefault("npu.resource_patterns", []string{})
 
 	viper.SetDefault("logging.level", "info")
 	viper.SetDefault("logging.file", "/tmp/k8s-monitor.log")
@@ -75,6 +177,7 @@ func LoadConfig(configFile string) (*Config, error) {
 	// Home kubeconfig default
 	if home, err := os.UserHomeDir(); err == nil {
 		viper.SetDefault("cluster.kubeconfig", filepath.Join(home, ".kube", "config"))
+		viper.SetDefault("ui.state_file", filepath.Join(home, ".config", "k8s-monitor", "state.json"))
 	}
 
 	if configFile != "" {
@@ -100,9 +203,16 @@ func LoadConfig(configFile string) (*Config, error) {
 		Kubeconfig:          viper.GetString("cluster.kubeconfig"),
 		Context:             viper.GetString("cluster.context"),
 		Namespace:           viper.GetString("cluster.namespace"),
+		AsUser:              viper.GetString("cluster.as"),
+		AsGroups:            viper.GetStringSlice("cluster.as_groups"),
+		AllowMutations:      viper.GetBool("cluster.allow_mutations"),
 		RefreshInterval:     viper.GetDuration("refresh.interval"),
 		Timeout:             viper.GetDuration("refresh.timeout"),
 		MaxConcurrent:       viper.GetInt("refresh.max_concurrent"),
+		RefreshViews:        viper.GetStringMapString("refresh.views"),
+		EventLimit:          viper.GetInt("events.limit"),
+		QuotaAlertWarning:   viper.GetFloat64("alerts.quota_warning"),
+		QuotaAlertCritical:  viper.GetFloat64("alerts.quota_critical"),
 		CacheTTL:            viper.GetDuration("cache.ttl"),
 		MaxCacheEntries:     viper.GetInt("cache.max_entries"),
 		ColorMode:           viper.GetString("ui.color_mode"),
@@ -111,8 +221,30 @@ func LoadConfig(configFile string) (*Config, error) {
 		NoColor:             viper.GetBool("ui.no_color"),
 		Locale:              viper.GetString("ui.locale"),
 		LogTailLines:        viper.GetInt("ui.log_tail_lines"),
+		HistorySize:         viper.GetInt("ui.history_size"),
+		HistoryFile:         viper.GetString("ui.history_file"),
+		StateFile:           viper.GetString("ui.state_file"),
+		NetworkRateWindow:   viper.GetDuration("ui.network_rate_window"),
+		RecentRestartWindow: viper.GetDuration("ui.recent_restart_window"),
+		PinnedNamespaces:    viper.GetStringSlice("ui.pinned_namespaces"),
+		HideCompletedPods:   viper.GetBool("ui.hide_completed_pods"),
+		HideFailedPodsAfter: viper.GetDuration("ui.hide_failed_pods_after"),
+		RawUnits:            viper.GetBool("ui.raw_units"),
+		NodeFlapThreshold:   viper.GetInt("ui.node_flap_threshold"),
+		HiddenAnnotations:   viper.GetStringSlice("ui.hidden_annotations"),
+		ExportDir:           viper.GetString("ui.export_dir"),
+		Theme:               viper.GetString("ui.theme"),
+		ThemeColors:         viper.GetStringMapString("ui.theme_colors"),
+		Columns:             viper.GetStringMapStringSlice("ui.columns"),
+		DefaultSort:         viper.GetStringMapString("ui.default_sort"),
 		InsecureKubelet:     viper.GetBool("kubelet.insecure"),
+		InsecureNodes:       viper.GetStringSlice("kubelet.insecure_nodes"),
+		KubeletTimeout:      viper.GetDuration("kubelet.timeout"),
+		KubeletRetries:      viper.GetInt("kubelet.retries"),
+		CRDsEnabled:         viper.GetBool("crds.enabled"),
+		CountedCRDs:         viper.GetStringSlice("crds.count"),
 		NPUExporterEndpoint: viper.GetString("npu_exporter.endpoint"),
+		NPUResourcePatterns: viper.GetStringSlice("npu.resource_patterns"),
 		LogLevel:            viper.GetString("logging.level"),
 		LogFile:             viper.GetString("logging.file"),
 	}
@@ -122,11 +254,14 @@ func LoadConfig(configFile string) (*Config, error) {
 		cfg.RefreshInterval = 2 * time.Second
 	}
 	if cfg.Timeout <= 0 {
-		cfg.Timeout = 5 * time.Second
+		cfg.Timeout = 15 * time.Second
 	}
 	if cfg.MaxConcurrent <= 0 {
 		cfg.MaxConcurrent = 10
 	}
+	if cfg.EventLimit < 0 {
+		cfg.EventLimit = 100
+	}
 	if cfg.CacheTTL <= 0 {
 		cfg.CacheTTL = 60 * time.Second
 	}
@@ -136,6 +271,9 @@ func LoadConfig(configFile string) (*Config, error) {
 	if cfg.ColorMode == "" {
 		cfg.ColorMode = "auto"
 	}
+	if cfg.Theme == "" {
+		cfg.Theme = "dark"
+	}
 	if cfg.DefaultView == "" {
 		cfg.DefaultView = "overview"
 	}
@@ -145,6 +283,24 @@ func LoadConfig(configFile string) (*Config, error) {
 	if cfg.LogTailLines <= 0 {
 		cfg.LogTailLines = 200
 	}
+	if cfg.HistorySize <= 0 {
+		cfg.HistorySize = 10
+	}
+	if cfg.NodeFlapThreshold <= 0 {
+		cfg.NodeFlapThreshold = 2
+	}
+	if cfg.NetworkRateWindow <= 0 {
+		cfg.NetworkRateWindow = 20 * time.Second
+	}
+	if cfg.RecentRestartWindow <= 0 {
+		cfg.RecentRestartWindow = 5 * time.Minute
+	}
+	if cfg.KubeletTimeout <= 0 {
+		cfg.KubeletTimeout = 3 * time.Second
+	}
+	if cfg.KubeletRetries < 0 {
+		cfg.KubeletRetries = 0
+	}
 	if cfg.LogLevel == "" {
 		cfg.LogLevel = "info"
 	}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/yourusername/k8s-monitor/internal/datasource"
+	"go.uber.org/zap"
+)
+
+// ListContexts returns the contexts defined in the kubeconfig and the active one
+func (a *App) ListContexts() ([]string, string, error) {
+	contexts, current, err := datasource.ListContexts(a.config.Kubeconfig)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if active := a.activeContext(); active != "" {
+		current = active
+	}
+	return contexts, current, nil
+}
+
+// SwitchContext rebuilds the data sources against another kubeconfig context
+// without restarting. The current sources keep serving if the switch fails.
+func (a *App) SwitchContext(kubeContext string) error {
+	a.logger.Info("Switching kubeconfig context", zap.String("context", kubeContext))
+
+	dataSource, ttlCache, refresher, err := a.newSources(kubeContext)
+	if err != nil {
+		return fmt.Errorf("failed to switch to context %s: %w", kubeContext, err)
+	}
+
+	a.mu.Lock()
+	oldDataSource, oldRefresher := a.dataSource, a.refresher
+	wasRunning := oldRefresher != nil && oldRefresher.GetStatus().IsRunning
+	a.dataSource, a.cache, a.refresher = dataSource, ttlCache, refresher
+	a.config.Context = kubeContext
+	a.mu.Unlock()
+
+	a.closeSources(oldDataSource, oldRefresher)
+
+	// Keep background refresh running if it was before the switch
+	if wasRunning {
+		if err := refresher.Start(); err != nil {
+			return fmt.Errorf("failed to start refresher: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// activeContext returns the context selected via flag, config or switcher
+func (a *App) activeContext() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.config.Context
+}
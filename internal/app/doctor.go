@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/yourusername/k8s-monitor/internal/datasource"
+	"github.com/yourusername/k8s-monitor/internal/diagnostic"
+	"go.uber.org/zap"
+)
+
+// Doctor reviews the RBAC permissions k8s-monitor needs for the configured
+// context and namespace. It only issues SelfSubjectAccessReviews, so it works
+// with credentials that cannot list anything yet.
+func (a *App) Doctor(ctx context.Context) ([]diagnostic.AccessResult, error) {
+	a.logger.Info("Checking RBAC permissions",
+		zap.String("context", a.config.Context),
+		zap.String("namespace", a.config.Namespace),
+		zap.String("as", a.config.AsUser),
+	)
+
+	apiServer, err := datasource.NewAPIServerClient(a.config.Kubeconfig, a.config.Context, a.config.impersonation(), a.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API Server client: %w", err)
+	}
+
+	return apiServer.CheckAccess(ctx, a.config.Namespace, diagnostic.DefaultAccessChecks)
+}
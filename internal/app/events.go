@@ -0,0 +1,32 @@
+package app
+
+import (
+	"go.uber.org/zap"
+)
+
+// SetEventLimit changes the number of most recent events fetched per refresh
+// (0 = all events) without restarting. Cached data is dropped so the next
+// GetClusterData call fetches events with the new limit.
+func (a *App) SetEventLimit(limit int) error {
+	a.logger.Info("Changing event limit", zap.Int("limit", limit))
+
+	a.mu.Lock()
+	a.config.EventLimit = limit
+	a.mu.Unlock()
+
+	dataSource, ttlCache, _ := a.sources()
+	if dataSource != nil {
+		dataSource.SetEventLimit(limit)
+	}
+	if ttlCache != nil {
+		return ttlCache.Invalidate()
+	}
+	return nil
+}
+
+// eventLimit returns the number of events fetched per refresh (0 = all)
+func (a *App) eventLimit() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.config.EventLimit
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"go.uber.org/zap"
+)
+
+// SetNamespace changes the namespace cluster data is fetched for ("" = all
+// namespaces) without restarting. Cached data of the previous scope is dropped
+// so the next GetClusterData call fetches the new scope.
+func (a *App) SetNamespace(namespace string) error {
+	a.logger.Info("Switching namespace scope", zap.String("namespace", namespace))
+
+	a.mu.Lock()
+	a.config.Namespace = namespace
+	a.mu.Unlock()
+
+	_, ttlCache, refresher := a.sources()
+	if ttlCache != nil {
+		if err := ttlCache.Invalidate(); err != nil {
+			return err
+		}
+	}
+	if refresher != nil {
+		refresher.SetNamespace(namespace)
+	}
+	return nil
+}
+
+// activeNamespace returns the namespace scope selected via flag, config or UI
+func (a *App) activeNamespace() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.config.Namespace
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/replay"
+	"go.uber.org/zap"
+)
+
+// Record fetches cluster data every refresh interval and writes each snapshot
+// to out until count frames were recorded (0 = unlimited) or ctx is cancelled.
+// It returns the number of frames written.
+func (a *App) Record(ctx context.Context, out io.Writer, count int) (int, error) {
+	a.logger.Info("Recording cluster snapshots",
+		zap.String("context", a.config.Context),
+		zap.String("namespace", a.config.Namespace),
+		zap.Duration("interval", a.config.RefreshInterval),
+		zap.Int("count", count),
+	)
+
+	if err := a.initDataSources(); err != nil {
+		return 0, fmt.Errorf("failed to initialize data sources: %w", err)
+	}
+
+	recorder := replay.NewRecorder(out)
+	ticker := time.NewTicker(a.config.RefreshInterval)
+	defer ticker.Stop()
+
+	recorded := 0
+	for {
+		data, err := a.dataSource.GetClusterDataWithTimeout(ctx, a.config.Namespace, a.config.Timeout)
+		if err != nil {
+			if ctx.Err() != nil {
+				return recorded, nil
+			}
+			return recorded, fmt.Errorf("failed to get cluster data: %w", err)
+		}
+		if err := recorder.Record(time.Now(), data); err != nil {
+			return recorded, err
+		}
+		recorded++
+		a.logger.Debug("Recorded cluster snapshot", zap.Int("frame", recorded))
+
+		if count > 0 && recorded >= count {
+			return recorded, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return recorded, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Replay runs the UI against a recording made by Record instead of a live
+// cluster. Frames are played back at the pace they were recorded.
+func (a *App) Replay(path string) error {
+	provider, err := replay.NewFileReplayProvider(path)
+	if err != nil {
+		return err
+	}
+
+	a.logger.Info("Replaying cluster recording",
+		zap.String("version", a.version),
+		zap.String("file", path),
+		zap.Int("frames", provider.Len()),
+		zap.Duration("refresh_interval", a.config.RefreshInterval),
+	)
+
+	if err := a.startUI(provider); err != nil {
+		return fmt.Errorf("failed to start UI: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"time"
+)
+
+// SetRefreshInterval changes how often the background refresher fetches
+// cluster data, so the interval of the view on screen also sets the load on
+// the API server and kubelets
+func (a *App) SetRefreshInterval(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	a.mu.Lock()
+	a.config.RefreshInterval = interval
+	a.mu.Unlock()
+
+	_, _, refresher := a.sources()
+	if refresher != nil {
+		refresher.SetInterval(interval)
+	}
+}
+
+// activeRefreshInterval returns the interval of the view on screen, or the
+// configured one before the UI set it
+func (a *App) activeRefreshInterval() time.Duration {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.config.RefreshInterval
+}
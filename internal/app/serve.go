@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/yourusername/k8s-monitor/internal/exporter"
+	"go.uber.org/zap"
+)
+
+// serveShutdownTimeout bounds how long in-flight scrapes may take on shutdown
+const serveShutdownTimeout = 5 * time.Second
+
+// Serve runs the background refresher without the UI and exposes the cluster
+// summary as Prometheus metrics on addr until ctx is cancelled
+func (a *App) Serve(ctx context.Context, addr string) error {
+	a.logger.Info("Starting k8s-monitor metrics server",
+		zap.String("version", a.version),
+		zap.String("addr", addr),
+		zap.String("context", a.config.Context),
+		zap.String("namespace", a.config.Namespace),
+		zap.Duration("refresh_interval", a.config.RefreshInterval),
+	)
+
+	// Initialize data sources
+	if err := a.initDataSources(); err != nil {
+		return fmt.Errorf("failed to initialize data sources: %w", err)
+	}
+
+	// Start background refresh so scrapes are served from the cache
+	if err := a.refresher.Start(); err != nil {
+		return fmt.Errorf("failed to start refresher: %w", err)
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		exporter.NewCollector(a.GetClusterData, a.logger),
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "ok")
+	})
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errChan:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("metrics server error: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		a.logger.Info("Shutting down metrics server")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("failed to shut down metrics server: %w", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// SnapshotReport is the machine-readable result of a one-shot snapshot
+type SnapshotReport struct {
+	Timestamp      time.Time             `json:"timestamp"`
+	Context        string                `json:"context,omitempty"`
+	Namespace      string                `json:"namespace,omitempty"`
+	Summary        *model.ClusterSummary `json:"summary"`
+	Alerts         []model.Alert         `json:"alerts"`
+	CriticalAlerts int                   `json:"criticalAlerts"`
+	WarningAlerts  int                   `json:"warningAlerts"`
+}
+
+// Snapshot fetches cluster data once, without starting the refresher or the UI
+func (a *App) Snapshot(ctx context.Context) (*SnapshotReport, error) {
+	if err := a.initDataSources(); err != nil {
+		return nil, fmt.Errorf("failed to initialize data sources: %w", err)
+	}
+
+	data, err := a.dataSource.GetClusterData(ctx, a.config.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster data: %w", err)
+	}
+
+	return NewSnapshotReport(data, a.config.Context, a.config.Namespace), nil
+}
+
+// NewSnapshotReport builds a report from cluster data. Alerts are moved out of
+// the summary into a top-level list so they are not serialized twice.
+func NewSnapshotReport(data *model.ClusterData, kubeContext, namespace string) *SnapshotReport {
+	report := &SnapshotReport{
+		Timestamp: time.Now(),
+		Context:   kubeContext,
+		Namespace: namespace,
+		Alerts:    []model.Alert{},
+	}
+
+	if data == nil || data.Summary == nil {
+		return report
+	}
+
+	summary := *data.Summary
+	report.Alerts = append(report.Alerts, summary.Alerts...)
+	summary.Alerts = nil
+	report.Summary = &summary
+
+	for _, alert := range report.Alerts {
+		switch alert.Severity {
+		case model.AlertSeverityCritical:
+			report.CriticalAlerts++
+		case model.AlertSeverityWarning:
+			report.WarningAlerts++
+		}
+	}
+
+	return report
+}
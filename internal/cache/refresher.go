@@ -16,6 +16,7 @@ type Refresher struct {
 	dataSource      *datasource.AggregatedDataSource
 	cache           *TTLCache
 	refreshInterval time.Duration
+	timeout         time.Duration // Bounds a whole refresh (0 = no limit)
 	namespace       string
 	logger          *zap.Logger
 
@@ -24,7 +25,9 @@ type Refresher struct {
 	wg         sync.WaitGroup
 	mu         sync.RWMutex
 	isRunning  bool
+	paused     bool // Ticks skip refreshing while paused (see Pause)
 	lastError  error
+	intervalCh chan struct{} // Signals run that SetInterval changed the interval
 	lastUpdate time.Time
 
 	// For rate calculation
@@ -50,6 +53,7 @@ func NewRefresher(
 		logger:          logger,
 		ctx:             ctx,
 		cancel:          cancel,
+		intervalCh:      make(chan struct{}, 1),
 	}
 }
 
@@ -104,7 +108,9 @@ func (r *Refresher) run() {
 	// Do initial refresh immediately
 	r.refresh()
 
+	r.mu.RLock()
 	ticker := time.NewTicker(r.refreshInterval)
+	r.mu.RUnlock()
 	defer ticker.Stop()
 
 	for {
@@ -113,8 +119,18 @@ func (r *Refresher) run() {
 			r.logger.Debug("Refresh loop exiting")
 			return
 
+		case <-r.intervalCh:
+			r.mu.RLock()
+			ticker.Reset(r.refreshInterval)
+			r.mu.RUnlock()
+
 		case <-ticker.C:
-			r.refresh()
+			r.mu.RLock()
+			paused := r.paused
+			r.mu.RUnlock()
+			if !paused {
+				r.refresh()
+			}
 		}
 	}
 }
@@ -125,7 +141,13 @@ func (r *Refresher) refresh() {
 
 	startTime := time.Now()
 
-	data, err := r.dataSource.GetClusterData(r.ctx, r.namespace)
+	r.mu.RLock()
+	namespace := r.namespace
+	timeout := r.timeout
+	r.mu.RUnlock()
+
+	// A stalled refresh fails with an error; the next tick retries
+	data, err := r.dataSource.GetClusterDataWithTimeout(r.ctx, namespace, timeout)
 	if err != nil {
 		r.mu.Lock()
 		r.lastError = err
@@ -138,6 +160,17 @@ func (r *Refresher) refresh() {
 		return
 	}
 
+	// Drop data of a namespace scope that was replaced while fetching
+	r.mu.RLock()
+	scopeChanged := namespace != r.namespace
+	r.mu.RUnlock()
+	if scopeChanged {
+		r.logger.Debug("Namespace changed during refresh, discarding data",
+			zap.String("namespace", namespace),
+		)
+		return
+	}
+
 	r.computeNetworkRates(data.Summary)
 
 	// Update cache
@@ -246,6 +279,7 @@ func (r *Refresher) GetStatus() RefresherStatus {
 
 	return RefresherStatus{
 		IsRunning:  r.isRunning,
+		Paused:     r.paused,
 		LastUpdate: r.lastUpdate,
 		LastError:  r.lastError,
 		Interval:   r.refreshInterval,
@@ -255,22 +289,56 @@ func (r *Refresher) GetStatus() RefresherStatus {
 // RefresherStatus represents the current state of the refresher
 type RefresherStatus struct {
 	IsRunning  bool
+	Paused     bool
 	LastUpdate time.Time
 	LastError  error
 	Interval   time.Duration
 }
 
-// SetInterval updates the refresh interval
+// SetInterval updates the refresh interval; a running refresher restarts its
+// timer so the new interval applies from now on
 func (r *Refresher) SetInterval(interval time.Duration) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if interval <= 0 || interval == r.refreshInterval {
+		return
+	}
+
 	r.logger.Info("Updating refresh interval",
 		zap.Duration("old_interval", r.refreshInterval),
 		zap.Duration("new_interval", interval),
 	)
 
 	r.refreshInterval = interval
+	select {
+	case r.intervalCh <- struct{}{}:
+	default: // A reset is already pending and will pick up this interval
+	}
+}
+
+// Pause stops the periodic refreshes until Resume, e.g. while the UI only
+// shows a single resource it fetches itself. RefreshNow still refreshes.
+func (r *Refresher) Pause() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.logger.Info("Pausing data refresher")
+	r.paused = true
+}
+
+// Resume restarts the periodic refreshes stopped by Pause
+func (r *Refresher) Resume() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.logger.Info("Resuming data refresher")
+	r.paused = false
+}
+
+// SetTimeout bounds how long a single refresh may take (0 = no limit)
+func (r *Refresher) SetTimeout(timeout time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.timeout = timeout
 }
 
 // SetNamespace updates the namespace filter
@@ -284,6 +352,9 @@ func (r *Refresher) SetNamespace(namespace string) {
 	)
 
 	r.namespace = namespace
+	// Network counters of another scope cannot be used for rates
+	r.lastSummary = nil
+	r.lastSample = time.Time{}
 
 	// Trigger immediate refresh with new namespace
 	go r.refresh()
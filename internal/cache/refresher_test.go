@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/datasource"
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+)
+
+// countingSource is a data source that counts how often nodes are listed,
+// i.e. how many refreshes ran
+type countingSource struct {
+	fetches atomic.Int32
+}
+
+func (s *countingSource) GetNodes(ctx context.Context) ([]*model.NodeData, error) {
+	s.fetches.Add(1)
+	return []*model.NodeData{}, nil
+}
+
+func (s *countingSource) GetPods(ctx context.Context, namespace string) ([]*model.PodData, error) {
+	return []*model.PodData{}, nil
+}
+
+func (s *countingSource) GetEvents(ctx context.Context, namespace string, eventTypes []string, limit int) ([]*model.EventData, error) {
+	return []*model.EventData{}, nil
+}
+
+func (s *countingSource) Name() string { return "Counting" }
+
+func (s *countingSource) Close() error { return nil }
+
+func newTestRefresher(t *testing.T, interval time.Duration) (*Refresher, *countingSource) {
+	t.Helper()
+	source := &countingSource{}
+	logger := zap.NewNop()
+	refresher := NewRefresher(datasource.NewAggregatedDataSource(source, nil, logger, 1),
+		NewTTLCache(time.Minute, logger), interval, "", logger)
+	if err := refresher.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	t.Cleanup(func() { refresher.Stop() })
+	return refresher, source
+}
+
+func TestRefresherSetIntervalResetsTimer(t *testing.T) {
+	refresher, source := newTestRefresher(t, time.Hour)
+
+	// Only the initial refresh runs at the hourly interval
+	time.Sleep(50 * time.Millisecond)
+	if got := source.fetches.Load(); got != 1 {
+		t.Fatalf("fetches before SetInterval = %d, want 1", got)
+	}
+
+	refresher.SetInterval(20 * time.Millisecond)
+	time.Sleep(150 * time.Millisecond)
+	if got := source.fetches.Load(); got < 3 {
+		t.Errorf("fetches after SetInterval(20ms) = %d, want the new interval to apply", got)
+	}
+	if got := refresher.GetStatus().Interval; got != 20*time.Millisecond {
+		t.Errorf("Interval = %v, want 20ms", got)
+	}
+}
+
+func TestRefresherPauseSkipsTicks(t *testing.T) {
+	refresher, source := newTestRefresher(t, 20*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+
+	refresher.Pause()
+	paused := source.fetches.Load()
+	time.Sleep(100 * time.Millisecond)
+	if got := source.fetches.Load(); got > paused+1 { // A refresh may have been in flight
+		t.Errorf("fetches while paused = %d, want at most %d", got, paused+1)
+	}
+	if !refresher.GetStatus().Paused {
+		t.Error("Paused = false after Pause")
+	}
+
+	refresher.Resume()
+	resumed := source.fetches.Load()
+	time.Sleep(100 * time.Millisecond)
+	if got := source.fetches.Load(); got <= resumed {
+		t.Errorf("fetches after Resume = %d, want more than %d", got, resumed)
+	}
+}
@@ -2,6 +2,7 @@ package datasource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"sync"
@@ -22,14 +23,32 @@ type AggregatedDataSource struct {
 	kubeletClient      *KubeletClient
 	volcanoClient      *VolcanoClient
 	npuExporterClient  *NPUExporterClient
+	crdClient          *CRDClient
 	logger             *zap.Logger
 	mu                 sync.RWMutex
 	maxConcurrent      int // Maximum concurrent kubelet queries
 	kubeletAccessMu    sync.RWMutex
 	kubeletAccess      *diagnostic.KubeletAccessStatus
 	kubeletSkipReason  string
+
+	// eventLimit caps the events fetched per refresh (0 = all events)
+	eventLimit int
+
+	// quotaWarning and quotaCritical are the percentages of a ResourceQuota
+	// hard limit at which namespace CPU/memory requests raise alerts
+	quotaWarning  float64
+	quotaCritical float64
 }
 
+// defaultEventLimit is the number of most recent events fetched per refresh
+const defaultEventLimit = 100
+
+// Default percentages of a quota hard limit that raise namespace quota alerts
+const (
+	defaultQuotaWarning  = 80.0
+	defaultQuotaCritical = 95.0
+)
+
 // NewAggregatedDataSource creates a new aggregated data source
 func NewAggregatedDataSource(apiServer DataSource, kubeletClient *KubeletClient, logger *zap.Logger, maxConcurrent int) *AggregatedDataSource {
 	if maxConcurrent <= 0 {
@@ -44,7 +63,58 @@ func NewAggregatedDataSource(apiServer DataSource, kubeletClient *KubeletClient,
 		kubeletClient:   kubeletClient,
 		logger:          logger,
 		maxConcurrent:   maxConcurrent,
+		eventLimit:      defaultEventLimit,
+		quotaWarning:    defaultQuotaWarning,
+		quotaCritical:   defaultQuotaCritical,
+	}
+}
+
+// SetEventLimit sets the number of most recent events fetched per refresh;
+// 0 fetches all events. Negative limits restore the default.
+func (a *AggregatedDataSource) SetEventLimit(limit int) {
+	if limit < 0 {
+		limit = defaultEventLimit
+	}
+	a.mu.Lock()
+	a.eventLimit = limit
+	a.mu.Unlock()
+}
+
+// EventLimit returns the number of events fetched per refresh (0 = all)
+func (a *AggregatedDataSource) EventLimit() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.eventLimit
+}
+
+// SetQuotaAlertThresholds sets the percentages of a ResourceQuota hard limit
+// at which namespace CPU/memory requests raise warning and critical alerts.
+// Non-positive values restore the defaults.
+func (a *AggregatedDataSource) SetQuotaAlertThresholds(warning, critical float64) {
+	if warning <= 0 {
+		warning = defaultQuotaWarning
+	}
+	if critical <= 0 {
+		critical = defaultQuotaCritical
+	}
+	a.mu.Lock()
+	a.quotaWarning, a.quotaCritical = warning, critical
+	a.mu.Unlock()
+}
+
+// quotaAlertThresholds returns the warning and critical quota alert
+// thresholds, falling back to the defaults when unset
+func (a *AggregatedDataSource) quotaAlertThresholds() (warning, critical float64) {
+	a.mu.RLock()
+	warning, critical = a.quotaWarning, a.quotaCritical
+	a.mu.RUnlock()
+	if warning <= 0 {
+		warning = defaultQuotaWarning
+	}
+	if critical <= 0 {
+		critical = defaultQuotaCritical
 	}
+	return warning, critical
 }
 
 // SetVolcanoClient sets the Volcano client for the data source
@@ -52,11 +122,33 @@ func (a *AggregatedDataSource) SetVolcanoClient(volcanoClient *VolcanoClient) {
 	a.volcanoClient = volcanoClient
 }
 
+// SetCRDClient sets the CRD client for the data source, enabling the CRD
+// summary
+func (a *AggregatedDataSource) SetCRDClient(crdClient *CRDClient) {
+	a.crdClient = crdClient
+}
+
 // SetNPUExporterClient sets the NPU-Exporter client for the data source
 func (a *AggregatedDataSource) SetNPUExporterClient(npuExporterClient *NPUExporterClient) {
 	a.npuExporterClient = npuExporterClient
 }
 
+// GetClusterDataWithTimeout is GetClusterData bounded by timeout (0 = no
+// limit), so a stalled API call fails the refresh instead of blocking it
+func (a *AggregatedDataSource) GetClusterDataWithTimeout(ctx context.Context, namespace string, timeout time.Duration) (*model.ClusterData, error) {
+	if timeout <= 0 {
+		return a.GetClusterData(ctx, namespace)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	data, err := a.GetClusterData(ctx, namespace)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("cluster data refresh timed out after %s: %w", timeout, err)
+	}
+	return data, err
+}
+
 // GetNodes retrieves nodes from API Server
 func (a *AggregatedDataSource) GetNodes(ctx context.Context) ([]*model.NodeData, error) {
 	return a.apiServer.GetNodes(ctx)
@@ -87,7 +179,7 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 		return nil, fmt.Errorf("failed to get pods: %w", err)
 	}
 
-	events, err := a.apiServer.GetEvents(ctx, namespace, []string{"Normal", "Warning"}, 100)
+	events, err := a.apiServer.GetEvents(ctx, namespace, []string{"Normal", "Warning"}, a.EventLimit())
 	if err != nil {
 		a.logger.Warn("Failed to get events, continuing without them",
 			zap.Error(err),
@@ -99,11 +191,16 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 	var services []*model.ServiceData
 	var pvs []*model.PVData
 	var pvcs []*model.PVCData
+	var storageClasses []*model.StorageClassData
 	var deployments []*model.DeploymentData
 	var statefulsets []*model.StatefulSetData
 	var daemonsets []*model.DaemonSetData
 	var jobs []*model.JobData
 	var cronjobs []*model.CronJobData
+	var quotas []*model.QuotaData
+	var limitRanges []*model.LimitRangeData
+	var pdbs []*model.PDBData
+	var serverVersion string
 
 	if apiServerClient, ok := a.apiServer.(*APIServerClient); ok {
 		services, err = apiServerClient.GetServices(ctx, namespace)
@@ -124,6 +221,12 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 			pvcs = []*model.PVCData{}
 		}
 
+		storageClasses, err = apiServerClient.GetStorageClasses(ctx)
+		if err != nil {
+			a.logger.Warn("Failed to get storage classes, continuing without them", zap.Error(err))
+			storageClasses = []*model.StorageClassData{}
+		}
+
 		deployments, err = apiServerClient.GetDeployments(ctx, namespace)
 		if err != nil {
 			a.logger.Warn("Failed to get deployments, continuing without them", zap.Error(err))
@@ -153,8 +256,34 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 			a.logger.Warn("Failed to get cronjobs, continuing without them", zap.Error(err))
 			cronjobs = []*model.CronJobData{}
 		}
+
+		quotas, err = apiServerClient.GetResourceQuotas(ctx, namespace)
+		if err != nil {
+			a.logger.Warn("Failed to get resource quotas, continuing without them", zap.Error(err))
+			quotas = []*model.QuotaData{}
+		}
+
+		limitRanges, err = apiServerClient.GetLimitRanges(ctx, namespace)
+		if err != nil {
+			a.logger.Warn("Failed to get limit ranges, continuing without them", zap.Error(err))
+			limitRanges = []*model.LimitRangeData{}
+		}
+
+		pdbs, err = apiServerClient.GetPDBs(ctx, namespace)
+		if err != nil {
+			a.logger.Warn("Failed to get pod disruption budgets, continuing without them", zap.Error(err))
+			pdbs = []*model.PDBData{}
+		}
+
+		serverVersion, err = apiServerClient.GetServerVersion(ctx)
+		if err != nil {
+			a.logger.Warn("Failed to get server version, continuing without it", zap.Error(err))
+		}
 	}
 
+	// Pod density comes from the pod list, so it is known without kubelet metrics
+	countNodePods(nodes, pods)
+
 	// Enrich with kubelet metrics if available
 	if a.kubeletClient != nil {
 		if skip, reason := a.shouldSkipKubeletEnrichment(ctx); skip {
@@ -168,6 +297,9 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 		}
 	}
 
+	// Volume usage was collected per pod; attach it to the claims and volumes
+	applyVolumeUsage(pods, pvcs, pvs)
+
 	// Enrich with NPU-Exporter metrics if available
 	if a.npuExporterClient != nil {
 		if err := a.npuExporterClient.EnrichNodeData(ctx, nodes); err != nil {
@@ -176,7 +308,7 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 	}
 
 	// Build cluster summary
-	summary := a.buildClusterSummary(nodes, pods, events, services, pvs, pvcs)
+	summary := a.buildClusterSummary(nodes, pods, events, services, pvs, pvcs, quotas)
 
 	// Fetch Volcano data if client is available
 	var volcanoJobs []*model.VolcanoJobData
@@ -191,6 +323,7 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 		if vcErr != nil {
 			a.logger.Warn("Failed to get Volcano jobs", zap.Error(vcErr))
 		}
+		applyVolcanoPreemption(volcanoJobs, pods, events)
 
 		hyperNodes, vcErr = a.volcanoClient.GetHyperNodes(ctx)
 		if vcErr != nil {
@@ -205,6 +338,22 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 		volcanoSummary = a.volcanoClient.BuildVolcanoSummary(volcanoJobs, hyperNodes, queues)
 	}
 
+	// Fetch CRDs if enabled
+	var crds []*model.CRDData
+	if a.crdClient != nil {
+		var crdErr error
+		crds, crdErr = a.crdClient.GetCRDs(ctx)
+		if crdErr != nil {
+			a.logger.Warn("Failed to get CRDs", zap.Error(crdErr))
+		}
+	}
+
+	// Fetches that failed because the context ended were skipped above, so
+	// the data is incomplete and must not replace a complete refresh
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("cluster data incomplete: %w", err)
+	}
+
 	clusterData := &model.ClusterData{
 		Nodes:          nodes,
 		Pods:           pods,
@@ -212,16 +361,22 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 		Services:       services,
 		PVs:            pvs,
 		PVCs:           pvcs,
+		StorageClasses: storageClasses,
 		Deployments:    deployments,
 		StatefulSets:   statefulsets,
 		DaemonSets:     daemonsets,
 		Jobs:           jobs,
 		CronJobs:       cronjobs,
 		Summary:        summary,
+		ServerVersion:  serverVersion,
+		ResourceQuotas: quotas,
+		LimitRanges:    limitRanges,
+		PDBs:           pdbs,
 		VolcanoJobs:    volcanoJobs,
 		HyperNodes:     hyperNodes,
 		Queues:         queues,
 		VolcanoSummary: volcanoSummary,
+		CRDs:           crds,
 	}
 
 	a.logger.Info("Cluster data fetched successfully",
@@ -231,6 +386,7 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 		zap.Int("services", len(services)),
 		zap.Int("pvs", len(pvs)),
 		zap.Int("pvcs", len(pvcs)),
+		zap.Int("storageClasses", len(storageClasses)),
 		zap.Int("deployments", len(deployments)),
 		zap.Int("statefulsets", len(statefulsets)),
 		zap.Int("daemonsets", len(daemonsets)),
@@ -243,10 +399,30 @@ func (a *AggregatedDataSource) GetClusterData(ctx context.Context, namespace str
 	return clusterData, nil
 }
 
+// countNodePods sets the pods running on each node and their share of the
+// node's allocatable pods. Succeeded and Failed pods are left out, as they no
+// longer count against the kubelet's max pods.
+func countNodePods(nodes []*model.NodeData, pods []*model.PodData) {
+	counts := make(map[string]int, len(nodes))
+	for _, pod := range pods {
+		if pod.Node != "" && pod.Phase != "Succeeded" && pod.Phase != "Failed" {
+			counts[pod.Node]++
+		}
+	}
+	for _, node := range nodes {
+		node.PodCount = counts[node.Name]
+		node.PodUsagePercent = 0
+		if node.PodAllocatable > 0 {
+			node.PodUsagePercent = float64(node.PodCount) / float64(node.PodAllocatable) * 100
+		}
+	}
+}
+
 // enrichWithKubeletMetrics enriches node and pod data with kubelet metrics
 func (a *AggregatedDataSource) enrichWithKubeletMetrics(ctx context.Context, nodes []*model.NodeData, pods []*model.PodData) {
 	startTime := time.Now()
 	a.logger.Debug("Enriching data with kubelet metrics", zap.Int("node_count", len(nodes)))
+	a.kubeletClient.SetNodeAddresses(nodes)
 
 	// Create pod lookup map by node
 	podsByNode := make(map[string][]*model.PodData)
@@ -267,18 +443,29 @@ func (a *AggregatedDataSource) enrichWithKubeletMetrics(ctx context.Context, nod
 		go func(n *model.NodeData) {
 			defer wg.Done()
 
-			// Acquire semaphore
-			sem <- struct{}{}
+			// Acquire semaphore, giving up on the node once the refresh is cancelled
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				a.mu.Lock()
+				n.HasKubeletMetrics = false
+				n.KubeletError = ctx.Err().Error()
+				a.mu.Unlock()
+				return
+			}
 			defer func() { <-sem }() // Release semaphore
 
 			nodeStartTime := time.Now()
 
-			// Get node metrics (including network)
+			// Get node metrics (including network). Queries are bounded by the
+			// kubelet timeout, so a slow node is skipped instead of holding its
+			// semaphore slot until the whole refresh times out.
 			cpuMillicores, memoryBytes, networkRx, networkTx, networkTimestamp, err := a.kubeletClient.GetNodeMetrics(ctx, n.Name)
 			if err != nil {
 				a.mu.Lock()
 				n.HasKubeletMetrics = false
 				n.KubeletError = err.Error()
+				n.KubeletTimedOut = errors.Is(err, ErrKubeletTimeout)
 				n.CPUUsage = 0
 				n.MemoryUsage = 0
 				n.NetworkRxBytes = 0
@@ -296,27 +483,7 @@ func (a *AggregatedDataSource) enrichWithKubeletMetrics(ctx context.Context, nod
 
 			// Update node data
 			a.mu.Lock()
-			n.CPUUsage = cpuMillicores
-			n.MemoryUsage = memoryBytes
-			n.NetworkRxBytes = networkRx
-			n.NetworkTxBytes = networkTx
-			n.NetworkTimestamp = networkTimestamp
-			n.HasKubeletMetrics = true
-			n.KubeletError = ""
-
-			// Calculate usage percentages
-			if n.CPUAllocatable > 0 {
-				n.CPUUsagePercent = float64(cpuMillicores) / float64(n.CPUAllocatable) * 100
-			}
-			if n.MemAllocatable > 0 {
-				n.MemoryUsagePercent = float64(memoryBytes) / float64(n.MemAllocatable) * 100
-			}
-
-			// Count pods on this node
-			n.PodCount = len(podsByNode[n.Name])
-			if n.PodAllocatable > 0 {
-				n.PodUsagePercent = float64(n.PodCount) / float64(n.PodAllocatable) * 100
-			}
+			setNodeUsage(n, cpuMillicores, memoryBytes, networkRx, networkTx, networkTimestamp)
 			a.mu.Unlock()
 
 			// Get pod metrics on this node
@@ -340,24 +507,7 @@ func (a *AggregatedDataSource) enrichWithKubeletMetrics(ctx context.Context, nod
 			for _, pod := range podsByNode[n.Name] {
 				key := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
 				if metrics, ok := podMetricsMap[key]; ok {
-					pod.CPUUsage = metrics.CPUUsage
-					pod.MemoryUsage = metrics.MemoryUsage
-					pod.NetworkRxBytes = metrics.NetworkRxBytes
-					pod.NetworkTxBytes = metrics.NetworkTxBytes
-					pod.NetworkTimestamp = metrics.NetworkTimestamp
-
-					// Update container-level metrics by matching container names
-					for i := range pod.ContainerStates {
-						containerName := pod.ContainerStates[i].Name
-						// Find matching container in metrics
-						for _, metricContainer := range metrics.ContainerStates {
-							if metricContainer.Name == containerName {
-								pod.ContainerStates[i].CPUUsage = metricContainer.CPUUsage
-								pod.ContainerStates[i].MemoryUsage = metricContainer.MemoryUsage
-								break
-							}
-						}
-					}
+					setPodUsage(pod, metrics)
 				}
 			}
 			a.mu.Unlock()
@@ -376,8 +526,76 @@ func (a *AggregatedDataSource) enrichWithKubeletMetrics(ctx context.Context, nod
 	)
 }
 
+// setNodeUsage stores kubelet usage metrics on a node and derives its usage percentages
+func setNodeUsage(n *model.NodeData, cpuMillicores, memoryBytes, networkRx, networkTx int64, networkTimestamp time.Time) {
+	n.CPUUsage = cpuMillicores
+	n.MemoryUsage = memoryBytes
+	n.NetworkRxBytes = networkRx
+	n.NetworkTxBytes = networkTx
+	n.NetworkTimestamp = networkTimestamp
+	n.HasKubeletMetrics = true
+	n.KubeletError = ""
+	n.KubeletTimedOut = false
+
+	// Calculate usage percentages
+	if n.CPUAllocatable > 0 {
+		n.CPUUsagePercent = float64(cpuMillicores) / float64(n.CPUAllocatable) * 100
+	}
+	if n.MemAllocatable > 0 {
+		n.MemoryUsagePercent = float64(memoryBytes) / float64(n.MemAllocatable) * 100
+	}
+}
+
+// setPodUsage copies kubelet usage metrics onto a pod and its containers
+func setPodUsage(pod, metrics *model.PodData) {
+	pod.CPUUsage = metrics.CPUUsage
+	pod.MemoryUsage = metrics.MemoryUsage
+	pod.NetworkRxBytes = metrics.NetworkRxBytes
+	pod.NetworkTxBytes = metrics.NetworkTxBytes
+	pod.NetworkTimestamp = metrics.NetworkTimestamp
+	pod.PVCUsage = metrics.PVCUsage
+
+	// Update container-level metrics by matching container names
+	for i := range pod.ContainerStates {
+		containerName := pod.ContainerStates[i].Name
+		// Find matching container in metrics
+		for _, metricContainer := range metrics.ContainerStates {
+			if metricContainer.Name == containerName {
+				pod.ContainerStates[i].CPUUsage = metricContainer.CPUUsage
+				pod.ContainerStates[i].MemoryUsage = metricContainer.MemoryUsage
+				break
+			}
+		}
+	}
+}
+
+// applyVolumeUsage copies the PVC volume usage kubelet reported for pods onto
+// the claims, and onto the volumes bound to them
+func applyVolumeUsage(pods []*model.PodData, pvcs []*model.PVCData, pvs []*model.PVData) {
+	usage := make(map[string]model.VolumeUsage)
+	for _, pod := range pods {
+		for claim, u := range pod.PVCUsage {
+			usage[pod.Namespace+"/"+claim] = u
+		}
+	}
+
+	for _, pvc := range pvcs {
+		pvc.Usage, pvc.UsedBytes = nil, 0
+		if u, ok := usage[pvc.Namespace+"/"+pvc.Name]; ok {
+			pvc.Usage = &u
+			pvc.UsedBytes = u.UsedBytes
+		}
+	}
+	for _, pv := range pvs {
+		pv.Usage = nil
+		if u, ok := usage[pv.Claim]; ok {
+			pv.Usage = &u
+		}
+	}
+}
+
 // buildClusterSummary builds cluster summary statistics
-func (a *AggregatedDataSource) buildClusterSummary(nodes []*model.NodeData, pods []*model.PodData, events []*model.EventData, services []*model.ServiceData, pvs []*model.PVData, pvcs []*model.PVCData) *model.ClusterSummary {
+func (a *AggregatedDataSource) buildClusterSummary(nodes []*model.NodeData, pods []*model.PodData, events []*model.EventData, services []*model.ServiceData, pvs []*model.PVData, pvcs []*model.PVCData, quotas []*model.QuotaData) *model.ClusterSummary {
 	summary := &model.ClusterSummary{
 		TotalNodes: len(nodes),
 		TotalPods:  len(pods),
@@ -393,6 +611,14 @@ func (a *AggregatedDataSource) buildClusterSummary(nodes []*model.NodeData, pods
 		}
 	}
 
+	// Build a map for GPU allocation per node (from running/pending pods)
+	gpuAllocatedPerNode := make(map[string]int64)
+	for _, pod := range pods {
+		if pod.Node != "" && (pod.Phase == "Running" || pod.Phase == "Pending") && pod.GPURequest > 0 {
+			gpuAllocatedPerNode[pod.Node] += pod.GPURequest
+		}
+	}
+
 	// Track unique SuperPod IDs for counting
 	superPodIDs := make(map[string]struct{})
 	hyperNodeIDs := make(map[string]struct{})
@@ -438,6 +664,9 @@ func (a *AggregatedDataSource) buildClusterSummary(nodes []*model.NodeData, pods
 			summary.NodesWithMetrics++
 		} else {
 			summary.NodesWithoutMetrics++
+			if node.KubeletTimedOut {
+				summary.KubeletTimeouts++
+			}
 			if node.KubeletError != "" {
 				if _, exists := errorSet[node.KubeletError]; !exists {
 					summary.KubeletErrors = append(summary.KubeletErrors, node.KubeletError)
@@ -446,6 +675,20 @@ func (a *AggregatedDataSource) buildClusterSummary(nodes []*model.NodeData, pods
 			}
 		}
 
+		// Sum up GPU (NVIDIA) resources
+		if node.GPUCapacity > 0 {
+			summary.GPUCapacity += node.GPUCapacity
+			summary.GPUAllocatable += node.GPUAllocatable
+			summary.GPUNodesCount++
+			if summary.GPUProduct == "" && node.GPUProduct != "" {
+				summary.GPUProduct = node.GPUProduct
+			}
+
+			// Update node's GPU allocated count from pod requests
+			node.GPUAllocated = gpuAllocatedPerNode[node.Name]
+			summary.GPUAllocated += node.GPUAllocated
+		}
+
 		// Sum up NPU (Ascend AI accelerator) resources
 		if node.NPUCapacity > 0 {
 			summary.NPUCapacity += node.NPUCapacity
@@ -550,6 +793,10 @@ func (a *AggregatedDataSource) buildClusterSummary(nodes []*model.NodeData, pods
 					lastTerminatedReason = "Error"
 				}
 			}
+			// Restarted containers report why their previous instance ended
+			if lastTerminatedReason == "" && container.LastTermination != nil {
+				lastTerminatedReason = container.LastTermination.Reason
+			}
 		}
 
 		// Count pod anomalies (avoid double-counting)
@@ -710,10 +957,21 @@ func (a *AggregatedDataSource) buildClusterSummary(nodes []*model.NodeData, pods
 	if summary.PodAllocatable > 0 {
 		summary.PodUtilization = float64(summary.TotalPods) / float64(summary.PodAllocatable) * 100
 	}
+	if summary.CPURequested > 0 && summary.CPUUsed > 0 {
+		summary.CPURequestEfficiency = float64(summary.CPUUsed) / float64(summary.CPURequested) * 100
+	}
+	if summary.MemoryRequested > 0 && summary.MemoryUsed > 0 {
+		summary.MemRequestEfficiency = float64(summary.MemoryUsed) / float64(summary.MemoryRequested) * 100
+	}
 	if summary.TotalStorageSize > 0 {
 		summary.StorageUsagePercent = float64(summary.UsedStorageSize) / float64(summary.TotalStorageSize) * 100
 	}
 
+	// Calculate GPU utilization
+	if summary.GPUAllocatable > 0 {
+		summary.GPUUtilization = float64(summary.GPUAllocated) / float64(summary.GPUAllocatable) * 100
+	}
+
 	// Calculate NPU utilization and topology statistics
 	if summary.NPUAllocatable > 0 {
 		summary.NPUUtilization = float64(summary.NPUAllocated) / float64(summary.NPUAllocatable) * 100
@@ -722,7 +980,7 @@ func (a *AggregatedDataSource) buildClusterSummary(nodes []*model.NodeData, pods
 	summary.SuperPodCount = len(superPodIDs)
 
 	// Collect alerts based on thresholds
-	summary.Alerts = a.collectAlerts(nodes, pods, services, pvcs, summary)
+	summary.Alerts = a.collectAlerts(nodes, pods, services, pvcs, quotas, summary)
 
 	return summary
 }
@@ -872,7 +1130,7 @@ func (a *AggregatedDataSource) Close() error {
 }
 
 // collectAlerts generates alerts based on cluster state and thresholds
-func (a *AggregatedDataSource) collectAlerts(nodes []*model.NodeData, pods []*model.PodData, services []*model.ServiceData, pvcs []*model.PVCData, summary *model.ClusterSummary) []model.Alert {
+func (a *AggregatedDataSource) collectAlerts(nodes []*model.NodeData, pods []*model.PodData, services []*model.ServiceData, pvcs []*model.PVCData, quotas []*model.QuotaData, summary *model.ClusterSummary) []model.Alert {
 	alerts := make([]model.Alert, 0)
 	now := time.Now()
 
@@ -1158,6 +1416,9 @@ func (a *AggregatedDataSource) collectAlerts(nodes []*model.NodeData, pods []*mo
 		}
 	}
 
+	// Namespace quota alerts
+	alerts = append(alerts, a.collectQuotaAlerts(quotas, now)...)
+
 	// Sort alerts by priority (using diagnostic.GetAlertPriority)
 	sort.Slice(alerts, func(i, j int) bool {
 		priI := diagnostic.GetAlertPriority(alerts[i].AlertType, alerts[i].Severity)
@@ -1168,10 +1429,165 @@ func (a *AggregatedDataSource) collectAlerts(nodes []*model.NodeData, pods []*mo
 	return alerts
 }
 
+// collectQuotaAlerts raises an alert for each namespace whose CPU or memory
+// requests exceed the quota thresholds of a ResourceQuota hard limit. When
+// several quotas of a namespace limit the same resource, the fullest counts.
+func (a *AggregatedDataSource) collectQuotaAlerts(quotas []*model.QuotaData, now time.Time) []model.Alert {
+	warning, critical := a.quotaAlertThresholds()
+
+	type quotaPressure struct {
+		namespace string
+		alertType model.AlertType
+		label     string
+		quota     string
+		resource  model.QuotaResource
+	}
+	var pressures []*quotaPressure
+	fullest := make(map[string]*quotaPressure)
+	for _, quota := range quotas {
+		for _, res := range quota.Resources {
+			var alertType model.AlertType
+			var label string
+			switch res.Name {
+			case "requests.cpu", "cpu":
+				alertType, label = model.AlertTypeNamespaceQuotaCPU, "CPU"
+			case "requests.memory", "memory":
+				alertType, label = model.AlertTypeNamespaceQuotaMemory, "Memory"
+			default:
+				continue
+			}
+			if res.UsagePercent() < warning {
+				continue
+			}
+			key := quota.Namespace + "/" + string(alertType)
+			if p, ok := fullest[key]; ok {
+				if res.UsagePercent() > p.resource.UsagePercent() {
+					p.quota, p.resource = quota.Name, res
+				}
+				continue
+			}
+			p := &quotaPressure{namespace: quota.Namespace, alertType: alertType, label: label, quota: quota.Name, resource: res}
+			fullest[key] = p
+			pressures = append(pressures, p)
+		}
+	}
+
+	alerts := make([]model.Alert, 0, len(pressures))
+	for _, p := range pressures {
+		percent := p.resource.UsagePercent()
+		severity, threshold := model.AlertSeverityWarning, warning
+		if percent >= critical {
+			severity, threshold = model.AlertSeverityCritical, critical
+		}
+		alerts = append(alerts, model.Alert{
+			Severity:          severity,
+			Category:          "Resource",
+			AlertType:         p.alertType,
+			ResourceType:      "Namespace",
+			ResourceName:      p.namespace,
+			Message:           fmt.Sprintf("%s requests near quota %s (%s of %s)", p.label, p.quota, p.resource.Used, p.resource.Hard),
+			Value:             fmt.Sprintf("%.1f%%", percent),
+			Threshold:         fmt.Sprintf("%.0f%%", threshold),
+			RecommendedAction: diagnostic.GetRecommendedAction(p.alertType, "", p.namespace),
+			Timestamp:         now,
+		})
+	}
+	return alerts
+}
+
 // GetPodLogs retrieves logs for a specific pod and container
-func (a *AggregatedDataSource) GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64) (string, error) {
+func (a *AggregatedDataSource) GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64, timestamps bool) (string, error) {
 	if a.apiServerClient == nil {
 		return "", fmt.Errorf("API server client not available")
 	}
-	return a.apiServerClient.GetPodLogs(ctx, namespace, podName, containerName, tailLines)
+	return a.apiServerClient.GetPodLogs(ctx, namespace, podName, containerName, tailLines, timestamps)
+}
+
+// GetNode fetches a single node with kubelet usage metrics when available,
+// without reloading the rest of the cluster
+func (a *AggregatedDataSource) GetNode(ctx context.Context, name string) (*model.NodeData, error) {
+	if a.apiServerClient == nil {
+		return nil, fmt.Errorf("API server client not available")
+	}
+	node, err := a.apiServerClient.GetNode(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if skip, _ := a.shouldSkipKubeletEnrichment(ctx); a.kubeletClient != nil && !skip {
+		a.kubeletClient.SetNodeAddresses([]*model.NodeData{node})
+		cpuMillicores, memoryBytes, networkRx, networkTx, networkTimestamp, err := a.kubeletClient.GetNodeMetrics(ctx, name)
+		if err != nil {
+			node.KubeletError = err.Error()
+			node.KubeletTimedOut = errors.Is(err, ErrKubeletTimeout)
+		} else {
+			setNodeUsage(node, cpuMillicores, memoryBytes, networkRx, networkTx, networkTimestamp)
+		}
+	}
+	return node, nil
+}
+
+// GetPod fetches a single pod with kubelet usage metrics when available,
+// without reloading the rest of the cluster
+func (a *AggregatedDataSource) GetPod(ctx context.Context, namespace, name string) (*model.PodData, error) {
+	if a.apiServerClient == nil {
+		return nil, fmt.Errorf("API server client not available")
+	}
+	pod, err := a.apiServerClient.GetPod(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if skip, _ := a.shouldSkipKubeletEnrichment(ctx); a.kubeletClient != nil && !skip && pod.Node != "" {
+		podMetricsMap, err := a.kubeletClient.GetAllPodMetricsOnNode(ctx, pod.Node)
+		if err != nil {
+			a.logger.Debug("Failed to get pod metrics",
+				zap.String("pod", namespace+"/"+name),
+				zap.Error(err),
+			)
+		} else if metrics, ok := podMetricsMap[namespace+"/"+name]; ok {
+			setPodUsage(pod, metrics)
+		}
+	}
+	return pod, nil
+}
+
+// GetObjectEvents returns the most recent events of a single object
+func (a *AggregatedDataSource) GetObjectEvents(ctx context.Context, kind, namespace, name string, limit int) ([]*model.EventData, error) {
+	if a.apiServerClient == nil {
+		return nil, fmt.Errorf("API server client not available")
+	}
+	return a.apiServerClient.GetObjectEvents(ctx, kind, namespace, name, limit)
+}
+
+// GetResourceYAML returns the live object of the given kind as YAML
+func (a *AggregatedDataSource) GetResourceYAML(ctx context.Context, kind, namespace, name string) (string, error) {
+	if a.apiServerClient == nil {
+		return "", fmt.Errorf("API server client not available")
+	}
+	return a.apiServerClient.GetResourceYAML(ctx, kind, namespace, name)
+}
+
+// CordonNode marks a node as unschedulable
+func (a *AggregatedDataSource) CordonNode(ctx context.Context, nodeName string) error {
+	if a.apiServerClient == nil {
+		return fmt.Errorf("API server client not available")
+	}
+	return a.apiServerClient.CordonNode(ctx, nodeName)
+}
+
+// UncordonNode marks a node as schedulable again
+func (a *AggregatedDataSource) UncordonNode(ctx context.Context, nodeName string) error {
+	if a.apiServerClient == nil {
+		return fmt.Errorf("API server client not available")
+	}
+	return a.apiServerClient.UncordonNode(ctx, nodeName)
+}
+
+// RolloutRestart restarts the pods of a deployment, statefulset or daemonset
+func (a *AggregatedDataSource) RolloutRestart(ctx context.Context, kind, namespace, name string) error {
+	if a.apiServerClient == nil {
+		return fmt.Errorf("API server client not available")
+	}
+	return a.apiServerClient.RolloutRestart(ctx, kind, namespace, name)
 }
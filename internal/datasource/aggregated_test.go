@@ -2,10 +2,15 @@ package datasource
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/yourusername/k8s-monitor/internal/model"
 	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
 )
 
 func TestBuildClusterSummary(t *testing.T) {
@@ -37,7 +42,7 @@ func TestBuildClusterSummary(t *testing.T) {
 	}
 
 	// Build summary
-	summary := agg.buildClusterSummary(nodes, pods, events, nil, nil, nil)
+	summary := agg.buildClusterSummary(nodes, pods, events, nil, nil, nil, nil)
 
 	// Verify node counts
 	if summary.TotalNodes != 3 {
@@ -76,6 +81,210 @@ func TestBuildClusterSummary(t *testing.T) {
 	}
 }
 
+func TestBuildClusterSummaryGPUAndNPU(t *testing.T) {
+	nodes := []*model.NodeData{
+		{Name: "gpu1", Status: "Ready", GPUCapacity: 8, GPUAllocatable: 8, GPUProduct: "NVIDIA-A100"},
+		{Name: "gpu2", Status: "Ready", GPUCapacity: 4, GPUAllocatable: 4},
+		{Name: "npu1", Status: "Ready", NPUCapacity: 8, NPUAllocatable: 8},
+	}
+	pods := []*model.PodData{
+		{Name: "train", Node: "gpu1", Phase: "Running", GPURequest: 4},
+		{Name: "queued", Node: "gpu2", Phase: "Pending", GPURequest: 2},
+		{Name: "done", Node: "gpu2", Phase: "Succeeded", GPURequest: 2},
+		{Name: "infer", Node: "npu1", Phase: "Running", NPURequest: 2},
+	}
+
+	agg := &AggregatedDataSource{logger: zap.NewNop()}
+	summary := agg.buildClusterSummary(nodes, pods, nil, nil, nil, nil, nil)
+
+	if summary.GPUCapacity != 12 || summary.GPUAllocatable != 12 || summary.GPUNodesCount != 2 {
+		t.Errorf("GPU capacity/allocatable/nodes = %d/%d/%d, want 12/12/2",
+			summary.GPUCapacity, summary.GPUAllocatable, summary.GPUNodesCount)
+	}
+	if summary.GPUAllocated != 6 {
+		t.Errorf("GPUAllocated = %d, want 6 (completed pods excluded)", summary.GPUAllocated)
+	}
+	if summary.GPUUtilization != 50 {
+		t.Errorf("GPUUtilization = %.1f, want 50", summary.GPUUtilization)
+	}
+	if summary.GPUProduct != "NVIDIA-A100" {
+		t.Errorf("GPUProduct = %q, want NVIDIA-A100", summary.GPUProduct)
+	}
+	if nodes[0].GPUAllocated != 4 || nodes[1].GPUAllocated != 2 {
+		t.Errorf("node GPUAllocated = %d/%d, want 4/2", nodes[0].GPUAllocated, nodes[1].GPUAllocated)
+	}
+	if summary.NPUCapacity != 8 || summary.NPUAllocated != 2 {
+		t.Errorf("NPU capacity/allocated = %d/%d, want 8/2", summary.NPUCapacity, summary.NPUAllocated)
+	}
+}
+
+func TestBuildClusterSummaryRequestEfficiency(t *testing.T) {
+	nodes := []*model.NodeData{
+		{Name: "node1", Status: "Ready", CPUUsage: 500, MemoryUsage: 1 << 30},
+		{Name: "node2", Status: "Ready", CPUUsage: 250},
+	}
+	pods := []*model.PodData{
+		{Name: "web", Phase: "Running", CPURequest: 2000, MemoryRequest: 2 << 30},
+		{Name: "queued", Phase: "Pending", CPURequest: 1000, MemoryRequest: 2 << 30},
+		{Name: "done", Phase: "Succeeded", CPURequest: 4000, MemoryRequest: 4 << 30},
+	}
+
+	agg := &AggregatedDataSource{logger: zap.NewNop()}
+	summary := agg.buildClusterSummary(nodes, pods, nil, nil, nil, nil, nil)
+
+	if summary.CPURequestEfficiency != 25 {
+		t.Errorf("CPURequestEfficiency = %.1f, want 25 (completed pods excluded)", summary.CPURequestEfficiency)
+	}
+	if summary.MemRequestEfficiency != 25 {
+		t.Errorf("MemRequestEfficiency = %.1f, want 25", summary.MemRequestEfficiency)
+	}
+
+	// Without usage metrics the efficiency is unknown rather than 0% used
+	for _, node := range nodes {
+		node.CPUUsage, node.MemoryUsage = 0, 0
+	}
+	summary = agg.buildClusterSummary(nodes, pods, nil, nil, nil, nil, nil)
+	if summary.CPURequestEfficiency != 0 || summary.MemRequestEfficiency != 0 {
+		t.Errorf("efficiency without metrics = %.1f/%.1f, want 0/0",
+			summary.CPURequestEfficiency, summary.MemRequestEfficiency)
+	}
+}
+
+func TestCollectQuotaAlerts(t *testing.T) {
+	quotas := []*model.QuotaData{
+		{Name: "compute", Namespace: "team-a", Resources: []model.QuotaResource{
+			{Name: "requests.cpu", Hard: "4", Used: "3400m", HardMilli: 4000, UsedMilli: 3400},
+			{Name: "requests.memory", Hard: "8Gi", Used: "7900Mi", HardMilli: 8192000, UsedMilli: 7900000},
+			{Name: "pods", Hard: "10", Used: "10", HardMilli: 10000, UsedMilli: 10000},
+		}},
+		// The fuller of two quotas limiting the same resource counts
+		{Name: "burst", Namespace: "team-a", Resources: []model.QuotaResource{
+			{Name: "cpu", Hard: "3500m", Used: "3400m", HardMilli: 3500, UsedMilli: 3400},
+		}},
+		{Name: "compute", Namespace: "team-b", Resources: []model.QuotaResource{
+			{Name: "requests.cpu", Hard: "4", Used: "1", HardMilli: 4000, UsedMilli: 1000},
+		}},
+	}
+
+	agg := &AggregatedDataSource{logger: zap.NewNop()}
+	summary := agg.buildClusterSummary(nil, nil, nil, nil, nil, nil, quotas)
+
+	got := make(map[model.AlertType]model.Alert)
+	for _, alert := range summary.Alerts {
+		if alert.ResourceType == "Namespace" {
+			got[alert.AlertType] = alert
+		}
+	}
+	if len(got) != 2 {
+		t.Fatalf("namespace alerts = %v, want CPU and memory of team-a", got)
+	}
+	cpu := got[model.AlertTypeNamespaceQuotaCPU]
+	if cpu.ResourceName != "team-a" || cpu.Severity != model.AlertSeverityCritical || cpu.Value != "97.1%" {
+		t.Errorf("CPU alert = %s %v %s, want team-a critical 97.1%% (burst quota)", cpu.ResourceName, cpu.Severity, cpu.Value)
+	}
+	memory := got[model.AlertTypeNamespaceQuotaMemory]
+	if memory.ResourceName != "team-a" || memory.Severity != model.AlertSeverityCritical {
+		t.Errorf("memory alert = %s %v, want team-a critical", memory.ResourceName, memory.Severity)
+	}
+
+	// Raised thresholds leave only the CPU alert, as a warning
+	agg.SetQuotaAlertThresholds(97, 99)
+	summary = agg.buildClusterSummary(nil, nil, nil, nil, nil, nil, quotas)
+	if len(summary.Alerts) != 1 || summary.Alerts[0].AlertType != model.AlertTypeNamespaceQuotaCPU ||
+		summary.Alerts[0].Severity != model.AlertSeverityWarning || summary.Alerts[0].Threshold != "97%" {
+		t.Errorf("alerts with raised thresholds = %+v, want one CPU warning at 97%%", summary.Alerts)
+	}
+}
+
+func TestSetPodUsage(t *testing.T) {
+	pod := &model.PodData{
+		Name:            "web-1",
+		ContainerStates: []model.ContainerState{{Name: "app"}, {Name: "sidecar"}},
+	}
+	metrics := &model.PodData{
+		CPUUsage:        150,
+		MemoryUsage:     64 << 20,
+		ContainerStates: []model.ContainerState{{Name: "sidecar", CPUUsage: 50, MemoryUsage: 16 << 20}},
+	}
+
+	setPodUsage(pod, metrics)
+
+	if pod.CPUUsage != 150 || pod.MemoryUsage != 64<<20 {
+		t.Errorf("pod usage = %d/%d, want 150/%d", pod.CPUUsage, pod.MemoryUsage, 64<<20)
+	}
+	if pod.ContainerStates[0].CPUUsage != 0 {
+		t.Errorf("app CPU = %d, want 0 without metrics", pod.ContainerStates[0].CPUUsage)
+	}
+	if pod.ContainerStates[1].CPUUsage != 50 || pod.ContainerStates[1].MemoryUsage != 16<<20 {
+		t.Errorf("sidecar usage = %d/%d, want 50/%d",
+			pod.ContainerStates[1].CPUUsage, pod.ContainerStates[1].MemoryUsage, 16<<20)
+	}
+}
+
+func TestApplyVolumeUsage(t *testing.T) {
+	pods := []*model.PodData{
+		{Name: "db-0", Namespace: "prod", PVCUsage: map[string]model.VolumeUsage{
+			"data-db-0": {UsedBytes: 950, CapacityBytes: 1000},
+		}},
+		{Name: "web", Namespace: "dev"},
+	}
+	pvcs := []*model.PVCData{
+		{Name: "data-db-0", Namespace: "prod", Volume: "pv-1"},
+		{Name: "data-db-0", Namespace: "dev", Usage: &model.VolumeUsage{UsedBytes: 1}},
+	}
+	pvs := []*model.PVData{
+		{Name: "pv-1", Claim: "prod/data-db-0"},
+		{Name: "pv-2"},
+	}
+
+	applyVolumeUsage(pods, pvcs, pvs)
+
+	if pvcs[0].Usage == nil || pvcs[0].UsedBytes != 950 || pvcs[0].Usage.UsagePercent() != 95 {
+		t.Errorf("prod/data-db-0 usage = %+v (%d used), want 950/1000", pvcs[0].Usage, pvcs[0].UsedBytes)
+	}
+	// Claims are matched by namespace, and stale usage is cleared
+	if pvcs[1].Usage != nil {
+		t.Errorf("dev/data-db-0 usage = %+v, want none", pvcs[1].Usage)
+	}
+	if pvs[0].Usage == nil || pvs[0].Usage.UsedBytes != 950 {
+		t.Errorf("pv-1 usage = %+v, want the usage of its claim", pvs[0].Usage)
+	}
+	if pvs[1].Usage != nil {
+		t.Errorf("pv-2 usage = %+v, want none without a claim", pvs[1].Usage)
+	}
+}
+
+func TestCountNodePods(t *testing.T) {
+	nodes := []*model.NodeData{
+		{Name: "node-1", PodAllocatable: 4},
+		{Name: "node-2", PodAllocatable: 110, PodCount: 7, PodUsagePercent: 6.4},
+		{Name: "node-3"},
+	}
+	pods := []*model.PodData{
+		{Name: "a", Node: "node-1", Phase: "Running"},
+		{Name: "b", Node: "node-1", Phase: "Pending"},
+		{Name: "c", Node: "node-1", Phase: "Running"},
+		{Name: "done", Node: "node-1", Phase: "Succeeded"},
+		{Name: "failed", Node: "node-1", Phase: "Failed"},
+		{Name: "unscheduled", Phase: "Pending"},
+		{Name: "d", Node: "node-3", Phase: "Running"},
+	}
+
+	countNodePods(nodes, pods)
+
+	if nodes[0].PodCount != 3 || nodes[0].PodUsagePercent != 75 {
+		t.Errorf("node-1 = %d pods (%.1f%%), want 3 pods (75%%)", nodes[0].PodCount, nodes[0].PodUsagePercent)
+	}
+	// Counts of a previous refresh are reset
+	if nodes[1].PodCount != 0 || nodes[1].PodUsagePercent != 0 {
+		t.Errorf("node-2 = %d pods (%.1f%%), want 0 pods", nodes[1].PodCount, nodes[1].PodUsagePercent)
+	}
+	// Nodes without allocatable pods have no density
+	if nodes[2].PodCount != 1 || nodes[2].PodUsagePercent != 0 {
+		t.Errorf("node-3 = %d pods (%.1f%%), want 1 pod without density", nodes[2].PodCount, nodes[2].PodUsagePercent)
+	}
+}
+
 func TestKubeletSummaryParsing(t *testing.T) {
 	// Test that kubelet summary types are properly defined
 	var summary KubeletSummary
@@ -125,18 +334,96 @@ func TestAggregatedDataSourceCreation(t *testing.T) {
 	}
 }
 
-// mockDataSource is a simple mock for testing
-type mockDataSource struct{}
+func TestAggregatedDataSourceEventLimit(t *testing.T) {
+	apiServer := &mockDataSource{}
+	agg := NewAggregatedDataSource(apiServer, nil, zap.NewNop(), 10)
+
+	if _, err := agg.GetClusterData(context.Background(), "team-a"); err != nil {
+		t.Fatalf("GetClusterData() error = %v", err)
+	}
+	if apiServer.eventNamespace != "team-a" || apiServer.eventLimit != defaultEventLimit {
+		t.Errorf("GetEvents(%q, limit %d), want (team-a, limit %d)", apiServer.eventNamespace, apiServer.eventLimit, defaultEventLimit)
+	}
+
+	// 0 fetches all events
+	agg.SetEventLimit(0)
+	if _, err := agg.GetClusterData(context.Background(), ""); err != nil {
+		t.Fatalf("GetClusterData() error = %v", err)
+	}
+	if apiServer.eventLimit != 0 {
+		t.Errorf("GetEvents() limit = %d, want 0", apiServer.eventLimit)
+	}
+
+	agg.SetEventLimit(-1)
+	if got := agg.EventLimit(); got != defaultEventLimit {
+		t.Errorf("EventLimit() = %d after a negative limit, want %d", got, defaultEventLimit)
+	}
+}
+
+func TestGetClusterDataWithTimeout(t *testing.T) {
+	// A pod list that stalls until the context ends fails the refresh
+	agg := NewAggregatedDataSource(&mockDataSource{podDelay: time.Minute}, nil, zap.NewNop(), 10)
+	data, err := agg.GetClusterDataWithTimeout(context.Background(), "", 20*time.Millisecond)
+	if err == nil || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetClusterDataWithTimeout() error = %v, want a deadline exceeded error", err)
+	}
+	if data != nil {
+		t.Errorf("GetClusterDataWithTimeout() returned data after timing out")
+	}
+
+	agg = NewAggregatedDataSource(&mockDataSource{}, nil, zap.NewNop(), 10)
+	if _, err := agg.GetClusterDataWithTimeout(context.Background(), "", time.Second); err != nil {
+		t.Errorf("GetClusterDataWithTimeout() error = %v, want nil", err)
+	}
+
+	// Optional fetches that fail once the context ended would leave the data
+	// incomplete, so it is rejected rather than returned as a full refresh
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if data, err := agg.GetClusterData(ctx, ""); err == nil || data != nil {
+		t.Errorf("GetClusterData() with an ended context = %v, %v, want an error", data, err)
+	}
+
+	// The same holds when the deadline expires in the late CRD fetch
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+	crdClient, err := NewCRDClient(&rest.Config{Host: server.URL}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewCRDClient() error = %v", err)
+	}
+	agg.SetCRDClient(crdClient)
+	if data, err := agg.GetClusterDataWithTimeout(context.Background(), "", 50*time.Millisecond); err == nil || data != nil {
+		t.Errorf("GetClusterDataWithTimeout() with a stalled CRD fetch = %v, %v, want an error", data, err)
+	}
+}
+
+// mockDataSource is a simple mock for testing; it records the arguments of
+// the last GetEvents call
+type mockDataSource struct {
+	eventNamespace string
+	eventLimit     int
+	podDelay       time.Duration // GetPods stalls this long or until the context ends
+}
 
 func (m *mockDataSource) GetNodes(ctx context.Context) ([]*model.NodeData, error) {
 	return []*model.NodeData{}, nil
 }
 
 func (m *mockDataSource) GetPods(ctx context.Context, namespace string) ([]*model.PodData, error) {
+	if m.podDelay > 0 {
+		select {
+		case <-time.After(m.podDelay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
 	return []*model.PodData{}, nil
 }
 
 func (m *mockDataSource) GetEvents(ctx context.Context, namespace string, eventTypes []string, limit int) ([]*model.EventData, error) {
+	m.eventNamespace, m.eventLimit = namespace, limit
 	return []*model.EventData{}, nil
 }
 
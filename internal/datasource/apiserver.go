@@ -14,7 +14,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -36,8 +39,10 @@ type APIServerClient struct {
 	cacheValidityDuration time.Duration
 }
 
-// NewAPIServerClient creates a new API Server client
-func NewAPIServerClient(kubeconfig, context string, logger *zap.Logger) (*APIServerClient, error) {
+// NewAPIServerClient creates a new API Server client. A non-empty
+// impersonation makes every request act as that user and groups (kubectl
+// --as/--as-group), e.g. to preview what a limited user can see.
+func NewAPIServerClient(kubeconfig, context string, impersonate rest.ImpersonationConfig, logger *zap.Logger) (*APIServerClient, error) {
 	var config *rest.Config
 	var err error
 
@@ -75,6 +80,10 @@ func NewAPIServerClient(kubeconfig, context string, logger *zap.Logger) (*APISer
 		}
 	}
 
+	if err := applyImpersonation(config, impersonate); err != nil {
+		return nil, err
+	}
+
 	// Create clientset
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
@@ -90,12 +99,50 @@ func NewAPIServerClient(kubeconfig, context string, logger *zap.Logger) (*APISer
 
 	logger.Info("API Server client initialized",
 		zap.String("host", config.Host),
+		zap.String("impersonate_user", config.Impersonate.UserName),
+		zap.Strings("impersonate_groups", config.Impersonate.Groups),
 		zap.Duration("cache_validity", client.cacheValidityDuration),
 	)
 
 	return client, nil
 }
 
+// applyImpersonation sets the impersonated user and groups on a REST config.
+// Like kubectl, impersonating groups requires a user.
+func applyImpersonation(config *rest.Config, impersonate rest.ImpersonationConfig) error {
+	if impersonate.UserName == "" {
+		if len(impersonate.Groups) > 0 {
+			return fmt.Errorf("impersonating groups %v requires a user (--as)", impersonate.Groups)
+		}
+		return nil
+	}
+	config.Impersonate = impersonate
+	return nil
+}
+
+// ListContexts returns the sorted context names defined in the kubeconfig
+// and its current context. An empty kubeconfig path uses the default
+// loading rules ($KUBECONFIG or $HOME/.kube/config).
+func ListContexts(kubeconfig string) ([]string, string, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if kubeconfig != "" {
+		loadingRules = &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig}
+	}
+
+	rawConfig, err := loadingRules.Load()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	contexts := make([]string, 0, len(rawConfig.Contexts))
+	for name := range rawConfig.Contexts {
+		contexts = append(contexts, name)
+	}
+	sort.Strings(contexts)
+
+	return contexts, rawConfig.CurrentContext, nil
+}
+
 // GetNodes retrieves all nodes in the cluster
 func (c *APIServerClient) GetNodes(ctx context.Context) ([]*model.NodeData, error) {
 	c.logger.Debug("Fetching nodes from API Server")
@@ -153,7 +200,11 @@ func (c *APIServerClient) GetPods(ctx context.Context, namespace string) ([]*mod
 	return pods, nil
 }
 
-// GetEvents retrieves recent events, optionally filtered by type
+// eventListPageSize is the number of events requested per list call
+const eventListPageSize = 500
+
+// GetEvents retrieves recent events, optionally filtered by type, most recent
+// first. A limit of 0 returns all events.
 func (c *APIServerClient) GetEvents(ctx context.Context, namespace string, eventTypes []string, limit int) ([]*model.EventData, error) {
 	c.logger.Debug("Fetching events from API Server",
 		zap.String("namespace", namespace),
@@ -161,25 +212,30 @@ func (c *APIServerClient) GetEvents(ctx context.Context, namespace string, event
 		zap.Int("limit", limit),
 	)
 
-	var eventList *corev1.EventList
-	var err error
-
-	if namespace == "" {
-		// Get all events across all namespaces
-		eventList, err = c.clientset.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
-	} else {
-		// Get events in specific namespace
-		eventList, err = c.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
+	// A single type is filtered by the API server; namespace "" lists all namespaces
+	opts := metav1.ListOptions{Limit: eventListPageSize}
+	if len(eventTypes) == 1 {
+		opts.FieldSelector = fields.OneTermEqualSelector("type", eventTypes[0]).String()
 	}
 
-	if err != nil {
-		return nil, fmt.Errorf("failed to list events: %w", err)
+	// Page through the events so busy clusters are not fetched in one response
+	var items []corev1.Event
+	for {
+		eventList, err := c.clientset.CoreV1().Events(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list events: %w", err)
+		}
+		items = append(items, eventList.Items...)
+		if eventList.Continue == "" {
+			break
+		}
+		opts.Continue = eventList.Continue
 	}
 
 	// Convert and filter events
 	events := make([]*model.EventData, 0)
-	for i := range eventList.Items {
-		event := &eventList.Items[i]
+	for i := range items {
+		event := &items[i]
 
 		// Filter by event type if specified
 		if len(eventTypes) > 0 {
@@ -210,13 +266,66 @@ func (c *APIServerClient) GetEvents(ctx context.Context, namespace string, event
 	}
 
 	c.logger.Debug("Events fetched successfully",
-		zap.Int("total", len(eventList.Items)),
+		zap.Int("total", len(items)),
 		zap.Int("filtered", len(events)),
 	)
 
 	return events, nil
 }
 
+// GetNode retrieves a single node
+func (c *APIServerClient) GetNode(ctx context.Context, name string) (*model.NodeData, error) {
+	node, err := c.clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", name, err)
+	}
+	return ConvertNode(node), nil
+}
+
+// GetPod retrieves a single pod
+func (c *APIServerClient) GetPod(ctx context.Context, namespace, name string) (*model.PodData, error) {
+	pod, err := c.clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod %s/%s: %w", namespace, name, err)
+	}
+	return ConvertPod(pod), nil
+}
+
+// GetObjectEvents retrieves the most recent events of a single object, e.g.
+// kind "Pod" or "Node". Events of cluster-scoped objects are searched in all
+// namespaces.
+func (c *APIServerClient) GetObjectEvents(ctx context.Context, kind, namespace, name string, limit int) ([]*model.EventData, error) {
+	if namespace == "" {
+		namespace = metav1.NamespaceAll
+	}
+	eventList, err := c.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: involvedObjectSelector(kind, name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events of %s %s: %w", kind, name, err)
+	}
+
+	events := make([]*model.EventData, 0, len(eventList.Items))
+	for i := range eventList.Items {
+		events = append(events, ConvertEvent(&eventList.Items[i]))
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp)
+	})
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// involvedObjectSelector returns the field selector matching the events of an object
+func involvedObjectSelector(kind, name string) string {
+	return fields.Set{
+		"involvedObject.kind": kind,
+		"involvedObject.name": name,
+	}.AsSelector().String()
+}
+
 // GetServices retrieves all services across namespaces
 func (c *APIServerClient) GetServices(ctx context.Context, namespace string) ([]*model.ServiceData, error) {
 	c.logger.Debug("Fetching services from API Server")
@@ -234,30 +343,7 @@ func (c *APIServerClient) GetServices(ctx context.Context, namespace string) ([]
 		return nil, fmt.Errorf("failed to list services: %w", err)
 	}
 
-	// Fetch all endpoints in one List call to avoid O(N) API calls
-	var endpointsList *corev1.EndpointsList
-	if namespace == "" {
-		endpointsList, err = c.clientset.CoreV1().Endpoints(corev1.NamespaceAll).List(ctx, metav1.ListOptions{})
-	} else {
-		endpointsList, err = c.clientset.CoreV1().Endpoints(namespace).List(ctx, metav1.ListOptions{})
-	}
-
-	// Build endpoint count map: namespace/name -> count
-	endpointCounts := make(map[string]int)
-	if err == nil && endpointsList != nil {
-		for _, ep := range endpointsList.Items {
-			count := 0
-			for _, subset := range ep.Subsets {
-				count += len(subset.Addresses)
-			}
-			key := fmt.Sprintf("%s/%s", ep.Namespace, ep.Name)
-			endpointCounts[key] = count
-		}
-	} else {
-		c.logger.Warn("Failed to fetch endpoints, endpoint counts will be unavailable",
-			zap.Error(err),
-		)
-	}
+	endpointCounts := c.getEndpointCounts(ctx, namespace)
 
 	result := make([]*model.ServiceData, 0, len(services.Items))
 	for _, svc := range services.Items {
@@ -266,12 +352,19 @@ func (c *APIServerClient) GetServices(ctx context.Context, namespace string) ([]
 			Namespace:         svc.Namespace,
 			Type:              string(svc.Spec.Type),
 			ClusterIP:         svc.Spec.ClusterIP,
+			ClusterIPs:        svc.Spec.ClusterIPs,
 			ExternalIPs:       svc.Spec.ExternalIPs,
+			ExternalName:      svc.Spec.ExternalName,
+			Headless:          svc.Spec.ClusterIP == corev1.ClusterIPNone,
 			Selector:          svc.Spec.Selector,
 			Labels:            svc.Labels,
 			Annotations:       svc.Annotations,
 			CreationTimestamp: svc.CreationTimestamp.Time,
 		}
+		// Servers older than dual-stack support only set the singular field
+		if len(serviceData.ClusterIPs) == 0 && serviceData.ClusterIP != "" {
+			serviceData.ClusterIPs = []string{serviceData.ClusterIP}
+		}
 
 		// Parse ports
 		serviceData.Ports = make([]model.ServicePort, len(svc.Spec.Ports))
@@ -315,6 +408,79 @@ func (c *APIServerClient) GetServices(ctx context.Context, namespace string) ([]
 	return result, nil
 }
 
+// getEndpointCounts returns the number of ready endpoints per service, keyed by
+// namespace/name. EndpointSlices are preferred because the legacy Endpoints
+// object is truncated at 1000 addresses; Endpoints are used when slices cannot
+// be listed. Both are fetched with one List call to avoid O(N) API calls.
+func (c *APIServerClient) getEndpointCounts(ctx context.Context, namespace string) map[string]int {
+	listNamespace := namespace
+	if listNamespace == "" {
+		listNamespace = corev1.NamespaceAll
+	}
+
+	slices, err := c.clientset.DiscoveryV1().EndpointSlices(listNamespace).List(ctx, metav1.ListOptions{})
+	if err == nil {
+		return countEndpointSliceAddresses(slices.Items)
+	}
+	c.logger.Debug("Failed to list endpoint slices, falling back to endpoints", zap.Error(err))
+
+	endpointsList, err := c.clientset.CoreV1().Endpoints(listNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		c.logger.Warn("Failed to fetch endpoints, endpoint counts will be unavailable",
+			zap.Error(err),
+		)
+		return map[string]int{}
+	}
+
+	// Build endpoint count map: namespace/name -> count
+	endpointCounts := make(map[string]int)
+	for _, ep := range endpointsList.Items {
+		count := 0
+		for _, subset := range ep.Subsets {
+			count += len(subset.Addresses)
+		}
+		key := fmt.Sprintf("%s/%s", ep.Namespace, ep.Name)
+		endpointCounts[key] = count
+	}
+	return endpointCounts
+}
+
+// countEndpointSliceAddresses sums the ready endpoints of each service across
+// its EndpointSlices, keyed by namespace/name. Dual-stack services have one set
+// of slices per address family, so the largest family count is used to avoid
+// counting each pod twice.
+func countEndpointSliceAddresses(slices []discoveryv1.EndpointSlice) map[string]int {
+	perFamily := make(map[string]map[discoveryv1.AddressType]int)
+	for _, slice := range slices {
+		serviceName := slice.Labels[discoveryv1.LabelServiceName]
+		if serviceName == "" {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", slice.Namespace, serviceName)
+		if perFamily[key] == nil {
+			perFamily[key] = make(map[discoveryv1.AddressType]int)
+		}
+		for _, endpoint := range slice.Endpoints {
+			// A nil ready condition means the endpoint is ready
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			perFamily[key][slice.AddressType]++
+		}
+	}
+
+	endpointCounts := make(map[string]int, len(perFamily))
+	for key, families := range perFamily {
+		endpointCounts[key] = 0
+		for _, count := range families {
+			if count > endpointCounts[key] {
+				endpointCounts[key] = count
+			}
+		}
+	}
+	return endpointCounts
+}
+
 // GetPersistentVolumes retrieves all persistent volumes
 func (c *APIServerClient) GetPersistentVolumes(ctx context.Context) ([]*model.PVData, error) {
 	c.logger.Debug("Fetching persistent volumes from API Server")
@@ -427,6 +593,112 @@ func (c *APIServerClient) GetPersistentVolumeClaims(ctx context.Context, namespa
 	return result, nil
 }
 
+// GetStorageClasses retrieves all storage classes
+func (c *APIServerClient) GetStorageClasses(ctx context.Context) ([]*model.StorageClassData, error) {
+	c.logger.Debug("Fetching storage classes from API Server")
+
+	scList, err := c.clientset.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storage classes: %w", err)
+	}
+
+	result := make([]*model.StorageClassData, 0, len(scList.Items))
+	for i := range scList.Items {
+		result = append(result, ConvertStorageClass(&scList.Items[i]))
+	}
+
+	c.logger.Debug("Storage classes fetched successfully",
+		zap.Int("count", len(result)),
+	)
+
+	return result, nil
+}
+
+// GetServerVersion returns the Kubernetes version of the API server (e.g. v1.28.3)
+func (c *APIServerClient) GetServerVersion(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	info, err := c.clientset.Discovery().ServerVersion()
+	if err != nil {
+		return "", fmt.Errorf("failed to get server version: %w", err)
+	}
+	return info.GitVersion, nil
+}
+
+// GetResourceQuotas retrieves ResourceQuotas, optionally filtered by namespace
+func (c *APIServerClient) GetResourceQuotas(ctx context.Context, namespace string) ([]*model.QuotaData, error) {
+	c.logger.Debug("Fetching resource quotas from API Server")
+
+	if namespace == "" {
+		namespace = corev1.NamespaceAll
+	}
+	quotaList, err := c.clientset.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+
+	result := make([]*model.QuotaData, 0, len(quotaList.Items))
+	for i := range quotaList.Items {
+		result = append(result, ConvertResourceQuota(&quotaList.Items[i]))
+	}
+
+	c.logger.Debug("Resource quotas fetched successfully",
+		zap.Int("count", len(result)),
+	)
+
+	return result, nil
+}
+
+// GetLimitRanges retrieves LimitRanges, optionally filtered by namespace
+func (c *APIServerClient) GetLimitRanges(ctx context.Context, namespace string) ([]*model.LimitRangeData, error) {
+	c.logger.Debug("Fetching limit ranges from API Server")
+
+	if namespace == "" {
+		namespace = corev1.NamespaceAll
+	}
+	lrList, err := c.clientset.CoreV1().LimitRanges(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limit ranges: %w", err)
+	}
+
+	result := make([]*model.LimitRangeData, 0, len(lrList.Items))
+	for i := range lrList.Items {
+		result = append(result, ConvertLimitRange(&lrList.Items[i]))
+	}
+
+	c.logger.Debug("Limit ranges fetched successfully",
+		zap.Int("count", len(result)),
+	)
+
+	return result, nil
+}
+
+// GetPDBs retrieves PodDisruptionBudgets, optionally filtered by namespace
+func (c *APIServerClient) GetPDBs(ctx context.Context, namespace string) ([]*model.PDBData, error) {
+	c.logger.Debug("Fetching pod disruption budgets from API Server")
+
+	if namespace == "" {
+		namespace = corev1.NamespaceAll
+	}
+	pdbList, err := c.clientset.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod disruption budgets: %w", err)
+	}
+
+	result := make([]*model.PDBData, 0, len(pdbList.Items))
+	for i := range pdbList.Items {
+		result = append(result, ConvertPDB(&pdbList.Items[i]))
+	}
+
+	c.logger.Debug("Pod disruption budgets fetched successfully",
+		zap.Int("count", len(result)),
+	)
+
+	return result, nil
+}
+
 // Helper functions
 func convertAccessModes(modes []corev1.PersistentVolumeAccessMode) []string {
 	result := make([]string, len(modes))
@@ -492,6 +764,14 @@ func (c *APIServerClient) CheckKubeletAccess(ctx context.Context) (*diagnostic.K
 	return diagnostic.CheckKubeletAccess(ctx, c.clientset.AuthorizationV1())
 }
 
+// CheckAccess reviews the current identity's permissions for each check
+func (c *APIServerClient) CheckAccess(ctx context.Context, namespace string, checks []diagnostic.AccessCheck) ([]diagnostic.AccessResult, error) {
+	if c == nil || c.clientset == nil {
+		return nil, fmt.Errorf("api server client not initialised")
+	}
+	return diagnostic.CheckAccess(ctx, c.clientset.AuthorizationV1(), namespace, checks)
+}
+
 // GetConfig returns the Kubernetes client config
 func (c *APIServerClient) GetConfig() *rest.Config {
 	return c.config
@@ -619,6 +899,8 @@ func (c *APIServerClient) GetDaemonSets(ctx context.Context, namespace string) (
 			Annotations:            ds.Annotations,
 			CreationTimestamp:      ds.CreationTimestamp.Time,
 			Selector:               ds.Spec.Selector.MatchLabels,
+			NodeSelector:           ds.Spec.Template.Spec.NodeSelector,
+			Tolerations:            ds.Spec.Template.Spec.Tolerations,
 		}
 
 		result = append(result, dsData)
@@ -722,6 +1004,9 @@ func (c *APIServerClient) GetCronJobs(ctx context.Context, namespace string) ([]
 		if cj.Status.LastScheduleTime != nil {
 			cronJobData.LastScheduleTime = cj.Status.LastScheduleTime.Time
 		}
+		if cj.Spec.TimeZone != nil {
+			cronJobData.TimeZone = *cj.Spec.TimeZone
+		}
 
 		result = append(result, cronJobData)
 	}
@@ -730,18 +1015,24 @@ func (c *APIServerClient) GetCronJobs(ctx context.Context, namespace string) ([]
 	return result, nil
 }
 
-// GetPodLogs retrieves logs for a specific pod and container
-func (c *APIServerClient) GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64) (string, error) {
+// GetPodLogs retrieves the last tailLines log lines of a pod container (all lines when tailLines <= 0),
+// optionally prefixed with the kubelet's RFC3339 timestamps
+func (c *APIServerClient) GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64, timestamps bool) (string, error) {
 	c.logger.Debug("Fetching pod logs",
 		zap.String("namespace", namespace),
 		zap.String("pod", podName),
 		zap.String("container", containerName),
 		zap.Int64("tailLines", tailLines),
+		zap.Bool("timestamps", timestamps),
 	)
 
 	opts := &corev1.PodLogOptions{
-		Container: containerName,
-		TailLines: &tailLines,
+		Container:  containerName,
+		Timestamps: timestamps,
+	}
+	// A non-positive tail fetches the whole log
+	if tailLines > 0 {
+		opts.TailLines = &tailLines
 	}
 
 	req := c.clientset.CoreV1().Pods(namespace).GetLogs(podName, opts)
@@ -956,19 +1247,71 @@ func (c *APIServerClient) DescribeNode(ctx context.Context, nodeName string) (st
 
 // GetPodYAML returns YAML representation of a pod
 func (c *APIServerClient) GetPodYAML(ctx context.Context, namespace, podName string) (string, error) {
-	c.logger.Debug("Getting pod YAML",
+	return c.GetResourceYAML(ctx, "Pod", namespace, podName)
+}
+
+// GetNodeYAML returns YAML representation of a node
+func (c *APIServerClient) GetNodeYAML(ctx context.Context, nodeName string) (string, error) {
+	return c.GetResourceYAML(ctx, "Node", "", nodeName)
+}
+
+// GetResourceYAML fetches the live object and returns it as YAML, like
+// kubectl get -o yaml. Supported kinds: Pod, Node, Deployment, StatefulSet, Service.
+func (c *APIServerClient) GetResourceYAML(ctx context.Context, kind, namespace, name string) (string, error) {
+	c.logger.Debug("Getting resource YAML",
+		zap.String("kind", kind),
 		zap.String("namespace", namespace),
-		zap.String("pod", podName),
+		zap.String("name", name),
 	)
 
-	// Get pod
-	pod, err := c.clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
-	if err != nil {
-		return "", fmt.Errorf("failed to get pod: %w", err)
+	var obj interface{}
+	switch strings.ToLower(kind) {
+	case "pod":
+		pod, err := c.clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get pod: %w", err)
+		}
+		pod.APIVersion, pod.Kind = "v1", "Pod"
+		pod.ManagedFields = nil
+		obj = pod
+	case "node":
+		node, err := c.clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get node: %w", err)
+		}
+		node.APIVersion, node.Kind = "v1", "Node"
+		node.ManagedFields = nil
+		obj = node
+	case "deployment":
+		deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get deployment: %w", err)
+		}
+		deployment.APIVersion, deployment.Kind = "apps/v1", "Deployment"
+		deployment.ManagedFields = nil
+		obj = deployment
+	case "statefulset":
+		statefulSet, err := c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get statefulset: %w", err)
+		}
+		statefulSet.APIVersion, statefulSet.Kind = "apps/v1", "StatefulSet"
+		statefulSet.ManagedFields = nil
+		obj = statefulSet
+	case "service":
+		service, err := c.clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get service: %w", err)
+		}
+		service.APIVersion, service.Kind = "v1", "Service"
+		service.ManagedFields = nil
+		obj = service
+	default:
+		return "", fmt.Errorf("unsupported resource kind: %s", kind)
 	}
 
 	// Convert to YAML
-	yamlBytes, err := yaml.Marshal(pod)
+	yamlBytes, err := yaml.Marshal(obj)
 	if err != nil {
 		return "", fmt.Errorf("failed to convert to YAML: %w", err)
 	}
@@ -976,23 +1319,67 @@ func (c *APIServerClient) GetPodYAML(ctx context.Context, namespace, podName str
 	return string(yamlBytes), nil
 }
 
-// GetNodeYAML returns YAML representation of a node
-func (c *APIServerClient) GetNodeYAML(ctx context.Context, nodeName string) (string, error) {
-	c.logger.Debug("Getting node YAML", zap.String("node", nodeName))
+// CordonNode marks a node as unschedulable
+func (c *APIServerClient) CordonNode(ctx context.Context, nodeName string) error {
+	return c.setNodeUnschedulable(ctx, nodeName, true)
+}
 
-	// Get node
-	node, err := c.clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+// UncordonNode marks a node as schedulable again
+func (c *APIServerClient) UncordonNode(ctx context.Context, nodeName string) error {
+	return c.setNodeUnschedulable(ctx, nodeName, false)
+}
+
+// setNodeUnschedulable patches Spec.Unschedulable on a node
+func (c *APIServerClient) setNodeUnschedulable(ctx context.Context, nodeName string, unschedulable bool) error {
+	c.logger.Info("Patching node schedulability",
+		zap.String("node", nodeName),
+		zap.Bool("unschedulable", unschedulable),
+	)
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable))
+	_, err := c.clientset.CoreV1().Nodes().Patch(ctx, nodeName, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
 	if err != nil {
-		return "", fmt.Errorf("failed to get node: %w", err)
+		return fmt.Errorf("failed to patch node: %w", err)
 	}
 
-	// Convert to YAML
-	yamlBytes, err := yaml.Marshal(node)
+	return nil
+}
+
+// restartedAtAnnotation is the pod template annotation kubectl sets to trigger a rollout restart
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// rolloutRestartPatch returns the strategic merge patch that stamps the pod
+// template with the restart time, replacing every pod like `kubectl rollout restart`
+func rolloutRestartPatch(now time.Time) []byte {
+	return []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+		restartedAtAnnotation, now.Format(time.RFC3339)))
+}
+
+// RolloutRestart restarts the pods of a deployment, statefulset or daemonset
+func (c *APIServerClient) RolloutRestart(ctx context.Context, kind, namespace, name string) error {
+	c.logger.Info("Restarting workload",
+		zap.String("kind", kind),
+		zap.String("namespace", namespace),
+		zap.String("name", name),
+	)
+
+	patch := rolloutRestartPatch(time.Now())
+	var err error
+	switch strings.ToLower(kind) {
+	case "deployment":
+		_, err = c.clientset.AppsV1().Deployments(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case "statefulset":
+		_, err = c.clientset.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case "daemonset":
+		_, err = c.clientset.AppsV1().DaemonSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	default:
+		return fmt.Errorf("rollout restart not supported for kind: %s", kind)
+	}
 	if err != nil {
-		return "", fmt.Errorf("failed to convert to YAML: %w", err)
+		return fmt.Errorf("failed to restart %s: %w", strings.ToLower(kind), err)
 	}
 
-	return string(yamlBytes), nil
+	return nil
 }
 
 // getContainerState returns a human-readable container state
@@ -0,0 +1,171 @@
+package datasource
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: staging
+clusters:
+- name: dev
+  cluster:
+    server: https://dev.example.com
+- name: prod
+  cluster:
+    server: https://prod.example.com
+contexts:
+- name: staging
+  context:
+    cluster: dev
+    user: admin
+- name: dev
+  context:
+    cluster: dev
+    user: admin
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+users:
+- name: admin
+  user:
+    token: test
+`
+
+func TestListContexts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(testKubeconfig), 0600); err != nil {
+		t.Fatalf("Failed to write kubeconfig: %v", err)
+	}
+
+	contexts, current, err := ListContexts(path)
+	if err != nil {
+		t.Fatalf("ListContexts() error = %v", err)
+	}
+
+	expected := []string{"dev", "prod", "staging"}
+	if !reflect.DeepEqual(contexts, expected) {
+		t.Errorf("ListContexts() contexts = %v, want %v", contexts, expected)
+	}
+	if current != "staging" {
+		t.Errorf("ListContexts() current = %q, want %q", current, "staging")
+	}
+}
+
+func TestListContextsMissingFile(t *testing.T) {
+	_, _, err := ListContexts(filepath.Join(t.TempDir(), "missing"))
+	if err == nil {
+		t.Error("ListContexts() expected error for missing kubeconfig")
+	}
+}
+
+func TestNewAPIServerClientImpersonation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(testKubeconfig), 0600); err != nil {
+		t.Fatalf("Failed to write kubeconfig: %v", err)
+	}
+
+	impersonate := rest.ImpersonationConfig{UserName: "jane", Groups: []string{"developers"}}
+	client, err := NewAPIServerClient(path, "prod", impersonate, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewAPIServerClient() error = %v", err)
+	}
+	config := client.GetConfig()
+	if config.Host != "https://prod.example.com" {
+		t.Errorf("Expected host https://prod.example.com, got %s", config.Host)
+	}
+	if !reflect.DeepEqual(config.Impersonate, impersonate) {
+		t.Errorf("Expected impersonation %+v, got %+v", impersonate, config.Impersonate)
+	}
+
+	// Without impersonation requests use the kubeconfig credentials
+	client, err = NewAPIServerClient(path, "", rest.ImpersonationConfig{}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewAPIServerClient() error = %v", err)
+	}
+	if user := client.GetConfig().Impersonate.UserName; user != "" {
+		t.Errorf("Expected no impersonation, got user %q", user)
+	}
+
+	// Groups alone cannot be impersonated
+	_, err = NewAPIServerClient(path, "", rest.ImpersonationConfig{Groups: []string{"developers"}}, zap.NewNop())
+	if err == nil {
+		t.Error("Expected error when impersonating groups without a user")
+	}
+}
+
+func TestRolloutRestartPatch(t *testing.T) {
+	now := time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)
+
+	var patch struct {
+		Spec struct {
+			Template struct {
+				Metadata struct {
+					Annotations map[string]string `json:"annotations"`
+				} `json:"metadata"`
+			} `json:"template"`
+		} `json:"spec"`
+	}
+	if err := json.Unmarshal(rolloutRestartPatch(now), &patch); err != nil {
+		t.Fatalf("Patch is not valid JSON: %v", err)
+	}
+
+	got := patch.Spec.Template.Metadata.Annotations[restartedAtAnnotation]
+	if got != "2024-03-01T08:30:00Z" {
+		t.Errorf("Expected restartedAt '2024-03-01T08:30:00Z', got '%s'", got)
+	}
+}
+
+func TestCountEndpointSliceAddresses(t *testing.T) {
+	ready, notReady := true, false
+	slice := func(name, service string, family discoveryv1.AddressType, conditions ...*bool) discoveryv1.EndpointSlice {
+		s := discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: service},
+			},
+			AddressType: family,
+		}
+		for _, ready := range conditions {
+			s.Endpoints = append(s.Endpoints, discoveryv1.Endpoint{Conditions: discoveryv1.EndpointConditions{Ready: ready}})
+		}
+		return s
+	}
+
+	counts := countEndpointSliceAddresses([]discoveryv1.EndpointSlice{
+		// Endpoints of a large service are spread over several slices
+		slice("web-a", "web", discoveryv1.AddressTypeIPv4, &ready, &ready, nil),
+		slice("web-b", "web", discoveryv1.AddressTypeIPv4, &ready, &notReady),
+		// Dual-stack: the same pods appear in an IPv6 slice
+		slice("api-v4", "api", discoveryv1.AddressTypeIPv4, &ready, &ready),
+		slice("api-v6", "api", discoveryv1.AddressTypeIPv6, &ready, &ready),
+		slice("empty", "idle", discoveryv1.AddressTypeIPv4, &notReady),
+		// Slices not owned by a service are ignored
+		slice("orphan", "", discoveryv1.AddressTypeIPv4, &ready),
+	})
+
+	expected := map[string]int{"default/web": 4, "default/api": 2, "default/idle": 0}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected counts %v, got %v", expected, counts)
+	}
+}
+
+func TestInvolvedObjectSelector(t *testing.T) {
+	got := involvedObjectSelector("Pod", "web-1")
+	want := "involvedObject.kind=Pod,involvedObject.name=web-1"
+	if got != want {
+		t.Errorf("involvedObjectSelector() = %q, want %q", got, want)
+	}
+}
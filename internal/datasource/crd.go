@@ -0,0 +1,168 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+var crdGVR = schema.GroupVersionResource{
+	Group:    "apiextensions.k8s.io",
+	Version:  "v1",
+	Resource: "customresourcedefinitions",
+}
+
+// crdCountPageSize is the page size of the list requests counting the
+// instances of a CRD
+const crdCountPageSize = 500
+
+// CRDClient lists the CustomResourceDefinitions of the cluster and counts the
+// instances of selected ones
+type CRDClient struct {
+	dynamicClient dynamic.Interface
+	logger        *zap.Logger
+	counted       []string // CRD name globs whose instances are counted
+}
+
+// NewCRDClient creates a new CRD client
+func NewCRDClient(config *rest.Config, logger *zap.Logger) (*CRDClient, error) {
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	return &CRDClient{
+		dynamicClient: dynamicClient,
+		logger:        logger,
+	}, nil
+}
+
+// SetCountedCRDs selects the CRDs whose instances are counted, as globs of
+// CRD names (<plural>.<group>, e.g. "*.volcano.sh"). Counting lists every
+// instance, so only CRDs matching a pattern are counted.
+func (c *CRDClient) SetCountedCRDs(patterns []string) error {
+	counted := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid CRD pattern %q: %w", pattern, err)
+		}
+		counted = append(counted, pattern)
+	}
+	c.counted = counted
+	return nil
+}
+
+// GetCRDs lists the CRDs of the cluster by group and kind, with the instance
+// counts of the CRDs selected by SetCountedCRDs. A failed count is recorded on
+// its CRD rather than failing the list.
+func (c *CRDClient) GetCRDs(ctx context.Context) ([]*model.CRDData, error) {
+	list, err := c.dynamicClient.Resource(crdGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CRDs: %w", err)
+	}
+
+	crds := make([]*model.CRDData, 0, len(list.Items))
+	for i := range list.Items {
+		crd := convertCRD(&list.Items[i])
+		if c.countsCRD(crd.Name) {
+			count, err := c.countInstances(ctx, crd)
+			if err != nil {
+				c.logger.Debug("Failed to count CRD instances",
+					zap.String("crd", crd.Name),
+					zap.Error(err),
+				)
+				crd.CountError = err.Error()
+			} else {
+				crd.Counted = true
+				crd.Instances = count
+			}
+		}
+		crds = append(crds, crd)
+	}
+	sort.Slice(crds, func(i, j int) bool {
+		if crds[i].Group != crds[j].Group {
+			return crds[i].Group < crds[j].Group
+		}
+		return crds[i].Kind < crds[j].Kind
+	})
+
+	c.logger.Debug("CRDs fetched successfully", zap.Int("count", len(crds)))
+	return crds, nil
+}
+
+// countsCRD reports whether the instances of a CRD are counted
+func (c *CRDClient) countsCRD(name string) bool {
+	for _, pattern := range c.counted {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// countInstances counts the instances of a CRD in all namespaces, paging
+// through the list unless the API server reports the remaining item count
+func (c *CRDClient) countInstances(ctx context.Context, crd *model.CRDData) (int, error) {
+	gvr := schema.GroupVersionResource{Group: crd.Group, Version: crd.Version, Resource: crd.Plural}
+	count := 0
+	opts := metav1.ListOptions{Limit: crdCountPageSize}
+	for {
+		list, err := c.dynamicClient.Resource(gvr).List(ctx, opts)
+		if err != nil {
+			return 0, err
+		}
+		count += len(list.Items)
+		if remaining := list.GetRemainingItemCount(); remaining != nil {
+			return count + int(*remaining), nil
+		}
+		if list.GetContinue() == "" {
+			return count, nil
+		}
+		opts.Continue = list.GetContinue()
+	}
+}
+
+// convertCRD converts an unstructured CustomResourceDefinition. The version is
+// the storage version, else the first served one.
+func convertCRD(obj *unstructured.Unstructured) *model.CRDData {
+	crd := &model.CRDData{
+		Name:              obj.GetName(),
+		CreationTimestamp: obj.GetCreationTimestamp().Time,
+	}
+	crd.Group, _, _ = unstructured.NestedString(obj.Object, "spec", "group")
+	crd.Scope, _, _ = unstructured.NestedString(obj.Object, "spec", "scope")
+	crd.Kind, _, _ = unstructured.NestedString(obj.Object, "spec", "names", "kind")
+	crd.Plural, _, _ = unstructured.NestedString(obj.Object, "spec", "names", "plural")
+
+	versions, _, _ := unstructured.NestedSlice(obj.Object, "spec", "versions")
+	for _, v := range versions {
+		version, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _, _ := unstructured.NestedString(version, "name")
+		served, _, _ := unstructured.NestedBool(version, "served")
+		storage, _, _ := unstructured.NestedBool(version, "storage")
+		if storage {
+			crd.Version = name
+			break
+		}
+		if served && crd.Version == "" {
+			crd.Version = name
+		}
+	}
+	return crd
+}
@@ -0,0 +1,94 @@
+package datasource
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func newTestCRD(group, kind, plural, scope string, versions ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": plural + "." + group},
+		"spec": map[string]interface{}{
+			"group":    group,
+			"scope":    scope,
+			"names":    map[string]interface{}{"kind": kind, "plural": plural},
+			"versions": versions,
+		},
+	}}
+}
+
+func TestConvertCRD(t *testing.T) {
+	crd := convertCRD(newTestCRD("example.com", "Widget", "widgets", "Namespaced",
+		map[string]interface{}{"name": "v1alpha1", "served": true, "storage": false},
+		map[string]interface{}{"name": "v1", "served": true, "storage": true},
+	))
+	if crd.Name != "widgets.example.com" || crd.Kind != "Widget" || crd.Group != "example.com" ||
+		crd.Plural != "widgets" || crd.Scope != "Namespaced" {
+		t.Errorf("convertCRD() = %+v", crd)
+	}
+	if crd.Version != "v1" {
+		t.Errorf("Version = %q, want storage version v1", crd.Version)
+	}
+
+	// Without a storage version the first served one is used
+	crd = convertCRD(newTestCRD("example.com", "Gadget", "gadgets", "Cluster",
+		map[string]interface{}{"name": "v1beta1", "served": false},
+		map[string]interface{}{"name": "v1beta2", "served": true},
+	))
+	if crd.Version != "v1beta2" {
+		t.Errorf("Version = %q, want first served version v1beta2", crd.Version)
+	}
+}
+
+func TestCRDClientGetCRDs(t *testing.T) {
+	widgetGVR := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	widget := func(namespace, name string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+		}}
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			crdGVR:    "CustomResourceDefinitionList",
+			widgetGVR: "WidgetList",
+		},
+		newTestCRD("example.com", "Widget", "widgets", "Namespaced",
+			map[string]interface{}{"name": "v1", "served": true, "storage": true}),
+		newTestCRD("aaa.io", "Thing", "things", "Cluster",
+			map[string]interface{}{"name": "v1", "served": true, "storage": true}),
+		widget("a", "w1"), widget("b", "w2"), widget("b", "w3"),
+	)
+	client := &CRDClient{dynamicClient: dynamicClient, logger: zap.NewNop()}
+	if err := client.SetCountedCRDs([]string{"*.example.com"}); err != nil {
+		t.Fatalf("SetCountedCRDs() error = %v", err)
+	}
+
+	crds, err := client.GetCRDs(context.Background())
+	if err != nil {
+		t.Fatalf("GetCRDs() error = %v", err)
+	}
+	if len(crds) != 2 || crds[0].Kind != "Thing" || crds[1].Kind != "Widget" {
+		t.Fatalf("GetCRDs() = %v, want Thing then Widget", crds)
+	}
+	// Only CRDs matching a pattern are counted
+	if crds[0].Counted {
+		t.Errorf("Thing counted, want only matching CRDs counted")
+	}
+	if !crds[1].Counted || crds[1].Instances != 3 {
+		t.Errorf("Widget instances = %d (counted %v), want 3", crds[1].Instances, crds[1].Counted)
+	}
+
+	if err := client.SetCountedCRDs([]string{"[bad"}); err == nil {
+		t.Error("SetCountedCRDs([bad) error = nil, want invalid pattern")
+	}
+}
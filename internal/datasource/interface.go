@@ -3,12 +3,17 @@ package datasource
 import (
 	"context"
 	"encoding/json"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/yourusername/k8s-monitor/internal/model"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DataSource defines the interface for Kubernetes data providers
@@ -46,6 +51,9 @@ type MetricsSource interface {
 
 // Helper functions to convert Kubernetes API objects to internal models
 
+// gpuResourceName is the extended resource of the NVIDIA device plugin
+const gpuResourceName corev1.ResourceName = "nvidia.com/gpu"
+
 // ConvertNode converts a Kubernetes Node to NodeData
 func ConvertNode(node *corev1.Node) *model.NodeData {
 	nodeData := &model.NodeData{
@@ -54,16 +62,27 @@ func ConvertNode(node *corev1.Node) *model.NodeData {
 		Status:            extractNodeStatus(node),
 		Conditions:        node.Status.Conditions,
 		Taints:            node.Spec.Taints,
+		Unschedulable:     node.Spec.Unschedulable,
 		Labels:            node.Labels,
 		Annotations:       node.Annotations,
 		CreationTimestamp: node.CreationTimestamp.Time,
+
+		KubeletVersion:          node.Status.NodeInfo.KubeletVersion,
+		KubeProxyVersion:        node.Status.NodeInfo.KubeProxyVersion,
+		ContainerRuntimeVersion: node.Status.NodeInfo.ContainerRuntimeVersion,
+		OSImage:                 node.Status.NodeInfo.OSImage,
+		KernelVersion:           node.Status.NodeInfo.KernelVersion,
 	}
 
 	// Extract IPs
 	for _, addr := range node.Status.Addresses {
 		switch addr.Type {
 		case corev1.NodeInternalIP:
-			nodeData.InternalIP = addr.Address
+			// Dual-stack nodes report one internal address per IP family
+			if nodeData.InternalIP == "" {
+				nodeData.InternalIP = addr.Address
+			}
+			nodeData.InternalIPs = append(nodeData.InternalIPs, addr.Address)
 		case corev1.NodeExternalIP:
 			nodeData.ExternalIP = addr.Address
 		}
@@ -91,22 +110,26 @@ func ConvertNode(node *corev1.Node) *model.NodeData {
 		}
 	}
 
-	// Extract NPU (Ascend AI accelerator) information from capacity/allocatable
-	// Look for resources like "huawei.com/ascend-1980", "huawei.com/Ascend910", etc.
-	for resourceName, quantity := range node.Status.Capacity {
-		resName := string(resourceName)
-		if strings.Contains(resName, "huawei.com/") && strings.Contains(strings.ToLower(resName), "ascend") {
-			nodeData.NPUCapacity = quantity.Value()
-			nodeData.NPUResourceName = resName
-			break
+	// Extract NPU information from capacity/allocatable: the extended resource
+	// matching the configured NPU resource patterns (Ascend by default), e.g.
+	// "huawei.com/ascend-1980" or "huawei.com/Ascend910"
+	if npuResource, ok := findNPUResource(node.Status.Capacity); ok {
+		capacity := node.Status.Capacity[npuResource]
+		nodeData.NPUCapacity = capacity.Value()
+		nodeData.NPUResourceName = string(npuResource)
+		if allocatable, ok := node.Status.Allocatable[npuResource]; ok {
+			nodeData.NPUAllocatable = allocatable.Value()
 		}
 	}
-	for resourceName, quantity := range node.Status.Allocatable {
-		resName := string(resourceName)
-		if strings.Contains(resName, "huawei.com/") && strings.Contains(strings.ToLower(resName), "ascend") {
-			nodeData.NPUAllocatable = quantity.Value()
-			break
+
+	// Extract GPU information from capacity/allocatable (NVIDIA device plugin)
+	if capacity, ok := node.Status.Capacity[gpuResourceName]; ok {
+		nodeData.GPUCapacity = capacity.Value()
+		if allocatable, ok := node.Status.Allocatable[gpuResourceName]; ok {
+			nodeData.GPUAllocatable = allocatable.Value()
 		}
+		// GPU model: nvidia.com/gpu.product (GPU Feature Discovery)
+		nodeData.GPUProduct = node.Labels["nvidia.com/gpu.product"]
 	}
 
 	// Extract NPU device info from node labels
@@ -255,6 +278,8 @@ func ConvertNode(node *corev1.Node) *model.NodeData {
 					}
 				}
 
+				nodeData.NPUDevices = buildNPUDevices(nodeData.NPUChips)
+
 				// Calculate averages and totals for node-level metrics
 				if len(npuMetrics.Chips) > 0 {
 					nodeData.NPUUtilization = totalAICore / float64(len(npuMetrics.Chips))
@@ -327,7 +352,8 @@ func ConvertPod(pod *corev1.Pod) *model.PodData {
 		Message:           pod.Status.Message,
 		HostIP:            pod.Status.HostIP,
 		PodIP:             pod.Status.PodIP,
-		QOSClass:          string(pod.Status.QOSClass),
+		QOSClass:          computePodQOSClass(pod),
+		GenerateName:      pod.GenerateName,
 		Labels:            pod.Labels,
 		Annotations:       pod.Annotations,
 		CreationTimestamp: pod.CreationTimestamp.Time,
@@ -338,6 +364,35 @@ func ConvertPod(pod *corev1.Pod) *model.PodData {
 		podData.StartTime = pod.Status.StartTime.Time
 	}
 
+	// Dual-stack pods have one IP per family; PodIP is always the first
+	for _, ip := range pod.Status.PodIPs {
+		podData.PodIPs = append(podData.PodIPs, ip.IP)
+	}
+	if len(podData.PodIPs) == 0 && podData.PodIP != "" {
+		podData.PodIPs = []string{podData.PodIP}
+	}
+
+	// Controller owner (used for grouping pods by workload)
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		podData.OwnerKind = owner.Kind
+		podData.OwnerName = owner.Name
+	}
+
+	// Scheduling status from the PodScheduled condition
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled {
+			podData.PodScheduled = string(cond.Status)
+			if cond.Status != corev1.ConditionTrue {
+				podData.SchedulingReason = cond.Reason
+				podData.SchedulingMessage = cond.Message
+			}
+			break
+		}
+	}
+
+	applySchedulingConstraints(podData, &pod.Spec)
+	podData.Volumes = convertPodVolumes(pod)
+
 	// Count containers
 	podData.Containers = len(pod.Spec.Containers)
 
@@ -357,30 +412,39 @@ func ConvertPod(pod *corev1.Pod) *model.PodData {
 		}
 
 		state := extractContainerState(&cs)
+		if last := state.LastTermination; last != nil && cs.RestartCount > 0 && last.FinishedAt.After(podData.LastRestartTime) {
+			podData.LastRestartTime = last.FinishedAt
+		}
 
 		// Fill in resource requests/limits for this container
 		if spec, found := containerSpecs[cs.Name]; found {
-			if spec.Resources.Requests != nil {
-				if cpu := spec.Resources.Requests.Cpu(); cpu != nil {
-					state.CPURequest = cpu.MilliValue()
-				}
-				if mem := spec.Resources.Requests.Memory(); mem != nil {
-					state.MemoryRequest = mem.Value()
-				}
-			}
-			if spec.Resources.Limits != nil {
-				if cpu := spec.Resources.Limits.Cpu(); cpu != nil {
-					state.CPULimit = cpu.MilliValue()
-				}
-				if mem := spec.Resources.Limits.Memory(); mem != nil {
-					state.MemoryLimit = mem.Value()
-				}
-			}
+			applyContainerResources(&state, &spec)
+			applyContainerProbes(&state, &spec)
+			applyContainerImage(&state, &spec)
+			applyContainerVolumeMounts(&state, &spec)
+			delete(containerSpecs, cs.Name)
 		}
 
 		podData.ContainerStates = append(podData.ContainerStates, state)
 	}
 
+	// Containers without a status yet (e.g. pod not scheduled) still report their requests/limits
+	for _, spec := range pod.Spec.Containers {
+		if _, pending := containerSpecs[spec.Name]; !pending {
+			continue
+		}
+		state := model.ContainerState{
+			Name:  spec.Name,
+			Image: spec.Image,
+			State: "Waiting",
+		}
+		applyContainerResources(&state, &spec)
+		applyContainerProbes(&state, &spec)
+		applyContainerImage(&state, &spec)
+		applyContainerVolumeMounts(&state, &spec)
+		podData.ContainerStates = append(podData.ContainerStates, state)
+	}
+
 	// Extract resource requests/limits
 	for _, container := range pod.Spec.Containers {
 		if container.Resources.Requests != nil {
@@ -390,10 +454,13 @@ func ConvertPod(pod *corev1.Pod) *model.PodData {
 			if mem := container.Resources.Requests.Memory(); mem != nil {
 				podData.MemoryRequest += mem.Value()
 			}
-			// Extract NPU requests (Ascend AI accelerators)
+			if gpu, ok := container.Resources.Requests[gpuResourceName]; ok {
+				podData.GPURequest += gpu.Value()
+			}
+			// Extract NPU requests (configured NPU resources, Ascend by default)
 			for resourceName, quantity := range container.Resources.Requests {
 				resName := string(resourceName)
-				if strings.Contains(resName, "huawei.com/") && strings.Contains(strings.ToLower(resName), "ascend") {
+				if isNPUResource(resName) {
 					podData.NPURequest += quantity.Value()
 					if podData.NPUResourceName == "" {
 						podData.NPUResourceName = resName
@@ -414,6 +481,221 @@ func ConvertPod(pod *corev1.Pod) *model.PodData {
 	return podData
 }
 
+// computePodQOSClass returns the QoS class the API server reports for the pod.
+// Pods without one (e.g. not yet admitted) get it derived from container
+// requests/limits, following the same rules as the kubelet (only CPU and
+// memory are considered).
+func computePodQOSClass(pod *corev1.Pod) string {
+	if pod.Status.QOSClass != "" {
+		return string(pod.Status.QOSClass)
+	}
+
+	requests := make(map[corev1.ResourceName]resource.Quantity)
+	limits := make(map[corev1.ResourceName]resource.Quantity)
+	isGuaranteed := true
+
+	containers := make([]corev1.Container, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	containers = append(containers, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+
+	for _, container := range containers {
+		for name, quantity := range container.Resources.Requests {
+			if !isQOSResource(name) || quantity.Sign() <= 0 {
+				continue
+			}
+			if existing, ok := requests[name]; ok {
+				quantity.Add(existing)
+			}
+			requests[name] = quantity
+		}
+
+		hasCPULimit, hasMemLimit := false, false
+		for name, quantity := range container.Resources.Limits {
+			if !isQOSResource(name) || quantity.Sign() <= 0 {
+				continue
+			}
+			if name == corev1.ResourceCPU {
+				hasCPULimit = true
+			} else {
+				hasMemLimit = true
+			}
+			if existing, ok := limits[name]; ok {
+				quantity.Add(existing)
+			}
+			limits[name] = quantity
+		}
+		if !hasCPULimit || !hasMemLimit {
+			isGuaranteed = false
+		}
+	}
+
+	if len(requests) == 0 && len(limits) == 0 {
+		return string(corev1.PodQOSBestEffort)
+	}
+
+	if isGuaranteed {
+		for name, req := range requests {
+			if lim, ok := limits[name]; !ok || lim.Cmp(req) != 0 {
+				isGuaranteed = false
+				break
+			}
+		}
+	}
+	if isGuaranteed && len(requests) == len(limits) {
+		return string(corev1.PodQOSGuaranteed)
+	}
+
+	return string(corev1.PodQOSBurstable)
+}
+
+// isQOSResource reports whether a resource participates in QoS classification
+func isQOSResource(name corev1.ResourceName) bool {
+	return name == corev1.ResourceCPU || name == corev1.ResourceMemory
+}
+
+// applyContainerResources copies CPU/memory requests and limits from a container spec
+func applyContainerResources(state *model.ContainerState, spec *corev1.Container) {
+	if spec.Resources.Requests != nil {
+		if cpu := spec.Resources.Requests.Cpu(); cpu != nil {
+			state.CPURequest = cpu.MilliValue()
+		}
+		if mem := spec.Resources.Requests.Memory(); mem != nil {
+			state.MemoryRequest = mem.Value()
+		}
+	}
+	if spec.Resources.Limits != nil {
+		if cpu := spec.Resources.Limits.Cpu(); cpu != nil {
+			state.CPULimit = cpu.MilliValue()
+		}
+		if mem := spec.Resources.Limits.Memory(); mem != nil {
+			state.MemoryLimit = mem.Value()
+		}
+	}
+}
+
+// applyContainerProbes copies the readiness, liveness and startup probe definitions from a container spec
+func applyContainerProbes(state *model.ContainerState, spec *corev1.Container) {
+	for _, p := range []struct {
+		probeType string
+		probe     *corev1.Probe
+	}{
+		{"Readiness", spec.ReadinessProbe},
+		{"Liveness", spec.LivenessProbe},
+		{"Startup", spec.StartupProbe},
+	} {
+		if p.probe == nil {
+			continue
+		}
+		state.Probes = append(state.Probes, model.ProbeInfo{
+			Type:             p.probeType,
+			Handler:          describeProbeHandler(&p.probe.ProbeHandler),
+			InitialDelay:     p.probe.InitialDelaySeconds,
+			Period:           p.probe.PeriodSeconds,
+			Timeout:          p.probe.TimeoutSeconds,
+			SuccessThreshold: p.probe.SuccessThreshold,
+			FailureThreshold: p.probe.FailureThreshold,
+		})
+	}
+}
+
+// applyContainerImage copies the image and pull policy from a container spec
+func applyContainerImage(state *model.ContainerState, spec *corev1.Container) {
+	state.SpecImage = spec.Image
+	state.ImagePullPolicy = string(spec.ImagePullPolicy)
+}
+
+// applyContainerVolumeMounts copies the volume mounts from a container spec
+func applyContainerVolumeMounts(state *model.ContainerState, spec *corev1.Container) {
+	for _, mount := range spec.VolumeMounts {
+		state.VolumeMounts = append(state.VolumeMounts, model.VolumeMount{
+			Name:      mount.Name,
+			MountPath: mount.MountPath,
+			SubPath:   mount.SubPath,
+			ReadOnly:  mount.ReadOnly,
+		})
+	}
+}
+
+// convertPodVolumes returns the volumes of a pod spec with the source of
+// their data
+func convertPodVolumes(pod *corev1.Pod) []model.PodVolume {
+	volumes := make([]model.PodVolume, 0, len(pod.Spec.Volumes))
+	for _, v := range pod.Spec.Volumes {
+		volume := model.PodVolume{Name: v.Name, Type: "Other"}
+		switch {
+		case v.ConfigMap != nil:
+			volume.Type, volume.Source = "ConfigMap", v.ConfigMap.Name
+		case v.Secret != nil:
+			volume.Type, volume.Source = "Secret", v.Secret.SecretName
+		case v.PersistentVolumeClaim != nil:
+			volume.Type, volume.Source = "PersistentVolumeClaim", v.PersistentVolumeClaim.ClaimName
+			volume.ReadOnly = v.PersistentVolumeClaim.ReadOnly
+		case v.Ephemeral != nil:
+			volume.Type, volume.Source = "Ephemeral", pod.Name+"-"+v.Name
+		case v.EmptyDir != nil:
+			volume.Type, volume.Source = "EmptyDir", string(v.EmptyDir.Medium)
+		case v.HostPath != nil:
+			volume.Type, volume.Source = "HostPath", v.HostPath.Path
+		case v.Projected != nil:
+			volume.Type = "Projected"
+			var sources []string
+			for _, source := range v.Projected.Sources {
+				switch {
+				case source.ConfigMap != nil:
+					sources = append(sources, "configmap/"+source.ConfigMap.Name)
+				case source.Secret != nil:
+					sources = append(sources, "secret/"+source.Secret.Name)
+				case source.ServiceAccountToken != nil:
+					sources = append(sources, "serviceaccount-token")
+				case source.DownwardAPI != nil:
+					sources = append(sources, "downward-api")
+				}
+			}
+			volume.Source = strings.Join(sources, ", ")
+		case v.DownwardAPI != nil:
+			volume.Type = "DownwardAPI"
+		case v.CSI != nil:
+			volume.Type, volume.Source = "CSI", v.CSI.Driver
+		case v.NFS != nil:
+			volume.Type, volume.Source = "NFS", v.NFS.Server+":"+v.NFS.Path
+		}
+		volumes = append(volumes, volume)
+	}
+	return volumes
+}
+
+// applySchedulingConstraints copies the scheduling gates, node selector,
+// required node affinity and tolerations that decide where a pod may run
+func applySchedulingConstraints(podData *model.PodData, spec *corev1.PodSpec) {
+	for _, gate := range spec.SchedulingGates {
+		podData.SchedulingGates = append(podData.SchedulingGates, gate.Name)
+	}
+	podData.NodeSelector = spec.NodeSelector
+	if spec.Affinity != nil && spec.Affinity.NodeAffinity != nil {
+		podData.NodeAffinity = spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	}
+	podData.Tolerations = spec.Tolerations
+}
+
+// describeProbeHandler renders a probe handler in the style of kubectl describe
+func describeProbeHandler(handler *corev1.ProbeHandler) string {
+	switch {
+	case handler.HTTPGet != nil:
+		scheme := "HTTP"
+		if handler.HTTPGet.Scheme == corev1.URISchemeHTTPS {
+			scheme = "HTTPS"
+		}
+		return scheme + " GET " + handler.HTTPGet.Host + ":" + handler.HTTPGet.Port.String() + handler.HTTPGet.Path
+	case handler.TCPSocket != nil:
+		return "TCP " + handler.TCPSocket.Host + ":" + handler.TCPSocket.Port.String()
+	case handler.GRPC != nil:
+		return "gRPC :" + strconv.Itoa(int(handler.GRPC.Port))
+	case handler.Exec != nil:
+		return "Exec " + strings.Join(handler.Exec.Command, " ")
+	}
+	return "Unknown"
+}
+
 // extractContainerState extracts container state from ContainerStatus
 func extractContainerState(cs *corev1.ContainerStatus) model.ContainerState {
 	state := model.ContainerState{
@@ -421,6 +703,8 @@ func extractContainerState(cs *corev1.ContainerStatus) model.ContainerState {
 		Image:        cs.Image,
 		Ready:        cs.Ready,
 		RestartCount: cs.RestartCount,
+		Started:      cs.Started,
+		ImageID:      cs.ImageID,
 	}
 
 	if cs.State.Running != nil {
@@ -436,6 +720,17 @@ func extractContainerState(cs *corev1.ContainerStatus) model.ContainerState {
 		state.ExitCode = cs.State.Terminated.ExitCode
 	}
 
+	if last := cs.LastTerminationState.Terminated; last != nil {
+		state.LastTermination = &model.ContainerTermination{
+			ExitCode:   last.ExitCode,
+			Signal:     last.Signal,
+			Reason:     last.Reason,
+			Message:    last.Message,
+			StartedAt:  last.StartedAt.Time,
+			FinishedAt: last.FinishedAt.Time,
+		}
+	}
+
 	return state
 }
 
@@ -449,11 +744,156 @@ func ConvertEvent(event *corev1.Event) *model.EventData {
 		FirstTimestamp:    event.FirstTimestamp.Time,
 		LastTimestamp:     event.LastTimestamp.Time,
 		InvolvedObject:    event.InvolvedObject.Kind + "/" + event.InvolvedObject.Name,
+		InvolvedKind:      event.InvolvedObject.Kind,
+		InvolvedName:      event.InvolvedObject.Name,
 		InvolvedNamespace: event.InvolvedObject.Namespace,
 		Source:            event.Source.Component,
 	}
 }
 
+// Annotations marking a StorageClass as the cluster default
+const (
+	defaultStorageClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// ConvertStorageClass converts a Kubernetes StorageClass to StorageClassData
+func ConvertStorageClass(sc *storagev1.StorageClass) *model.StorageClassData {
+	// Reclaim policy and binding mode default to Delete and Immediate when unset
+	reclaimPolicy := string(corev1.PersistentVolumeReclaimDelete)
+	if sc.ReclaimPolicy != nil {
+		reclaimPolicy = string(*sc.ReclaimPolicy)
+	}
+	bindingMode := string(storagev1.VolumeBindingImmediate)
+	if sc.VolumeBindingMode != nil {
+		bindingMode = string(*sc.VolumeBindingMode)
+	}
+
+	return &model.StorageClassData{
+		Name:                 sc.Name,
+		Provisioner:          sc.Provisioner,
+		ReclaimPolicy:        reclaimPolicy,
+		VolumeBindingMode:    bindingMode,
+		AllowVolumeExpansion: sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion,
+		IsDefault: sc.Annotations[defaultStorageClassAnnotation] == "true" ||
+			sc.Annotations[betaDefaultStorageClassAnnotation] == "true",
+		Parameters:        sc.Parameters,
+		Labels:            sc.Labels,
+		CreationTimestamp: sc.CreationTimestamp.Time,
+	}
+}
+
+// ConvertResourceQuota converts a Kubernetes ResourceQuota to QuotaData
+func ConvertResourceQuota(quota *corev1.ResourceQuota) *model.QuotaData {
+	data := &model.QuotaData{
+		Name:      quota.Name,
+		Namespace: quota.Namespace,
+	}
+
+	for name, hard := range quota.Status.Hard {
+		used := quota.Status.Used[name]
+		remaining := hard.DeepCopy()
+		remaining.Sub(used)
+		if remaining.Sign() < 0 {
+			remaining = resource.Quantity{}
+		}
+		data.Resources = append(data.Resources, model.QuotaResource{
+			Name:      string(name),
+			Hard:      hard.String(),
+			Used:      used.String(),
+			Remaining: remaining.String(),
+			HardMilli: hard.MilliValue(),
+			UsedMilli: used.MilliValue(),
+		})
+	}
+	// Status is empty until the quota controller has processed the quota
+	if len(data.Resources) == 0 {
+		for name, hard := range quota.Spec.Hard {
+			data.Resources = append(data.Resources, model.QuotaResource{
+				Name:      string(name),
+				Hard:      hard.String(),
+				Remaining: hard.String(),
+				HardMilli: hard.MilliValue(),
+			})
+		}
+	}
+
+	sort.Slice(data.Resources, func(i, j int) bool {
+		return data.Resources[i].Name < data.Resources[j].Name
+	})
+	return data
+}
+
+// ConvertLimitRange converts a Kubernetes LimitRange to LimitRangeData,
+// flattening each limit into one item per resource
+func ConvertLimitRange(lr *corev1.LimitRange) *model.LimitRangeData {
+	data := &model.LimitRangeData{
+		Name:      lr.Name,
+		Namespace: lr.Namespace,
+	}
+
+	for _, limit := range lr.Spec.Limits {
+		resources := make(map[corev1.ResourceName]bool)
+		for _, list := range []corev1.ResourceList{limit.Min, limit.Max, limit.Default, limit.DefaultRequest, limit.MaxLimitRequestRatio} {
+			for name := range list {
+				resources[name] = true
+			}
+		}
+
+		names := make([]string, 0, len(resources))
+		for name := range resources {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			resourceName := corev1.ResourceName(name)
+			data.Limits = append(data.Limits, model.LimitRangeItem{
+				Type:           string(limit.Type),
+				Resource:       name,
+				Min:            quantityString(limit.Min, resourceName),
+				Max:            quantityString(limit.Max, resourceName),
+				Default:        quantityString(limit.Default, resourceName),
+				DefaultRequest: quantityString(limit.DefaultRequest, resourceName),
+				MaxRatio:       quantityString(limit.MaxLimitRequestRatio, resourceName),
+			})
+		}
+	}
+
+	return data
+}
+
+// ConvertPDB converts a Kubernetes PodDisruptionBudget to PDBData
+func ConvertPDB(pdb *policyv1.PodDisruptionBudget) *model.PDBData {
+	data := &model.PDBData{
+		Name:               pdb.Name,
+		Namespace:          pdb.Namespace,
+		CurrentHealthy:     pdb.Status.CurrentHealthy,
+		DesiredHealthy:     pdb.Status.DesiredHealthy,
+		ExpectedPods:       pdb.Status.ExpectedPods,
+		DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+		CreationTimestamp:  pdb.CreationTimestamp.Time,
+	}
+	if pdb.Spec.MinAvailable != nil {
+		data.MinAvailable = pdb.Spec.MinAvailable.String()
+	}
+	if pdb.Spec.MaxUnavailable != nil {
+		data.MaxUnavailable = pdb.Spec.MaxUnavailable.String()
+	}
+	if pdb.Spec.Selector != nil {
+		data.Selector = pdb.Spec.Selector.MatchLabels
+	}
+	return data
+}
+
+// quantityString returns a resource quantity from list, or "" when unset
+func quantityString(list corev1.ResourceList, name corev1.ResourceName) string {
+	if q, ok := list[name]; ok {
+		return q.String()
+	}
+	return ""
+}
+
 // parseMemoryValue parses memory value strings like "64Gi", "32G", "65536Mi" to bytes
 func parseMemoryValue(value string) int64 {
 	value = strings.TrimSpace(value)
@@ -1,10 +1,17 @@
 package datasource
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/yourusername/k8s-monitor/internal/model"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestConvertNode(t *testing.T) {
@@ -48,6 +55,113 @@ func TestConvertNode(t *testing.T) {
 	}
 }
 
+func TestConvertNodeGPU(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "gpu-node",
+			Labels: map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-80GB"},
+		},
+		Status: corev1.NodeStatus{
+			Capacity:    corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+			Allocatable: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("7")},
+		},
+	}
+
+	nodeData := ConvertNode(node)
+	if nodeData.GPUCapacity != 8 || nodeData.GPUAllocatable != 7 {
+		t.Errorf("GPU capacity/allocatable = %d/%d, want 8/7", nodeData.GPUCapacity, nodeData.GPUAllocatable)
+	}
+	if nodeData.GPUProduct != "NVIDIA-A100-SXM4-80GB" {
+		t.Errorf("GPUProduct = %q, want NVIDIA-A100-SXM4-80GB", nodeData.GPUProduct)
+	}
+	if nodeData.NPUCapacity != 0 {
+		t.Errorf("NPUCapacity = %d, want 0 for a GPU-only node", nodeData.NPUCapacity)
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainer", Namespace: "ml"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "a", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")}}},
+				{Name: "b", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}}},
+			},
+		},
+	}
+	if podData := ConvertPod(pod); podData.GPURequest != 3 {
+		t.Errorf("GPURequest = %d, want 3", podData.GPURequest)
+	}
+}
+
+func TestConvertDualStackAddresses(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "dual"},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: corev1.NodeHostName, Address: "dual"},
+				{Type: corev1.NodeInternalIP, Address: "fd00::1"},
+			},
+		},
+	}
+	nodeData := ConvertNode(node)
+	if nodeData.InternalIP != "10.0.0.1" {
+		t.Errorf("InternalIP = %q, want 10.0.0.1", nodeData.InternalIP)
+	}
+	if want := []string{"10.0.0.1", "fd00::1"}; !reflect.DeepEqual(nodeData.InternalIPs, want) {
+		t.Errorf("InternalIPs = %v, want %v", nodeData.InternalIPs, want)
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Status: corev1.PodStatus{
+			PodIP:  "10.244.0.5",
+			PodIPs: []corev1.PodIP{{IP: "10.244.0.5"}, {IP: "fd00:10:244::5"}},
+		},
+	}
+	if podData := ConvertPod(pod); !reflect.DeepEqual(podData.PodIPs, []string{"10.244.0.5", "fd00:10:244::5"}) {
+		t.Errorf("PodIPs = %v, want both families", podData.PodIPs)
+	}
+
+	// Pods without status.podIPs fall back to the singular field
+	pod.Status.PodIPs = nil
+	if podData := ConvertPod(pod); !reflect.DeepEqual(podData.PodIPs, []string{"10.244.0.5"}) {
+		t.Errorf("PodIPs = %v, want [10.244.0.5]", podData.PodIPs)
+	}
+}
+
+func TestConvertNodeSystemInfo(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-1"},
+		Status: corev1.NodeStatus{
+			NodeInfo: corev1.NodeSystemInfo{
+				KubeletVersion:          "v1.28.3",
+				KubeProxyVersion:        "v1.28.3",
+				ContainerRuntimeVersion: "containerd://1.7.2",
+				OSImage:                 "Ubuntu 22.04.3 LTS",
+				KernelVersion:           "5.15.0-88-generic",
+			},
+		},
+	}
+
+	nodeData := ConvertNode(node)
+
+	if nodeData.KubeletVersion != "v1.28.3" {
+		t.Errorf("Expected kubelet version 'v1.28.3', got '%s'", nodeData.KubeletVersion)
+	}
+	if nodeData.KubeProxyVersion != "v1.28.3" {
+		t.Errorf("Expected kube-proxy version 'v1.28.3', got '%s'", nodeData.KubeProxyVersion)
+	}
+	if nodeData.ContainerRuntimeVersion != "containerd://1.7.2" {
+		t.Errorf("Expected container runtime 'containerd://1.7.2', got '%s'", nodeData.ContainerRuntimeVersion)
+	}
+	if nodeData.OSImage != "Ubuntu 22.04.3 LTS" {
+		t.Errorf("Expected OS image 'Ubuntu 22.04.3 LTS', got '%s'", nodeData.OSImage)
+	}
+	if nodeData.KernelVersion != "5.15.0-88-generic" {
+		t.Errorf("Expected kernel version '5.15.0-88-generic', got '%s'", nodeData.KernelVersion)
+	}
+}
+
 func TestConvertPod(t *testing.T) {
 	// Create a sample pod
 	pod := &corev1.Pod{
@@ -131,7 +245,623 @@ func TestConvertEvent(t *testing.T) {
 	if eventData.InvolvedObject != "Pod/test-pod" {
 		t.Errorf("Expected involved object 'Pod/test-pod', got '%s'", eventData.InvolvedObject)
 	}
+	if eventData.InvolvedKind != "Pod" || eventData.InvolvedName != "test-pod" {
+		t.Errorf("Expected involved kind/name 'Pod'/'test-pod', got '%s'/'%s'", eventData.InvolvedKind, eventData.InvolvedName)
+	}
 	if eventData.Source != "scheduler" {
 		t.Errorf("Expected source 'scheduler', got '%s'", eventData.Source)
 	}
 }
+
+func TestConvertNodeTaintsAndLabels(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tainted-node",
+			Labels: map[string]string{
+				"kubernetes.io/hostname":      "tainted-node",
+				"topology.kubernetes.io/zone": "zone-a",
+			},
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{
+				{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "node.kubernetes.io/unreachable", Effect: corev1.TaintEffectNoExecute},
+			},
+		},
+	}
+
+	nodeData := ConvertNode(node)
+
+	if len(nodeData.Taints) != 2 {
+		t.Fatalf("Expected 2 taints, got %d", len(nodeData.Taints))
+	}
+	if nodeData.Taints[0].Key != "dedicated" || nodeData.Taints[0].Value != "gpu" || nodeData.Taints[0].Effect != corev1.TaintEffectNoSchedule {
+		t.Errorf("Unexpected first taint: %+v", nodeData.Taints[0])
+	}
+	if len(nodeData.Labels) != 2 || nodeData.Labels["topology.kubernetes.io/zone"] != "zone-a" {
+		t.Errorf("Expected full label map, got %v", nodeData.Labels)
+	}
+}
+
+func TestComputePodQOSClass(t *testing.T) {
+	resources := func(cpu, mem string) corev1.ResourceList {
+		list := corev1.ResourceList{}
+		if cpu != "" {
+			list[corev1.ResourceCPU] = resource.MustParse(cpu)
+		}
+		if mem != "" {
+			list[corev1.ResourceMemory] = resource.MustParse(mem)
+		}
+		return list
+	}
+
+	tests := []struct {
+		name     string
+		requests corev1.ResourceList
+		limits   corev1.ResourceList
+		status   corev1.PodQOSClass
+		expected string
+	}{
+		{"no resources", nil, nil, "", "BestEffort"},
+		{"requests equal limits", resources("500m", "256Mi"), resources("500m", "256Mi"), "", "Guaranteed"},
+		{"requests below limits", resources("250m", "128Mi"), resources("500m", "256Mi"), "", "Burstable"},
+		{"missing memory limit", resources("500m", "256Mi"), resources("500m", ""), "", "Burstable"},
+		{"requests only", resources("100m", ""), nil, "", "Burstable"},
+		// The class reported by the API server wins over the local calculation
+		{"status reported", resources("500m", "256Mi"), resources("500m", "256Mi"), corev1.PodQOSBurstable, "Burstable"},
+	}
+
+	for _, tt := range tests {
+		pod := &corev1.Pod{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "app",
+						Resources: corev1.ResourceRequirements{
+							Requests: tt.requests,
+							Limits:   tt.limits,
+						},
+					},
+				},
+			},
+			Status: corev1.PodStatus{QOSClass: tt.status},
+		}
+		if got := ConvertPod(pod).QOSClass; got != tt.expected {
+			t.Errorf("%s: expected QoS class '%s', got '%s'", tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestConvertPodSchedulingStatus(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pending-pod", Namespace: "default"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  "Unschedulable",
+					Message: "0/3 nodes are available: 3 Insufficient cpu.",
+				},
+			},
+		},
+	}
+
+	podData := ConvertPod(pod)
+
+	if podData.PodScheduled != "False" {
+		t.Errorf("Expected PodScheduled 'False', got '%s'", podData.PodScheduled)
+	}
+	if podData.SchedulingReason != "Unschedulable" {
+		t.Errorf("Expected reason 'Unschedulable', got '%s'", podData.SchedulingReason)
+	}
+	if podData.SchedulingMessage == "" {
+		t.Errorf("Expected scheduler message to be set")
+	}
+}
+
+func TestConvertPodSchedulingConstraints(t *testing.T) {
+	affinity := &corev1.NodeSelector{
+		NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{
+				{Key: "topology.kubernetes.io/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a", "b"}},
+			},
+		}},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "gated", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			SchedulingGates: []corev1.PodSchedulingGate{{Name: "example.com/quota"}, {Name: "example.com/warmup"}},
+			NodeSelector:    map[string]string{"disktype": "ssd"},
+			Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: affinity,
+			}},
+			Tolerations: []corev1.Toleration{
+				{Key: "gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+	}
+
+	podData := ConvertPod(pod)
+
+	if len(podData.SchedulingGates) != 2 || podData.SchedulingGates[0] != "example.com/quota" {
+		t.Errorf("SchedulingGates = %v, want [example.com/quota example.com/warmup]", podData.SchedulingGates)
+	}
+	if podData.NodeSelector["disktype"] != "ssd" {
+		t.Errorf("NodeSelector = %v, want disktype=ssd", podData.NodeSelector)
+	}
+	if podData.NodeAffinity != affinity {
+		t.Errorf("NodeAffinity = %v, want the required node selector", podData.NodeAffinity)
+	}
+	if len(podData.Tolerations) != 1 || podData.Tolerations[0].Key != "gpu" {
+		t.Errorf("Tolerations = %v, want the gpu toleration", podData.Tolerations)
+	}
+
+	// Pods without constraints leave them empty
+	podData = ConvertPod(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "plain"}})
+	if podData.SchedulingGates != nil || podData.NodeSelector != nil || podData.NodeAffinity != nil || podData.Tolerations != nil {
+		t.Errorf("expected no scheduling constraints, got %+v", podData)
+	}
+}
+
+func TestConvertPodContainerResources(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "app",
+					Image: "nginx",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("100m"),
+							corev1.ResourceMemory: resource.MustParse("128Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("256Mi"),
+						},
+					},
+				},
+				{
+					Name:  "sidecar",
+					Image: "envoy",
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory: resource.MustParse("64Mi"),
+						},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "app", Image: "nginx", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	}
+
+	podData := ConvertPod(pod)
+
+	if len(podData.ContainerStates) != 2 {
+		t.Fatalf("Expected 2 container states, got %d", len(podData.ContainerStates))
+	}
+
+	app := podData.ContainerStates[0]
+	if app.CPURequest != 100 || app.CPULimit != 500 {
+		t.Errorf("Expected app CPU request/limit 100/500, got %d/%d", app.CPURequest, app.CPULimit)
+	}
+	if app.MemoryRequest != 128*1024*1024 || app.MemoryLimit != 256*1024*1024 {
+		t.Errorf("Expected app memory request/limit 128Mi/256Mi, got %d/%d", app.MemoryRequest, app.MemoryLimit)
+	}
+
+	// Container without a status still reports its spec resources
+	sidecar := podData.ContainerStates[1]
+	if sidecar.Name != "sidecar" || sidecar.State != "Waiting" {
+		t.Errorf("Expected waiting sidecar container, got %s (%s)", sidecar.Name, sidecar.State)
+	}
+	if sidecar.MemoryLimit != 64*1024*1024 || sidecar.CPURequest != 0 {
+		t.Errorf("Expected sidecar memory limit 64Mi and no CPU request, got %d/%d", sidecar.MemoryLimit, sidecar.CPURequest)
+	}
+}
+
+func TestConvertPodProbes(t *testing.T) {
+	started := true
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "app",
+					Image: "nginx",
+					ReadinessProbe: &corev1.Probe{
+						ProbeHandler: corev1.ProbeHandler{
+							HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromInt32(8080)},
+						},
+						PeriodSeconds:    10,
+						FailureThreshold: 3,
+					},
+					LivenessProbe: &corev1.Probe{
+						ProbeHandler: corev1.ProbeHandler{
+							TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromString("http")},
+						},
+						InitialDelaySeconds: 15,
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:    "app",
+					Image:   "nginx",
+					Ready:   false,
+					Started: &started,
+					State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				},
+			},
+		},
+	}
+
+	podData := ConvertPod(pod)
+
+	if len(podData.ContainerStates) != 1 {
+		t.Fatalf("Expected 1 container state, got %d", len(podData.ContainerStates))
+	}
+
+	app := podData.ContainerStates[0]
+	if app.Started == nil || !*app.Started {
+		t.Errorf("Expected container to be reported as started")
+	}
+	if len(app.Probes) != 2 {
+		t.Fatalf("Expected 2 probes, got %d", len(app.Probes))
+	}
+
+	readiness := app.Probes[0]
+	if readiness.Type != "Readiness" || readiness.Handler != "HTTP GET :8080/healthz" {
+		t.Errorf("Unexpected readiness probe: %s %s", readiness.Type, readiness.Handler)
+	}
+	if readiness.Period != 10 || readiness.FailureThreshold != 3 {
+		t.Errorf("Expected period 10s and failure threshold 3, got %d/%d", readiness.Period, readiness.FailureThreshold)
+	}
+
+	liveness := app.Probes[1]
+	if liveness.Type != "Liveness" || liveness.Handler != "TCP :http" || liveness.InitialDelay != 15 {
+		t.Errorf("Unexpected liveness probe: %s %s delay=%d", liveness.Type, liveness.Handler, liveness.InitialDelay)
+	}
+}
+
+func TestConvertPodLastTermination(t *testing.T) {
+	started := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	finished := started.Add(90 * time.Minute)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app", Image: "nginx"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:         "app",
+					Image:        "nginx",
+					RestartCount: 3,
+					State:        corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							ExitCode:   137,
+							Signal:     9,
+							Reason:     "OOMKilled",
+							StartedAt:  metav1.NewTime(started),
+							FinishedAt: metav1.NewTime(finished),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	podData := ConvertPod(pod)
+
+	if len(podData.ContainerStates) != 1 {
+		t.Fatalf("Expected 1 container state, got %d", len(podData.ContainerStates))
+	}
+	last := podData.ContainerStates[0].LastTermination
+	if last == nil {
+		t.Fatal("Expected last termination to be captured")
+	}
+	if last.ExitCode != 137 || last.Signal != 9 || last.Reason != "OOMKilled" {
+		t.Errorf("Unexpected last termination: exit %d signal %d reason %s", last.ExitCode, last.Signal, last.Reason)
+	}
+	if !last.StartedAt.Equal(started) || !last.FinishedAt.Equal(finished) {
+		t.Errorf("Unexpected last termination times: %v - %v", last.StartedAt, last.FinishedAt)
+	}
+
+	if !podData.LastRestartTime.Equal(finished) {
+		t.Errorf("Expected last restart at %v, got %v", finished, podData.LastRestartTime)
+	}
+
+	// The most recent restart across containers wins
+	later := finished.Add(time.Hour)
+	pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+		Name:         "sidecar",
+		RestartCount: 1,
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Reason: "Error", FinishedAt: metav1.NewTime(later)},
+		},
+	})
+	if got := ConvertPod(pod).LastRestartTime; !got.Equal(later) {
+		t.Errorf("Expected last restart at %v, got %v", later, got)
+	}
+	pod.Status.ContainerStatuses = pod.Status.ContainerStatuses[:1]
+
+	// Containers that never terminated have no last termination
+	pod.Status.ContainerStatuses[0].LastTerminationState = corev1.ContainerState{}
+	podData = ConvertPod(pod)
+	if last := podData.ContainerStates[0].LastTermination; last != nil {
+		t.Errorf("Expected no last termination, got %+v", last)
+	}
+	if !podData.LastRestartTime.IsZero() {
+		t.Errorf("Expected no last restart, got %v", podData.LastRestartTime)
+	}
+}
+
+func TestConvertPodImages(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "app", Image: "nginx:latest", ImagePullPolicy: corev1.PullIfNotPresent},
+				{Name: "sidecar", Image: "envoy:v1.28", ImagePullPolicy: corev1.PullAlways},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name:    "app",
+					Image:   "docker.io/library/nginx:latest",
+					ImageID: "docker.io/library/nginx@sha256:abc123",
+					State:   corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				},
+			},
+		},
+	}
+
+	podData := ConvertPod(pod)
+
+	if len(podData.ContainerStates) != 2 {
+		t.Fatalf("Expected 2 container states, got %d", len(podData.ContainerStates))
+	}
+	app := podData.ContainerStates[0]
+	if app.SpecImage != "nginx:latest" || app.Image != "docker.io/library/nginx:latest" {
+		t.Errorf("Unexpected images: spec %q, running %q", app.SpecImage, app.Image)
+	}
+	if app.ImagePullPolicy != "IfNotPresent" || app.ImageID != "docker.io/library/nginx@sha256:abc123" {
+		t.Errorf("Unexpected pull policy %q or image ID %q", app.ImagePullPolicy, app.ImageID)
+	}
+
+	// Containers without a status still report their spec image
+	sidecar := podData.ContainerStates[1]
+	if sidecar.SpecImage != "envoy:v1.28" || sidecar.ImagePullPolicy != "Always" || sidecar.ImageID != "" {
+		t.Errorf("Unexpected sidecar image: %q %q %q", sidecar.SpecImage, sidecar.ImagePullPolicy, sidecar.ImageID)
+	}
+}
+
+func TestConvertPodVolumes(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-0", Namespace: "prod"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "db",
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "data", MountPath: "/var/lib/db"},
+					{Name: "config", MountPath: "/etc/db/db.conf", SubPath: "db.conf", ReadOnly: true},
+					{Name: "sock", MountPath: "/var/run/docker.sock"},
+				},
+			}},
+			Volumes: []corev1.Volume{
+				{Name: "data", VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-db-0"}}},
+				{Name: "config", VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "db-config"}}}},
+				{Name: "sock", VolumeSource: corev1.VolumeSource{
+					HostPath: &corev1.HostPathVolumeSource{Path: "/var/run/docker.sock"}}},
+				{Name: "scratch", VolumeSource: corev1.VolumeSource{
+					Ephemeral: &corev1.EphemeralVolumeSource{}}},
+				{Name: "cache", VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMediumMemory}}},
+			},
+		},
+	}
+
+	podData := ConvertPod(pod)
+
+	want := []model.PodVolume{
+		{Name: "data", Type: "PersistentVolumeClaim", Source: "data-db-0"},
+		{Name: "config", Type: "ConfigMap", Source: "db-config"},
+		{Name: "sock", Type: "HostPath", Source: "/var/run/docker.sock"},
+		{Name: "scratch", Type: "Ephemeral", Source: "db-0-scratch"},
+		{Name: "cache", Type: "EmptyDir", Source: "Memory"},
+	}
+	if !reflect.DeepEqual(podData.Volumes, want) {
+		t.Errorf("Volumes = %+v, want %+v", podData.Volumes, want)
+	}
+	// Generic ephemeral volumes are backed by a claim named after the pod
+	if claim := podData.Volumes[3].Claim(); claim != "db-0-scratch" {
+		t.Errorf("ephemeral volume claim = %q, want db-0-scratch", claim)
+	}
+
+	// Containers without a status still report their mounts
+	mounts := podData.ContainerStates[0].VolumeMounts
+	if len(mounts) != 3 {
+		t.Fatalf("Expected 3 volume mounts, got %d", len(mounts))
+	}
+	if mounts[1] != (model.VolumeMount{Name: "config", MountPath: "/etc/db/db.conf", SubPath: "db.conf", ReadOnly: true}) {
+		t.Errorf("Unexpected config mount %+v", mounts[1])
+	}
+}
+
+func TestConvertStorageClass(t *testing.T) {
+	retain := corev1.PersistentVolumeReclaimRetain
+	waitForConsumer := storagev1.VolumeBindingWaitForFirstConsumer
+	expand := true
+	sc := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "fast-ssd",
+			Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+		},
+		Provisioner:          "ebs.csi.aws.com",
+		ReclaimPolicy:        &retain,
+		VolumeBindingMode:    &waitForConsumer,
+		AllowVolumeExpansion: &expand,
+	}
+
+	scData := ConvertStorageClass(sc)
+
+	if scData.Name != "fast-ssd" || scData.Provisioner != "ebs.csi.aws.com" {
+		t.Errorf("Expected fast-ssd/ebs.csi.aws.com, got %s/%s", scData.Name, scData.Provisioner)
+	}
+	if scData.ReclaimPolicy != "Retain" || scData.VolumeBindingMode != "WaitForFirstConsumer" {
+		t.Errorf("Expected Retain/WaitForFirstConsumer, got %s/%s", scData.ReclaimPolicy, scData.VolumeBindingMode)
+	}
+	if !scData.IsDefault || !scData.AllowVolumeExpansion {
+		t.Errorf("Expected default class with expansion, got default=%v expansion=%v", scData.IsDefault, scData.AllowVolumeExpansion)
+	}
+
+	// Unset policy and binding mode fall back to the Kubernetes defaults
+	plain := ConvertStorageClass(&storagev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "standard"},
+		Provisioner: "kubernetes.io/no-provisioner",
+	})
+	if plain.ReclaimPolicy != "Delete" || plain.VolumeBindingMode != "Immediate" || plain.IsDefault {
+		t.Errorf("Expected Delete/Immediate non-default, got %s/%s default=%v", plain.ReclaimPolicy, plain.VolumeBindingMode, plain.IsDefault)
+	}
+}
+
+func TestConvertResourceQuota(t *testing.T) {
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "team-a"},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{
+				corev1.ResourceRequestsCPU:    resource.MustParse("4"),
+				corev1.ResourceRequestsMemory: resource.MustParse("8Gi"),
+				corev1.ResourcePods:           resource.MustParse("10"),
+			},
+			Used: corev1.ResourceList{
+				corev1.ResourceRequestsCPU:    resource.MustParse("3800m"),
+				corev1.ResourceRequestsMemory: resource.MustParse("2Gi"),
+			},
+		},
+	}
+
+	data := ConvertResourceQuota(quota)
+
+	if data.Name != "compute" || data.Namespace != "team-a" {
+		t.Errorf("Expected team-a/compute, got %s/%s", data.Namespace, data.Name)
+	}
+	if len(data.Resources) != 3 {
+		t.Fatalf("Expected 3 resources, got %d", len(data.Resources))
+	}
+	// Resources are sorted by name
+	pods, cpu, mem := data.Resources[0], data.Resources[1], data.Resources[2]
+	if pods.Name != "pods" || cpu.Name != "requests.cpu" || mem.Name != "requests.memory" {
+		t.Errorf("Unexpected resource order: %s, %s, %s", pods.Name, cpu.Name, mem.Name)
+	}
+	if cpu.Hard != "4" || cpu.Used != "3800m" || cpu.UsagePercent() != 95 {
+		t.Errorf("Expected requests.cpu 3800m/4 (95%%), got %s/%s (%.1f%%)", cpu.Used, cpu.Hard, cpu.UsagePercent())
+	}
+	if cpu.Remaining != "200m" {
+		t.Errorf("Expected 200m requests.cpu remaining, got %s", cpu.Remaining)
+	}
+	if mem.UsagePercent() != 25 || mem.Remaining != "6Gi" {
+		t.Errorf("Expected requests.memory at 25%% with 6Gi remaining, got %.1f%% (%s)", mem.UsagePercent(), mem.Remaining)
+	}
+	if pods.Used != "0" || pods.UsagePercent() != 0 {
+		t.Errorf("Expected unused pods quota, got %s (%.1f%%)", pods.Used, pods.UsagePercent())
+	}
+
+	// Quotas not yet processed by the controller fall back to the spec
+	pending := ConvertResourceQuota(&corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "team-a"},
+		Spec:       corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("5")}},
+	})
+	if len(pending.Resources) != 1 || pending.Resources[0].Hard != "5" {
+		t.Errorf("Expected pods hard limit 5 from spec, got %+v", pending.Resources)
+	}
+}
+
+func TestConvertLimitRange(t *testing.T) {
+	lr := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "team-a"},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{{
+				Type: corev1.LimitTypeContainer,
+				Max:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				Default: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+					corev1.ResourceMemory: resource.MustParse("512Mi"),
+				},
+				DefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+			}},
+		},
+	}
+
+	data := ConvertLimitRange(lr)
+
+	if len(data.Limits) != 2 {
+		t.Fatalf("Expected 2 limit items, got %d", len(data.Limits))
+	}
+	cpu, mem := data.Limits[0], data.Limits[1]
+	if cpu.Type != "Container" || cpu.Resource != "cpu" || cpu.Max != "2" || cpu.Default != "500m" || cpu.DefaultRequest != "" {
+		t.Errorf("Unexpected cpu limit: %+v", cpu)
+	}
+	if mem.Resource != "memory" || mem.Default != "512Mi" || mem.DefaultRequest != "256Mi" || mem.Max != "" {
+		t.Errorf("Unexpected memory limit: %+v", mem)
+	}
+}
+
+func TestConvertPDB(t *testing.T) {
+	minAvailable := intstr.FromString("50%")
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-a"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{
+			CurrentHealthy:     2,
+			DesiredHealthy:     2,
+			ExpectedPods:       3,
+			DisruptionsAllowed: 0,
+		},
+	}
+
+	data := ConvertPDB(pdb)
+
+	if data.MinAvailable != "50%" || data.MaxUnavailable != "" {
+		t.Errorf("Expected minAvailable 50%% and no maxUnavailable, got %q/%q", data.MinAvailable, data.MaxUnavailable)
+	}
+	if data.CurrentHealthy != 2 || data.DesiredHealthy != 2 || data.ExpectedPods != 3 {
+		t.Errorf("Unexpected health counts: %+v", data)
+	}
+	if data.Selector["app"] != "web" {
+		t.Errorf("Expected selector app=web, got %v", data.Selector)
+	}
+	if !data.BlocksEviction() {
+		t.Error("Expected PDB with no allowed disruptions to block eviction")
+	}
+
+	// A budget without matching pods does not block anything
+	maxUnavailable := intstr.FromInt32(1)
+	empty := ConvertPDB(&policyv1.PodDisruptionBudget{
+		Spec: policyv1.PodDisruptionBudgetSpec{MaxUnavailable: &maxUnavailable},
+	})
+	if empty.MaxUnavailable != "1" || empty.BlocksEviction() {
+		t.Errorf("Expected maxUnavailable 1 and no blocking, got %q blocking=%v", empty.MaxUnavailable, empty.BlocksEviction())
+	}
+}
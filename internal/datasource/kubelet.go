@@ -4,9 +4,14 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"path"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/yourusername/k8s-monitor/internal/model"
@@ -18,8 +23,23 @@ const (
 	kubeletPort        = 10250
 	summaryAPIPath     = "/stats/summary"
 	defaultHTTPTimeout = 3 * time.Second // Reduced from 10s to 3s for faster failover
+	defaultRetries     = 1
+	retryBackoff       = 200 * time.Millisecond // Doubled on every retry
 )
 
+// ErrKubeletTimeout is returned when a kubelet does not answer within the query timeout
+var ErrKubeletTimeout = errors.New("kubelet query timed out")
+
+// kubeletStatusError is a non-200 response of the kubelet Summary API
+type kubeletStatusError struct {
+	code int
+	body string
+}
+
+func (e *kubeletStatusError) Error() string {
+	return fmt.Sprintf("kubelet returned status %d: %s", e.code, e.body)
+}
+
 // KubeletClient implements MetricsSource using kubelet Summary API
 type KubeletClient struct {
 	httpClient *http.Client
@@ -27,6 +47,17 @@ type KubeletClient struct {
 	logger     *zap.Logger
 	useProxy   bool // true: use API Server proxy, false: direct access
 	insecure   bool // true: skip TLS verification
+
+	// Per-node TLS verification override (see SetInsecureNodes)
+	insecureClient *http.Client
+	insecureNames  []string // Node name globs
+	insecureCIDRs  []*net.IPNet
+	nodeIPsMu      sync.RWMutex
+	nodeIPs        map[string][]string // Node name -> internal IPs, for insecureCIDRs
+
+	// Query policy (see SetQueryPolicy)
+	timeout time.Duration
+	retries int
 }
 
 // NewKubeletClient creates a new kubelet client
@@ -45,7 +76,6 @@ func NewKubeletClient(config *rest.Config, useProxy, insecure bool, logger *zap.
 		}
 
 		httpClient = &http.Client{
-			Timeout:   defaultHTTPTimeout,
 			Transport: transport,
 		}
 
@@ -53,7 +83,6 @@ func NewKubeletClient(config *rest.Config, useProxy, insecure bool, logger *zap.
 	} else {
 		// Direct access to kubelet (requires TLS configuration)
 		httpClient = &http.Client{
-			Timeout: defaultHTTPTimeout,
 			Transport: &http.Transport{
 				TLSClientConfig: &tls.Config{
 					InsecureSkipVerify: insecure,
@@ -71,6 +100,8 @@ func NewKubeletClient(config *rest.Config, useProxy, insecure bool, logger *zap.
 		logger:     logger,
 		useProxy:   useProxy,
 		insecure:   insecure,
+		timeout:    defaultHTTPTimeout,
+		retries:    defaultRetries,
 	}
 
 	logger.Info("Kubelet client initialized",
@@ -81,7 +112,134 @@ func NewKubeletClient(config *rest.Config, useProxy, insecure bool, logger *zap.
 	return client, nil
 }
 
-// GetNodeMetrics retrieves CPU/Memory/Network metrics for a node
+// SetQueryPolicy sets the per-request timeout of kubelet queries and how many
+// times a failed node metrics query is retried. Non-positive timeouts keep the
+// default.
+func (c *KubeletClient) SetQueryPolicy(timeout time.Duration, retries int) {
+	if timeout > 0 {
+		c.timeout = timeout
+	}
+	if retries < 0 {
+		retries = 0
+	}
+	c.retries = retries
+}
+
+// SetInsecureNodes skips TLS verification of kubelet queries for the listed
+// nodes only, each entry being a node name glob ("gpu-*"), an IP or a CIDR
+// matched against the node's internal IPs. Other nodes keep the verification
+// chosen by the global insecure flag; an empty list turns the override off.
+// The list only applies to direct access: in proxy mode the kubelet TLS is
+// terminated by the API server, so a non-empty list is an error.
+func (c *KubeletClient) SetInsecureNodes(entries []string) error {
+	var names []string
+	var cidrs []*net.IPNet
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			cidrs = append(cidrs, cidr)
+			continue
+		}
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			cidrs = append(cidrs, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		if _, err := path.Match(entry, ""); err != nil {
+			return fmt.Errorf("invalid node pattern %q: %w", entry, err)
+		}
+		names = append(names, entry)
+	}
+
+	if c.useProxy && (len(names) > 0 || len(cidrs) > 0) {
+		return fmt.Errorf("per-node TLS skipping requires direct kubelet access, but kubelet queries go through the API server proxy")
+	}
+
+	if c.insecureClient != nil {
+		c.insecureClient.CloseIdleConnections()
+		c.insecureClient = nil
+	}
+	c.insecureNames, c.insecureCIDRs = names, cidrs
+	if len(names) == 0 && len(cidrs) == 0 {
+		return nil
+	}
+
+	c.insecureClient = &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	c.logger.Info("Kubelet TLS verification skipped for selected nodes",
+		zap.Strings("nodes", names),
+		zap.Int("cidrs", len(cidrs)),
+	)
+	return nil
+}
+
+// SetNodeAddresses records the internal IPs of nodes, matched against the
+// CIDRs of SetInsecureNodes
+func (c *KubeletClient) SetNodeAddresses(nodes []*model.NodeData) {
+	if len(c.insecureCIDRs) == 0 {
+		return
+	}
+	c.nodeIPsMu.Lock()
+	defer c.nodeIPsMu.Unlock()
+	if c.nodeIPs == nil {
+		c.nodeIPs = make(map[string][]string, len(nodes))
+	}
+	for _, node := range nodes {
+		ips := node.InternalIPs
+		if len(ips) == 0 && node.InternalIP != "" {
+			ips = []string{node.InternalIP}
+		}
+		c.nodeIPs[node.Name] = ips
+	}
+}
+
+// insecureNode reports whether a node is selected by SetInsecureNodes
+func (c *KubeletClient) insecureNode(nodeName string) bool {
+	for _, pattern := range c.insecureNames {
+		if matched, _ := path.Match(pattern, nodeName); matched {
+			return true
+		}
+	}
+	if len(c.insecureCIDRs) == 0 {
+		return false
+	}
+	c.nodeIPsMu.RLock()
+	ips := c.nodeIPs[nodeName]
+	c.nodeIPsMu.RUnlock()
+	for _, addr := range ips {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			continue
+		}
+		for _, cidr := range c.insecureCIDRs {
+			if cidr.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// httpClientFor returns the client for the kubelet queries of a node
+func (c *KubeletClient) httpClientFor(nodeName string) *http.Client {
+	if c.insecureClient != nil && c.insecureNode(nodeName) {
+		return c.insecureClient
+	}
+	return c.httpClient
+}
+
+// GetNodeMetrics retrieves CPU/Memory/Network metrics for a node. Failed
+// queries are retried with backoff, except timeouts: a slow kubelet is given
+// up on after a single timeout so it does not hold up the refresh.
 func (c *KubeletClient) GetNodeMetrics(ctx context.Context, nodeName string) (cpuMillicores int64, memoryBytes int64, networkRxBytes int64, networkTxBytes int64, networkTimestamp time.Time, err error) {
 	c.logger.Debug("Fetching node metrics from kubelet",
 		zap.String("node", nodeName),
@@ -89,6 +247,20 @@ func (c *KubeletClient) GetNodeMetrics(ctx context.Context, nodeName string) (cp
 	)
 
 	summary, err := c.fetchSummary(ctx, nodeName)
+	for attempt := 0; err != nil && attempt < c.retries && retryable(ctx, err); attempt++ {
+		backoff := retryBackoff << attempt
+		c.logger.Debug("Retrying node metrics",
+			zap.String("node", nodeName),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+		select {
+		case <-ctx.Done():
+			return 0, 0, 0, 0, time.Time{}, fmt.Errorf("failed to fetch summary: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		summary, err = c.fetchSummary(ctx, nodeName)
+	}
 	if err != nil {
 		return 0, 0, 0, 0, time.Time{}, fmt.Errorf("failed to fetch summary: %w", err)
 	}
@@ -245,6 +417,7 @@ func (c *KubeletClient) GetAllPodMetricsOnNode(ctx context.Context, nodeName str
 
 			podData.ContainerStates = append(podData.ContainerStates, containerState)
 		}
+		podData.PVCUsage = pvcVolumeUsage(pod.Volume)
 
 		podMetrics[key] = podData
 	}
@@ -257,8 +430,49 @@ func (c *KubeletClient) GetAllPodMetricsOnNode(ctx context.Context, nodeName str
 	return podMetrics, nil
 }
 
-// fetchSummary fetches the summary from kubelet
-func (c *KubeletClient) fetchSummary(ctx context.Context, nodeName string) (*KubeletSummary, error) {
+// pvcVolumeUsage returns the filesystem usage of the PVC-backed volumes of a
+// pod, keyed by claim name. Volumes without stats are left out.
+func pvcVolumeUsage(volumes []VolumeStats) map[string]model.VolumeUsage {
+	var usage map[string]model.VolumeUsage
+	for _, volume := range volumes {
+		if volume.PVCRef == nil || volume.FsStats.UsedBytes == nil {
+			continue
+		}
+		if usage == nil {
+			usage = make(map[string]model.VolumeUsage)
+		}
+		u := model.VolumeUsage{UsedBytes: int64(*volume.FsStats.UsedBytes)}
+		if volume.FsStats.CapacityBytes != nil {
+			u.CapacityBytes = int64(*volume.FsStats.CapacityBytes)
+		}
+		usage[volume.PVCRef.Name] = u
+	}
+	return usage
+}
+
+// retryable reports whether a failed kubelet query is worth retrying: not
+// timeouts, cancellations or client errors such as missing RBAC permissions
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ErrKubeletTimeout) {
+		return false
+	}
+	var statusErr *kubeletStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.code >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// fetchSummary fetches the summary from kubelet, giving up after the query timeout
+func (c *KubeletClient) fetchSummary(parent context.Context, nodeName string) (*KubeletSummary, error) {
+	ctx, cancel := context.WithTimeout(parent, c.timeout)
+	defer cancel()
+
+	// timedOut reports whether err is due to the query timeout rather than the caller
+	timedOut := func() bool {
+		return errors.Is(ctx.Err(), context.DeadlineExceeded) && parent.Err() == nil
+	}
+
 	var url string
 	var req *http.Request
 	var err error
@@ -284,19 +498,25 @@ func (c *KubeletClient) fetchSummary(ctx context.Context, nodeName string) (*Kub
 		zap.String("url", url),
 	)
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.httpClientFor(nodeName).Do(req)
 	if err != nil {
+		if timedOut() {
+			return nil, fmt.Errorf("%w after %s", ErrKubeletTimeout, c.timeout)
+		}
 		return nil, fmt.Errorf("HTTP request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("kubelet returned status %d: %s", resp.StatusCode, string(body))
+		return nil, &kubeletStatusError{code: resp.StatusCode, body: string(body)}
 	}
 
 	var summary KubeletSummary
 	if err := json.NewDecoder(resp.Body).Decode(&summary); err != nil {
+		if timedOut() {
+			return nil, fmt.Errorf("%w after %s", ErrKubeletTimeout, c.timeout)
+		}
 		return nil, fmt.Errorf("failed to decode summary: %w", err)
 	}
 
@@ -315,5 +535,8 @@ func (c *KubeletClient) Name() string {
 func (c *KubeletClient) Close() error {
 	c.logger.Info("Closing kubelet client")
 	c.httpClient.CloseIdleConnections()
+	if c.insecureClient != nil {
+		c.insecureClient.CloseIdleConnections()
+	}
 	return nil
 }
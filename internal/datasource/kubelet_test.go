@@ -0,0 +1,171 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
+)
+
+const testSummary = `{"node":{"nodeName":"n1","cpu":{"usageNanoCores":250000000},"memory":{"workingSetBytes":1048576}}}`
+
+// newTestKubeletClient returns a proxy-mode kubelet client for handler and
+// the number of requests the handler received
+func newTestKubeletClient(t *testing.T, handler func(attempt int32, w http.ResponseWriter)) (*KubeletClient, *int32) {
+	t.Helper()
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/nodes/n1/proxy/stats/summary" {
+			http.NotFound(w, r)
+			return
+		}
+		handler(atomic.AddInt32(&hits, 1), w)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewKubeletClient(&rest.Config{Host: server.URL}, true, false, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewKubeletClient() error = %v", err)
+	}
+	return client, &hits
+}
+
+func TestKubeletClientRetriesServerErrors(t *testing.T) {
+	client, hits := newTestKubeletClient(t, func(attempt int32, w http.ResponseWriter) {
+		if attempt == 1 {
+			http.Error(w, "overloaded", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(testSummary))
+	})
+	client.SetQueryPolicy(time.Second, 1)
+
+	cpu, memory, _, _, _, err := client.GetNodeMetrics(context.Background(), "n1")
+	if err != nil {
+		t.Fatalf("GetNodeMetrics() error = %v", err)
+	}
+	if cpu != 250 || memory != 1048576 {
+		t.Errorf("GetNodeMetrics() = %dm, %d bytes, want 250m, 1048576 bytes", cpu, memory)
+	}
+	if got := atomic.LoadInt32(hits); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestKubeletClientDoesNotRetryClientErrors(t *testing.T) {
+	client, hits := newTestKubeletClient(t, func(attempt int32, w http.ResponseWriter) {
+		http.Error(w, "nodes/proxy is forbidden", http.StatusForbidden)
+	})
+	client.SetQueryPolicy(time.Second, 3)
+
+	if _, _, _, _, _, err := client.GetNodeMetrics(context.Background(), "n1"); err == nil {
+		t.Fatal("GetNodeMetrics() error = nil, want forbidden")
+	}
+	if got := atomic.LoadInt32(hits); got != 1 {
+		t.Errorf("requests = %d, want 1", got)
+	}
+}
+
+func TestKubeletClientTimeout(t *testing.T) {
+	client, hits := newTestKubeletClient(t, func(attempt int32, w http.ResponseWriter) {
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte(testSummary))
+	})
+	client.SetQueryPolicy(50*time.Millisecond, 3)
+
+	start := time.Now()
+	_, _, _, _, _, err := client.GetNodeMetrics(context.Background(), "n1")
+	if !errors.Is(err, ErrKubeletTimeout) {
+		t.Fatalf("GetNodeMetrics() error = %v, want ErrKubeletTimeout", err)
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("GetNodeMetrics() took %s, want about the 50ms timeout", elapsed)
+	}
+	if got := atomic.LoadInt32(hits); got != 1 {
+		t.Errorf("requests = %d, want 1 (timeouts are not retried)", got)
+	}
+}
+
+func TestGetAllPodMetricsOnNodeVolumeUsage(t *testing.T) {
+	const summary = `{"node":{"nodeName":"n1"},"pods":[{"podRef":{"name":"db-0","namespace":"prod"},"volume":[
+		{"name":"data","fsStats":{"usedBytes":900,"capacityBytes":1000},"pvcRef":{"name":"data-db-0","namespace":"prod"}},
+		{"name":"tmp","fsStats":{"usedBytes":10,"capacityBytes":100}},
+		{"name":"logs","fsStats":{},"pvcRef":{"name":"logs-db-0","namespace":"prod"}}]}]}`
+	client, _ := newTestKubeletClient(t, func(attempt int32, w http.ResponseWriter) {
+		w.Write([]byte(summary))
+	})
+
+	pods, err := client.GetAllPodMetricsOnNode(context.Background(), "n1")
+	if err != nil {
+		t.Fatalf("GetAllPodMetricsOnNode() error = %v", err)
+	}
+	pod, ok := pods["prod/db-0"]
+	if !ok {
+		t.Fatal("pod prod/db-0 missing")
+	}
+	// Only PVC-backed volumes with stats are reported
+	if len(pod.PVCUsage) != 1 {
+		t.Fatalf("PVCUsage = %v, want only data-db-0", pod.PVCUsage)
+	}
+	if got := pod.PVCUsage["data-db-0"]; got.UsedBytes != 900 || got.CapacityBytes != 1000 {
+		t.Errorf("data-db-0 usage = %+v, want 900/1000 bytes", got)
+	}
+}
+
+func TestKubeletClientInsecureNodes(t *testing.T) {
+	client, err := NewKubeletClient(&rest.Config{}, false, false, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewKubeletClient() error = %v", err)
+	}
+
+	if err := client.SetInsecureNodes([]string{"gpu-*", "10.1.0.0/16", "fd00::1"}); err != nil {
+		t.Fatalf("SetInsecureNodes() error = %v", err)
+	}
+	client.SetNodeAddresses([]*model.NodeData{
+		{Name: "cpu-1", InternalIP: "10.1.2.3"},
+		{Name: "cpu-2", InternalIPs: []string{"10.2.0.1", "fd00::1"}},
+		{Name: "cpu-3", InternalIP: "10.2.0.2"},
+	})
+	for node, wantInsecure := range map[string]bool{"gpu-7": true, "cpu-1": true, "cpu-2": true, "cpu-3": false} {
+		if insecure := client.httpClientFor(node) != client.httpClient; insecure != wantInsecure {
+			t.Errorf("httpClientFor(%s) insecure = %v, want %v", node, insecure, wantInsecure)
+		}
+	}
+
+	// Clearing the list verifies every node again
+	if err := client.SetInsecureNodes(nil); err != nil {
+		t.Fatalf("SetInsecureNodes(nil) error = %v", err)
+	}
+	if client.httpClientFor("gpu-7") != client.httpClient {
+		t.Error("httpClientFor(gpu-7) is insecure after clearing the list")
+	}
+
+	if err := client.SetInsecureNodes([]string{"gpu-["}); err == nil {
+		t.Error("SetInsecureNodes(gpu-[) error = nil, want invalid pattern")
+	}
+}
+
+func TestKubeletClientInsecureNodesRejectedInProxyMode(t *testing.T) {
+	client, err := NewKubeletClient(&rest.Config{Host: "https://127.0.0.1:6443"}, true, false, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewKubeletClient() error = %v", err)
+	}
+
+	// The kubelet TLS is terminated by the API server, so the list cannot apply
+	if err := client.SetInsecureNodes([]string{"gpu-*"}); err == nil {
+		t.Error("SetInsecureNodes(gpu-*) error = nil in proxy mode, want an error")
+	}
+	if client.httpClientFor("gpu-7") != client.httpClient {
+		t.Error("httpClientFor(gpu-7) is insecure in proxy mode")
+	}
+	if err := client.SetInsecureNodes(nil); err != nil {
+		t.Errorf("SetInsecureNodes(nil) error = %v in proxy mode, want nil", err)
+	}
+}
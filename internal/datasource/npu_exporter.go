@@ -395,6 +395,18 @@ func (c *NPUExporterClient) EnrichNodeData(ctx context.Context, nodes []*model.N
 		return nil
 	}
 
+	// Some exporters only report the device count without per-chip metrics
+	if len(chipMetrics) == 0 {
+		c.logger.Debug("NPU-Exporter exposes no per-chip metrics", zap.Int("npu_count", npuCount))
+		for _, node := range npuNodes {
+			if len(node.NPUChips) == 0 {
+				node.NPUAggregateOnly = true
+				node.NPUMetricsTime = time.Now()
+			}
+		}
+		return nil
+	}
+
 	// Convert chip metrics map to sorted slice for consistent assignment
 	chips := make([]*NPUChipMetrics, 0, len(chipMetrics))
 	for _, chip := range chipMetrics {
@@ -469,6 +481,7 @@ func (c *NPUExporterClient) enrichSingleNode(node *model.NodeData, chips []*NPUC
 
 	chipCount := len(chips)
 	node.NPUChips = nodeChips
+	node.NPUDevices = buildNPUDevices(nodeChips)
 	node.NPUUtilization = totalUtil / float64(chipCount)
 	node.NPUMemoryTotal = totalHBMTotal * 1024 * 1024
 	node.NPUMemoryUsed = totalHBMUsed * 1024 * 1024
@@ -534,6 +547,54 @@ func (c *NPUExporterClient) distributeChipsToNodes(nodes []*model.NodeData, chip
 	}
 }
 
+// buildNPUDevices aggregates chip metrics into per-card metrics, ordered by card ID
+func buildNPUDevices(chips []model.NPUChipData) []model.NPUDeviceMetric {
+	byCard := make(map[int]*model.NPUDeviceMetric)
+	var cardIDs []int
+	for _, chip := range chips {
+		device, exists := byCard[chip.NPUID]
+		if !exists {
+			device = &model.NPUDeviceMetric{CardID: chip.NPUID, Health: "OK"}
+			byCard[chip.NPUID] = device
+			cardIDs = append(cardIDs, chip.NPUID)
+		}
+
+		device.Chips++
+		device.Utilization += float64(chip.AICore)
+		device.HBMUsed += chip.HBMUsed
+		device.HBMTotal += chip.HBMTotal
+		device.Power += chip.Power
+		if chip.Temp > device.Temp {
+			device.Temp = chip.Temp
+		}
+
+		if npuHealthSeverity(chip.Health) > npuHealthSeverity(device.Health) {
+			device.Health = chip.Health
+		}
+	}
+
+	sort.Ints(cardIDs)
+	devices := make([]model.NPUDeviceMetric, 0, len(cardIDs))
+	for _, id := range cardIDs {
+		device := byCard[id]
+		device.Utilization /= float64(device.Chips)
+		devices = append(devices, *device)
+	}
+	return devices
+}
+
+// npuHealthSeverity ranks chip health values; anything other than OK or Warning is an error
+func npuHealthSeverity(health string) int {
+	switch health {
+	case "OK", "":
+		return 0
+	case "Warning":
+		return 1
+	default:
+		return 2
+	}
+}
+
 // convertChipMetrics converts NPUChipMetrics to model.NPUChipData
 func (c *NPUExporterClient) convertChipMetrics(chip *NPUChipMetrics) model.NPUChipData {
 	chipData := model.NPUChipData{
@@ -0,0 +1,70 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+)
+
+func TestBuildNPUDevices(t *testing.T) {
+	chips := []model.NPUChipData{
+		{NPUID: 1, Chip: 0, AICore: 40, HBMUsed: 1000, HBMTotal: 32000, Temp: 55, Power: 80, Health: "OK"},
+		{NPUID: 0, Chip: 0, AICore: 10, HBMUsed: 500, HBMTotal: 32000, Temp: 60, Power: 70, Health: "OK"},
+		{NPUID: 0, Chip: 1, AICore: 30, HBMUsed: 1500, HBMTotal: 32000, Temp: 72, Power: 75, Health: "Error"},
+		{NPUID: 1, Chip: 1, AICore: 60, HBMUsed: 3000, HBMTotal: 32000, Temp: 50, Power: 85, Health: "Warning"},
+	}
+
+	devices := buildNPUDevices(chips)
+	if len(devices) != 2 {
+		t.Fatalf("Expected 2 cards, got %d", len(devices))
+	}
+
+	card0 := devices[0]
+	if card0.CardID != 0 || card0.Chips != 2 {
+		t.Errorf("Expected card 0 with 2 chips, got card %d with %d chips", card0.CardID, card0.Chips)
+	}
+	if card0.Utilization != 20 {
+		t.Errorf("Expected average utilization 20, got %.1f", card0.Utilization)
+	}
+	if card0.HBMUsed != 2000 || card0.HBMTotal != 64000 {
+		t.Errorf("Expected HBM 2000/64000 MB, got %d/%d", card0.HBMUsed, card0.HBMTotal)
+	}
+	if card0.Temp != 72 || card0.Power != 145 {
+		t.Errorf("Expected hottest chip 72°C and total power 145W, got %d/%.0f", card0.Temp, card0.Power)
+	}
+	if card0.Health != "Error" {
+		t.Errorf("Expected worst chip health Error, got %s", card0.Health)
+	}
+
+	if devices[1].CardID != 1 || devices[1].Health != "Warning" {
+		t.Errorf("Expected card 1 with Warning health, got card %d (%s)", devices[1].CardID, devices[1].Health)
+	}
+}
+
+func TestEnrichNodeDataAggregateOnly(t *testing.T) {
+	// Exporter that only reports the device count, without per-chip metrics
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "# HELP machine_npu_nums Amount of npu installed on the machine.\nmachine_npu_nums 8\n")
+	}))
+	defer server.Close()
+
+	client := &NPUExporterClient{httpClient: server.Client(), logger: zap.NewNop()}
+	client.SetEndpoint(server.URL)
+
+	node := &model.NodeData{Name: "npu-node", NPUCapacity: 8, NPUAllocatable: 8}
+	if err := client.EnrichNodeData(context.Background(), []*model.NodeData{node}); err != nil {
+		t.Fatalf("EnrichNodeData failed: %v", err)
+	}
+
+	if !node.NPUAggregateOnly {
+		t.Error("Expected node to be marked as aggregate-only")
+	}
+	if len(node.NPUChips) != 0 || len(node.NPUDevices) != 0 {
+		t.Errorf("Expected no chip or card metrics, got %d chips and %d cards", len(node.NPUChips), len(node.NPUDevices))
+	}
+}
@@ -0,0 +1,79 @@
+package datasource
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultNPUResourcePatterns match the extended resources of the Huawei Ascend
+// device plugin, e.g. "huawei.com/ascend-1980" or "huawei.com/Ascend910"
+var DefaultNPUResourcePatterns = []string{"huawei.com/*ascend*"}
+
+// npuResourcePatterns are the normalized patterns of the extended resources
+// counted as NPUs, in priority order
+var npuResourcePatterns = DefaultNPUResourcePatterns
+
+// SetNPUResourcePatterns sets the extended resources counted as NPUs. Patterns
+// use path.Match syntax and are matched case-insensitively, e.g.
+// "huawei.com/*ascend*" or "example.com/npu"; when a node exposes several
+// matching resources the earliest pattern wins. An empty list restores
+// DefaultNPUResourcePatterns. It must be called before any data is fetched.
+func SetNPUResourcePatterns(patterns []string) error {
+	if len(patterns) == 0 {
+		npuResourcePatterns = DefaultNPUResourcePatterns
+		return nil
+	}
+
+	normalized := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == "" {
+			return fmt.Errorf("empty NPU resource pattern")
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid NPU resource pattern %q: %w", pattern, err)
+		}
+		normalized = append(normalized, pattern)
+	}
+	npuResourcePatterns = normalized
+	return nil
+}
+
+// npuPatternIndex returns the index of the first pattern matching a resource
+// name, or -1 when the resource is not an NPU
+func npuPatternIndex(resourceName string) int {
+	name := strings.ToLower(resourceName)
+	for i, pattern := range npuResourcePatterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return i
+		}
+	}
+	return -1
+}
+
+// isNPUResource reports whether an extended resource is counted as NPUs
+func isNPUResource(resourceName string) bool {
+	return npuPatternIndex(resourceName) >= 0
+}
+
+// findNPUResource returns the NPU resource of a resource list: the one matching
+// the earliest pattern, the alphabetically first among equal matches
+func findNPUResource(resources corev1.ResourceList) (corev1.ResourceName, bool) {
+	names := make([]string, 0, len(resources))
+	for resourceName := range resources {
+		names = append(names, string(resourceName))
+	}
+	sort.Strings(names)
+
+	best, bestIndex := "", -1
+	for _, name := range names {
+		if i := npuPatternIndex(name); i >= 0 && (bestIndex < 0 || i < bestIndex) {
+			best, bestIndex = name, i
+		}
+	}
+	return corev1.ResourceName(best), bestIndex >= 0
+}
@@ -0,0 +1,89 @@
+package datasource
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConvertNodeNPUResourcePatterns(t *testing.T) {
+	t.Cleanup(func() { _ = SetNPUResourcePatterns(nil) })
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "accel-node"},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				"huawei.com/Ascend910": resource.MustParse("8"),
+				"example.com/npu":      resource.MustParse("4"),
+			},
+			Allocatable: corev1.ResourceList{
+				"huawei.com/Ascend910": resource.MustParse("7"),
+				"example.com/npu":      resource.MustParse("3"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		patterns     []string
+		wantResource string
+		wantCapacity int64
+		wantAlloc    int64
+	}{
+		{"default matches Ascend", nil, "huawei.com/Ascend910", 8, 7},
+		{"custom resource", []string{"example.com/npu"}, "example.com/npu", 4, 3},
+		{"earliest pattern wins", []string{"example.com/*", "huawei.com/*ascend*"}, "example.com/npu", 4, 3},
+		{"case-insensitive", []string{"HUAWEI.COM/ASCEND*"}, "huawei.com/Ascend910", 8, 7},
+		{"no match", []string{"nvidia.com/gpu"}, "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetNPUResourcePatterns(tt.patterns); err != nil {
+				t.Fatalf("SetNPUResourcePatterns() error = %v", err)
+			}
+			nodeData := ConvertNode(node)
+			if nodeData.NPUResourceName != tt.wantResource {
+				t.Errorf("NPUResourceName = %q, want %q", nodeData.NPUResourceName, tt.wantResource)
+			}
+			if nodeData.NPUCapacity != tt.wantCapacity || nodeData.NPUAllocatable != tt.wantAlloc {
+				t.Errorf("NPU capacity/allocatable = %d/%d, want %d/%d",
+					nodeData.NPUCapacity, nodeData.NPUAllocatable, tt.wantCapacity, tt.wantAlloc)
+			}
+		})
+	}
+}
+
+func TestConvertPodNPURequestsCustomPattern(t *testing.T) {
+	t.Cleanup(func() { _ = SetNPUResourcePatterns(nil) })
+	if err := SetNPUResourcePatterns([]string{"example.com/npu"}); err != nil {
+		t.Fatalf("SetNPUResourcePatterns() error = %v", err)
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainer", Namespace: "ml"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "a", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{"example.com/npu": resource.MustParse("2")}}},
+				{Name: "b", Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{"example.com/npu": resource.MustParse("1")}}},
+			},
+		},
+	}
+
+	podData := ConvertPod(pod)
+	if podData.NPURequest != 3 || podData.NPUResourceName != "example.com/npu" {
+		t.Errorf("NPU request = %d %q, want 3 \"example.com/npu\"", podData.NPURequest, podData.NPUResourceName)
+	}
+}
+
+func TestSetNPUResourcePatternsInvalid(t *testing.T) {
+	t.Cleanup(func() { _ = SetNPUResourcePatterns(nil) })
+
+	for _, patterns := range [][]string{{"huawei.com/[ascend"}, {" "}} {
+		if err := SetNPUResourcePatterns(patterns); err == nil {
+			t.Errorf("SetNPUResourcePatterns(%q) expected error", patterns)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package datasource
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -35,8 +36,27 @@ var (
 		Version:  "v1beta1",
 		Resource: "queues",
 	}
+
+	podGroupGVR = schema.GroupVersionResource{
+		Group:    "scheduling.volcano.sh",
+		Version:  "v1beta1",
+		Resource: "podgroups",
+	}
+
+	priorityClassGVR = schema.GroupVersionResource{
+		Group:    "scheduling.k8s.io",
+		Version:  "v1",
+		Resource: "priorityclasses",
+	}
 )
 
+// volcanoJobNameLabel is set by Volcano on the pods of a job
+const volcanoJobNameLabel = "volcano.sh/job-name"
+
+// preemptedByPattern extracts the preemptor of a "Preempted by ns/pod on node
+// ..." event message
+var preemptedByPattern = regexp.MustCompile(`Preempted by (?:pod )?([a-z0-9.-]+)/([a-z0-9.-]+)`)
+
 // VolcanoClient provides access to Volcano CRD resources
 type VolcanoClient struct {
 	dynamicClient dynamic.Interface
@@ -106,9 +126,133 @@ func (c *VolcanoClient) GetVolcanoJobs(ctx context.Context, namespace string) ([
 		}
 	}
 
+	c.attachPodGroups(ctx, namespace, jobs)
+	c.attachPriorities(ctx, jobs)
+
 	return jobs, nil
 }
 
+// attachPriorities sets the priority of each job from the value of its
+// priority class. Jobs without a class get the value of the global default
+// class. Listing errors (e.g. missing RBAC) are logged and leave the
+// priorities at 0.
+func (c *VolcanoClient) attachPriorities(ctx context.Context, jobs []*model.VolcanoJobData) {
+	if len(jobs) == 0 {
+		return
+	}
+
+	list, err := c.dynamicClient.Resource(priorityClassGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		c.logger.Debug("Failed to list PriorityClasses", zap.Error(err))
+		return
+	}
+	applyPriorityClasses(jobs, list.Items)
+}
+
+// applyPriorityClasses sets the priority of each job from the value of its
+// priority class, or of the global default class when it has none
+func applyPriorityClasses(jobs []*model.VolcanoJobData, classes []unstructured.Unstructured) {
+	values := make(map[string]int32, len(classes))
+	var defaultValue int32
+	for i := range classes {
+		value, _, _ := unstructured.NestedInt64(classes[i].Object, "value")
+		values[classes[i].GetName()] = int32(value)
+		if globalDefault, _, _ := unstructured.NestedBool(classes[i].Object, "globalDefault"); globalDefault {
+			defaultValue = int32(value)
+		}
+	}
+	for _, job := range jobs {
+		if job.PriorityClassName == "" {
+			job.Priority = defaultValue
+			continue
+		}
+		job.Priority = values[job.PriorityClassName]
+	}
+}
+
+// attachPodGroups sets the PodGroup status of each job from the PodGroups it
+// owns. PodGroups are optional: listing errors (e.g. missing RBAC) are logged
+// and leave the jobs unchanged.
+func (c *VolcanoClient) attachPodGroups(ctx context.Context, namespace string, jobs []*model.VolcanoJobData) {
+	if len(jobs) == 0 {
+		return
+	}
+
+	list, err := c.dynamicClient.Resource(podGroupGVR).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		c.logger.Debug("Failed to list Volcano PodGroups", zap.Error(err))
+		return
+	}
+
+	byJob := make(map[string]*model.VolcanoJobData, len(jobs))
+	for _, job := range jobs {
+		byJob[job.Namespace+"/"+job.Name] = job
+	}
+	for i := range list.Items {
+		pg := &list.Items[i]
+		owner := podGroupOwnerJob(pg)
+		if owner == "" {
+			continue
+		}
+		if job, ok := byJob[pg.GetNamespace()+"/"+owner]; ok {
+			applyPodGroup(job, pg)
+		}
+	}
+}
+
+// podGroupOwnerJob returns the name of the Volcano job owning a PodGroup, or ""
+func podGroupOwnerJob(pg *unstructured.Unstructured) string {
+	for _, ref := range pg.GetOwnerReferences() {
+		if ref.Kind == "Job" && strings.HasPrefix(ref.APIVersion, volcanoJobGVR.Group+"/") {
+			return ref.Name
+		}
+	}
+	return ""
+}
+
+// applyPodGroup copies the phase, minMember and latest unschedulable condition
+// of a PodGroup into its job
+func applyPodGroup(job *model.VolcanoJobData, pg *unstructured.Unstructured) {
+	job.PodGroup = pg.GetName()
+	if minMember, ok, _ := unstructured.NestedInt64(pg.Object, "spec", "minMember"); ok {
+		job.PodGroupMinMember = int32(minMember)
+	}
+	if phase, ok, _ := unstructured.NestedString(pg.Object, "status", "phase"); ok {
+		job.PodGroupPhase = phase
+	}
+	if job.PriorityClassName == "" {
+		job.PriorityClassName, _, _ = unstructured.NestedString(pg.Object, "spec", "priorityClassName")
+	}
+
+	// Conditions are appended over time; only the latest one reflects the
+	// current scheduling state
+	conditions, _, _ := unstructured.NestedSlice(pg.Object, "status", "conditions")
+	for _, item := range conditions {
+		if condition, ok := item.(map[string]interface{}); ok {
+			reason, _, _ := unstructured.NestedString(condition, "reason")
+			message, _, _ := unstructured.NestedString(condition, "message")
+			if isPreemptionEviction(reason, message) {
+				lastTransition, _, _ := unstructured.NestedString(condition, "lastTransitionTime")
+				at, _ := time.Parse(time.RFC3339, lastTransition)
+				markPreempted(job, message, at)
+			}
+		}
+	}
+	if len(conditions) == 0 {
+		return
+	}
+	latest, ok := conditions[len(conditions)-1].(map[string]interface{})
+	if !ok {
+		return
+	}
+	condType, _, _ := unstructured.NestedString(latest, "type")
+	condStatus, _, _ := unstructured.NestedString(latest, "status")
+	if condType == "Unschedulable" && condStatus == "True" {
+		job.PodGroupReason, _, _ = unstructured.NestedString(latest, "reason")
+		job.PodGroupMessage, _, _ = unstructured.NestedString(latest, "message")
+	}
+}
+
 // convertVolcanoJob converts an unstructured Volcano job to VolcanoJobData
 func (c *VolcanoClient) convertVolcanoJob(obj *unstructured.Unstructured) *model.VolcanoJobData {
 	job := &model.VolcanoJobData{
@@ -134,6 +278,9 @@ func (c *VolcanoClient) convertVolcanoJob(obj *unstructured.Unstructured) *model
 		if queue, ok, _ := unstructured.NestedString(spec, "queue"); ok {
 			job.Queue = queue
 		}
+		if priorityClassName, ok, _ := unstructured.NestedString(spec, "priorityClassName"); ok {
+			job.PriorityClassName = priorityClassName
+		}
 		if minAvailable, ok, _ := unstructured.NestedInt64(spec, "minAvailable"); ok {
 			job.MinAvailable = int32(minAvailable)
 		}
@@ -155,6 +302,9 @@ func (c *VolcanoClient) convertVolcanoJob(obj *unstructured.Unstructured) *model
 					taskData.Replicas = int32(replicas)
 					job.Replicas += int32(replicas)
 				}
+				if minAvailable, ok, _ := unstructured.NestedInt64(taskMap, "minAvailable"); ok {
+					taskData.MinAvailable = int32(minAvailable)
+				}
 
 				// Get NPU request from task template
 				containers, found, _ := unstructured.NestedSlice(taskMap, "template", "spec", "containers")
@@ -167,7 +317,7 @@ func (c *VolcanoClient) convertVolcanoJob(obj *unstructured.Unstructured) *model
 						requests, found, _ := unstructured.NestedMap(containerMap, "resources", "requests")
 						if found {
 							for resName, qty := range requests {
-								if strings.Contains(resName, "huawei.com/") && strings.Contains(strings.ToLower(resName), "ascend") {
+								if isNPUResource(resName) {
 									if qtyStr, ok := qty.(string); ok {
 										// Parse quantity (e.g., "16")
 										var npuVal int64
@@ -214,6 +364,19 @@ func (c *VolcanoClient) convertVolcanoJob(obj *unstructured.Unstructured) *model
 			job.Pending = int32(pending)
 		}
 
+		// Per-task pod phase counts, e.g. taskStatusCount.worker.phase.Running
+		for i := range job.Tasks {
+			task := &job.Tasks[i]
+			phases, ok, _ := unstructured.NestedMap(status, "taskStatusCount", task.Name, "phase")
+			if !ok {
+				continue
+			}
+			task.Running = int32(phaseCount(phases, "Running"))
+			task.Pending = int32(phaseCount(phases, "Pending"))
+			task.Succeeded = int32(phaseCount(phases, "Succeeded"))
+			task.Failed = int32(phaseCount(phases, "Failed"))
+		}
+
 		// Parse timestamps
 		if startTimeStr, ok, _ := unstructured.NestedString(status, "startTime"); ok {
 			if t, err := time.Parse(time.RFC3339, startTimeStr); err == nil {
@@ -239,6 +402,83 @@ func (c *VolcanoClient) convertVolcanoJob(obj *unstructured.Unstructured) *model
 	return job
 }
 
+// isPreemptionEviction reports whether an event or condition records pods
+// evicted by preemption: Volcano's "Evict" with a preempt reason, or the
+// default scheduler's "Preempted"
+func isPreemptionEviction(reason, message string) bool {
+	switch reason {
+	case "Preempted":
+		return true
+	case "Evict", "Evicted":
+		return strings.Contains(strings.ToLower(message), "preempt")
+	}
+	return false
+}
+
+// markPreempted records a preemption of the pods of a job, keeping the latest
+func markPreempted(job *model.VolcanoJobData, message string, at time.Time) {
+	job.Preempted = true
+	if job.PreemptionTime.IsZero() || at.After(job.PreemptionTime) {
+		job.PreemptionMessage = message
+		job.PreemptionTime = at
+	}
+}
+
+// applyVolcanoPreemption marks the jobs whose pods were preempted, and the
+// jobs whose pods preempted them, from the events of their PodGroups and pods.
+// Only the fetched events are seen, so older preemptions may be missed.
+func applyVolcanoPreemption(jobs []*model.VolcanoJobData, pods []*model.PodData, events []*model.EventData) {
+	if len(jobs) == 0 || len(events) == 0 {
+		return
+	}
+
+	byName := make(map[string]*model.VolcanoJobData, len(jobs))
+	byPodGroup := make(map[string]*model.VolcanoJobData, len(jobs))
+	for _, job := range jobs {
+		byName[job.Namespace+"/"+job.Name] = job
+		if job.PodGroup != "" {
+			byPodGroup[job.Namespace+"/"+job.PodGroup] = job
+		}
+	}
+	byPod := make(map[string]*model.VolcanoJobData)
+	for _, pod := range pods {
+		if name := pod.Labels[volcanoJobNameLabel]; name != "" {
+			if job, ok := byName[pod.Namespace+"/"+name]; ok {
+				byPod[pod.Namespace+"/"+pod.Name] = job
+			}
+		}
+	}
+
+	for _, event := range events {
+		if !isPreemptionEviction(event.Reason, event.Message) {
+			continue
+		}
+		key := event.InvolvedNamespace + "/" + event.InvolvedName
+		var victim *model.VolcanoJobData
+		switch event.InvolvedKind {
+		case "PodGroup":
+			victim = byPodGroup[key]
+		case "Pod":
+			victim = byPod[key]
+		}
+		if victim != nil {
+			markPreempted(victim, event.Message, event.LastTimestamp)
+		}
+
+		if match := preemptedByPattern.FindStringSubmatch(event.Message); match != nil {
+			if preemptor, ok := byPod[match[1]+"/"+match[2]]; ok {
+				preemptor.Preempting = true
+			}
+		}
+	}
+}
+
+// phaseCount returns the count of a phase in a taskStatusCount phase map
+func phaseCount(phases map[string]interface{}, phase string) int64 {
+	count, _, _ := unstructured.NestedInt64(phases, phase)
+	return count
+}
+
 // GetHyperNodes retrieves all HyperNodes
 func (c *VolcanoClient) GetHyperNodes(ctx context.Context) ([]*model.HyperNodeData, error) {
 	if !c.available {
@@ -363,6 +603,15 @@ func (c *VolcanoClient) convertQueue(obj *unstructured.Unstructured) *model.Queu
 		if guarantee, ok, _ := unstructured.NestedMap(spec, "guarantee"); ok {
 			q.CPUGuarantee, q.MemoryGuarantee, q.NPUGuarantee, _, _ = c.parseQueueResources(guarantee)
 		}
+
+		// Parse capability (upper limit)
+		if capability, ok, _ := unstructured.NestedMap(spec, "capability"); ok {
+			var npuName string
+			q.CPUCapability, q.MemoryCapability, q.NPUCapability, q.PodCapability, npuName = c.parseQueueResources(capability)
+			if q.NPUResourceName == "" {
+				q.NPUResourceName = npuName
+			}
+		}
 	}
 
 	// Get status fields
@@ -408,8 +657,8 @@ func (c *VolcanoClient) parseQueueResources(resources map[string]interface{}) (i
 			// Pods are count-type resources, not CPU-like
 			pods = parseCountQuantity(valStr)
 		default:
-			// Check for NPU resources (huawei.com/ascend-*)
-			if strings.Contains(key, "ascend") || strings.Contains(key, "npu") {
+			// Check for NPU resources (configured patterns, or any ascend/npu resource)
+			if isNPUResource(key) || strings.Contains(key, "ascend") || strings.Contains(key, "npu") {
 				// NPU is a count-type resource, not CPU-like
 				npu = parseCountQuantity(valStr)
 				npuResourceName = key
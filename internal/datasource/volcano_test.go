@@ -2,6 +2,10 @@ package datasource
 
 import (
 	"testing"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestParseCountQuantity(t *testing.T) {
@@ -96,3 +100,183 @@ func TestNPUNotMultipliedBy1000(t *testing.T) {
 		t.Errorf("CPU count 4 should be converted to millicores (4000), got %d", cpuResult)
 	}
 }
+
+func TestConvertQueueCapability(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "training"},
+		"spec": map[string]interface{}{
+			"weight": int64(4),
+			"deserved": map[string]interface{}{
+				"cpu":                    "32",
+				"huawei.com/ascend-1980": "16",
+			},
+			"capability": map[string]interface{}{
+				"cpu":                    "64",
+				"memory":                 "256Gi",
+				"huawei.com/ascend-1980": "32",
+			},
+		},
+		"status": map[string]interface{}{
+			"state":   "Open",
+			"pending": int64(3),
+			"allocated": map[string]interface{}{
+				"huawei.com/ascend-1980": "24",
+			},
+		},
+	}}
+
+	q := (&VolcanoClient{}).convertQueue(obj)
+
+	if q.Weight != 4 {
+		t.Errorf("Expected weight 4, got %d", q.Weight)
+	}
+	if q.NPUDeserved != 16 || q.NPUAllocated != 24 || q.NPUCapability != 32 {
+		t.Errorf("Expected NPU deserved/allocated/capability 16/24/32, got %d/%d/%d", q.NPUDeserved, q.NPUAllocated, q.NPUCapability)
+	}
+	if q.CPUCapability != 64000 || q.MemoryCapability != 256*1024*1024*1024 {
+		t.Errorf("Expected capability 64 cores and 256Gi, got %d/%d", q.CPUCapability, q.MemoryCapability)
+	}
+	if q.NPUResourceName != "huawei.com/ascend-1980" {
+		t.Errorf("Expected NPU resource name huawei.com/ascend-1980, got %s", q.NPUResourceName)
+	}
+}
+
+func TestConvertVolcanoJobTaskStatus(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "train", "namespace": "ml"},
+		"spec": map[string]interface{}{
+			"minAvailable": int64(3),
+			"tasks": []interface{}{
+				map[string]interface{}{"name": "ps", "replicas": int64(1), "minAvailable": int64(1)},
+				map[string]interface{}{"name": "worker", "replicas": int64(4), "minAvailable": int64(2)},
+			},
+		},
+		"status": map[string]interface{}{
+			"state": map[string]interface{}{"phase": "Running"},
+			"taskStatusCount": map[string]interface{}{
+				"worker": map[string]interface{}{
+					"phase": map[string]interface{}{"Running": int64(2), "Pending": int64(1), "Failed": int64(1)},
+				},
+			},
+		},
+	}}
+
+	job := (&VolcanoClient{}).convertVolcanoJob(obj)
+
+	if len(job.Tasks) != 2 {
+		t.Fatalf("Expected 2 tasks, got %d", len(job.Tasks))
+	}
+	ps, worker := job.Tasks[0], job.Tasks[1]
+	if ps.MinAvailable != 1 || ps.Running != 0 {
+		t.Errorf("Expected ps minAvailable 1 with no status, got %+v", ps)
+	}
+	if worker.MinAvailable != 2 || worker.Running != 2 || worker.Pending != 1 || worker.Failed != 1 || worker.Succeeded != 0 {
+		t.Errorf("Expected worker min 2, running/pending/failed 2/1/1, got %+v", worker)
+	}
+}
+
+func TestApplyPodGroup(t *testing.T) {
+	pg := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":      "train-0f3c",
+			"namespace": "ml",
+			"ownerReferences": []interface{}{
+				map[string]interface{}{"apiVersion": "batch.volcano.sh/v1alpha1", "kind": "Job", "name": "train", "uid": "0f3c"},
+			},
+		},
+		"spec": map[string]interface{}{"minMember": int64(5)},
+		"status": map[string]interface{}{
+			"phase": "Inqueue",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Scheduled", "status": "True"},
+				map[string]interface{}{"type": "Unschedulable", "status": "True", "reason": "NotEnoughResources", "message": "3/5 tasks in gang unschedulable"},
+			},
+		},
+	}}
+
+	if owner := podGroupOwnerJob(pg); owner != "train" {
+		t.Fatalf("Expected owner job train, got %q", owner)
+	}
+
+	job := &model.VolcanoJobData{Name: "train", Namespace: "ml"}
+	applyPodGroup(job, pg)
+	if job.PodGroup != "train-0f3c" || job.PodGroupPhase != "Inqueue" || job.PodGroupMinMember != 5 {
+		t.Errorf("Unexpected PodGroup fields: %q %q %d", job.PodGroup, job.PodGroupPhase, job.PodGroupMinMember)
+	}
+	if job.PodGroupReason != "NotEnoughResources" || job.PodGroupMessage != "3/5 tasks in gang unschedulable" {
+		t.Errorf("Expected the unschedulable condition, got %q: %q", job.PodGroupReason, job.PodGroupMessage)
+	}
+
+	// A later Scheduled condition clears the unschedulable reason
+	conditions := pg.Object["status"].(map[string]interface{})["conditions"].([]interface{})
+	pg.Object["status"].(map[string]interface{})["conditions"] = append(conditions,
+		map[string]interface{}{"type": "Scheduled", "status": "True"})
+	job = &model.VolcanoJobData{Name: "train", Namespace: "ml"}
+	applyPodGroup(job, pg)
+	if job.PodGroupReason != "" {
+		t.Errorf("Expected no unschedulable reason after scheduling, got %q", job.PodGroupReason)
+	}
+
+	// PodGroups of other owners are ignored
+	pg.SetOwnerReferences(nil)
+	if owner := podGroupOwnerJob(pg); owner != "" {
+		t.Errorf("Expected no owner job, got %q", owner)
+	}
+}
+
+func TestApplyPriorityClasses(t *testing.T) {
+	classes := []unstructured.Unstructured{
+		{Object: map[string]interface{}{"metadata": map[string]interface{}{"name": "high"}, "value": int64(1000)}},
+		{Object: map[string]interface{}{"metadata": map[string]interface{}{"name": "normal"}, "value": int64(100), "globalDefault": true}},
+	}
+	jobs := []*model.VolcanoJobData{
+		{Name: "a", PriorityClassName: "high"},
+		{Name: "b"},
+		{Name: "c", PriorityClassName: "missing"},
+	}
+	applyPriorityClasses(jobs, classes)
+
+	if jobs[0].Priority != 1000 {
+		t.Errorf("Expected priority 1000 from class high, got %d", jobs[0].Priority)
+	}
+	if jobs[1].Priority != 100 {
+		t.Errorf("Expected the global default priority 100, got %d", jobs[1].Priority)
+	}
+	if jobs[2].Priority != 0 {
+		t.Errorf("Expected priority 0 for an unknown class, got %d", jobs[2].Priority)
+	}
+}
+
+func TestApplyVolcanoPreemption(t *testing.T) {
+	victim := &model.VolcanoJobData{Name: "train", Namespace: "ml", PodGroup: "train-0f3c"}
+	preemptor := &model.VolcanoJobData{Name: "infer", Namespace: "ml", PodGroup: "infer-1a2b"}
+	other := &model.VolcanoJobData{Name: "eval", Namespace: "ml", PodGroup: "eval-9e8d"}
+	pods := []*model.PodData{
+		{Name: "train-worker-0", Namespace: "ml", Labels: map[string]string{volcanoJobNameLabel: "train"}},
+		{Name: "infer-worker-0", Namespace: "ml", Labels: map[string]string{volcanoJobNameLabel: "infer"}},
+	}
+	now := time.Now()
+	events := []*model.EventData{
+		{Reason: "Evict", Message: "Pod is evicted, because of preempt", InvolvedKind: "PodGroup",
+			InvolvedNamespace: "ml", InvolvedName: "train-0f3c", LastTimestamp: now.Add(-time.Hour)},
+		{Reason: "Preempted", Message: "Preempted by ml/infer-worker-0 on node npu-1", InvolvedKind: "Pod",
+			InvolvedNamespace: "ml", InvolvedName: "train-worker-0", LastTimestamp: now},
+		// Evictions for other reasons are not preemption
+		{Reason: "Evict", Message: "Pod is evicted, because of reclaim", InvolvedKind: "PodGroup",
+			InvolvedNamespace: "ml", InvolvedName: "eval-9e8d", LastTimestamp: now},
+	}
+	applyVolcanoPreemption([]*model.VolcanoJobData{victim, preemptor, other}, pods, events)
+
+	if !victim.Preempted || victim.Preempting {
+		t.Errorf("Expected train to be preempted only, got preempted=%v preempting=%v", victim.Preempted, victim.Preempting)
+	}
+	if victim.PreemptionMessage != "Preempted by ml/infer-worker-0 on node npu-1" || !victim.PreemptionTime.Equal(now) {
+		t.Errorf("Expected the latest preemption event, got %q at %v", victim.PreemptionMessage, victim.PreemptionTime)
+	}
+	if !preemptor.Preempting || preemptor.Preempted {
+		t.Errorf("Expected infer to be preempting only, got preempted=%v preempting=%v", preemptor.Preempted, preemptor.Preempting)
+	}
+	if other.Preempted || other.Preempting {
+		t.Errorf("Expected eval to be unaffected by a reclaim eviction")
+	}
+}
@@ -0,0 +1,152 @@
+package diagnostic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authorizationclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
+)
+
+// AccessCheck describes one permission k8s-monitor relies on
+type AccessCheck struct {
+	Verb        string
+	Group       string
+	Resource    string
+	Subresource string
+	// Namespaced resources are checked in the monitored namespace, if any
+	Namespaced bool
+	// Optional permissions only disable a feature when denied
+	Optional bool
+	// Feature is what the permission is used for
+	Feature string
+}
+
+// Name returns the resource in kubectl notation, e.g. deployments.apps or nodes/proxy
+func (c AccessCheck) Name() string {
+	name := c.Resource
+	if c.Group != "" {
+		name += "." + c.Group
+	}
+	if c.Subresource != "" {
+		name += "/" + c.Subresource
+	}
+	return name
+}
+
+// AccessResult is the outcome of an AccessCheck
+type AccessResult struct {
+	Check   AccessCheck
+	Allowed bool
+	Reason  string
+}
+
+// DefaultAccessChecks lists the permissions used by the console, snapshot and serve commands
+var DefaultAccessChecks = []AccessCheck{
+	{Verb: "list", Resource: "nodes", Feature: "Nodes view and cluster capacity"},
+	{Verb: "list", Resource: "pods", Namespaced: true, Feature: "Pods view and alerts"},
+	{Verb: "list", Resource: "events", Namespaced: true, Feature: "Events view"},
+	{Verb: "list", Resource: "services", Namespaced: true, Feature: "Network view"},
+	{Verb: "list", Resource: "persistentvolumes", Feature: "Storage view"},
+	{Verb: "list", Group: "apps", Resource: "deployments", Namespaced: true, Feature: "Workloads view"},
+	{Verb: "list", Group: "batch", Resource: "jobs", Namespaced: true, Feature: "Workloads view"},
+	kubeletProxyCheck,
+	{Verb: "list", Group: "metrics.k8s.io", Resource: "nodes", Optional: true, Feature: "metrics-server node usage"},
+	{Verb: "list", Group: "metrics.k8s.io", Resource: "pods", Namespaced: true, Optional: true, Feature: "metrics-server pod usage"},
+	{Verb: "list", Group: "batch.volcano.sh", Resource: "jobs", Namespaced: true, Optional: true, Feature: "Volcano jobs"},
+	{Verb: "list", Group: "scheduling.volcano.sh", Resource: "queues", Optional: true, Feature: "Volcano queues"},
+	{Verb: "list", Group: "topology.volcano.sh", Resource: "hypernodes", Optional: true, Feature: "Volcano HyperNodes"},
+}
+
+// CheckAccess performs a SelfSubjectAccessReview for each check. Namespaced
+// checks are scoped to namespace; an empty namespace checks all namespaces.
+func CheckAccess(ctx context.Context, client authorizationclient.AuthorizationV1Interface, namespace string, checks []AccessCheck) ([]AccessResult, error) {
+	results := make([]AccessResult, 0, len(checks))
+	for _, check := range checks {
+		attributes := &authorizationv1.ResourceAttributes{
+			Verb:        check.Verb,
+			Group:       check.Group,
+			Resource:    check.Resource,
+			Subresource: check.Subresource,
+		}
+		if check.Namespaced {
+			attributes.Namespace = namespace
+		}
+
+		sar := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: attributes},
+		}
+		resp, err := client.SelfSubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+		if err != nil {
+			return results, fmt.Errorf("failed to review access to %s: %w", check.Name(), err)
+		}
+
+		result := AccessResult{Check: check, Allowed: resp.Status.Allowed}
+		if !resp.Status.Allowed {
+			var details []string
+			if resp.Status.Reason != "" {
+				details = append(details, resp.Status.Reason)
+			}
+			if resp.Status.EvaluationError != "" {
+				details = append(details, resp.Status.EvaluationError)
+			}
+			result.Reason = strings.TrimSpace(strings.Join(details, " • "))
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// SuggestedClusterRole returns a ClusterRole manifest granting the denied
+// permissions, or "" when everything is allowed
+func SuggestedClusterRole(results []AccessResult) string {
+	// Group denied resources by API group and verb, as kubectl would
+	type ruleKey struct {
+		group string
+		verbs string
+	}
+	resources := make(map[ruleKey][]string)
+	var keys []ruleKey
+	for _, result := range results {
+		if result.Allowed {
+			continue
+		}
+		check := result.Check
+		verbs := "get, list, watch"
+		if check.Subresource != "" {
+			verbs = check.Verb
+		}
+		key := ruleKey{group: check.Group, verbs: verbs}
+		if _, ok := resources[key]; !ok {
+			keys = append(keys, key)
+		}
+		resource := check.Resource
+		if check.Subresource != "" {
+			resource += "/" + check.Subresource
+		}
+		resources[key] = append(resources[key], resource)
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].group < keys[j].group
+	})
+
+	var b strings.Builder
+	b.WriteString("apiVersion: rbac.authorization.k8s.io/v1\n")
+	b.WriteString("kind: ClusterRole\n")
+	b.WriteString("metadata:\n")
+	b.WriteString("  name: k8s-monitor-readonly\n")
+	b.WriteString("rules:\n")
+	for _, key := range keys {
+		fmt.Fprintf(&b, "  - apiGroups: [%q]\n", key.group)
+		fmt.Fprintf(&b, "    resources: [%s]\n", strings.Join(resources[key], ", "))
+		fmt.Fprintf(&b, "    verbs: [%s]\n", key.verbs)
+	}
+	return b.String()
+}
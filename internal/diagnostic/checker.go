@@ -87,6 +87,10 @@ func GetRecommendedAction(alertType model.AlertType, namespace, resourceName str
 	case model.AlertTypeClusterPodCapacity:
 		return "kubectl get pods -A | wc -l # Check pod distribution across nodes"
 
+	// Namespace alerts
+	case model.AlertTypeNamespaceQuotaCPU, model.AlertTypeNamespaceQuotaMemory:
+		return "kubectl describe resourcequota -n " + resourceName + " # Raise the quota or reduce workload requests"
+
 	default:
 		return ""
 	}
@@ -175,6 +179,10 @@ func GetRecommendedActionChinese(alertType model.AlertType, namespace, resourceN
 	case model.AlertTypeClusterPodCapacity:
 		return "kubectl get pods -A | wc -l # 检查 Pod 在节点上的分布"
 
+	// Namespace alerts
+	case model.AlertTypeNamespaceQuotaCPU, model.AlertTypeNamespaceQuotaMemory:
+		return "kubectl describe resourcequota -n " + resourceName + " # 提高配额或降低工作负载的资源请求"
+
 	default:
 		return ""
 	}
@@ -222,6 +230,8 @@ func GetAlertPriority(alertType model.AlertType, severity model.AlertSeverity) i
 		return basePriority + 10
 	case model.AlertTypePodHighRestarts:
 		return basePriority + 10
+	case model.AlertTypeNamespaceQuotaCPU, model.AlertTypeNamespaceQuotaMemory:
+		return basePriority + 5
 	case model.AlertTypePVCPendingTooLong:
 		return basePriority + 5
 
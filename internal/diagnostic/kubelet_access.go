@@ -3,11 +3,8 @@ package diagnostic
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
-	authorizationv1 "k8s.io/api/authorization/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	authorizationclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
 )
 
@@ -33,42 +30,19 @@ func (s *KubeletAccessStatus) Message() string {
 	return fmt.Sprintf("%s • 请执行 `kubectl auth can-i get nodes/proxy` 或授予相应 RBAC", message)
 }
 
+// kubeletProxyCheck is the permission needed to scrape kubelet summaries through the API Server
+var kubeletProxyCheck = AccessCheck{Verb: "get", Resource: "nodes", Subresource: "proxy", Optional: true, Feature: "kubelet usage metrics"}
+
 // CheckKubeletAccess performs a SelfSubjectAccessReview for nodes/proxy.
 func CheckKubeletAccess(ctx context.Context, client authorizationclient.AuthorizationV1Interface) (*KubeletAccessStatus, error) {
-	sar := &authorizationv1.SelfSubjectAccessReview{
-		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
-			ResourceAttributes: &authorizationv1.ResourceAttributes{
-				Verb:        "get",
-				Resource:    "nodes",
-				Subresource: "proxy",
-				Group:       "",
-			},
-		},
-	}
-
-	resp, err := client.SelfSubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	results, err := CheckAccess(ctx, client, "", []AccessCheck{kubeletProxyCheck})
 	if err != nil {
 		return nil, err
 	}
 
-	status := &KubeletAccessStatus{
-		ProxyAllowed: resp.Status.Allowed,
+	return &KubeletAccessStatus{
+		ProxyAllowed: results[0].Allowed,
+		ProxyMessage: results[0].Reason,
 		CheckedAt:    time.Now(),
-	}
-
-	if resp.Status.Allowed {
-		return status, nil
-	}
-
-	var details []string
-	if resp.Status.Reason != "" {
-		details = append(details, resp.Status.Reason)
-	}
-	if resp.Status.EvaluationError != "" {
-		details = append(details, resp.Status.EvaluationError)
-	}
-
-	status.ProxyMessage = strings.TrimSpace(strings.Join(details, " • "))
-
-	return status, nil
+	}, nil
 }
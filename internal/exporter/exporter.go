@@ -0,0 +1,167 @@
+package exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+)
+
+// namespace prefixes every exported metric name
+const namespace = "k8s_monitor"
+
+// DataFunc returns the latest cluster data (typically served from the cache)
+type DataFunc func() (*model.ClusterData, error)
+
+// Collector exposes the cluster summary as Prometheus metrics.
+// Values are read from the data function on every scrape, so the
+// collector itself holds no state besides metric descriptors.
+type Collector struct {
+	getData DataFunc
+	logger  *zap.Logger
+
+	up                  *prometheus.Desc
+	lastRefresh         *prometheus.Desc
+	nodes               *prometheus.Desc
+	pods                *prometheus.Desc
+	cpuCapacity         *prometheus.Desc
+	cpuAllocatable      *prometheus.Desc
+	cpuRequested        *prometheus.Desc
+	cpuUsed             *prometheus.Desc
+	cpuUtilization      *prometheus.Desc
+	memoryCapacity      *prometheus.Desc
+	memoryAllocatable   *prometheus.Desc
+	memoryRequested     *prometheus.Desc
+	memoryUsed          *prometheus.Desc
+	memoryUtilization   *prometheus.Desc
+	npuCapacity         *prometheus.Desc
+	npuAllocatable      *prometheus.Desc
+	npuAllocated        *prometheus.Desc
+	npuUtilization      *prometheus.Desc
+	alerts              *prometheus.Desc
+	warningEvents       *prometheus.Desc
+	nodesWithoutMetrics *prometheus.Desc
+}
+
+// NewCollector creates a collector reading cluster data from getData
+func NewCollector(getData DataFunc, logger *zap.Logger) *Collector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, labels, nil)
+	}
+
+	return &Collector{
+		getData: getData,
+		logger:  logger,
+
+		up:                  desc("up", "Whether the last cluster data fetch succeeded (1) or failed (0)."),
+		lastRefresh:         desc("last_refresh_timestamp_seconds", "Unix time of the last successful cluster data refresh."),
+		nodes:               desc("cluster_nodes", "Number of nodes by readiness.", "state"),
+		pods:                desc("cluster_pods", "Number of pods by phase.", "phase"),
+		cpuCapacity:         desc("cluster_cpu_capacity_millicores", "Total CPU capacity across all nodes."),
+		cpuAllocatable:      desc("cluster_cpu_allocatable_millicores", "Total allocatable CPU across all nodes."),
+		cpuRequested:        desc("cluster_cpu_requested_millicores", "Total CPU requested by pods."),
+		cpuUsed:             desc("cluster_cpu_used_millicores", "Total CPU used (from kubelet metrics)."),
+		cpuUtilization:      desc("cluster_cpu_utilization_percent", "CPU used as a percentage of capacity."),
+		memoryCapacity:      desc("cluster_memory_capacity_bytes", "Total memory capacity across all nodes."),
+		memoryAllocatable:   desc("cluster_memory_allocatable_bytes", "Total allocatable memory across all nodes."),
+		memoryRequested:     desc("cluster_memory_requested_bytes", "Total memory requested by pods."),
+		memoryUsed:          desc("cluster_memory_used_bytes", "Total memory used (from kubelet metrics)."),
+		memoryUtilization:   desc("cluster_memory_utilization_percent", "Memory used as a percentage of capacity."),
+		npuCapacity:         desc("cluster_npu_capacity", "Total NPU capacity across all nodes."),
+		npuAllocatable:      desc("cluster_npu_allocatable", "Total allocatable NPUs."),
+		npuAllocated:        desc("cluster_npu_allocated", "Total NPUs allocated to pods."),
+		npuUtilization:      desc("cluster_npu_utilization_percent", "Allocated NPUs as a percentage of allocatable."),
+		alerts:              desc("alerts", "Number of active alerts by severity.", "severity"),
+		warningEvents:       desc("cluster_warning_events", "Number of warning events."),
+		nodesWithoutMetrics: desc("cluster_nodes_without_metrics", "Number of nodes missing kubelet metrics."),
+	}
+}
+
+// Describe sends all metric descriptors
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, d := range []*prometheus.Desc{
+		c.up, c.lastRefresh, c.nodes, c.pods,
+		c.cpuCapacity, c.cpuAllocatable, c.cpuRequested, c.cpuUsed, c.cpuUtilization,
+		c.memoryCapacity, c.memoryAllocatable, c.memoryRequested, c.memoryUsed, c.memoryUtilization,
+		c.npuCapacity, c.npuAllocatable, c.npuAllocated, c.npuUtilization,
+		c.alerts, c.warningEvents, c.nodesWithoutMetrics,
+	} {
+		ch <- d
+	}
+}
+
+// Collect reads the latest cluster data and emits the summary metrics
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	data, err := c.getData()
+	if err != nil || data == nil || data.Summary == nil {
+		if err != nil && c.logger != nil {
+			c.logger.Warn("Failed to get cluster data for metrics", zap.Error(err))
+		}
+		ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, 0)
+		return
+	}
+	s := data.Summary
+
+	gauge := func(desc *prometheus.Desc, value float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labels...)
+	}
+
+	gauge(c.up, 1)
+	if !s.LastRefreshTime.IsZero() {
+		gauge(c.lastRefresh, float64(s.LastRefreshTime.Unix()))
+	}
+
+	gauge(c.nodes, float64(s.ReadyNodes), "ready")
+	gauge(c.nodes, float64(s.NotReadyNodes), "not_ready")
+
+	gauge(c.pods, float64(s.RunningPods), "running")
+	gauge(c.pods, float64(s.PendingPods), "pending")
+	gauge(c.pods, float64(s.FailedPods), "failed")
+	gauge(c.pods, float64(s.UnknownPods), "unknown")
+
+	gauge(c.cpuCapacity, float64(s.CPUCapacity))
+	gauge(c.cpuAllocatable, float64(s.CPUAllocatable))
+	gauge(c.cpuRequested, float64(s.CPURequested))
+	gauge(c.cpuUsed, float64(s.CPUUsed))
+	gauge(c.cpuUtilization, s.CPUUsageUtilization)
+
+	gauge(c.memoryCapacity, float64(s.MemoryCapacity))
+	gauge(c.memoryAllocatable, float64(s.MemoryAllocatable))
+	gauge(c.memoryRequested, float64(s.MemoryRequested))
+	gauge(c.memoryUsed, float64(s.MemoryUsed))
+	gauge(c.memoryUtilization, s.MemUsageUtilization)
+
+	gauge(c.npuCapacity, float64(s.NPUCapacity))
+	gauge(c.npuAllocatable, float64(s.NPUAllocatable))
+	gauge(c.npuAllocated, float64(s.NPUAllocated))
+	gauge(c.npuUtilization, s.NPUUtilization)
+
+	// Always emit every severity so absent alerts read as 0 rather than missing
+	counts := map[model.AlertSeverity]int{
+		model.AlertSeverityCritical: 0,
+		model.AlertSeverityWarning:  0,
+		model.AlertSeverityInfo:     0,
+	}
+	for _, alert := range s.Alerts {
+		counts[alert.Severity]++
+	}
+	for severity, count := range counts {
+		gauge(c.alerts, float64(count), severityLabel(severity))
+	}
+
+	gauge(c.warningEvents, float64(s.WarningEvents))
+	gauge(c.nodesWithoutMetrics, float64(s.NodesWithoutMetrics))
+}
+
+// severityLabel converts an alert severity to a lowercase label value
+func severityLabel(severity model.AlertSeverity) string {
+	switch severity {
+	case model.AlertSeverityCritical:
+		return "critical"
+	case model.AlertSeverityWarning:
+		return "warning"
+	case model.AlertSeverityInfo:
+		return "info"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,94 @@
+package exporter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// gather registers the collector and returns metric values keyed by name{labels}
+func gather(t *testing.T, c *Collector) map[string]float64 {
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(c); err != nil {
+		t.Fatalf("Failed to register collector: %v", err)
+	}
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("Failed to gather metrics: %v", err)
+	}
+
+	values := make(map[string]float64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			key := family.GetName()
+			for _, label := range metric.GetLabel() {
+				key += fmt.Sprintf("{%s=%s}", label.GetName(), label.GetValue())
+			}
+			values[key] = gaugeValue(metric)
+		}
+	}
+	return values
+}
+
+func gaugeValue(metric *dto.Metric) float64 {
+	return metric.GetGauge().GetValue()
+}
+
+func TestCollectorExportsSummary(t *testing.T) {
+	data := &model.ClusterData{
+		Summary: &model.ClusterSummary{
+			ReadyNodes:    3,
+			NotReadyNodes: 1,
+			RunningPods:   10,
+			PendingPods:   2,
+			CPUUsed:       1500,
+			NPUAllocated:  4,
+			Alerts: []model.Alert{
+				{Severity: model.AlertSeverityCritical},
+				{Severity: model.AlertSeverityWarning},
+				{Severity: model.AlertSeverityWarning},
+			},
+		},
+	}
+
+	values := gather(t, NewCollector(func() (*model.ClusterData, error) { return data, nil }, nil))
+
+	expected := map[string]float64{
+		"k8s_monitor_up":                             1,
+		"k8s_monitor_cluster_nodes{state=ready}":     3,
+		"k8s_monitor_cluster_nodes{state=not_ready}": 1,
+		"k8s_monitor_cluster_pods{phase=running}":    10,
+		"k8s_monitor_cluster_pods{phase=pending}":    2,
+		"k8s_monitor_cluster_cpu_used_millicores":    1500,
+		"k8s_monitor_cluster_npu_allocated":          4,
+		"k8s_monitor_alerts{severity=critical}":      1,
+		"k8s_monitor_alerts{severity=warning}":       2,
+		"k8s_monitor_alerts{severity=info}":          0,
+	}
+	for name, want := range expected {
+		got, ok := values[name]
+		if !ok {
+			t.Errorf("Expected metric %s to be exported", name)
+			continue
+		}
+		if got != want {
+			t.Errorf("Expected %s = %v, got %v", name, want, got)
+		}
+	}
+}
+
+func TestCollectorReportsDown(t *testing.T) {
+	values := gather(t, NewCollector(func() (*model.ClusterData, error) {
+		return nil, fmt.Errorf("cluster unreachable")
+	}, nil))
+
+	if values["k8s_monitor_up"] != 0 {
+		t.Errorf("Expected k8s_monitor_up = 0, got %v", values["k8s_monitor_up"])
+	}
+	if len(values) != 1 {
+		t.Errorf("Expected only the up metric when data is unavailable, got %d metrics", len(values))
+	}
+}
@@ -3,6 +3,8 @@ package i18n
 import (
 	"embed"
 	"encoding/json"
+	"errors"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 	"github.com/nicksnyder/go-i18n/v2/i18n"
@@ -15,23 +17,38 @@ var localeFS embed.FS
 // Bundle holds all translation files
 var bundle *i18n.Bundle
 
+// Supported languages
+const (
+	English  = "en"
+	Chinese  = "zh"
+	Japanese = "ja"
+	Korean   = "ko"
+)
+
+// catalogs lists the embedded message file of each supported language.
+// Keys missing from a catalog fall back to English.
+var catalogs = []struct {
+	file string
+	name string
+}{
+	{"active.en.toml", "English"},
+	{"active.zh.toml", "Chinese"},
+	{"active.ja.toml", "Japanese"},
+	{"active.ko.toml", "Korean"},
+}
+
 func init() {
-	// Initialize bundle with default language (English)
+	// Initialize bundle with default language (English). Plural rules of
+	// every language come with go-i18n's CLDR data.
 	bundle = i18n.NewBundle(language.English)
 	bundle.RegisterUnmarshalFunc("toml", toml.Unmarshal)
 
 	// Load translation files from embedded FS
-	// English
-	if data, err := localeFS.ReadFile("active.en.toml"); err == nil {
-		if _, err := bundle.ParseMessageFileBytes(data, "active.en.toml"); err != nil {
-			panic("failed to load English translations: " + err.Error())
-		}
-	}
-
-	// Chinese (Simplified)
-	if data, err := localeFS.ReadFile("active.zh.toml"); err == nil {
-		if _, err := bundle.ParseMessageFileBytes(data, "active.zh.toml"); err != nil {
-			panic("failed to load Chinese translations: " + err.Error())
+	for _, catalog := range catalogs {
+		if data, err := localeFS.ReadFile(catalog.file); err == nil {
+			if _, err := bundle.ParseMessageFileBytes(data, catalog.file); err != nil {
+				panic("failed to load " + catalog.name + " translations: " + err.Error())
+			}
 		}
 	}
 }
@@ -41,29 +58,43 @@ type Localizer struct {
 	localizer *i18n.Localizer
 }
 
+// Language returns the supported language of a locale such as "zh_CN",
+// "ja-JP" or "ko", or English for unknown locales
+func Language(locale string) string {
+	tag, err := language.Parse(strings.ReplaceAll(locale, "_", "-"))
+	if err != nil {
+		return English
+	}
+	base, _ := tag.Base()
+	switch lang := base.String(); lang {
+	case Chinese, Japanese, Korean:
+		return lang
+	}
+	return English
+}
+
 // NewLocalizer creates a new localizer for the given locale
-// Supported locales: "en" (English), "zh" (Chinese Simplified)
+// Supported locales: "en" (English), "zh" (Chinese Simplified), "ja" (Japanese), "ko" (Korean)
 func NewLocalizer(locale string) *Localizer {
-	// Normalize locale string
-	var lang language.Tag
-	switch locale {
-	case "zh", "zh-CN", "zh_CN":
-		lang = language.Chinese
-	case "en", "en-US", "en_US":
-		lang = language.English
-	default:
-		// Default to English for unknown locales
-		lang = language.English
+	return &Localizer{
+		localizer: i18n.NewLocalizer(bundle, Language(locale)),
 	}
+}
 
-	return &Localizer{
-		localizer: i18n.NewLocalizer(bundle, lang.String()),
+// localize looks up a message, accepting the English fallback go-i18n
+// returns alongside an error for keys missing from the locale's catalog
+func (l *Localizer) localize(config *i18n.LocalizeConfig) (string, error) {
+	msg, err := l.localizer.Localize(config)
+	var notFound *i18n.MessageNotFoundErr
+	if errors.As(err, &notFound) && msg != "" {
+		return msg, nil
 	}
+	return msg, err
 }
 
 // T translates a message ID to the localized string
 func (l *Localizer) T(messageID string) string {
-	msg, err := l.localizer.Localize(&i18n.LocalizeConfig{
+	msg, err := l.localize(&i18n.LocalizeConfig{
 		MessageID: messageID,
 	})
 	if err != nil {
@@ -76,7 +107,7 @@ func (l *Localizer) T(messageID string) string {
 // TP translates a message with plural support
 // count is used to determine plural form
 func (l *Localizer) TP(messageID string, count int) string {
-	msg, err := l.localizer.Localize(&i18n.LocalizeConfig{
+	msg, err := l.localize(&i18n.LocalizeConfig{
 		MessageID:   messageID,
 		PluralCount: count,
 	})
@@ -89,7 +120,7 @@ func (l *Localizer) TP(messageID string, count int) string {
 // TF translates a message with template data
 // templateData contains variables to be substituted in the translated string
 func (l *Localizer) TF(messageID string, templateData map[string]interface{}) string {
-	msg, err := l.localizer.Localize(&i18n.LocalizeConfig{
+	msg, err := l.localize(&i18n.LocalizeConfig{
 		MessageID:    messageID,
 		TemplateData: templateData,
 	})
@@ -103,7 +134,7 @@ func (l *Localizer) TF(messageID string, templateData map[string]interface{}) st
 
 // TWithDefault translates a message with a default fallback
 func (l *Localizer) TWithDefault(messageID, defaultMsg string) string {
-	msg, err := l.localizer.Localize(&i18n.LocalizeConfig{
+	msg, err := l.localize(&i18n.LocalizeConfig{
 		DefaultMessage: &i18n.Message{
 			ID:    messageID,
 			Other: defaultMsg,
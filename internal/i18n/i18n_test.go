@@ -0,0 +1,41 @@
+package i18n
+
+import "testing"
+
+func TestLanguage(t *testing.T) {
+	tests := map[string]string{
+		"ja_JP": Japanese,
+		"ko-KR": Korean,
+		"zh_CN": Chinese,
+		"zh":    Chinese,
+		"en_US": English,
+		"fr":    English,
+		"":      English,
+	}
+	for locale, want := range tests {
+		if got := Language(locale); got != want {
+			t.Errorf("Language(%q) = %q, want %q", locale, got, want)
+		}
+	}
+}
+
+func TestLocalizerFallsBackToEnglish(t *testing.T) {
+	ja := NewLocalizer("ja_JP")
+	if got := ja.T("app.name"); got != "Kubernetes モニター" {
+		t.Errorf("T(app.name) = %q, want the Japanese translation", got)
+	}
+
+	// columns.rollout and views.pods.completed_hidden have no Japanese
+	// translation yet
+	if got := ja.T("columns.rollout"); got != "ROLLOUT" {
+		t.Errorf("T(columns.rollout) = %q, want English fallback ROLLOUT", got)
+	}
+	got := ja.TF("views.pods.completed_hidden", map[string]interface{}{"Count": 3})
+	if want := "3 completed hidden (C to show)"; got != want {
+		t.Errorf("TF(views.pods.completed_hidden) = %q, want %q", got, want)
+	}
+
+	if got := ja.T("no.such.key"); got != "no.such.key" {
+		t.Errorf("T(no.such.key) = %q, want the message ID", got)
+	}
+}
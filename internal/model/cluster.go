@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -8,24 +9,38 @@ import (
 
 // ClusterData represents the overall cluster state
 type ClusterData struct {
-	Nodes        []*NodeData
-	Pods         []*PodData
-	Events       []*EventData
-	Services     []*ServiceData
-	PVs          []*PVData
-	PVCs         []*PVCData
-	Deployments  []*DeploymentData
-	StatefulSets []*StatefulSetData
-	DaemonSets   []*DaemonSetData
-	Jobs         []*JobData
-	CronJobs     []*CronJobData
-	Summary      *ClusterSummary
+	Nodes          []*NodeData
+	Pods           []*PodData
+	Events         []*EventData
+	Services       []*ServiceData
+	PVs            []*PVData
+	PVCs           []*PVCData
+	StorageClasses []*StorageClassData
+	Deployments    []*DeploymentData
+	StatefulSets   []*StatefulSetData
+	DaemonSets     []*DaemonSetData
+	Jobs           []*JobData
+	CronJobs       []*CronJobData
+	Summary        *ClusterSummary
+
+	// Kubernetes version reported by the API server (e.g. v1.28.3)
+	ServerVersion string
+
+	// Namespace policy
+	ResourceQuotas []*QuotaData
+	LimitRanges    []*LimitRangeData
+
+	// PodDisruptionBudgets
+	PDBs []*PDBData
 
 	// Volcano scheduler data
 	VolcanoJobs    []*VolcanoJobData
 	HyperNodes     []*HyperNodeData
 	Queues         []*QueueData
 	VolcanoSummary *VolcanoSummary
+
+	// CustomResourceDefinitions, only fetched when enabled in the config
+	CRDs []*CRDData
 }
 
 // ClusterSummary provides high-level cluster metrics
@@ -106,6 +121,7 @@ type ClusterSummary struct {
 	KubeletMetricsAvailable bool     // True if at least one kubelet metrics call succeeded
 	KubeletError            string   // First kubelet error encountered
 	KubeletErrors           []string // Unique kubelet error messages
+	KubeletTimeouts         int      // Number of nodes whose kubelet query timed out
 
 	// Node health statistics (pressure indicators)
 	MemoryPressureNodes int // Number of nodes with memory pressure
@@ -131,6 +147,11 @@ type ClusterSummary struct {
 	CPULimitUtilization float64 // CPULimited / CPUAllocatable * 100
 	MemLimitUtilization float64 // MemoryLimited / MemoryAllocatable * 100
 
+	// Request efficiency: how much of the requested resources is actually used.
+	// Low values mean reserved capacity sits idle. 0 when usage is unknown.
+	CPURequestEfficiency float64 // CPUUsed / CPURequested * 100
+	MemRequestEfficiency float64 // MemoryUsed / MemoryRequested * 100
+
 	// NPU statistics (Ascend AI accelerators)
 	NPUCapacity    int64   // Total NPU capacity across all nodes
 	NPUAllocatable int64   // Total allocatable NPUs
@@ -142,6 +163,14 @@ type ClusterSummary struct {
 	NPUResourceName string // e.g., "huawei.com/ascend-1980"
 	NPUChipType     string // e.g., "Ascend910", "Ascend310"
 
+	// GPU statistics (NVIDIA, nvidia.com/gpu)
+	GPUCapacity    int64   // Total GPU capacity across all nodes
+	GPUAllocatable int64   // Total allocatable GPUs
+	GPUAllocated   int64   // Total GPUs allocated to pods
+	GPUUtilization float64 // GPUAllocated / GPUAllocatable * 100
+	GPUNodesCount  int     // Number of nodes with GPU
+	GPUProduct     string  // e.g., "NVIDIA-A100-SXM4-80GB" (from first node with GPU)
+
 	// Topology information (Volcano HyperNode)
 	HyperClusterID   string // volcano.sh/hypercluster
 	HyperNodeCount   int    // Number of HyperNodes (Tier 1)
@@ -188,6 +217,10 @@ const (
 	AlertTypeClusterCPUCritical    AlertType = "cluster_cpu_critical"
 	AlertTypeClusterMemoryCritical AlertType = "cluster_memory_critical"
 	AlertTypeClusterPodCapacity    AlertType = "cluster_pod_capacity"
+
+	// Namespace alert types
+	AlertTypeNamespaceQuotaCPU    AlertType = "namespace_quota_cpu"
+	AlertTypeNamespaceQuotaMemory AlertType = "namespace_quota_memory"
 )
 
 // Alert represents a resource alert
@@ -227,6 +260,26 @@ func (s AlertSeverity) String() string {
 	}
 }
 
+// MarshalText encodes the severity by name so JSON/YAML output is readable
+func (s AlertSeverity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a severity written by MarshalText
+func (s *AlertSeverity) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Critical":
+		*s = AlertSeverityCritical
+	case "Warning":
+		*s = AlertSeverityWarning
+	case "Info":
+		*s = AlertSeverityInfo
+	default:
+		return fmt.Errorf("unknown alert severity %q", text)
+	}
+	return nil
+}
+
 // PodRestartInfo represents a pod with high restart count
 type PodRestartInfo struct {
 	Name         string
@@ -267,6 +320,18 @@ type NPUChipData struct {
 	BandwidthTx      float64 `json:"bandwidth_tx"`       // Bandwidth TX in MB/s
 }
 
+// NPUDeviceMetric represents the metrics of one NPU card, aggregated over its chips
+type NPUDeviceMetric struct {
+	CardID      int     // NPU card ID (NPUChipData.NPUID)
+	Chips       int     // Number of chips reporting metrics
+	Utilization float64 // Average AI Core utilization percentage
+	HBMUsed     int64   // HBM memory used in MB
+	HBMTotal    int64   // HBM memory total in MB
+	Temp        int     // Hottest chip temperature in Celsius
+	Power       float64 // Total power consumption in Watts
+	Health      string  // Worst chip health (OK, Warning, Error)
+}
+
 // NPUMetricsData represents the full NPU metrics from node annotation
 type NPUMetricsData struct {
 	Timestamp time.Time      `json:"timestamp"`
@@ -277,6 +342,7 @@ type NPUMetricsData struct {
 type NodeData struct {
 	Name              string
 	InternalIP        string
+	InternalIPs       []string // All internal addresses, one per IP family on dual-stack nodes
 	ExternalIP        string
 	Roles             []string
 	Status            string // Ready, NotReady, Unknown
@@ -285,6 +351,14 @@ type NodeData struct {
 	Labels            map[string]string
 	Annotations       map[string]string
 	CreationTimestamp time.Time
+	Unschedulable     bool // True when the node is cordoned
+
+	// System info (from status.nodeInfo)
+	KubeletVersion          string
+	KubeProxyVersion        string
+	ContainerRuntimeVersion string
+	OSImage                 string
+	KernelVersion           string
 
 	// Capacity and Allocatable
 	CPUCapacity    int64 // millicores
@@ -317,6 +391,7 @@ type NodeData struct {
 	// Metrics availability
 	HasKubeletMetrics bool
 	KubeletError      string
+	KubeletTimedOut   bool // The last kubelet query exceeded the timeout
 
 	// NPU (Ascend AI accelerator) information
 	NPUCapacity     int64  // Total NPU capacity on this node
@@ -342,6 +417,16 @@ type NodeData struct {
 	NPUMetricsTime    time.Time // Timestamp of last metrics update
 	NPUChips          []NPUChipData // Detailed per-chip metrics from collector
 
+	// GPU (NVIDIA) information
+	GPUCapacity    int64  // Total GPU capacity on this node (nvidia.com/gpu)
+	GPUAllocatable int64  // Allocatable GPUs on this node
+	GPUAllocated   int64  // GPUs currently allocated to pods on this node
+	GPUProduct     string // e.g., "NVIDIA-A100-SXM4-80GB" from nvidia.com/gpu.product
+
+	// NPU per-card metrics
+	NPUDevices       []NPUDeviceMetric // Per-card metrics aggregated from NPUChips
+	NPUAggregateOnly bool              // NPU-Exporter is reachable but exposes no per-chip metrics
+
 	// Topology information (from node labels)
 	HyperNodeID    string // volcano.sh/hypernode
 	HyperClusterID string // volcano.sh/hypercluster
@@ -359,7 +444,11 @@ type PodData struct {
 	Message           string
 	HostIP            string
 	PodIP             string
-	QOSClass          string
+	PodIPs            []string // All pod IPs, primary first (one per IP family on dual-stack clusters)
+	QOSClass          string // Guaranteed, Burstable, BestEffort
+	GenerateName      string // Name prefix used by controllers (e.g. "web-5f6c7d8b9-")
+	OwnerKind         string // Controller owner kind (ReplicaSet, StatefulSet, Job, ...)
+	OwnerName         string // Controller owner name
 	Labels            map[string]string
 	Annotations       map[string]string
 	CreationTimestamp time.Time
@@ -370,6 +459,7 @@ type PodData struct {
 	ReadyContainers int
 	RestartCount    int32
 	ContainerStates []ContainerState
+	LastRestartTime time.Time // When a container last terminated and was restarted (zero if never)
 
 	// Resource requests/limits
 	CPURequest    int64 // millicores
@@ -381,6 +471,9 @@ type PodData struct {
 	NPURequest      int64  // Number of NPUs requested
 	NPUResourceName string // Resource name, e.g., "huawei.com/ascend-1980"
 
+	// GPU requests (NVIDIA, nvidia.com/gpu)
+	GPURequest int64 // Number of GPUs requested
+
 	// Usage metrics (from kubelet)
 	CPUUsage         int64
 	MemoryUsage      int64
@@ -388,8 +481,50 @@ type PodData struct {
 	NetworkTxBytes   int64
 	NetworkTimestamp time.Time // Kubelet-provided timestamp for network metrics
 
+	// PVC volume usage (from kubelet volume stats), keyed by claim name
+	PVCUsage map[string]VolumeUsage
+
 	// Conditions
 	Conditions []corev1.PodCondition
+
+	// Scheduling status (from the PodScheduled condition)
+	PodScheduled      string // True, False, Unknown (empty if condition absent)
+	SchedulingReason  string // e.g. Unschedulable
+	SchedulingMessage string // Scheduler explanation when not scheduled
+
+	// Scheduling constraints (from the pod spec)
+	SchedulingGates []string             // Gates holding the pod back from scheduling until removed
+	NodeSelector    map[string]string    // Node labels the pod requires
+	NodeAffinity    *corev1.NodeSelector // Required node affinity; nodes must match one of its terms
+	Tolerations     []corev1.Toleration  // Taints the pod tolerates
+
+	// Volumes of the pod spec, mounted by containers through their VolumeMounts
+	Volumes []PodVolume
+}
+
+// PodVolume is a volume of a pod spec and where its data comes from
+type PodVolume struct {
+	Name     string
+	Type     string // ConfigMap, Secret, PersistentVolumeClaim, Ephemeral, EmptyDir, HostPath, Projected, ...
+	Source   string // ConfigMap, Secret or claim name, host path, emptyDir medium, ...
+	ReadOnly bool
+}
+
+// Claim returns the PVC backing the volume, "" for volumes not backed by one.
+// Generic ephemeral volumes are backed by a claim named <pod>-<volume>.
+func (v PodVolume) Claim() string {
+	if v.Type == "PersistentVolumeClaim" || v.Type == "Ephemeral" {
+		return v.Source
+	}
+	return ""
+}
+
+// VolumeMount is where a container mounts a pod volume
+type VolumeMount struct {
+	Name      string // Pod volume name
+	MountPath string
+	SubPath   string
+	ReadOnly  bool
 }
 
 // ContainerState represents container status
@@ -403,6 +538,14 @@ type ContainerState struct {
 	Message      string
 	ExitCode     int32
 
+	// How the previous instance of the container ended (nil if it never terminated)
+	LastTermination *ContainerTermination
+
+	// Image as written in the pod spec; Image is the one the runtime reports
+	SpecImage       string
+	ImagePullPolicy string // Always, IfNotPresent, Never
+	ImageID         string // Image the container runs, e.g. "docker.io/library/nginx@sha256:..."
+
 	// Resource usage (from kubelet metrics)
 	CPUUsage    int64 // millicores
 	MemoryUsage int64 // bytes
@@ -412,6 +555,34 @@ type ContainerState struct {
 	CPULimit      int64 // millicores
 	MemoryRequest int64 // bytes
 	MemoryLimit   int64 // bytes
+
+	// Probe configuration and status
+	Started *bool // Startup probe result from the kubelet (nil when not reported)
+	Probes  []ProbeInfo
+
+	// Volume mounts from the container spec
+	VolumeMounts []VolumeMount
+}
+
+// ContainerTermination describes how a container instance terminated
+type ContainerTermination struct {
+	ExitCode   int32
+	Signal     int32
+	Reason     string // e.g. OOMKilled, Error, Completed
+	Message    string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// ProbeInfo describes a container health probe
+type ProbeInfo struct {
+	Type             string // Readiness, Liveness, Startup
+	Handler          string // e.g. "HTTP GET :8080/healthz", "TCP :5432", "Exec cat /tmp/ready"
+	InitialDelay     int32  // seconds
+	Period           int32  // seconds
+	Timeout          int32  // seconds
+	SuccessThreshold int32
+	FailureThreshold int32
 }
 
 // EventData represents a Kubernetes event
@@ -423,6 +594,8 @@ type EventData struct {
 	FirstTimestamp    time.Time
 	LastTimestamp     time.Time
 	InvolvedObject    string // e.g., "Pod/mypod", "Node/node1"
+	InvolvedKind      string // e.g., "Pod", "Node", "Deployment"
+	InvolvedName      string
 	InvolvedNamespace string
 	Source            string
 }
@@ -433,6 +606,7 @@ type ServiceData struct {
 	Namespace         string
 	Type              string // ClusterIP, NodePort, LoadBalancer, ExternalName
 	ClusterIP         string
+	ClusterIPs        []string // All cluster IPs, primary first (one per IP family on dual-stack clusters)
 	ExternalIPs       []string
 	Ports             []ServicePort
 	Selector          map[string]string
@@ -440,6 +614,11 @@ type ServiceData struct {
 	Annotations       map[string]string
 	CreationTimestamp time.Time
 
+	// Headless services (ClusterIP None) resolve to the pod IPs in DNS;
+	// ExternalName services to a CNAME of ExternalName
+	Headless     bool
+	ExternalName string
+
 	// LoadBalancer info
 	LoadBalancerIP string
 	Ingress        []string
@@ -473,6 +652,9 @@ type PVData struct {
 
 	// Volume source type
 	VolumeType string // NFS, iSCSI, HostPath, etc.
+
+	// Usage of the bound claim (if available from kubelet volume stats)
+	Usage *VolumeUsage
 }
 
 // PVCData represents a PersistentVolumeClaim
@@ -492,6 +674,97 @@ type PVCData struct {
 
 	// Usage info (if available from metrics)
 	UsedBytes int64
+	Usage     *VolumeUsage // Filesystem usage from kubelet volume stats
+}
+
+// VolumeUsage is the filesystem usage of a mounted PVC reported by kubelet
+type VolumeUsage struct {
+	UsedBytes     int64
+	CapacityBytes int64 // Filesystem size, which may differ from the requested storage
+}
+
+// UsagePercent returns how full the volume filesystem is (0-100)
+func (u VolumeUsage) UsagePercent() float64 {
+	if u.CapacityBytes <= 0 {
+		return 0
+	}
+	return float64(u.UsedBytes) / float64(u.CapacityBytes) * 100
+}
+
+// StorageClassData represents a StorageClass
+type StorageClassData struct {
+	Name                 string
+	Provisioner          string // CSI driver or in-tree provisioner
+	ReclaimPolicy        string // Delete, Retain
+	VolumeBindingMode    string // Immediate, WaitForFirstConsumer
+	AllowVolumeExpansion bool
+	IsDefault            bool
+	Parameters           map[string]string
+	Labels               map[string]string
+	CreationTimestamp    time.Time
+}
+
+// QuotaData represents a namespace ResourceQuota
+type QuotaData struct {
+	Name      string
+	Namespace string
+	Resources []QuotaResource // Sorted by resource name
+}
+
+// QuotaResource is a single resource tracked by a ResourceQuota
+type QuotaResource struct {
+	Name      string // e.g. requests.cpu, limits.memory, pods
+	Hard      string // Hard limit as written in the quota (e.g. "4", "8Gi")
+	Used      string
+	Remaining string // Hard minus used, floored at zero
+	HardMilli int64  // Hard limit in milli-units, for usage ratios
+	UsedMilli int64
+}
+
+// UsagePercent returns how much of the hard limit is consumed (0-100+)
+func (r QuotaResource) UsagePercent() float64 {
+	if r.HardMilli <= 0 {
+		return 0
+	}
+	return float64(r.UsedMilli) / float64(r.HardMilli) * 100
+}
+
+// LimitRangeData represents a namespace LimitRange
+type LimitRangeData struct {
+	Name      string
+	Namespace string
+	Limits    []LimitRangeItem
+}
+
+// LimitRangeItem holds the constraints of one resource for one object type
+type LimitRangeItem struct {
+	Type           string // Container, Pod, PersistentVolumeClaim
+	Resource       string // cpu, memory, storage, ...
+	Min            string
+	Max            string
+	Default        string // Default limit
+	DefaultRequest string
+	MaxRatio       string // Max limit/request ratio
+}
+
+// PDBData represents a PodDisruptionBudget
+type PDBData struct {
+	Name               string
+	Namespace          string
+	MinAvailable       string // Absolute number or percentage; "" when unset
+	MaxUnavailable     string // Absolute number or percentage; "" when unset
+	CurrentHealthy     int32
+	DesiredHealthy     int32
+	ExpectedPods       int32 // Pods counted by the budget
+	DisruptionsAllowed int32 // Voluntary evictions currently allowed
+	Selector           map[string]string
+	CreationTimestamp  time.Time
+}
+
+// BlocksEviction reports whether the budget currently rejects every voluntary
+// eviction of its pods, which makes node drains hang
+func (p *PDBData) BlocksEviction() bool {
+	return p.ExpectedPods > 0 && p.DisruptionsAllowed == 0
 }
 
 // DeploymentData represents a Kubernetes Deployment
@@ -514,6 +787,18 @@ type DeploymentData struct {
 	Conditions []string
 }
 
+// RollingOut reports whether the deployment is mid-rollout: some replicas do
+// not run the latest pod template yet or are unavailable
+func (d *DeploymentData) RollingOut() bool {
+	return d.Replicas > 0 && (d.UpdatedReplicas != d.Replicas || d.AvailableReplicas < d.Replicas)
+}
+
+// RolloutPercent returns the share of desired replicas running the latest pod
+// template, 0-100
+func (d *DeploymentData) RolloutPercent() float64 {
+	return rolloutPercent(d.UpdatedReplicas, d.Replicas)
+}
+
 // StatefulSetData represents a Kubernetes StatefulSet
 type StatefulSetData struct {
 	Name              string
@@ -530,6 +815,46 @@ type StatefulSetData struct {
 	Selector map[string]string
 }
 
+// RollingOut reports whether the statefulset is mid-rollout: some replicas do
+// not run the latest revision yet or are not ready
+func (s *StatefulSetData) RollingOut() bool {
+	return s.Replicas > 0 && (s.UpdatedReplicas != s.Replicas || s.ReadyReplicas < s.Replicas)
+}
+
+// RolloutPercent returns the share of desired replicas running the latest
+// revision, 0-100
+func (s *StatefulSetData) RolloutPercent() float64 {
+	return rolloutPercent(s.UpdatedReplicas, s.Replicas)
+}
+
+// rolloutPercent returns updated replicas as a percentage of desired ones
+func rolloutPercent(updated, desired int32) float64 {
+	if desired <= 0 {
+		return 100
+	}
+	percent := float64(updated) / float64(desired) * 100
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+// CRDData represents a CustomResourceDefinition
+type CRDData struct {
+	Name              string // <plural>.<group>
+	Kind              string
+	Group             string
+	Version           string // Storage version
+	Plural            string
+	Scope             string // Namespaced or Cluster
+	CreationTimestamp time.Time
+
+	// Instances is the number of objects of the CRD, set when Counted
+	Counted    bool
+	Instances  int
+	CountError string
+}
+
 // DaemonSetData represents a Kubernetes DaemonSet
 type DaemonSetData struct {
 	Name                   string
@@ -544,6 +869,11 @@ type DaemonSetData struct {
 
 	// Selector
 	Selector map[string]string
+
+	// Pod template scheduling constraints, used to work out which nodes
+	// should run a daemon pod
+	NodeSelector map[string]string
+	Tolerations  []corev1.Toleration
 }
 
 // JobData represents a Kubernetes Job
@@ -567,6 +897,7 @@ type CronJobData struct {
 	Name              string
 	Namespace         string
 	Schedule          string
+	TimeZone          string // spec.timeZone; empty means the controller's local time zone
 	Suspend           bool
 	Active            int32 // Number of active jobs
 	LastScheduleTime  time.Time
@@ -606,6 +937,21 @@ type VolcanoJobData struct {
 
 	// Tasks info
 	Tasks []VolcanoTaskData
+
+	// PodGroup used for gang scheduling (empty if not found)
+	PodGroup          string
+	PodGroupPhase     string // Pending, Inqueue, Running, Unknown, Completed
+	PodGroupMinMember int32
+	PodGroupReason    string // Reason of the latest unsatisfied condition, e.g. NotEnoughResources
+	PodGroupMessage   string
+
+	// Scheduling priority and preemption
+	PriorityClassName string    // spec.priorityClassName of the job, else of its PodGroup
+	Priority          int32     // Value of the priority class (0 when none or unknown)
+	Preempted         bool      // Pods of the job were evicted to make room for other pods
+	Preempting        bool      // Pods of the job preempted pods of other workloads
+	PreemptionMessage string    // Message of the latest preemption event or condition
+	PreemptionTime    time.Time // Time of the latest preemption event or condition
 }
 
 // VolcanoTaskData represents a task in a Volcano Job
@@ -614,6 +960,12 @@ type VolcanoTaskData struct {
 	Replicas     int32
 	MinAvailable int32
 	NPURequest   int64 // NPU per replica
+
+	// Pod phase counts from the job's status.taskStatusCount
+	Running   int32
+	Pending   int32
+	Succeeded int32
+	Failed    int32
 }
 
 // HyperNodeData represents a Volcano HyperNode (network topology)
@@ -661,6 +1013,12 @@ type QueueData struct {
 	MemoryGuarantee int64
 	NPUGuarantee    int64
 
+	// Capability resources (hard upper limit, including borrowed resources)
+	CPUCapability    int64 // millicores
+	MemoryCapability int64 // bytes
+	NPUCapability    int64 // NPU count
+	PodCapability    int64 // Pod count
+
 	// NPU resource name (e.g., "huawei.com/ascend-1980")
 	NPUResourceName string
 
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAlertSeverityJSONRoundTrip(t *testing.T) {
+	for _, severity := range []AlertSeverity{AlertSeverityInfo, AlertSeverityWarning, AlertSeverityCritical} {
+		data, err := json.Marshal(Alert{Severity: severity})
+		if err != nil {
+			t.Fatalf("Marshal(%s) error = %v", severity, err)
+		}
+		var alert Alert
+		if err := json.Unmarshal(data, &alert); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", data, err)
+		}
+		if alert.Severity != severity {
+			t.Errorf("round trip of %s = %s", severity, alert.Severity)
+		}
+	}
+
+	var severity AlertSeverity
+	if err := json.Unmarshal([]byte(`"Unknown"`), &severity); err == nil {
+		t.Error("Unmarshal(\"Unknown\") error = nil, want an error")
+	}
+}
@@ -0,0 +1,136 @@
+package replay
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+func TestRecordAndReadFrames(t *testing.T) {
+	var buf bytes.Buffer
+	recorder := NewRecorder(&buf)
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		data := &model.ClusterData{
+			Nodes:   []*model.NodeData{{Name: "node-1", CPUUsage: int64(100 * (i + 1))}},
+			Summary: &model.ClusterSummary{TotalNodes: 1, RunningPods: i},
+		}
+		if err := recorder.Record(start.Add(time.Duration(i)*2*time.Second), data); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+
+	frames, err := ReadFrames(&buf)
+	if err != nil {
+		t.Fatalf("ReadFrames failed: %v", err)
+	}
+	if len(frames) != 3 {
+		t.Fatalf("Expected 3 frames, got %d", len(frames))
+	}
+	if !frames[2].Timestamp.Equal(start.Add(4 * time.Second)) {
+		t.Errorf("Expected third frame at %v, got %v", start.Add(4*time.Second), frames[2].Timestamp)
+	}
+	if frames[2].Data.Nodes[0].CPUUsage != 300 || frames[2].Data.Summary.RunningPods != 2 {
+		t.Errorf("Frame data not preserved: %+v", frames[2].Data.Summary)
+	}
+}
+
+func TestReadFramesRejectsEmptyFrame(t *testing.T) {
+	if _, err := ReadFrames(bytes.NewBufferString(`{"timestamp":"2024-01-01T00:00:00Z"}`)); err == nil {
+		t.Error("Expected error for frame without cluster data")
+	}
+}
+
+// writeRecording records frames taken every interval from start to a file
+func writeRecording(t *testing.T, start time.Time, interval time.Duration, frames ...*model.ClusterData) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "cluster.json")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Failed to create recording: %v", err)
+	}
+	defer file.Close()
+	recorder := NewRecorder(file)
+	for i, data := range frames {
+		if err := recorder.Record(start.Add(time.Duration(i)*interval), data); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+	return path
+}
+
+func TestFileReplayProvider(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	path := writeRecording(t, start, 10*time.Second,
+		&model.ClusterData{Summary: &model.ClusterSummary{TotalPods: 1}},
+		&model.ClusterData{Summary: &model.ClusterSummary{TotalPods: 2}},
+	)
+
+	provider, err := NewFileReplayProvider(path)
+	if err != nil {
+		t.Fatalf("NewFileReplayProvider failed: %v", err)
+	}
+	if provider.Len() != 2 {
+		t.Fatalf("Expected 2 frames, got %d", provider.Len())
+	}
+
+	// Frames follow the recorded timestamps: extra fetches between them
+	// return the current frame, and the last one is held
+	now := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	provider.now = func() time.Time { return now }
+	for _, step := range []struct {
+		after time.Duration
+		want  int
+	}{{0, 1}, {2 * time.Second, 1}, {7 * time.Second, 1}, {time.Second, 2}, {time.Minute, 2}} {
+		now = now.Add(step.after)
+		data, err := provider.GetClusterData()
+		if err != nil {
+			t.Fatalf("GetClusterData failed: %v", err)
+		}
+		if data.Summary.TotalPods != step.want {
+			t.Errorf("Expected frame with %d pods after %v, got %d", step.want, step.after, data.Summary.TotalPods)
+		}
+	}
+
+	if err := provider.ForceRefresh(); err != nil {
+		t.Errorf("ForceRefresh should be a no-op, got %v", err)
+	}
+}
+
+func TestFileReplayProviderReplaysAlerts(t *testing.T) {
+	data := &model.ClusterData{Summary: &model.ClusterSummary{
+		Alerts: []model.Alert{
+			{Severity: model.AlertSeverityCritical, ResourceName: "node-1"},
+			{Severity: model.AlertSeverityWarning, ResourceName: "node-2"},
+		},
+	}}
+	path := writeRecording(t, time.Now(), time.Second, data)
+
+	provider, err := NewFileReplayProvider(path)
+	if err != nil {
+		t.Fatalf("NewFileReplayProvider failed: %v", err)
+	}
+	replayed, err := provider.GetClusterData()
+	if err != nil {
+		t.Fatalf("GetClusterData failed: %v", err)
+	}
+	alerts := replayed.Summary.Alerts
+	if len(alerts) != 2 || alerts[0].Severity != model.AlertSeverityCritical || alerts[1].Severity != model.AlertSeverityWarning {
+		t.Errorf("Alerts not preserved: %+v", alerts)
+	}
+}
+
+func TestFileReplayProviderEmptyRecording(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.json")
+	if err := os.WriteFile(path, nil, 0644); err != nil {
+		t.Fatalf("Failed to write recording: %v", err)
+	}
+	if _, err := NewFileReplayProvider(path); err == nil {
+		t.Error("Expected error for empty recording")
+	}
+}
@@ -6,17 +6,21 @@ import (
 	"strings"
 	"time"
 
-	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/k8s-monitor/internal/model"
 )
 
+// mutationTimeout bounds a mutating API call started from the action menu
+const mutationTimeout = 15 * time.Second
+
 // ActionMenuItem represents a single action in the menu
 type ActionMenuItem struct {
 	Label       string
 	Key         string
 	Description string
 	Action      ActionType
+	Target      string // Object the action applies to, for actions offered once per object
 }
 
 // ActionType defines the type of action
@@ -28,7 +32,17 @@ const (
 	ActionGetYAML
 	ActionCopyName
 	ActionCopyNamespaceName
+	ActionCopyYAML
 	ActionShowEvents
+	ActionCordonNode
+	ActionUncordonNode
+	ActionViewNode
+	ActionViewNodePods
+	ActionRolloutRestart
+	ActionDrainPreview
+	ActionKubectlDescribe
+	ActionKubectlEdit
+	ActionViewPVC
 )
 
 // getActionMenuItems returns available actions based on current context
@@ -68,11 +82,34 @@ func (m *Model) getActionMenuItems() []ActionMenuItem {
 			Action:      ActionCopyNamespaceName,
 		})
 		items = append(items, ActionMenuItem{
-			Label:       "⚡ Show Events",
+			Label:       "📎 Copy YAML",
 			Key:         "6",
+			Description: "Copy kubectl get -o yaml output",
+			Action:      ActionCopyYAML,
+		})
+		items = append(items, ActionMenuItem{
+			Label:       "⚡ Show Events",
+			Key:         "7",
 			Description: "Related events",
 			Action:      ActionShowEvents,
 		})
+		if m.selectedPod.Node != "" {
+			items = append(items, ActionMenuItem{
+				Label:       "💻 View Node",
+				Key:         "8",
+				Description: "Open node " + m.selectedPod.Node,
+				Action:      ActionViewNode,
+			})
+		}
+		for _, claim := range podClaims(m.selectedPod) {
+			items = append(items, ActionMenuItem{
+				Label:       "💾 View PVC",
+				Key:         fmt.Sprintf("%d", len(items)+1),
+				Description: "Open claim " + claim,
+				Action:      ActionViewPVC,
+				Target:      claim,
+			})
+		}
 	}
 
 	// Actions for Node detail view
@@ -96,16 +133,117 @@ func (m *Model) getActionMenuItems() []ActionMenuItem {
 			Action:      ActionCopyName,
 		})
 		items = append(items, ActionMenuItem{
-			Label:       "⚡ Show Events",
+			Label:       "📎 Copy YAML",
 			Key:         "4",
+			Description: "Copy kubectl get -o yaml output",
+			Action:      ActionCopyYAML,
+		})
+		items = append(items, ActionMenuItem{
+			Label:       "⚡ Show Events",
+			Key:         "5",
 			Description: "Related events",
 			Action:      ActionShowEvents,
 		})
+		items = append(items, ActionMenuItem{
+			Label:       "📦 View Pods",
+			Key:         "6",
+			Description: "Pods view filtered to this node",
+			Action:      ActionViewNodePods,
+		})
+		items = append(items, ActionMenuItem{
+			Label:       "🧹 Drain Preview",
+			Key:         "7",
+			Description: "Pods a drain would evict and PDBs blocking it (read-only)",
+			Action:      ActionDrainPreview,
+		})
+
+		// Mutating actions are only offered when explicitly enabled
+		if m.allowMutations {
+			if m.selectedNode.Unschedulable {
+				items = append(items, ActionMenuItem{
+					Label:       "✅ Uncordon",
+					Key:         "8",
+					Description: "kubectl uncordon (allow new pods)",
+					Action:      ActionUncordonNode,
+				})
+			} else {
+				items = append(items, ActionMenuItem{
+					Label:       "🚫 Cordon",
+					Key:         "8",
+					Description: "kubectl cordon (stop scheduling new pods)",
+					Action:      ActionCordonNode,
+				})
+			}
+		}
+	}
+
+	// Copy actions for the other detail views showing a single object
+	if kind, _, _, ok := m.selectedResourceRef(); ok && kind != "Pod" && kind != "Node" {
+		items = append(items, ActionMenuItem{
+			Label:       "📎 Copy Namespace/Name",
+			Key:         "1",
+			Description: "Copy namespace/name",
+			Action:      ActionCopyNamespaceName,
+		})
+		items = append(items, ActionMenuItem{
+			Label:       "📎 Copy YAML",
+			Key:         "2",
+			Description: "Copy kubectl get -o yaml output",
+			Action:      ActionCopyYAML,
+		})
+	}
+
+	// External kubectl for any object shown in a detail view; edit is mutating
+	if _, _, _, ok := m.selectedResourceRef(); ok && m.kubectlEnabled {
+		items = append(items, ActionMenuItem{
+			Label:       "🖥  kubectl describe",
+			Key:         fmt.Sprintf("%d", len(items)+1),
+			Description: "Run kubectl describe in the terminal (q returns)",
+			Action:      ActionKubectlDescribe,
+		})
+		if m.allowMutations {
+			items = append(items, ActionMenuItem{
+				Label:       "✏️  kubectl edit",
+				Key:         fmt.Sprintf("%d", len(items)+1),
+				Description: "Edit the live object in $EDITOR with kubectl edit",
+				Action:      ActionKubectlEdit,
+			})
+		}
+	}
+
+	// Actions for workload detail views
+	if _, _, _, ok := m.selectedWorkloadRef(); ok && m.allowMutations {
+		items = append(items, ActionMenuItem{
+			Label:       "🔄 Rollout Restart",
+			Key:         fmt.Sprintf("%d", len(items)+1),
+			Description: "kubectl rollout restart (replace all pods)",
+			Action:      ActionRolloutRestart,
+		})
 	}
 
 	return items
 }
 
+// selectedWorkloadRef identifies the workload of the current detail view that
+// supports a rollout restart
+func (m *Model) selectedWorkloadRef() (kind, namespace, name string, ok bool) {
+	switch m.currentView {
+	case ViewDeploymentDetail:
+		if m.selectedDeployment != nil {
+			return "Deployment", m.selectedDeployment.Namespace, m.selectedDeployment.Name, true
+		}
+	case ViewStatefulSetDetail:
+		if m.selectedStatefulSet != nil {
+			return "StatefulSet", m.selectedStatefulSet.Namespace, m.selectedStatefulSet.Name, true
+		}
+	case ViewDaemonSetDetail:
+		if m.selectedDaemonSet != nil {
+			return "DaemonSet", m.selectedDaemonSet.Namespace, m.selectedDaemonSet.Name, true
+		}
+	}
+	return "", "", "", false
+}
+
 // renderActionMenu renders the action menu overlay
 func (m *Model) renderActionMenu() string {
 	items := m.getActionMenuItems()
@@ -166,8 +304,8 @@ func (m *Model) renderActionMenu() string {
 	return menuStyle.Render(content)
 }
 
-// executeAction executes the selected action
-func (m *Model) executeAction(action ActionType) tea.Cmd {
+// executeAction executes the selected action on its target, if any
+func (m *Model) executeAction(action ActionType, target string) tea.Cmd {
 	switch action {
 	case ActionViewLogs:
 		if m.selectedPod != nil && len(m.selectedPod.ContainerStates) > 0 {
@@ -228,98 +366,253 @@ func (m *Model) executeAction(action ActionType) tea.Cmd {
 		}
 
 	case ActionGetYAML:
-		// Execute get yaml command asynchronously
-		return func() tea.Msg {
-			var content string
-			var err error
+		// Fetch the live object as YAML asynchronously
+		return m.fetchResourceYAML()
+
+	case ActionCopyName:
+		if _, _, name, ok := m.selectedResourceRef(); ok {
+			return m.copyToClipboard(name, name)
+		}
+
+	case ActionCopyNamespaceName:
+		if _, namespace, name, ok := m.selectedResourceRef(); ok {
+			ref := resourceRefString(namespace, name)
+			return m.copyToClipboard(ref, ref)
+		}
+
+	case ActionCopyYAML:
+		// Fetch the live object as YAML and copy it asynchronously
+		return m.copyResourceYAML()
+
+	case ActionShowEvents:
+		// Switch to events view filtered for this resource
+		m.currentView = ViewEvents
+		m.detailMode = false
+		m.actionMenuMode = false
+		return nil
+
+	case ActionViewNode:
+		if m.selectedPod != nil {
+			return m.viewPodNode(m.selectedPod)
+		}
+
+	case ActionViewPVC:
+		if m.selectedPod != nil {
+			return m.viewPodPVC(m.selectedPod, target)
+		}
+
+	case ActionViewNodePods:
+		if m.selectedNode != nil {
+			m.viewNodePods(m.selectedNode.Name)
+		}
+
+	case ActionDrainPreview:
+		if m.selectedNode != nil {
+			return m.showDrainPreview(m.selectedNode.Name)
+		}
+
+	case ActionKubectlDescribe:
+		return m.runKubectl("describe")
+
+	case ActionKubectlEdit:
+		return m.runKubectl("edit")
 
+	case ActionRolloutRestart:
+		// Mutating actions require explicit confirmation
+		if kind, namespace, name, ok := m.selectedWorkloadRef(); ok && m.allowMutations {
+			m.confirmMode = true
+			m.confirmAction = action
+			m.confirmMessage = fmt.Sprintf("Restart all pods of %s %s/%s?", strings.ToLower(kind), namespace, name)
+		}
+		return nil
+
+	case ActionCordonNode, ActionUncordonNode:
+		// Mutating actions require explicit confirmation
+		if m.allowMutations && m.selectedNode != nil {
+			verb := "Cordon"
+			if action == ActionUncordonNode {
+				verb = "Uncordon"
+			}
+			m.confirmMode = true
+			m.confirmAction = action
+			m.confirmMessage = fmt.Sprintf("%s node %s?", verb, m.selectedNode.Name)
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// viewPodNode opens the detail view of the node a pod is scheduled on
+func (m *Model) viewPodNode(pod *model.PodData) tea.Cmd {
+	// Coming from that node's detail: just go back to it
+	if m.fromNodeDetail && m.selectedNode != nil && m.selectedNode.Name == pod.Node {
+		m.currentView = ViewNodeDetail
+		m.fromNodeDetail = false
+		m.selectedPod = nil
+		m.detailScrollOffset = 0
+		return nil
+	}
+
+	if m.clusterData != nil {
+		for _, node := range m.clusterData.Nodes {
+			if node.Name == pod.Node {
+				m.selectedNode = node
+				m.selectedPod = nil
+				m.currentView = ViewNodeDetail
+				m.detailMode = true
+				m.detailScrollOffset = 0
+				m.nodePodSelectedIndex = -1
+				m.fromJobDetail = false
+				m.fromVolcanoJobDetail = false
+				m.fromDeploymentDetail = false
+				m.fromStatefulSetDetail = false
+				m.fromNodeDetail = false
+				m.fromHeatmap = false
+				return nil
+			}
+		}
+	}
+
+	m.exportMessage = fmt.Sprintf("❌ Node not found: %s", pod.Node)
+	return tea.Tick(time.Second*2, func(time.Time) tea.Msg {
+		return clearExportMessageMsg{}
+	})
+}
+
+// viewNodePods switches to the Pods view filtered to the pods on a node
+func (m *Model) viewNodePods(nodeName string) {
+	m.filterNode = nodeName
+	m.currentView = ViewPods
+	m.detailMode = false
+	m.selectedNode = nil
+	m.nodePodSelectedIndex = -1
+	m.selectedIndex = 0
+	m.scrollOffset = 0
+}
+
+// executeConfirmedAction runs a mutating action after the user confirmed it
+func (m *Model) executeConfirmedAction(action ActionType) tea.Cmd {
+	m.confirmMessage = ""
+	if !m.allowMutations {
+		return nil
+	}
+
+	switch action {
+	case ActionRolloutRestart:
+		return m.rolloutRestart()
+
+	case ActionCordonNode, ActionUncordonNode:
+		if m.selectedNode == nil {
+			return nil
+		}
+		nodeName := m.selectedNode.Name
+		cordon := action == ActionCordonNode
+		return func() tea.Msg {
 			// Try to get the API client through type assertion
 			apiClient, ok := m.dataProvider.(interface {
-				GetPodYAML(ctx context.Context, namespace, podName string) (string, error)
-				GetNodeYAML(ctx context.Context, nodeName string) (string, error)
+				CordonNode(ctx context.Context, nodeName string) error
+				UncordonNode(ctx context.Context, nodeName string) error
 			})
 
 			if !ok {
 				return commandOutputMsg{
-					title: "Error",
-					content: "API client does not support YAML export",
-					err: fmt.Errorf("unsupported operation"),
+					title:   "Error",
+					content: "API client does not support cordon/uncordon",
+					err:     fmt.Errorf("unsupported operation"),
 				}
 			}
 
-			ctx := context.Background()
-			if m.selectedPod != nil {
-				content, err = apiClient.GetPodYAML(ctx, m.selectedPod.Namespace, m.selectedPod.Name)
-			} else if m.selectedNode != nil {
-				content, err = apiClient.GetNodeYAML(ctx, m.selectedNode.Name)
+			ctx, cancel := context.WithTimeout(context.Background(), mutationTimeout)
+			var err error
+			verb := "cordoned"
+			if cordon {
+				err = apiClient.CordonNode(ctx, nodeName)
+			} else {
+				verb = "uncordoned"
+				err = apiClient.UncordonNode(ctx, nodeName)
 			}
+			cancel()
 
 			if err != nil {
 				return commandOutputMsg{
-					title: "Get YAML Error",
+					title:   fmt.Sprintf("Cordon Error: %s", nodeName),
 					content: err.Error(),
-					err: err,
+					err:     err,
 				}
 			}
 
-			var title string
-			if m.selectedPod != nil {
-				title = fmt.Sprintf("YAML: %s/%s", m.selectedPod.Namespace, m.selectedPod.Name)
-			} else if m.selectedNode != nil {
-				title = fmt.Sprintf("YAML: %s", m.selectedNode.Name)
-			}
+			// Refresh so the node list reflects the new scheduling state
+			_ = m.dataProvider.ForceRefresh()
 
 			return commandOutputMsg{
-				title: title,
-				content: content,
+				title:   fmt.Sprintf("Node: %s", nodeName),
+				content: fmt.Sprintf("node/%s %s", nodeName, verb),
 			}
 		}
+	}
 
-	case ActionCopyName:
-		var name string
-		if m.selectedPod != nil {
-			name = m.selectedPod.Name
-		} else if m.selectedNode != nil {
-			name = m.selectedNode.Name
-		}
+	return nil
+}
 
-		if name != "" {
-			// Actually copy to clipboard
-			err := clipboard.WriteAll(name)
-			if err != nil {
-				m.exportMessage = fmt.Sprintf("❌ Copy failed: %v", err)
-			} else {
-				m.exportMessage = fmt.Sprintf("✅ Copied: %s", name)
+// rolloutRestart restarts the workload of the current detail view and reports
+// the result in the command output viewer
+func (m *Model) rolloutRestart() tea.Cmd {
+	kind, namespace, name, ok := m.selectedWorkloadRef()
+	if !ok {
+		return nil
+	}
+
+	return func() tea.Msg {
+		// Try to get the API client through type assertion
+		apiClient, ok := m.dataProvider.(interface {
+			RolloutRestart(ctx context.Context, kind, namespace, name string) error
+		})
+
+		if !ok {
+			return commandOutputMsg{
+				title:   "Error",
+				content: "API client does not support rollout restart",
+				err:     fmt.Errorf("unsupported operation"),
 			}
-			return tea.Tick(time.Second*2, func(time.Time) tea.Msg {
-				return clearExportMessageMsg{}
-			})
 		}
 
-	case ActionCopyNamespaceName:
-		if m.selectedPod != nil {
-			fullName := fmt.Sprintf("%s/%s", m.selectedPod.Namespace, m.selectedPod.Name)
-			// Actually copy to clipboard
-			err := clipboard.WriteAll(fullName)
-			if err != nil {
-				m.exportMessage = fmt.Sprintf("❌ Copy failed: %v", err)
-			} else {
-				m.exportMessage = fmt.Sprintf("✅ Copied: %s", fullName)
+		ref := fmt.Sprintf("%s/%s", strings.ToLower(kind), name)
+		ctx, cancel := context.WithTimeout(context.Background(), mutationTimeout)
+		err := apiClient.RolloutRestart(ctx, kind, namespace, name)
+		cancel()
+		if err != nil {
+			return commandOutputMsg{
+				title:   fmt.Sprintf("Restart Error: %s", ref),
+				content: err.Error(),
+				err:     err,
 			}
-			return tea.Tick(time.Second*2, func(time.Time) tea.Msg {
-				return clearExportMessageMsg{}
-			})
 		}
 
-	case ActionShowEvents:
-		// Switch to events view filtered for this resource
-		m.currentView = ViewEvents
-		m.detailMode = false
-		m.actionMenuMode = false
-		return nil
+		// Refresh so the detail view picks up the rollout progress
+		_ = m.dataProvider.ForceRefresh()
+
+		return commandOutputMsg{
+			title:   fmt.Sprintf("%s: %s/%s", kind, namespace, name),
+			content: fmt.Sprintf("%s restarted", ref),
+		}
 	}
+}
 
-	return nil
+// renderConfirmPrompt renders the confirmation prompt for mutating actions
+func (m *Model) renderConfirmPrompt() string {
+	lines := []string{
+		StyleWarning.Render("⚠️  " + m.confirmMessage),
+		"",
+		StyleTextMuted.Render("  y Confirm • n/ESC Cancel"),
+	}
+
+	promptStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorWarning).
+		Padding(1, 2)
+
+	return promptStyle.Render(strings.Join(lines, "\n"))
 }
 
 // commandOutputMsg is sent when a command output is ready to display
@@ -327,6 +620,7 @@ type commandOutputMsg struct {
 	title   string
 	content string
 	err     error
+	raw     bool // Show content without line numbers, e.g. for copying
 }
 
 // clearExportMessageMsg is sent to clear the export message
@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+)
+
+// uiState is the UI state persisted across restarts
+type uiState struct {
+	MutedAlertTypes []model.AlertType `json:"muted_alert_types,omitempty"`
+	Sorts           map[string]string `json:"sorts,omitempty"` // Last sort chosen per view, e.g. "nodes": "cpu:desc"
+}
+
+// uiStateFile stores uiState as a JSON document
+type uiStateFile struct {
+	mu   sync.Mutex
+	path string
+}
+
+// load reads the state file; a missing file yields an empty state
+func (f *uiStateFile) load() (*uiState, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	state := &uiState{}
+	data, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state file: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to decode state file: %w", err)
+	}
+	return state, nil
+}
+
+// save atomically replaces the state file
+func (f *uiStateFile) save(state *uiState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode state: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return fmt.Errorf("failed to create state directory: %w", err)
+	}
+	tmpPath := f.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+	if err := os.Rename(tmpPath, f.path); err != nil {
+		return fmt.Errorf("failed to replace state file: %w", err)
+	}
+	return nil
+}
+
+// SetStateFile enables persisting UI state (muted alert types, the sort of
+// each view) to path and restores the state saved by a previous run
+func (m *Model) SetStateFile(path string) error {
+	store := &uiStateFile{path: path}
+	m.stateFile = store
+
+	state, err := store.load()
+	if err != nil {
+		return err
+	}
+	for _, alertType := range state.MutedAlertTypes {
+		m.mutedAlertTypes[alertType] = true
+	}
+	m.restoreViewSorts(state.Sorts)
+	return nil
+}
+
+// persistUIState returns a command that writes the current UI state to the state file
+func (m *Model) persistUIState() tea.Cmd {
+	if m.stateFile == nil {
+		return nil
+	}
+
+	state := &uiState{Sorts: m.savedViewSorts()}
+	for alertType := range m.mutedAlertTypes {
+		state.MutedAlertTypes = append(state.MutedAlertTypes, alertType)
+	}
+	sort.Slice(state.MutedAlertTypes, func(i, j int) bool {
+		return state.MutedAlertTypes[i] < state.MutedAlertTypes[j]
+	})
+
+	store := m.stateFile
+	logger := m.logger
+	return func() tea.Msg {
+		if err := store.save(state); err != nil && logger != nil {
+			logger.Warn("Failed to persist UI state", zap.Error(err))
+		}
+		return nil
+	}
+}
+
+// alertKey identifies an alert across refreshes by its type and resource
+func alertKey(alert *model.Alert) string {
+	return fmt.Sprintf("%s|%s/%s/%s", alert.AlertType, alert.ResourceType, alert.Namespace, alert.ResourceName)
+}
+
+// isAlertAcked reports whether an alert was acknowledged or its type is muted
+func (m *Model) isAlertAcked(alert *model.Alert) bool {
+	return m.ackedAlerts[alertKey(alert)] || (alert.AlertType != "" && m.mutedAlertTypes[alert.AlertType])
+}
+
+// getDisplayedAlerts returns the alerts in display order: active alerts by
+// severity (Critical → Warning → Info), followed by acknowledged or muted ones
+func (m *Model) getDisplayedAlerts() (active, acked []model.Alert) {
+	if m.clusterData == nil || m.clusterData.Summary == nil {
+		return nil, nil
+	}
+
+	for _, severity := range []model.AlertSeverity{model.AlertSeverityCritical, model.AlertSeverityWarning, model.AlertSeverityInfo} {
+		for _, alert := range m.clusterData.Summary.Alerts {
+			if alert.Severity != severity {
+				continue
+			}
+			if m.isAlertAcked(&alert) {
+				acked = append(acked, alert)
+			} else {
+				active = append(active, alert)
+			}
+		}
+	}
+	return active, acked
+}
+
+// selectedAlert returns the alert under the cursor in the Alerts view
+func (m *Model) selectedAlert() *model.Alert {
+	active, acked := m.getFilteredAlerts()
+	alerts := append(active, acked...)
+	if m.selectedIndex < 0 || m.selectedIndex >= len(alerts) {
+		return nil
+	}
+	return &alerts[m.selectedIndex]
+}
+
+// toggleAlertAck acknowledges the selected alert, or clears its acknowledgement
+func (m *Model) toggleAlertAck() {
+	alert := m.selectedAlert()
+	if alert == nil {
+		return
+	}
+	key := alertKey(alert)
+	if m.ackedAlerts[key] {
+		delete(m.ackedAlerts, key)
+	} else {
+		m.ackedAlerts[key] = true
+	}
+}
+
+// toggleAlertTypeMute mutes or unmutes every alert of the selected alert's type
+// and persists the muted types
+func (m *Model) toggleAlertTypeMute() tea.Cmd {
+	alert := m.selectedAlert()
+	if alert == nil || alert.AlertType == "" {
+		return nil
+	}
+	if m.mutedAlertTypes[alert.AlertType] {
+		delete(m.mutedAlertTypes, alert.AlertType)
+	} else {
+		m.mutedAlertTypes[alert.AlertType] = true
+	}
+	return m.persistUIState()
+}
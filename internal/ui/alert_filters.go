@@ -0,0 +1,181 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yourusername/k8s-monitor/internal/diagnostic"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// alertFilterField identifies the focused row of the alerts filter panel
+type alertFilterField int
+
+const (
+	alertFilterSeverity alertFilterField = iota
+	alertFilterCategory
+	alertFilterFieldCount
+)
+
+// alertSeverityOptions are the selectable severities ("" = all)
+var alertSeverityOptions = []string{"", "Critical", "Warning", "Info"}
+
+// alertCategoryOptions are the selectable alert categories ("" = all)
+var alertCategoryOptions = []string{"", "Node", "Pod", "Service", "Storage", "Resource"}
+
+// alertSort returns the effective alerts sort. Sort fields of other views fall
+// back to grouping by severity in detection order.
+func (m *Model) alertSort() SortField {
+	if m.sortField == SortByPriority {
+		return SortByPriority
+	}
+	return SortByStatus
+}
+
+// alertFiltersActive reports whether any alerts filter is set
+func (m *Model) alertFiltersActive() bool {
+	return m.filterAlertSeverity != "" || m.filterAlertCategory != ""
+}
+
+// getFilteredAlerts returns the alerts shown in the Alerts view: the displayed
+// alerts narrowed by the severity/category filters and ordered by the active sort
+func (m *Model) getFilteredAlerts() (active, acked []model.Alert) {
+	allActive, allAcked := m.getDisplayedAlerts()
+	active = m.filterAlerts(allActive)
+	acked = m.filterAlerts(allAcked)
+
+	if m.alertSort() == SortByPriority {
+		sortAlertsByPriority(active)
+		sortAlertsByPriority(acked)
+	}
+	return active, acked
+}
+
+// filterAlerts returns the alerts matching the severity and category filters
+func (m *Model) filterAlerts(alerts []model.Alert) []model.Alert {
+	if !m.alertFiltersActive() {
+		return alerts
+	}
+
+	var filtered []model.Alert
+	for _, alert := range alerts {
+		if m.filterAlertSeverity != "" && alert.Severity.String() != m.filterAlertSeverity {
+			continue
+		}
+		if m.filterAlertCategory != "" && alert.Category != m.filterAlertCategory {
+			continue
+		}
+		filtered = append(filtered, alert)
+	}
+	return filtered
+}
+
+// sortAlertsByPriority orders alerts most urgent first, keeping detection
+// order for alerts of equal priority
+func sortAlertsByPriority(alerts []model.Alert) {
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return diagnostic.GetAlertPriority(alerts[i].AlertType, alerts[i].Severity) >
+			diagnostic.GetAlertPriority(alerts[j].AlertType, alerts[j].Severity)
+	})
+}
+
+// openAlertFilter opens the alerts filter panel, or moves focus to the next
+// filter row when already open (closing it after the last row)
+func (m *Model) openAlertFilter() {
+	if !m.filterMode {
+		m.filterMode = true
+		m.alertFilterField = alertFilterSeverity
+		return
+	}
+	m.alertFilterField++
+	if m.alertFilterField >= alertFilterFieldCount {
+		m.filterMode = false
+		m.alertFilterField = alertFilterSeverity
+	}
+}
+
+// handleAlertFilterNavigation cycles the value of the focused alerts filter row
+func (m *Model) handleAlertFilterNavigation(direction int) {
+	// cycle returns the option after moving direction steps with wrap-around
+	cycle := func(options []string, current string) string {
+		idx := 0
+		for i, option := range options {
+			if option == current {
+				idx = i
+			}
+		}
+		idx += direction
+		if idx < 0 {
+			idx = len(options) - 1
+		}
+		if idx >= len(options) {
+			idx = 0
+		}
+		return options[idx]
+	}
+
+	switch m.alertFilterField {
+	case alertFilterSeverity:
+		m.filterAlertSeverity = cycle(alertSeverityOptions, m.filterAlertSeverity)
+	case alertFilterCategory:
+		m.filterAlertCategory = cycle(alertCategoryOptions, m.filterAlertCategory)
+	}
+
+	// Reset scroll and selection when filter changes
+	m.scrollOffset = 0
+	m.selectedIndex = 0
+}
+
+// renderAlertFilterSummary renders the active alerts filters, or "" when none are set
+func (m *Model) renderAlertFilterSummary() string {
+	var parts []string
+	if m.filterAlertSeverity != "" {
+		parts = append(parts, m.TF("alerts.severity_filter", map[string]interface{}{
+			"Severity": m.filterAlertSeverity,
+		}))
+	}
+	if m.filterAlertCategory != "" {
+		parts = append(parts, m.TF("alerts.category_filter", map[string]interface{}{
+			"Category": m.filterAlertCategory,
+		}))
+	}
+	return strings.Join(parts, " ")
+}
+
+// renderAlertFilterPanel renders the alerts filter panel (severity, category)
+func (m *Model) renderAlertFilterPanel() string {
+	var lines []string
+
+	lines = append(lines, StyleHeader.Render(m.T("filter.alerts_title")))
+	lines = append(lines, "")
+
+	orAll := func(value string) string {
+		if value == "" {
+			return m.T("filter.all")
+		}
+		return value
+	}
+
+	rows := []struct {
+		field alertFilterField
+		label string
+		value string
+	}{
+		{alertFilterSeverity, m.T("alerts.severity"), orAll(m.filterAlertSeverity)},
+		{alertFilterCategory, m.T("alerts.category"), orAll(m.filterAlertCategory)},
+	}
+
+	for _, row := range rows {
+		line := fmt.Sprintf("  %s ◀ %s ▶", padRight(row.label, 10), row.value)
+		if row.field == m.alertFilterField {
+			line = StyleSelected.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, StyleTextMuted.Render("  "+m.T("filter.events_hint")))
+
+	return strings.Join(lines, "\n")
+}
@@ -24,10 +24,15 @@ func (m *Model) renderAlerts() string {
 	header := m.renderAlertsHeader(alerts)
 
 	// Alert list
-	alertList := m.renderAlertsList(alerts)
+	active, acked := m.getFilteredAlerts()
+	shown := append(active, acked...)
+	alertList := m.renderAlertsList()
+	if len(shown) == 0 {
+		alertList = StyleTextMuted.Render(m.T("alerts.no_match"))
+	}
 
 	// Footer with stats
-	footer := m.renderAlertsFooter(alerts)
+	footer := m.renderAlertsFooter(shown)
 
 	content := lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -38,6 +43,16 @@ func (m *Model) renderAlerts() string {
 		footer,
 	)
 
+	// Show filter panel if in filter mode
+	if m.filterMode {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			content,
+			"",
+			m.renderAlertFilterPanel(),
+		)
+	}
+
 	return content
 }
 
@@ -115,6 +130,16 @@ func (m *Model) renderAlertsHeader(alerts []model.Alert) string {
 	if info > 0 {
 		summary += fmt.Sprintf(" • %s: %d", StyleTextSecondary.Render(m.T("stats.info")), info)
 	}
+	if _, acked := m.getDisplayedAlerts(); len(acked) > 0 {
+		summary += fmt.Sprintf(" • %s: %d", StyleTextMuted.Render(m.T("alerts.acknowledged")), len(acked))
+	}
+
+	// Add sort indicator
+	sortInfo := m.T("alerts.severity")
+	if m.alertSort() == SortByPriority {
+		sortInfo = m.T("alerts.priority")
+	}
+	summary += fmt.Sprintf(" • %s: %s", m.T("common.sort"), sortInfo)
 
 	return lipgloss.JoinHorizontal(
 		lipgloss.Top,
@@ -124,13 +149,20 @@ func (m *Model) renderAlertsHeader(alerts []model.Alert) string {
 	)
 }
 
+// alertSection is a titled group of rows in the Alerts view
+type alertSection struct {
+	title  string
+	alerts []model.Alert
+}
+
 // renderAlertsList renders the list of alerts
-func (m *Model) renderAlertsList(alerts []model.Alert) string {
+func (m *Model) renderAlertsList() string {
 	var rows []string
 
-	// Group alerts by severity FIRST (before slicing)
+	// Group active alerts by severity; acknowledged and muted alerts go last
+	active, acked := m.getFilteredAlerts()
 	var critical, warning, info []model.Alert
-	for _, alert := range alerts {
+	for _, alert := range active {
 		switch alert.Severity {
 		case model.AlertSeverityCritical:
 			critical = append(critical, alert)
@@ -140,12 +172,22 @@ func (m *Model) renderAlertsList(alerts []model.Alert) string {
 			info = append(info, alert)
 		}
 	}
+	sections := []alertSection{
+		{StyleDanger.Bold(true).Render(m.T("stats.critical")), critical},
+		{StyleWarning.Bold(true).Render(m.T("stats.warning")), warning},
+		{StyleTextSecondary.Bold(true).Render(m.T("stats.info")), info},
+		{StyleTextMuted.Bold(true).Render(m.T("alerts.acknowledged")), acked},
+	}
 
-	// Flatten into display order: Critical → Warning → Info
-	flatAlerts := make([]model.Alert, 0, len(alerts))
-	flatAlerts = append(flatAlerts, critical...)
-	flatAlerts = append(flatAlerts, warning...)
-	flatAlerts = append(flatAlerts, info...)
+	// Flatten into display order, remembering each alert's section
+	var flatAlerts []model.Alert
+	var sectionOf []int
+	for i, section := range sections {
+		for _, alert := range section.alerts {
+			flatAlerts = append(flatAlerts, alert)
+			sectionOf = append(sectionOf, i)
+		}
+	}
 
 	// Calculate visible range based on scroll
 	maxVisible := m.height - 12
@@ -176,49 +218,30 @@ func (m *Model) renderAlertsList(alerts []model.Alert) string {
 		endIdx = totalAlerts
 	}
 
-	visibleAlerts := flatAlerts[startIdx:endIdx]
-
-	// Render grouped sections (Critical → Warning → Info)
-	criticalCount := 0
-	warningCount := 0
-	infoCount := 0
-
-	for i, alert := range visibleAlerts {
-		absoluteIdx := startIdx + i
+	// Render grouped sections (Critical → Warning → Info → Acknowledged)
+	currentSection := -1
+	for absoluteIdx := startIdx; absoluteIdx < endIdx; absoluteIdx++ {
+		alert := flatAlerts[absoluteIdx]
 
-		// Section header when entering a new severity group
-		if absoluteIdx < len(critical) && criticalCount == 0 {
-			rows = append(rows, StyleDanger.Bold(true).Render(m.T("stats.critical")))
-			rows = append(rows, "")
-		} else if absoluteIdx >= len(critical) && absoluteIdx < len(critical)+len(warning) && warningCount == 0 {
-			if criticalCount > 0 {
-				rows = append(rows, "")
-			}
-			rows = append(rows, StyleWarning.Bold(true).Render(m.T("stats.warning")))
-			rows = append(rows, "")
-		} else if absoluteIdx >= len(critical)+len(warning) && infoCount == 0 {
-			if criticalCount > 0 || warningCount > 0 {
+		// Section header when entering a new group
+		if sectionOf[absoluteIdx] != currentSection {
+			if currentSection >= 0 {
 				rows = append(rows, "")
 			}
-			rows = append(rows, StyleTextSecondary.Bold(true).Render(m.T("stats.info")))
+			currentSection = sectionOf[absoluteIdx]
+			rows = append(rows, sections[currentSection].title)
 			rows = append(rows, "")
 		}
 
-		// Render alert row
+		// Render alert row; acknowledged alerts are dimmed
 		row := m.renderAlertRow(alert)
+		if m.isAlertAcked(&alert) {
+			row = StyleTextMuted.Render(stripANSI(row))
+		}
 		if absoluteIdx == m.selectedIndex {
 			row = StyleSelected.Render(row)
 		}
 		rows = append(rows, row)
-
-		// Count alerts in each section
-		if absoluteIdx < len(critical) {
-			criticalCount++
-		} else if absoluteIdx < len(critical)+len(warning) {
-			warningCount++
-		} else {
-			infoCount++
-		}
 	}
 
 	return strings.Join(rows, "\n")
@@ -292,6 +315,9 @@ func (m *Model) renderAlertsFooter(alerts []model.Alert) string {
 	if len(categoryStats) > 0 {
 		stats += " • " + strings.Join(categoryStats, " • ")
 	}
+	if filters := m.renderAlertFilterSummary(); filters != "" {
+		stats += " " + StyleHighlight.Render(filters)
+	}
 
 	// Add scroll position indicator if there are more items than visible
 	maxVisible := m.height - 12
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// annotationPreviewCount is the number of annotations listed while the
+// Annotations section is collapsed
+const annotationPreviewCount = 5
+
+// defaultHiddenAnnotations are the annotations hidden until expanded with o
+var defaultHiddenAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+}
+
+// SetHiddenAnnotations sets the annotation keys, or glob patterns such as
+// "kubernetes.io/config.*", hidden from detail views until expanded with o
+func (m *Model) SetHiddenAnnotations(patterns []string) {
+	m.hiddenAnnotations = patterns
+}
+
+// isHiddenAnnotation reports whether an annotation is hidden as noisy
+func (m *Model) isHiddenAnnotation(key string) bool {
+	for _, pattern := range m.hiddenAnnotations {
+		if pattern == key {
+			return true
+		}
+		if matched, err := path.Match(pattern, key); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// renderAnnotations renders the Annotations section of a detail view sorted
+// by key. Collapsed, it lists the first annotations that are not hidden; o
+// expands it to all annotations. Values are cut to a single line.
+func (m *Model) renderAnnotations(annotations map[string]string) []string {
+	var visible []string
+	hidden := 0
+	for k := range annotations {
+		if !m.expandAnnotations && m.isHiddenAnnotation(k) {
+			hidden++
+			continue
+		}
+		visible = append(visible, k)
+	}
+	sort.Strings(visible)
+
+	lines := []string{StyleHeader.Render(m.TF("detail.annotations", map[string]interface{}{
+		"Count": len(annotations),
+	})), ""}
+	if len(annotations) == 0 {
+		return append(lines, StyleTextMuted.Render("  "+m.T("detail.no_annotations")))
+	}
+
+	collapsed := 0
+	if !m.expandAnnotations && len(visible) > annotationPreviewCount {
+		collapsed = len(visible) - annotationPreviewCount
+		visible = visible[:annotationPreviewCount]
+	}
+
+	maxLineWidth := m.width - 4
+	if maxLineWidth < 40 {
+		maxLineWidth = 40
+	}
+	for _, k := range visible {
+		// Multi-line values such as JSON documents are flattened to one line
+		value := strings.Join(strings.Fields(annotations[k]), " ")
+		line := fmt.Sprintf("%s=%s", StyleTextSecondary.Render(k), value)
+		lines = append(lines, "  "+truncate(line, maxLineWidth))
+	}
+
+	if collapsed > 0 || hidden > 0 {
+		lines = append(lines, StyleTextMuted.Render("  "+m.TF("detail.annotations_collapsed", map[string]interface{}{
+			"Count": collapsed + hidden,
+		})))
+	} else if m.expandAnnotations && len(annotations) > annotationPreviewCount {
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("detail.annotations_expanded")))
+	}
+	return lines
+}
+
+// hasAnnotationsSection reports whether the current detail view shows an
+// Annotations section
+func (m *Model) hasAnnotationsSection() bool {
+	return m.currentView == ViewPodDetail || m.currentView == ViewNodeDetail || m.currentView == ViewServiceDetail
+}
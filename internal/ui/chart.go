@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// RenderLineChart renders a multi-line ASCII time-series chart.
+// data: values oldest to newest; width/height: plot area in characters;
+// minY/maxY: Y-axis range; yFormat: formats Y-axis labels.
+// Returns the chart rows including the Y axis (without X-axis labels) and the
+// width of the Y-axis labels, so callers can align an X-axis under the plot.
+func RenderLineChart(data []float64, width, height int, minY, maxY float64, yFormat func(float64) string) ([]string, int) {
+	if width < 2 {
+		width = 2
+	}
+	if height < 2 {
+		height = 2
+	}
+	if maxY <= minY {
+		maxY = minY + 1
+	}
+
+	// Fit data to plot width: average when longer, stretch when shorter
+	points := make([]float64, width)
+	if len(data) >= width {
+		copy(points, downsampleSeries(data, width))
+	} else {
+		for col := 0; col < width; col++ {
+			idx := 0
+			if len(data) > 1 {
+				idx = col * (len(data) - 1) / (width - 1)
+			}
+			points[col] = data[idx]
+		}
+	}
+
+	// Map each point to a row (0 = bottom)
+	rowOf := func(v float64) int {
+		normalized := (v - minY) / (maxY - minY)
+		row := int(math.Round(normalized * float64(height-1)))
+		if row < 0 {
+			row = 0
+		}
+		if row >= height {
+			row = height - 1
+		}
+		return row
+	}
+
+	// Plot points, connecting consecutive points with vertical segments
+	grid := make([][]rune, height)
+	for r := range grid {
+		grid[r] = []rune(strings.Repeat(" ", width))
+	}
+	prevRow := -1
+	for col, v := range points {
+		row := rowOf(v)
+		if prevRow >= 0 && prevRow != row {
+			lo, hi := prevRow, row
+			if lo > hi {
+				lo, hi = hi, lo
+			}
+			for r := lo + 1; r < hi; r++ {
+				grid[r][col] = '│'
+			}
+		}
+		grid[row][col] = '•'
+		prevRow = row
+	}
+
+	// Y-axis labels on bottom, middle and top rows
+	labels := make(map[int]string)
+	labels[0] = yFormat(minY)
+	mid := (height - 1) / 2
+	labels[mid] = yFormat(minY + (maxY-minY)*float64(mid)/float64(height-1))
+	labels[height-1] = yFormat(maxY)
+	labelWidth := 0
+	for _, label := range labels {
+		if len(label) > labelWidth {
+			labelWidth = len(label)
+		}
+	}
+
+	var lines []string
+	for r := height - 1; r >= 0; r-- {
+		label := strings.Repeat(" ", labelWidth)
+		axis := "│"
+		if l, ok := labels[r]; ok {
+			label = fmt.Sprintf("%*s", labelWidth, l)
+			axis = "┤"
+		}
+		lines = append(lines, StyleTextMuted.Render(label+" "+axis)+StyleHighlight.Render(string(grid[r])))
+	}
+	lines = append(lines, StyleTextMuted.Render(strings.Repeat(" ", labelWidth)+" └"+strings.Repeat("─", width)))
+
+	return lines, labelWidth
+}
+
+// renderChartTimeAxis renders X-axis labels spanning the plot width:
+// elapsed time at the left edge and nowLabel at the right edge
+func renderChartTimeAxis(span time.Duration, width, labelWidth int, nowLabel string) string {
+	left := "-" + span.Round(time.Second).String()
+	gap := width - len(left) - len(nowLabel)
+	if gap < 1 {
+		gap = 1
+	}
+	return StyleTextMuted.Render(strings.Repeat(" ", labelWidth+2) + left + strings.Repeat(" ", gap) + nowLabel)
+}
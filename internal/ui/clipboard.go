@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+	"go.uber.org/zap"
+)
+
+// clipboardCopiedMsg is sent once text has been copied to the clipboard
+type clipboardCopiedMsg struct {
+	label string // What was copied, shown in the status message
+}
+
+// copyToClipboard returns a command copying text to the system clipboard.
+// Without a usable clipboard (e.g. over SSH or without xclip/xsel) the text is
+// shown in the command output viewer instead, so it can be selected from the
+// terminal.
+func (m *Model) copyToClipboard(text, label string) tea.Cmd {
+	return func() tea.Msg {
+		return m.writeClipboard(text, label)
+	}
+}
+
+// writeClipboard copies text to the system clipboard, falling back to the
+// command output viewer when no clipboard is available
+func (m *Model) writeClipboard(text, label string) tea.Msg {
+	if err := clipboard.WriteAll(text); err != nil {
+		m.logger.Debug("Clipboard unavailable", zap.Error(err))
+		return commandOutputMsg{
+			title:   fmt.Sprintf("Clipboard unavailable - select %s below to copy it", label),
+			content: text,
+			raw:     true,
+		}
+	}
+	return clipboardCopiedMsg{label: label}
+}
+
+// resourceRefString formats an object reference as namespace/name, or just
+// the name for cluster-scoped objects
+func resourceRefString(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
@@ -0,0 +1,295 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// listColumn describes a column of a list table
+type listColumn struct {
+	id     string // Config identifier, e.g. "namespace"
+	header string // i18n key of the header
+	width  int
+	fit    bool // Sized to its widest cell by fitColumns
+}
+
+// podListColumns are the available columns of the Pods view
+var podListColumns = []listColumn{
+	{"name", "columns.name", 26, true},
+	{"namespace", "columns.namespace", 14, true},
+	{"status", "columns.status", 16, false},
+	{"node", "columns.node", podNodeColumnWidth, true},
+	{"ip", "columns.pod_ip", podIPColumnWidth, false},
+	{"qos", "columns.qos", 10, false},
+	{"cpu", "columns.cpu", 12, false},       // Trend indicator
+	{"memory", "columns.memory", 15, false}, // Trend indicator
+	{"rx", "columns.rx", 11, false},         // Network RX
+	{"tx", "columns.tx", 11, false},         // Network TX
+	{"restarts", "columns.restarts", 8, false},
+	{"age", "columns.age", 8, false},
+}
+
+// nodeListColumns are the available columns of the Nodes view
+var nodeListColumns = []listColumn{
+	{"name", "columns.name", 30, true},
+	{"status", "columns.status", 17, false}, // Wide enough for the flapping marker
+	{"roles", "columns.roles", 15, true},
+	{"cpu", "columns.cpu", 18, false},       // Wide enough for the trend indicator
+	{"memory", "columns.memory", 23, false}, // Wide enough for the trend indicator
+	{"npu", "columns.npu", 12, false},
+	{"rx", "columns.rx", 11, false}, // Network RX bandwidth
+	{"tx", "columns.tx", 11, false}, // Network TX bandwidth
+	{"pods", "columns.pods", 15, false},
+	{"version", "columns.version", 14, true}, // Kubelet version
+	{"ip", "columns.ip", 15, false},
+	{"age", "columns.age", 8, false},
+}
+
+// deploymentListColumns are the available columns of the Deployments section
+var deploymentListColumns = []listColumn{
+	{"name", "columns.name", 35, true},
+	{"namespace", "columns.namespace", 15, true},
+	{"ready", "columns.ready", 15, false},
+	{"up_to_date", "columns.up_to_date", 12, false},
+	{"available", "columns.available", 12, false},
+	{"rollout", "columns.rollout", 18, false},
+	{"age", "columns.age", 8, false},
+}
+
+// statefulSetListColumns are the columns of the StatefulSets section
+var statefulSetListColumns = []listColumn{
+	{"name", "columns.name", 35, true},
+	{"namespace", "columns.namespace", 15, true},
+	{"ready", "columns.ready", 15, false},
+	{"current", "columns.current", 12, false},
+	{"updated", "columns.updated", 12, false},
+	{"rollout", "columns.rollout", 18, false},
+}
+
+// daemonSetListColumns are the columns of the DaemonSets section
+var daemonSetListColumns = []listColumn{
+	{"name", "columns.name", 35, true},
+	{"namespace", "columns.namespace", 15, true},
+	{"desired", "columns.desired", 12, false},
+	{"current", "columns.current", 12, false},
+	{"ready", "columns.ready", 12, false},
+	{"available", "columns.available", 12, false},
+	{"health", "columns.health", 16, false},
+}
+
+// cronJobListColumns are the columns of the CronJobs section
+var cronJobListColumns = []listColumn{
+	{"name", "columns.name", 30, true},
+	{"namespace", "columns.namespace", 15, true},
+	{"schedule", "columns.schedule", 20, true},
+	{"suspend", "columns.suspend", 10, false},
+	{"active", "columns.active", 10, false},
+	{"last_schedule", "columns.last_schedule", 20, false},
+	{"next_schedule", "columns.next_schedule", 20, false},
+}
+
+// listColumnSets maps the views of the ui.columns config to their columns
+var listColumnSets = map[string][]listColumn{
+	"pods":        podListColumns,
+	"nodes":       nodeListColumns,
+	"deployments": deploymentListColumns,
+}
+
+// defaultListColumns are the columns shown when a view is not configured
+var defaultListColumns = map[string][]string{
+	"pods":        {"name", "namespace", "status", "node", "ip", "qos", "cpu", "memory", "rx", "tx", "restarts"},
+	"nodes":       {"name", "status", "roles", "cpu", "memory", "npu", "rx", "tx", "pods", "version"},
+	"deployments": {"name", "namespace", "ready", "up_to_date", "available", "rollout"},
+}
+
+// SetColumns sets the visible columns of list views, in display order, e.g.
+// {"pods": ["name", "status", "cpu"]}. Views missing from the map keep their
+// default columns.
+func (m *Model) SetColumns(columns map[string][]string) error {
+	configured := make(map[string][]string)
+	for view, ids := range columns {
+		available, ok := listColumnSets[view]
+		if !ok {
+			return fmt.Errorf("unknown view %q (want one of %s)", view, strings.Join(listColumnViews(), ", "))
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, id := range ids {
+			if _, ok := findListColumn(available, id); !ok {
+				return fmt.Errorf("unknown %s column %q (want one of %s)", view, id, listColumnIDs(available))
+			}
+			if seen[id] {
+				return fmt.Errorf("duplicate %s column %q", view, id)
+			}
+			seen[id] = true
+		}
+		configured[view] = ids
+	}
+	m.listColumns = configured
+	return nil
+}
+
+// listColumnViews returns the configurable views in alphabetical order
+func listColumnViews() []string {
+	views := make([]string, 0, len(listColumnSets))
+	for view := range listColumnSets {
+		views = append(views, view)
+	}
+	sort.Strings(views)
+	return views
+}
+
+// listColumnIDs returns the comma-separated identifiers of columns
+func listColumnIDs(columns []listColumn) string {
+	ids := make([]string, len(columns))
+	for i, column := range columns {
+		ids[i] = column.id
+	}
+	return strings.Join(ids, ", ")
+}
+
+// findListColumn looks up a column by its identifier
+func findListColumn(columns []listColumn, id string) (listColumn, bool) {
+	for _, column := range columns {
+		if column.id == id {
+			return column, true
+		}
+	}
+	return listColumn{}, false
+}
+
+// visibleColumns returns the columns of a view in display order. configured
+// is false when the view uses its default columns.
+func (m *Model) visibleColumns(view string) (columns []listColumn, configured bool) {
+	ids, configured := m.listColumns[view]
+	if !configured {
+		ids = defaultListColumns[view]
+	}
+	for _, id := range ids {
+		column, _ := findListColumn(listColumnSets[view], id)
+		if column.id == "age" {
+			column.width = m.ageColumnWidth(column.width)
+		}
+		columns = append(columns, column)
+	}
+	return columns, configured
+}
+
+// withoutColumns returns columns minus those with the given identifiers
+func withoutColumns(columns []listColumn, ids ...string) []listColumn {
+	var kept []listColumn
+	for _, column := range columns {
+		hidden := false
+		for _, id := range ids {
+			if column.id == id {
+				hidden = true
+			}
+		}
+		if !hidden {
+			kept = append(kept, column)
+		}
+	}
+	return kept
+}
+
+// indentColumns returns a copy of columns whose first column is narrowed by n,
+// for rows indented by n characters
+func indentColumns(columns []listColumn, n int) []listColumn {
+	indented := append([]listColumn(nil), columns...)
+	if len(indented) > 0 {
+		indented[0].width -= n
+	}
+	return indented
+}
+
+// columnsWidth returns the width of a row of columns including separators
+func columnsWidth(columns []listColumn) int {
+	width := 0
+	for _, column := range columns {
+		width += column.width
+	}
+	if len(columns) > 1 {
+		width += 2 * (len(columns) - 1)
+	}
+	return width
+}
+
+// renderColumns renders one table row, padding each cell to its column width
+func renderColumns(columns []listColumn, cell func(column listColumn) string) string {
+	cells := make([]string, len(columns))
+	for i, column := range columns {
+		cells[i] = padRight(cell(column), column.width)
+	}
+	return strings.Join(cells, "  ")
+}
+
+// renderColumnsHeader renders the translated header row of columns
+func (m *Model) renderColumnsHeader(columns []listColumn) string {
+	return renderColumns(columns, func(column listColumn) string {
+		return m.T(column.header)
+	})
+}
+
+// minFitColumnWidth is the narrowest a content-sized column shrinks to when a
+// table overflows the terminal
+const minFitColumnWidth = 8
+
+// unboundedColumnWidth lets fitColumns measure cells without truncation
+const unboundedColumnWidth = 1 << 16
+
+// fitColumns adapts a table of count rows to the available width: columns
+// marked fit are sized to their widest cell (cell renders the cell of row i),
+// leftover width goes to the name column, and when the table overflows the
+// widest content-sized columns are narrowed first. Columns are returned
+// unchanged while the terminal size is unknown.
+func (m *Model) fitColumns(columns []listColumn, available, count int, cell func(i int, column listColumn) string) []listColumn {
+	if available <= 0 {
+		return columns
+	}
+
+	fitted := append([]listColumn(nil), columns...)
+	minWidths := make([]int, len(fitted))
+	for c, column := range fitted {
+		if !column.fit {
+			continue
+		}
+		headerWidth := visualLength(m.T(column.header))
+		minWidths[c] = max(minFitColumnWidth, headerWidth)
+
+		measured := column
+		measured.width = unboundedColumnWidth
+		width := headerWidth
+		for i := 0; i < count; i++ {
+			width = max(width, visualLength(cell(i, measured)))
+		}
+		fitted[c].width = width
+	}
+
+	total := columnsWidth(fitted)
+	if total < available {
+		for c := range fitted {
+			if fitted[c].id == "name" {
+				fitted[c].width += available - total
+				break
+			}
+		}
+		return fitted
+	}
+
+	for ; total > available; total-- {
+		widest := -1
+		for c := range fitted {
+			if fitted[c].fit && fitted[c].width > minWidths[c] && (widest < 0 || fitted[c].width > fitted[widest].width) {
+				widest = c
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		fitted[widest].width--
+	}
+	return fitted
+}
@@ -52,6 +52,10 @@ func (m *Model) renderCommandOutput() string {
 
 	// Render lines with line numbers
 	for i, line := range visibleLines {
+		if m.commandOutputRaw {
+			sections = append(sections, line)
+			continue
+		}
 		lineNum := startIdx + i + 1
 		lineNumStr := StyleTextMuted.Render(fmt.Sprintf("%4d│ ", lineNum))
 		sections = append(sections, lineNumStr+line)
@@ -0,0 +1,221 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// contextSwitcher is implemented by data providers that can switch kubeconfig context
+type contextSwitcher interface {
+	ListContexts() ([]string, string, error)
+	SwitchContext(kubeContext string) error
+}
+
+// contextListMsg carries the contexts parsed from the kubeconfig
+type contextListMsg struct {
+	contexts []string
+	current  string
+	err      error
+}
+
+// contextSwitchedMsg is sent once the data provider has switched context
+type contextSwitchedMsg struct {
+	context string
+	err     error
+}
+
+// SetActiveContext sets the kubeconfig context shown in the header
+func (m *Model) SetActiveContext(kubeContext string) {
+	m.activeContext = kubeContext
+}
+
+// openContextSwitcher loads the kubeconfig contexts for the switcher overlay
+func (m *Model) openContextSwitcher() tea.Cmd {
+	switcher, ok := m.dataProvider.(contextSwitcher)
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		contexts, current, err := switcher.ListContexts()
+		return contextListMsg{contexts: contexts, current: current, err: err}
+	}
+}
+
+// switchContext asks the data provider to rebuild its sources for kubeContext
+func (m *Model) switchContext(kubeContext string) tea.Cmd {
+	switcher, ok := m.dataProvider.(contextSwitcher)
+	if !ok {
+		return nil
+	}
+	m.switchingContext = kubeContext
+	return func() tea.Msg {
+		return contextSwitchedMsg{context: kubeContext, err: switcher.SwitchContext(kubeContext)}
+	}
+}
+
+// handleContextListMsg opens the switcher with the current context preselected
+func (m *Model) handleContextListMsg(msg contextListMsg) {
+	if msg.err != nil {
+		m.err = fmt.Errorf("failed to list contexts: %w", msg.err)
+		return
+	}
+	m.contextList = msg.contexts
+	m.contextSelectedIndex = 0
+	for i, name := range msg.contexts {
+		if name == msg.current {
+			m.contextSelectedIndex = i
+			break
+		}
+	}
+	m.contextSwitcherMode = true
+}
+
+// handleContextSwitchedMsg resets cluster-specific state after a context switch
+func (m *Model) handleContextSwitchedMsg(msg contextSwitchedMsg) tea.Cmd {
+	m.switchingContext = ""
+	if msg.err != nil {
+		m.err = msg.err
+		return nil
+	}
+
+	m.activeContext = msg.context
+	m.resetClusterState()
+	return m.fetchData()
+}
+
+// handleContextSwitcherKey handles keys while the context switcher is visible
+func (m *Model) handleContextSwitcherKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Up):
+		if m.contextSelectedIndex > 0 {
+			m.contextSelectedIndex--
+		}
+	case key.Matches(msg, m.keys.Down):
+		if m.contextSelectedIndex < len(m.contextList)-1 {
+			m.contextSelectedIndex++
+		}
+	case key.Matches(msg, m.keys.Enter):
+		m.contextSwitcherMode = false
+		if m.contextSelectedIndex < len(m.contextList) {
+			selected := m.contextList[m.contextSelectedIndex]
+			if selected != m.activeContext {
+				return m, m.switchContext(selected)
+			}
+		}
+	case key.Matches(msg, m.keys.Back), key.Matches(msg, m.keys.SwitchContext):
+		m.contextSwitcherMode = false
+	case msg.String() == "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// resetClusterState clears all data and view state tied to the previous cluster
+func (m *Model) resetClusterState() {
+	m.clusterData = nil
+	m.err = nil
+	m.refreshCounter = 0
+	m.prevSummary = nil
+	m.summaryDelta = nil
+
+	// Leave detail, logs and overlay modes since selections no longer exist
+	if m.detailMode {
+		m.currentView = ViewOverview
+	}
+	m.detailMode = false
+	m.cancelLogsFetch()
+	m.logsMode = false
+	m.logsSearchMode = false
+	m.commandOutputMode = false
+	m.actionMenuMode = false
+	m.filterMode = false
+	m.searchMode = false
+	m.paletteMode = false
+	m.focusMode = false
+	m.focusSeq++
+
+	m.selectedNode = nil
+	m.selectedPod = nil
+	m.selectedEvent = nil
+	m.selectedJob = nil
+	m.selectedService = nil
+	m.selectedDeployment = nil
+	m.selectedStatefulSet = nil
+	m.selectedDaemonSet = nil
+	m.selectedCronJob = nil
+	m.selectedPV = nil
+	m.selectedPVC = nil
+	m.selectedVolcanoJob = nil
+	m.selectedQueue = nil
+	m.selectedSuperPod = nil
+	m.selectedIndex = 0
+	m.scrollOffset = 0
+	m.detailScrollOffset = 0
+	m.nodePodSelectedIndex = -1
+	m.fromJobDetail = false
+	m.fromVolcanoJobDetail = false
+	m.fromDeploymentDetail = false
+	m.fromStatefulSetDetail = false
+	m.fromNodeDetail = false
+	m.fromHeatmap = false
+	m.fromPodDetail = false
+	m.comparedNodes = nil
+
+	// Namespaces and names differ between clusters
+	m.filterNamespace = ""
+	m.filterNode = ""
+	m.filterStatus = ""
+	m.filterRole = ""
+	m.filterEventType = ""
+	m.filterEventKind = ""
+	m.filterEventSince = 0
+	m.filterAlertSeverity = ""
+	m.filterAlertCategory = ""
+	m.searchText = ""
+	m.expandedPodGroups = make(map[string]bool)
+	m.ackedAlerts = make(map[string]bool)
+
+	m.cachedSortedNodes = nil
+	m.cachedSortedPods = nil
+	m.cachedPodRows = nil
+	m.cachedSortedEvents = nil
+
+	m.resetMetricHistory()
+}
+
+// renderContextSwitcher renders the context switcher overlay
+func (m *Model) renderContextSwitcher() string {
+	var lines []string
+	lines = append(lines, StyleHeader.Render("☸ "+m.T("context.title")))
+	lines = append(lines, "")
+
+	if len(m.contextList) == 0 {
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("context.none")))
+	}
+	for i, name := range m.contextList {
+		marker := "  "
+		if name == m.activeContext {
+			marker = "● "
+		}
+		if i == m.contextSelectedIndex {
+			lines = append(lines, StyleSelected.Render(fmt.Sprintf("  %s%s", marker, name)))
+		} else {
+			lines = append(lines, fmt.Sprintf("  %s%s", StyleStatusReady.Render(marker), name))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, StyleTextMuted.Render("  ↑/↓ Navigate • Enter Switch • ESC Cancel"))
+
+	switcherStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorPrimary).
+		Padding(1, 2)
+
+	return switcherStyle.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// crdPanelRows limits the CRDs listed in the overview CRD summary
+const crdPanelRows = 15
+
+// sortedCRDs returns the CRDs for the overview summary: counted ones first,
+// most instances first, then the others by group and kind
+func (m *Model) sortedCRDs() []*model.CRDData {
+	crds := make([]*model.CRDData, len(m.clusterData.CRDs))
+	copy(crds, m.clusterData.CRDs)
+	sort.SliceStable(crds, func(i, j int) bool {
+		if crds[i].Counted != crds[j].Counted {
+			return crds[i].Counted
+		}
+		return crds[i].Instances > crds[j].Instances
+	})
+	return crds
+}
+
+// renderCRDSummary renders the CRD summary panel of the overview: kind,
+// group/version, scope and instance count of each CRD, or "" when CRDs are
+// not fetched
+func (m *Model) renderCRDSummary() string {
+	if m.clusterData == nil || len(m.clusterData.CRDs) == 0 {
+		return ""
+	}
+
+	const (
+		colKind  = 28
+		colGroup = 44
+		colScope = 12
+		colCount = 10
+	)
+	crds := m.sortedCRDs()
+	lines := []string{
+		StyleHeader.Render("🧩 " + m.TF("overview.crds.title", map[string]interface{}{"Count": len(crds)})),
+		"",
+		StyleTextMuted.Render(fmt.Sprintf("  %s %s %s %s",
+			padRight(m.T("columns.kind"), colKind),
+			padRight(m.T("overview.crds.group_version"), colGroup),
+			padRight(m.T("overview.crds.scope"), colScope),
+			padRight(m.T("overview.crds.instances"), colCount))),
+	}
+	for i, crd := range crds {
+		if i == crdPanelRows {
+			lines = append(lines, StyleTextMuted.Render("  "+m.TF("overview.crds.more", map[string]interface{}{
+				"Count": len(crds) - crdPanelRows,
+			})))
+			break
+		}
+		instances := StyleTextMuted.Render("-")
+		switch {
+		case crd.CountError != "":
+			instances = StyleWarning.Render("?")
+		case crd.Counted:
+			instances = StyleHighlight.Render(fmt.Sprintf("%d", crd.Instances))
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s %s %s",
+			padRight(truncate(crd.Kind, colKind), colKind),
+			padRight(truncate(crd.Group+"/"+crd.Version, colGroup), colGroup),
+			padRight(crd.Scope, colScope),
+			instances))
+	}
+	return StyleBorder.Width(115).Render(strings.Join(lines, "\n"))
+}
@@ -49,6 +49,7 @@ func (m *Model) renderCronJobDetail() string {
 	}
 
 	// Clamp scroll offset
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
@@ -97,10 +98,9 @@ func (m *Model) renderCronJobBasicInfo(cj *model.CronJobData) string {
 		cj.Namespace))
 
 	// Age
-	age := time.Since(cj.CreationTimestamp)
 	info = append(info, fmt.Sprintf("  %s: %s",
 		StyleTextSecondary.Render("Age"),
-		formatAge(age)))
+		m.formatAgeOrTime(cj.CreationTimestamp)))
 
 	return strings.Join(info, "\n")
 }
@@ -128,6 +128,32 @@ func (m *Model) renderCronJobSchedule(cj *model.CronJobData) string {
 		StyleTextSecondary.Render("Suspend"),
 		suspendStr))
 
+	// Time zone
+	timeZone := StyleTextMuted.Render("UTC (controller default)")
+	if cj.TimeZone != "" {
+		timeZone = cj.TimeZone
+	}
+	info = append(info, fmt.Sprintf("  %s: %s",
+		StyleTextSecondary.Render("Time Zone"),
+		timeZone))
+
+	// Next schedule time
+	var nextStr string
+	next, err := cronJobNextRun(cj, time.Now())
+	switch {
+	case cj.Suspend:
+		nextStr = StyleWarning.Render("Suspended") + " " + StyleTextMuted.Render("(no runs until resumed)")
+	case err != nil:
+		nextStr = StyleDanger.Render("Invalid schedule") + " " + StyleTextMuted.Render("("+err.Error()+")")
+	default:
+		nextStr = fmt.Sprintf("%s %s",
+			next.Local().Format("2006-01-02 15:04:05"),
+			StyleTextMuted.Render("(in "+formatDuration(time.Until(next))+")"))
+	}
+	info = append(info, fmt.Sprintf("  %s: %s",
+		StyleTextSecondary.Render("Next Schedule Time"),
+		nextStr))
+
 	return strings.Join(info, "\n")
 }
 
@@ -151,8 +177,11 @@ func (m *Model) renderCronJobStatus(cj *model.CronJobData) string {
 
 	// Last schedule time
 	if !cj.LastScheduleTime.IsZero() {
-		lastSchedule := formatAge(time.Since(cj.LastScheduleTime))
-		info = append(info, fmt.Sprintf("  %s: %s ago",
+		lastSchedule := m.formatAgeOrTime(cj.LastScheduleTime)
+		if m.timeFormat == timeFormatRelative {
+			lastSchedule += " ago"
+		}
+		info = append(info, fmt.Sprintf("  %s: %s",
 			StyleTextSecondary.Render("Last Schedule Time"),
 			lastSchedule))
 	} else {
@@ -223,9 +252,9 @@ func (m *Model) renderCronJobActiveJobs(cj *model.CronJobData) string {
 		colName        = 35
 		colCompletions = 15
 		colDuration    = 12
-		colAge         = 10
 		colStatus      = 12
 	)
+	colAge := m.ageColumnWidth(10)
 
 	headerRow := fmt.Sprintf("  %s  %s  %s  %s  %s",
 		padRight("JOB NAME", colName),
@@ -268,7 +297,7 @@ func (m *Model) renderCronJobActiveJobs(cj *model.CronJobData) string {
 			duration = StyleTextMuted.Render("running")
 		}
 
-		age := formatAge(time.Since(job.CreationTimestamp))
+		age := m.formatAgeOrTime(job.CreationTimestamp)
 
 		row := fmt.Sprintf("  %s  %s  %s  %s  %s",
 			padRight(truncate(job.Name, colName), colName),
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// cronJobNextRun returns the next time a CronJob fires after now. Schedules are
+// parsed like the CronJob controller does (standard 5-field syntax, @hourly
+// style macros and CRON_TZ= prefixes); without spec.timeZone the controller's
+// local time zone is assumed to be UTC.
+func cronJobNextRun(cj *model.CronJobData, now time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(cj.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	location := time.UTC
+	if cj.TimeZone != "" {
+		location, err = time.LoadLocation(cj.TimeZone)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	next := schedule.Next(now.In(location))
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("schedule %q never fires", cj.Schedule)
+	}
+	return next, nil
+}
+
+// formatCronJobNextRun renders the next run of a CronJob for the list view:
+// the time until it fires (or its timestamp in absolute mode), "suspended" for
+// suspended CronJobs and "invalid schedule" when the schedule cannot be parsed
+func (m *Model) formatCronJobNextRun(cj *model.CronJobData) string {
+	if cj.Suspend {
+		return StyleWarning.Render(m.T("workloads.cronjobs.suspended"))
+	}
+	next, err := cronJobNextRun(cj, time.Now())
+	if err != nil {
+		return StyleDanger.Render(m.T("workloads.cronjobs.invalid_schedule"))
+	}
+	if m.timeFormat == timeFormatAbsolute {
+		return next.Local().Format(absoluteTimeLayout)
+	}
+	return m.TF("workloads.cronjobs.in", map[string]interface{}{
+		"Duration": formatAge(time.Until(next)),
+	})
+}
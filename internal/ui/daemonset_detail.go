@@ -2,12 +2,30 @@ package ui
 
 import (
 	"fmt"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/yourusername/k8s-monitor/internal/model"
+	corev1 "k8s.io/api/core/v1"
 )
 
+// daemonSetDefaultTolerations are the tolerations the DaemonSet controller adds
+// to every daemon pod, so node conditions like these never block scheduling
+var daemonSetDefaultTolerations = []corev1.Toleration{
+	{Key: "node.kubernetes.io/not-ready", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	{Key: "node.kubernetes.io/unreachable", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	{Key: "node.kubernetes.io/disk-pressure", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: "node.kubernetes.io/memory-pressure", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: "node.kubernetes.io/pid-pressure", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: "node.kubernetes.io/unschedulable", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+}
+
+// daemonSetNodeGap is an eligible node without a ready daemon pod
+type daemonSetNodeGap struct {
+	node string
+	pod  *model.PodData // nil when no daemon pod exists on the node
+}
+
 // renderDaemonSetDetail renders the daemonset detail view
 func (m *Model) renderDaemonSetDetail() string {
 	if m.selectedDaemonSet == nil {
@@ -27,6 +45,10 @@ func (m *Model) renderDaemonSetDetail() string {
 	sections = append(sections, m.renderDaemonSetStatus(ds))
 	sections = append(sections, "")
 
+	// Node coverage
+	sections = append(sections, m.renderDaemonSetNodeCoverage(ds))
+	sections = append(sections, "")
+
 	// Selector
 	sections = append(sections, m.renderDaemonSetSelector(ds))
 	sections = append(sections, "")
@@ -45,6 +67,7 @@ func (m *Model) renderDaemonSetDetail() string {
 	}
 
 	// Clamp scroll offset
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
@@ -93,10 +116,9 @@ func (m *Model) renderDaemonSetBasicInfo(ds *model.DaemonSetData) string {
 		ds.Namespace))
 
 	// Age
-	age := time.Since(ds.CreationTimestamp)
 	info = append(info, fmt.Sprintf("  %s: %s",
 		StyleTextSecondary.Render("Age"),
-		formatAge(age)))
+		m.formatAgeOrTime(ds.CreationTimestamp)))
 
 	return strings.Join(info, "\n")
 }
@@ -267,12 +289,12 @@ func (m *Model) renderDaemonSetPods(ds *model.DaemonSetData) string {
 			phaseStyled = StyleTextMuted.Render(phase)
 		}
 
-		cpuStr := FormatMillicores(pod.CPUUsage)
+		cpuStr := FormatMillicores(pod.CPUUsage, m.rawUnits)
 		if pod.CPUUsage == 0 {
 			cpuStr = StyleTextMuted.Render("-")
 		}
 
-		memStr := FormatBytes(pod.MemoryUsage)
+		memStr := formatMemoryBytes(pod.MemoryUsage, m.rawUnits)
 		if pod.MemoryUsage == 0 {
 			memStr = StyleTextMuted.Render("-")
 		}
@@ -310,3 +332,160 @@ func (m *Model) renderDaemonSetPods(ds *model.DaemonSetData) string {
 
 	return strings.Join(info, "\n")
 }
+
+// daemonSetHealth renders the list health indicator: a check mark when every
+// desired pod is ready, otherwise how many are not ready
+func (m *Model) daemonSetHealth(ds *model.DaemonSetData) string {
+	if ds.DesiredNumberScheduled == 0 {
+		return StyleTextMuted.Render("-")
+	}
+	notReady := ds.DesiredNumberScheduled - ds.NumberReady
+	if notReady <= 0 {
+		return StyleStatusReady.Render("✓")
+	}
+
+	label := "⚠ " + m.TF("workloads.daemonsets.not_ready", map[string]interface{}{"Count": notReady})
+	if ds.NumberReady == 0 {
+		return StyleDanger.Render(label)
+	}
+	return StyleWarning.Render(label)
+}
+
+// daemonSetRunsOnNode reports whether a daemon pod should be scheduled on the
+// node: its nodeSelector matches and every NoSchedule/NoExecute taint is
+// tolerated. Node affinity is not evaluated.
+func daemonSetRunsOnNode(ds *model.DaemonSetData, node *model.NodeData) bool {
+	for key, value := range ds.NodeSelector {
+		if node.Labels[key] != value {
+			return false
+		}
+	}
+
+	tolerations := make([]corev1.Toleration, 0, len(ds.Tolerations)+len(daemonSetDefaultTolerations))
+	tolerations = append(tolerations, ds.Tolerations...)
+	tolerations = append(tolerations, daemonSetDefaultTolerations...)
+	return toleratesNodeTaints(tolerations, node)
+}
+
+// toleratesNodeTaints reports whether the tolerations cover every NoSchedule
+// and NoExecute taint of the node
+func toleratesNodeTaints(tolerations []corev1.Toleration, node *model.NodeData) bool {
+	for i := range node.Taints {
+		taint := &node.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for _, toleration := range tolerations {
+			if toleration.ToleratesTaint(taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// isPodReady reports whether a pod is running with all containers ready
+func isPodReady(pod *model.PodData) bool {
+	return pod.Phase == "Running" && pod.Containers > 0 && pod.ReadyContainers == pod.Containers
+}
+
+// getDaemonSetNodeGaps returns the eligible nodes and those among them where
+// the daemon pod is missing or not ready, matching pods by owner and node
+func (m *Model) getDaemonSetNodeGaps(ds *model.DaemonSetData) (eligible int, gaps []daemonSetNodeGap) {
+	if m.clusterData == nil {
+		return 0, nil
+	}
+
+	podsByNode := make(map[string]*model.PodData)
+	for _, pod := range m.clusterData.Pods {
+		if pod.OwnerKind != "DaemonSet" || pod.OwnerName != ds.Name || pod.Namespace != ds.Namespace || pod.Node == "" {
+			continue
+		}
+		// Prefer a ready pod when an old and a new one share the node mid-rollout
+		if existing, ok := podsByNode[pod.Node]; !ok || (!isPodReady(existing) && isPodReady(pod)) {
+			podsByNode[pod.Node] = pod
+		}
+	}
+
+	for _, node := range m.clusterData.Nodes {
+		if !daemonSetRunsOnNode(ds, node) {
+			continue
+		}
+		eligible++
+		pod, ok := podsByNode[node.Name]
+		if ok && isPodReady(pod) {
+			continue
+		}
+		gaps = append(gaps, daemonSetNodeGap{node: node.Name, pod: pod})
+	}
+
+	sort.Slice(gaps, func(i, j int) bool {
+		return gaps[i].node < gaps[j].node
+	})
+	return eligible, gaps
+}
+
+// renderDaemonSetNodeCoverage renders the nodes where the daemon pod is
+// missing or not ready
+func (m *Model) renderDaemonSetNodeCoverage(ds *model.DaemonSetData) string {
+	var info []string
+
+	if m.clusterData == nil || len(m.clusterData.Nodes) == 0 {
+		info = append(info, StyleSubHeader.Render("Node Coverage"))
+		info = append(info, "")
+		info = append(info, StyleTextMuted.Render("  No node information available"))
+		return strings.Join(info, "\n")
+	}
+
+	eligible, gaps := m.getDaemonSetNodeGaps(ds)
+	info = append(info, StyleSubHeader.Render(fmt.Sprintf("Node Coverage (%d/%d ready)", eligible-len(gaps), eligible)))
+	info = append(info, "")
+
+	if len(gaps) == 0 {
+		info = append(info, StyleStatusReady.Render(fmt.Sprintf("  ✓ Daemon pod ready on all %d eligible nodes", eligible)))
+		return strings.Join(info, "\n")
+	}
+
+	const (
+		colNode  = 30
+		colState = 12
+		colPod   = 35
+		colPhase = 11
+	)
+
+	headerRow := fmt.Sprintf("  %s  %s  %s  %s",
+		padRight(m.T("columns.node"), colNode),
+		padRight(m.T("columns.status"), colState),
+		padRight(m.T("columns.pod"), colPod),
+		padRight(m.T("columns.phase"), colPhase),
+	)
+	info = append(info, StyleTextMuted.Render(headerRow))
+
+	for _, gap := range gaps {
+		state := StyleDanger.Render("Missing")
+		podName, phase := StyleTextMuted.Render("-"), StyleTextMuted.Render("-")
+		if gap.pod != nil {
+			state = StyleWarning.Render("Not Ready")
+			podName = truncate(gap.pod.Name, colPod)
+			phase = gap.pod.Phase
+			if gap.pod.Reason != "" {
+				phase = gap.pod.Reason
+			}
+		}
+
+		row := fmt.Sprintf("  %s  %s  %s  %s",
+			padRight(truncate(gap.node, colNode), colNode),
+			padRight(state, colState),
+			padRight(podName, colPod),
+			phase,
+		)
+		info = append(info, row)
+	}
+
+	return strings.Join(info, "\n")
+}
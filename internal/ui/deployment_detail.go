@@ -3,7 +3,6 @@ package ui
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/yourusername/k8s-monitor/internal/model"
 )
@@ -16,6 +15,10 @@ func (m *Model) renderDeploymentDetail() string {
 
 	deploy := m.selectedDeployment
 
+	// Clamp the pod selection to the listed pods
+	pods := m.getDeploymentPods(deploy)
+	m.deploymentPodSelectedIndex = clampWorkloadPodIndex(m.deploymentPodSelectedIndex, pods)
+
 	// Build sections
 	var sections []string
 
@@ -49,11 +52,15 @@ func (m *Model) renderDeploymentDetail() string {
 	}
 
 	// Clamp scroll offset
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
 	}
 	detailScrollOffset := m.detailScrollOffset
+	if len(pods) > 0 {
+		detailScrollOffset = followSelectedPod(lines, m.deploymentPodSelectedIndex, detailScrollOffset, maxVisible)
+	}
 	if detailScrollOffset > maxScroll {
 		detailScrollOffset = maxScroll
 	}
@@ -97,10 +104,9 @@ func (m *Model) renderDeploymentBasicInfo(deploy *model.DeploymentData) string {
 		deploy.Namespace))
 
 	// Age
-	age := time.Since(deploy.CreationTimestamp)
 	info = append(info, fmt.Sprintf("  %s: %s",
 		StyleTextSecondary.Render("Age"),
-		formatAge(age)))
+		m.formatAgeOrTime(deploy.CreationTimestamp)))
 
 	return strings.Join(info, "\n")
 }
@@ -197,144 +203,5 @@ func (m *Model) renderDeploymentSelector(deploy *model.DeploymentData) string {
 
 // renderDeploymentPods renders pods managed by this deployment
 func (m *Model) renderDeploymentPods(deploy *model.DeploymentData) string {
-	var info []string
-
-	if m.clusterData == nil || len(m.clusterData.Pods) == 0 {
-		info = append(info, StyleSubHeader.Render("Managed Pods"))
-		info = append(info, "")
-		info = append(info, StyleTextMuted.Render("  No pod information available"))
-		return strings.Join(info, "\n")
-	}
-
-	// Check if deployment has a selector
-	if len(deploy.Selector) == 0 {
-		info = append(info, StyleSubHeader.Render("Managed Pods"))
-		info = append(info, "")
-		info = append(info, StyleTextMuted.Render("  No selector configured (matchLabels is empty)"))
-		return strings.Join(info, "\n")
-	}
-
-	// Find pods that match the deployment selector
-	var matchingPods []*model.PodData
-	for _, pod := range m.clusterData.Pods {
-		// Check if pod is in same namespace
-		if pod.Namespace != deploy.Namespace {
-			continue
-		}
-
-		// Check if pod labels match deployment selector
-		matches := true
-		for selectorKey, selectorValue := range deploy.Selector {
-			if podLabelValue, exists := pod.Labels[selectorKey]; !exists || podLabelValue != selectorValue {
-				matches = false
-				break
-			}
-		}
-
-		if matches {
-			matchingPods = append(matchingPods, pod)
-		}
-	}
-
-	info = append(info, StyleSubHeader.Render(fmt.Sprintf("Managed Pods (%d)", len(matchingPods))))
-	info = append(info, "")
-
-	if len(matchingPods) == 0 {
-		info = append(info, StyleTextMuted.Render("  No pods match this deployment selector"))
-		return strings.Join(info, "\n")
-	}
-
-	// Table header
-	const (
-		colName     = 38
-		colPhase    = 11
-		colCPU      = 11
-		colMemory   = 11
-		colRx       = 10
-		colTx       = 10
-		colRestarts = 8
-	)
-
-	headerRow := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s",
-		padRight(m.T("columns.pod_name"), colName),
-		padRight(m.T("columns.phase"), colPhase),
-		padRight(m.T("columns.cpu"), colCPU),
-		padRight(m.T("columns.memory"), colMemory),
-		padRight(m.T("columns.rx"), colRx),
-		padRight(m.T("columns.tx"), colTx),
-		padRight(m.T("columns.restarts"), colRestarts),
-	)
-	info = append(info, StyleTextMuted.Render(headerRow))
-
-	// Show up to 20 pods
-	displayCount := len(matchingPods)
-	if displayCount > 20 {
-		displayCount = 20
-	}
-
-	for i := 0; i < displayCount; i++ {
-		pod := matchingPods[i]
-
-		phase := pod.Phase
-		var phaseStyled string
-		switch phase {
-		case "Running":
-			phaseStyled = StyleStatusRunning.Render(phase)
-		case "Succeeded":
-			phaseStyled = StyleStatusReady.Render(phase)
-		case "Failed":
-			phaseStyled = StyleStatusNotReady.Render(phase)
-		case "Pending":
-			phaseStyled = StyleStatusPending.Render(phase)
-		default:
-			phaseStyled = StyleTextMuted.Render(phase)
-		}
-
-		cpuStr := FormatMillicores(pod.CPUUsage)
-		if pod.CPUUsage == 0 {
-			cpuStr = StyleTextMuted.Render("-")
-		}
-
-		memStr := FormatBytes(pod.MemoryUsage)
-		if pod.MemoryUsage == 0 {
-			memStr = StyleTextMuted.Render("-")
-		}
-
-		// Network RX (download/receive)
-		rxRate := m.calculatePodNetworkRxRate(pod.Namespace, pod.Name)
-		rxStr := formatNetworkRate(rxRate)
-		if rxRate == 0 {
-			rxStr = StyleTextMuted.Render("-")
-		}
-
-		// Network TX (upload/send)
-		txRate := m.calculatePodNetworkTxRate(pod.Namespace, pod.Name)
-		txStr := formatNetworkRate(txRate)
-		if txRate == 0 {
-			txStr = StyleTextMuted.Render("-")
-		}
-
-		restarts := fmt.Sprintf("%d", pod.RestartCount)
-		if pod.RestartCount > 0 {
-			restarts = StyleWarning.Render(restarts)
-		}
-
-		row := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s",
-			padRight(truncate(pod.Name, colName), colName),
-			padRight(phaseStyled, colPhase),
-			padRight(cpuStr, colCPU),
-			padRight(memStr, colMemory),
-			padRight(rxStr, colRx),
-			padRight(txStr, colTx),
-			padRight(restarts, colRestarts),
-		)
-		info = append(info, row)
-	}
-
-	if len(matchingPods) > displayCount {
-		info = append(info, "")
-		info = append(info, StyleTextMuted.Render(fmt.Sprintf("  ... and %d more pods", len(matchingPods)-displayCount)))
-	}
-
-	return strings.Join(info, "\n")
+	return m.renderManagedPods(m.getDeploymentPods(deploy), m.deploymentPodSelectedIndex, "No pods match this deployment")
 }
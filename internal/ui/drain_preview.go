@@ -0,0 +1,167 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// mirrorPodAnnotation marks the API mirror of a static pod managed by the kubelet
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+// drainPodGroup is the set of pods on a node sharing a controller owner
+type drainPodGroup struct {
+	owner string // e.g. "ReplicaSet default/web-5d8f"
+	pods  []*model.PodData
+}
+
+// drainBlocker is a PodDisruptionBudget that would reject some evictions of a drain
+type drainBlocker struct {
+	pdb    *model.PDBData
+	onNode int // Running pods of the budget on the drained node
+}
+
+// isMirrorPod reports whether a pod is the mirror of a static pod, which a
+// drain cannot delete
+func isMirrorPod(pod *model.PodData) bool {
+	_, ok := pod.Annotations[mirrorPodAnnotation]
+	return ok
+}
+
+// groupPodsByOwner groups pods by their controller owner, sorted by owner
+func groupPodsByOwner(pods []*model.PodData) []drainPodGroup {
+	index := make(map[string]int)
+	var groups []drainPodGroup
+	for _, pod := range pods {
+		owner := "Unmanaged (no controller, drain needs --force)"
+		if pod.OwnerKind != "" {
+			owner = fmt.Sprintf("%s %s/%s", pod.OwnerKind, pod.Namespace, pod.OwnerName)
+		}
+		i, ok := index[owner]
+		if !ok {
+			i = len(groups)
+			index[owner] = i
+			groups = append(groups, drainPodGroup{owner: owner})
+		}
+		groups[i].pods = append(groups[i].pods, pod)
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].owner < groups[j].owner })
+	for _, group := range groups {
+		sort.Slice(group.pods, func(i, j int) bool { return group.pods[i].Name < group.pods[j].Name })
+	}
+	return groups
+}
+
+// drainBlockers returns, per evicted pod, the PodDisruptionBudgets that would
+// reject its eviction: budgets covering more running pods on the node than
+// the disruptions they currently allow. Pending and finished pods are evicted
+// regardless of budgets.
+func (m *Model) drainBlockers(pods []*model.PodData) map[*model.PodData][]drainBlocker {
+	blockers := make(map[*model.PodData][]drainBlocker)
+	if m.clusterData == nil {
+		return blockers
+	}
+
+	for _, pdb := range m.clusterData.PDBs {
+		var covered []*model.PodData
+		for _, pod := range pods {
+			if pod.Phase == "Running" && pod.Namespace == pdb.Namespace && podMatchesSelector(pod, pdb.Selector) {
+				covered = append(covered, pod)
+			}
+		}
+		if len(covered) == 0 || int32(len(covered)) <= pdb.DisruptionsAllowed {
+			continue
+		}
+		for _, pod := range covered {
+			blockers[pod] = append(blockers[pod], drainBlocker{pdb: pdb, onNode: len(covered)})
+		}
+	}
+	return blockers
+}
+
+// renderDrainPreview builds a read-only report of what draining a node would
+// do: the pods evicted grouped by owner, DaemonSet and mirror pods left in
+// place, and the pods whose PodDisruptionBudget would block the drain
+func (m *Model) renderDrainPreview(nodeName string) string {
+	var evicted, daemonSet, mirror []*model.PodData
+	if m.clusterData != nil {
+		for _, pod := range m.clusterData.Pods {
+			if pod.Node != nodeName {
+				continue
+			}
+			switch {
+			case isMirrorPod(pod):
+				mirror = append(mirror, pod)
+			case pod.OwnerKind == "DaemonSet":
+				daemonSet = append(daemonSet, pod)
+			default:
+				evicted = append(evicted, pod)
+			}
+		}
+	}
+	blockers := m.drainBlockers(evicted)
+
+	var lines []string
+	lines = append(lines, "Read-only analysis: nothing is cordoned or evicted.")
+	lines = append(lines, "")
+	lines = append(lines, fmt.Sprintf("Pods on node: %d  (evicted: %d, DaemonSet: %d, mirror/static: %d)",
+		len(evicted)+len(daemonSet)+len(mirror), len(evicted), len(daemonSet), len(mirror)))
+	if len(blockers) > 0 {
+		lines = append(lines, fmt.Sprintf("⚠ %d pod(s) are covered by PodDisruptionBudgets allowing fewer disruptions; the drain would wait until enough pods are healthy elsewhere", len(blockers)))
+	} else if len(evicted) > 0 {
+		lines = append(lines, "✓ No PodDisruptionBudget blocks the drain")
+	}
+
+	// renderGroups lists pods grouped by owner, annotating each pod via note
+	renderGroups := func(title string, pods []*model.PodData, note func(*model.PodData) string) {
+		if len(pods) == 0 {
+			return
+		}
+		lines = append(lines, "")
+		lines = append(lines, fmt.Sprintf("%s: %d", title, len(pods)))
+		for _, group := range groupPodsByOwner(pods) {
+			lines = append(lines, "  "+group.owner)
+			for _, pod := range group.pods {
+				line := fmt.Sprintf("    %-50s %-10s", pod.Name, pod.Phase)
+				if note != nil {
+					line += note(pod)
+				}
+				lines = append(lines, strings.TrimRight(line, " "))
+			}
+		}
+	}
+
+	renderGroups("Evicted", evicted, func(pod *model.PodData) string {
+		var notes []string
+		for _, blocker := range blockers[pod] {
+			notes = append(notes, fmt.Sprintf("✗ PDB %s allows %d disruption(s) for %d pod(s) here",
+				blocker.pdb.Name, blocker.pdb.DisruptionsAllowed, blocker.onNode))
+		}
+		return strings.Join(notes, "; ")
+	})
+	renderGroups("Left in place, DaemonSet pods (--ignore-daemonsets)", daemonSet, nil)
+	renderGroups("Left in place, mirror/static pods (managed by the kubelet)", mirror, nil)
+
+	if len(evicted)+len(daemonSet)+len(mirror) == 0 {
+		lines = append(lines, "")
+		lines = append(lines, "No pods on this node; the drain would only cordon it.")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// showDrainPreview opens the drain preview of a node in the command viewer
+func (m *Model) showDrainPreview(nodeName string) tea.Cmd {
+	content := m.renderDrainPreview(nodeName)
+	return func() tea.Msg {
+		return commandOutputMsg{
+			title:   fmt.Sprintf("Drain Preview: %s", nodeName),
+			content: content,
+			raw:     true,
+		}
+	}
+}
@@ -43,6 +43,7 @@ func (m *Model) renderEventDetail() string {
 
 	// Clamp scroll offset to valid range
 	totalLines := len(allLines)
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
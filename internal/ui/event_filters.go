@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// eventFilterField identifies the focused row of the events filter panel
+type eventFilterField int
+
+const (
+	eventFilterType eventFilterField = iota
+	eventFilterKind
+	eventFilterSince
+	eventFilterFieldCount
+)
+
+// eventTypeOptions are the selectable event types ("" = all)
+var eventTypeOptions = []string{"", "Warning", "Normal"}
+
+// eventSinceOptions are the selectable time windows (0 = all time)
+var eventSinceOptions = []time.Duration{0, 5 * time.Minute, 15 * time.Minute, time.Hour}
+
+// eventTime returns when an event last occurred, falling back to its first occurrence
+func eventTime(event *model.EventData) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp
+	}
+	return event.FirstTimestamp
+}
+
+// getEventKinds returns the sorted involved-object kinds present in the events
+func (m *Model) getEventKinds() []string {
+	if m.clusterData == nil {
+		return []string{}
+	}
+
+	kindMap := make(map[string]bool)
+	for _, event := range m.clusterData.Events {
+		if event.InvolvedKind != "" {
+			kindMap[event.InvolvedKind] = true
+		}
+	}
+
+	kinds := make([]string, 0, len(kindMap))
+	for kind := range kindMap {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+// openEventFilter opens the events filter panel, or moves focus to the next
+// filter row when already open (closing it after the last row)
+func (m *Model) openEventFilter() {
+	if !m.filterMode {
+		m.filterMode = true
+		m.eventFilterField = eventFilterType
+		return
+	}
+	m.eventFilterField++
+	if m.eventFilterField >= eventFilterFieldCount {
+		m.filterMode = false
+		m.eventFilterField = eventFilterType
+	}
+}
+
+// handleEventFilterNavigation cycles the value of the focused events filter row
+func (m *Model) handleEventFilterNavigation(direction int) {
+	// cycle returns the index after moving direction steps with wrap-around
+	cycle := func(current, total int) int {
+		next := current + direction
+		if next < 0 {
+			return total - 1
+		}
+		if next >= total {
+			return 0
+		}
+		return next
+	}
+
+	switch m.eventFilterField {
+	case eventFilterType:
+		idx := 0
+		for i, t := range eventTypeOptions {
+			if t == m.filterEventType {
+				idx = i
+			}
+		}
+		m.filterEventType = eventTypeOptions[cycle(idx, len(eventTypeOptions))]
+
+	case eventFilterKind:
+		options := append([]string{""}, m.getEventKinds()...)
+		idx := 0
+		for i, kind := range options {
+			if kind == m.filterEventKind {
+				idx = i
+			}
+		}
+		m.filterEventKind = options[cycle(idx, len(options))]
+
+	case eventFilterSince:
+		idx := 0
+		for i, since := range eventSinceOptions {
+			if since == m.filterEventSince {
+				idx = i
+			}
+		}
+		m.filterEventSince = eventSinceOptions[cycle(idx, len(eventSinceOptions))]
+	}
+
+	// Reset scroll and selection when filter changes
+	m.scrollOffset = 0
+	m.selectedIndex = 0
+}
+
+// formatEventSince renders a since-filter window for display
+func (m *Model) formatEventSince(since time.Duration) string {
+	if since == 0 {
+		return m.T("filter.all_time")
+	}
+	return m.TF("filter.last", map[string]interface{}{
+		"Duration": strings.TrimSuffix(strings.TrimSuffix(since.String(), "0s"), "0m"),
+	})
+}
+
+// renderEventFilterPanel renders the events filter panel (type, kind, since)
+func (m *Model) renderEventFilterPanel() string {
+	var lines []string
+
+	lines = append(lines, StyleHeader.Render(m.T("filter.events_title")))
+	lines = append(lines, "")
+
+	orAll := func(value string) string {
+		if value == "" {
+			return m.T("filter.all")
+		}
+		return value
+	}
+
+	rows := []struct {
+		field eventFilterField
+		label string
+		value string
+	}{
+		{eventFilterType, m.T("columns.type"), orAll(m.filterEventType)},
+		{eventFilterKind, m.T("columns.kind"), orAll(m.filterEventKind)},
+		{eventFilterSince, m.T("columns.since"), m.formatEventSince(m.filterEventSince)},
+	}
+
+	for _, row := range rows {
+		line := fmt.Sprintf("  %s ◀ %s ▶", padRight(row.label, 8), row.value)
+		if row.field == m.eventFilterField {
+			line = StyleSelected.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, StyleTextMuted.Render("  "+m.T("filter.events_hint")))
+
+	return strings.Join(lines, "\n")
+}
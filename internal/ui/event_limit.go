@@ -0,0 +1,61 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// eventLimiter is implemented by data providers that can change the number
+// of events fetched per refresh
+type eventLimiter interface {
+	SetEventLimit(limit int) error
+}
+
+// eventLimitMsg is sent once the data provider has changed its event limit
+type eventLimitMsg struct {
+	limit int
+	err   error
+}
+
+// SetEventLimit sets the configured number of most recent events fetched per
+// refresh (0 = all events). A in the Events view switches to all events and back.
+func (m *Model) SetEventLimit(limit int) {
+	m.eventLimit = limit
+	m.activeEventLimit = limit
+}
+
+// toggleAllEvents switches the Events view between the configured event limit
+// and all events
+func (m *Model) toggleAllEvents() tea.Cmd {
+	limiter, ok := m.dataProvider.(eventLimiter)
+	if !ok || m.eventLimit == 0 {
+		return nil
+	}
+
+	limit := 0
+	if m.activeEventLimit == 0 {
+		limit = m.eventLimit
+	}
+	return func() tea.Msg {
+		return eventLimitMsg{limit: limit, err: limiter.SetEventLimit(limit)}
+	}
+}
+
+// handleEventLimitMsg fetches events again with the new limit
+func (m *Model) handleEventLimitMsg(msg eventLimitMsg) tea.Cmd {
+	if msg.err != nil {
+		m.err = msg.err
+		return nil
+	}
+
+	m.activeEventLimit = msg.limit
+	m.err = nil
+	m.selectedIndex = 0
+	m.scrollOffset = 0
+	m.cachedSortedEvents = nil
+	return m.fetchData()
+}
+
+// eventsTruncated reports whether the fetched events were cut at the limit
+func (m *Model) eventsTruncated() bool {
+	return m.clusterData != nil && m.activeEventLimit > 0 && len(m.clusterData.Events) >= m.activeEventLimit
+}
@@ -13,6 +13,10 @@ import (
 func (m *Model) renderEvents() string {
 	events := m.getFilteredEvents()
 	if len(events) == 0 {
+		// Keep the filter panel reachable when filters hide every event
+		if m.filterMode {
+			return m.T("msg.no_events") + "\n\n" + m.renderEventFilterPanel()
+		}
 		return m.T("msg.no_events")
 	}
 
@@ -37,6 +41,16 @@ func (m *Model) renderEvents() string {
 		footer,
 	)
 
+	// Show filter panel if in filter mode
+	if m.filterMode {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			content,
+			"",
+			m.renderEventFilterPanel(),
+		)
+	}
+
 	// Show search indicator if in search mode
 	if m.searchMode {
 		searchPanel := m.renderSearchPanel()
@@ -62,6 +76,31 @@ func (m *Model) renderEventsHeader(events []*model.EventData) string {
 			"Type": m.filterEventType,
 		})
 	}
+	if m.filterEventKind != "" {
+		summary += " " + m.TF("events.kind_filter", map[string]interface{}{
+			"Kind": m.filterEventKind,
+		})
+	}
+	if m.filterEventSince > 0 {
+		summary += " (" + m.formatEventSince(m.filterEventSince) + ")"
+	}
+
+	// Add sort indicator
+	field, order := m.eventSort()
+	var sortInfo string
+	switch field {
+	case SortByTime:
+		sortInfo = m.T("columns.last_seen")
+	case SortByCount:
+		sortInfo = m.T("columns.count")
+	case SortByType:
+		sortInfo = m.T("columns.type")
+	}
+	arrow := "↑"
+	if order == SortDesc {
+		arrow = "↓"
+	}
+	summary += fmt.Sprintf(" • %s: %s %s", m.T("common.sort"), sortInfo, arrow)
 
 	return lipgloss.JoinHorizontal(
 		lipgloss.Top,
@@ -95,10 +134,7 @@ func (m *Model) renderEventsList(events []*model.EventData) string {
 	rows = append(rows, strings.Repeat("─", colType+colReason+colObject+colMessage+colCount+8))
 
 	// Calculate visible range based on scroll
-	maxVisible := m.height - 10
-	if maxVisible < 1 {
-		maxVisible = 1
-	}
+	maxVisible := m.listVisibleRows()
 
 	totalEvents := len(events)
 
@@ -195,11 +231,15 @@ func (m *Model) renderEventsFooter(events []*model.EventData) string {
 		totalEvents,
 	)
 
-	// Add scroll position indicator if there are more items than visible
-	maxVisible := m.height - 10
-	if maxVisible < 1 {
-		maxVisible = 1
+	// Only the most recent events are fetched unless A shows all of them
+	if m.eventsTruncated() {
+		stats += "  " + StyleWarning.Render(m.TF("events.limited", map[string]interface{}{
+			"Limit": m.activeEventLimit,
+		}))
 	}
+
+	// Add scroll position indicator if there are more items than visible
+	maxVisible := m.listVisibleRows()
 	if totalEvents > maxVisible {
 		scrollInfo := fmt.Sprintf("  [%d-%d %s %d]",
 			m.scrollOffset+1,
@@ -223,10 +263,51 @@ func (m *Model) getSortedEvents(inputEvents []*model.EventData) []*model.EventDa
 	events := make([]*model.EventData, len(inputEvents))
 	copy(events, inputEvents)
 
-	// Sort by LastTimestamp (most recent first) using sort.Slice (O(n log n))
-	sort.Slice(events, func(i, j int) bool {
-		return events[i].LastTimestamp.After(events[j].LastTimestamp)
-	})
+	// Fuzzy search results keep their match-score order
+	if m.fuzzyRanking() {
+		return events
+	}
+
+	// Sort using sort.SliceStable so ties keep the most-recent-first order from the API
+	field, order := m.eventSort()
+	switch field {
+	case SortByCount:
+		sort.SliceStable(events, func(i, j int) bool {
+			if order == SortAsc {
+				return events[i].Count < events[j].Count
+			}
+			return events[i].Count > events[j].Count
+		})
+
+	case SortByType:
+		// Warnings before Normal events, most recent first within each type
+		sort.SliceStable(events, func(i, j int) bool {
+			wi, wj := events[i].Type == "Warning", events[j].Type == "Warning"
+			if wi != wj {
+				return wi == (order == SortAsc)
+			}
+			return eventTime(events[i]).After(eventTime(events[j]))
+		})
+
+	default:
+		sort.SliceStable(events, func(i, j int) bool {
+			if order == SortAsc {
+				return eventTime(events[i]).Before(eventTime(events[j]))
+			}
+			return eventTime(events[i]).After(eventTime(events[j]))
+		})
+	}
 
 	return events
 }
+
+// eventSort returns the effective events sort. Sort fields of other views fall
+// back to most-recent-first, matching the order returned by the API server.
+func (m *Model) eventSort() (SortField, SortOrder) {
+	switch m.sortField {
+	case SortByTime, SortByCount, SortByType:
+		return m.sortField, m.sortOrder
+	default:
+		return SortByTime, SortDesc
+	}
+}
@@ -1,15 +1,16 @@
 package ui
 
 import (
-	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
+	"unicode"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/k8s-monitor/internal/diagnostic"
 )
 
 // ExportFormat represents the export file format
@@ -31,17 +32,25 @@ type exportErrorMsg struct {
 	err error
 }
 
+// SetExportDir sets the directory export files are written to; "" keeps
+// ~/.config/k8s-monitor/exports
+func (m *Model) SetExportDir(dir string) {
+	m.exportDir = dir
+}
+
 // getExportDir returns the export directory path and ensures it exists
-func getExportDir() (string, error) {
-	// Get user home directory
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		// Fallback to current directory if home dir not available
-		return ".", nil
-	}
+func getExportDir(exportDir string) (string, error) {
+	if exportDir == "" {
+		// Get user home directory
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			// Fallback to current directory if home dir not available
+			return ".", nil
+		}
 
-	// Use ~/.config/k8s-monitor/exports/
-	exportDir := filepath.Join(homeDir, ".config", "k8s-monitor", "exports")
+		// Use ~/.config/k8s-monitor/exports/
+		exportDir = filepath.Join(homeDir, ".config", "k8s-monitor", "exports")
+	}
 
 	// Check if directory exists
 	if _, err := os.Stat(exportDir); os.IsNotExist(err) {
@@ -67,28 +76,30 @@ func getExportDir() (string, error) {
 func (m *Model) exportData(format ExportFormat) tea.Cmd {
 	return func() tea.Msg {
 		// Get export directory
-		exportDir, err := getExportDir()
+		exportDir, err := getExportDir(m.exportDir)
 		if err != nil {
 			return exportErrorMsg{err: err}
 		}
 
-		timestamp := time.Now().Format("20060102-150405")
 		var filename string
 		var exportErr error
 
 		switch m.currentView {
 		case ViewNodes:
-			filename = fmt.Sprintf("k8s-nodes-%s", timestamp)
+			filename = m.exportFilename("nodes")
 			exportErr = m.exportNodes(exportDir, filename, format)
 		case ViewPods:
-			filename = fmt.Sprintf("k8s-pods-%s", timestamp)
+			filename = m.exportFilename("pods")
 			exportErr = m.exportPods(exportDir, filename, format)
 		case ViewEvents:
-			filename = fmt.Sprintf("k8s-events-%s", timestamp)
+			filename = m.exportFilename("events")
 			exportErr = m.exportEvents(exportDir, filename, format)
 		case ViewNetwork:
-			filename = fmt.Sprintf("k8s-services-%s", timestamp)
+			filename = m.exportFilename("services")
 			exportErr = m.exportServices(exportDir, filename, format)
+		case ViewAlerts:
+			filename = m.exportFilename("alerts")
+			exportErr = m.exportAlerts(exportDir, filename, format)
 		default:
 			return exportErrorMsg{err: fmt.Errorf("export not supported for this view")}
 		}
@@ -109,6 +120,24 @@ func (m *Model) exportData(format ExportFormat) tea.Cmd {
 	}
 }
 
+// exportFilename returns the name, without extension, of an export of kind:
+// k8s-<context>-<kind>-<timestamp>, leaving out the context when unknown
+// (e.g. in replay mode)
+func (m *Model) exportFilename(kind string) string {
+	timestamp := time.Now().Format("20060102-150405")
+	if m.activeContext == "" {
+		return fmt.Sprintf("k8s-%s-%s", kind, timestamp)
+	}
+	// Context names may contain characters unsafe in file names, e.g. ARNs
+	context := strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, m.activeContext)
+	return fmt.Sprintf("k8s-%s-%s-%s", context, kind, timestamp)
+}
+
 // getExportCount returns the number of items exported
 func (m *Model) getExportCount() int {
 	if m.clusterData == nil {
@@ -124,6 +153,8 @@ func (m *Model) getExportCount() int {
 		return len(m.clusterData.Events)
 	case ViewNetwork:
 		return len(m.clusterData.Services)
+	case ViewAlerts:
+		return len(m.exportedAlerts())
 	default:
 		return 0
 	}
@@ -141,55 +172,9 @@ func (m *Model) exportNodes(exportDir, filename string, format ExportFormat) err
 	return m.exportNodesJSON(exportDir, filename+".json")
 }
 
-// exportNodesCSV exports nodes to CSV format
+// exportNodesCSV exports nodes to CSV format using nodeCSVSchema
 func (m *Model) exportNodesCSV(exportDir, filename string) error {
-	fullPath := filepath.Join(exportDir, filename)
-	file, err := os.Create(fullPath)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
-
-	// Write header
-	header := []string{
-		m.T("columns.name"),
-		m.T("columns.status"),
-		m.T("columns.roles"),
-		m.T("columns.cpu"),
-		m.T("columns.memory"),
-		m.T("columns.pods"),
-		m.T("columns.age"),
-	}
-	if err := writer.Write(header); err != nil {
-		return err
-	}
-
-	// Write data
-	for _, node := range m.clusterData.Nodes {
-		age := formatAge(time.Since(node.CreationTimestamp))
-		roles := strings.Join(node.Roles, ",")
-		if roles == "" {
-			roles = "<none>"
-		}
-
-		record := []string{
-			node.Name,
-			node.Status,
-			roles,
-			fmt.Sprintf("%d", node.CPUUsage),
-			fmt.Sprintf("%d", node.MemoryUsage),
-			fmt.Sprintf("%d", node.PodCount),
-			age,
-		}
-		if err := writer.Write(record); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return writeCSV(filepath.Join(exportDir, filename), nodeCSVSchema, m.clusterData.Nodes)
 }
 
 // exportNodesJSON exports nodes to JSON format
@@ -218,53 +203,9 @@ func (m *Model) exportPods(exportDir, filename string, format ExportFormat) erro
 	return m.exportPodsJSON(exportDir, filename+".json")
 }
 
-// exportPodsCSV exports pods to CSV format
+// exportPodsCSV exports pods to CSV format using podCSVSchema
 func (m *Model) exportPodsCSV(exportDir, filename string) error {
-	fullPath := filepath.Join(exportDir, filename)
-	file, err := os.Create(fullPath)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
-
-	// Write header
-	header := []string{
-		m.T("columns.namespace"),
-		m.T("columns.name"),
-		m.T("columns.phase"),
-		m.T("columns.node"),
-		m.T("columns.cpu"),
-		m.T("columns.memory"),
-		m.T("columns.restarts"),
-		m.T("columns.age"),
-	}
-	if err := writer.Write(header); err != nil {
-		return err
-	}
-
-	// Write data
-	for _, pod := range m.clusterData.Pods {
-		age := formatAge(time.Since(pod.CreationTimestamp))
-
-		record := []string{
-			pod.Namespace,
-			pod.Name,
-			pod.Phase,
-			pod.Node,
-			fmt.Sprintf("%d", pod.CPUUsage),
-			fmt.Sprintf("%d", pod.MemoryUsage),
-			fmt.Sprintf("%d", pod.RestartCount),
-			age,
-		}
-		if err := writer.Write(record); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return writeCSV(filepath.Join(exportDir, filename), podCSVSchema, m.clusterData.Pods)
 }
 
 // exportPodsJSON exports pods to JSON format
@@ -293,42 +234,9 @@ func (m *Model) exportEvents(exportDir, filename string, format ExportFormat) er
 	return m.exportEventsJSON(exportDir, filename+".json")
 }
 
-// exportEventsCSV exports events to CSV format
+// exportEventsCSV exports events to CSV format using eventCSVSchema
 func (m *Model) exportEventsCSV(exportDir, filename string) error {
-	fullPath := filepath.Join(exportDir, filename)
-	file, err := os.Create(fullPath)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
-
-	// Write header
-	header := []string{"Type", "Reason", "Object", "Message", "Count", "Age"}
-	if err := writer.Write(header); err != nil {
-		return err
-	}
-
-	// Write data
-	for _, event := range m.clusterData.Events {
-		age := formatAge(time.Since(event.LastTimestamp))
-
-		record := []string{
-			event.Type,
-			event.Reason,
-			event.InvolvedObject,
-			event.Message,
-			fmt.Sprintf("%d", event.Count),
-			age,
-		}
-		if err := writer.Write(record); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return writeCSV(filepath.Join(exportDir, filename), eventCSVSchema, m.clusterData.Events)
 }
 
 // exportEventsJSON exports events to JSON format
@@ -357,8 +265,13 @@ func (m *Model) exportServices(exportDir, filename string, format ExportFormat)
 	return m.exportServicesJSON(exportDir, filename+".json")
 }
 
-// exportServicesCSV exports services to CSV format
+// exportServicesCSV exports services to CSV format using serviceCSVSchema
 func (m *Model) exportServicesCSV(exportDir, filename string) error {
+	return writeCSV(filepath.Join(exportDir, filename), serviceCSVSchema, m.clusterData.Services)
+}
+
+// exportServicesJSON exports services to JSON format
+func (m *Model) exportServicesJSON(exportDir, filename string) error {
 	fullPath := filepath.Join(exportDir, filename)
 	file, err := os.Create(fullPath)
 	if err != nil {
@@ -366,54 +279,76 @@ func (m *Model) exportServicesCSV(exportDir, filename string) error {
 	}
 	defer file.Close()
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
-
-	// Write header
-	header := []string{"Namespace", "Name", "Type", "ClusterIP", "ExternalIP", "Ports", "Age"}
-	if err := writer.Write(header); err != nil {
-		return err
-	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(m.clusterData.Services)
+}
 
-	// Write data
-	for _, svc := range m.clusterData.Services {
-		age := formatAge(time.Since(svc.CreationTimestamp))
+// exportedAlert is an alert as written to export files, with readable
+// severity and the recommended action filled in
+type exportedAlert struct {
+	Severity          string
+	Category          string
+	Type              string
+	ResourceType      string
+	Namespace         string
+	Resource          string
+	Value             string
+	Threshold         string
+	Message           string
+	RecommendedAction string
+	Acknowledged      bool
+	Timestamp         time.Time
+}
 
-		// Format external IPs
-		externalIP := strings.Join(svc.ExternalIPs, ",")
-		if externalIP == "" {
-			externalIP = "<none>"
+// exportedAlerts returns every alert of the summary (active first, then
+// acknowledged, each by severity), ignoring the Alerts view filters
+func (m *Model) exportedAlerts() []exportedAlert {
+	active, acked := m.getDisplayedAlerts()
+	alerts := make([]exportedAlert, 0, len(active)+len(acked))
+	for i, alert := range append(active, acked...) {
+		action := alert.RecommendedAction
+		if action == "" && alert.AlertType != "" {
+			action = diagnostic.GetRecommendedAction(alert.AlertType, alert.Namespace, alert.ResourceName)
 		}
+		alerts = append(alerts, exportedAlert{
+			Severity:          alert.Severity.String(),
+			Category:          alert.Category,
+			Type:              string(alert.AlertType),
+			ResourceType:      alert.ResourceType,
+			Namespace:         alert.Namespace,
+			Resource:          alert.ResourceName,
+			Value:             alert.Value,
+			Threshold:         alert.Threshold,
+			Message:           alert.Message,
+			RecommendedAction: action,
+			Acknowledged:      i >= len(active),
+			Timestamp:         alert.Timestamp,
+		})
+	}
+	return alerts
+}
 
-		// Format ports
-		var portStrings []string
-		for _, port := range svc.Ports {
-			portStrings = append(portStrings, fmt.Sprintf("%d/%s", port.Port, port.Protocol))
-		}
-		ports := strings.Join(portStrings, ",")
-		if ports == "" {
-			ports = "<none>"
-		}
+// exportAlerts exports alerts data
+func (m *Model) exportAlerts(exportDir, filename string, format ExportFormat) error {
+	alerts := m.exportedAlerts()
+	if len(alerts) == 0 {
+		return fmt.Errorf("no alerts to export")
+	}
 
-		record := []string{
-			svc.Namespace,
-			svc.Name,
-			svc.Type,
-			svc.ClusterIP,
-			externalIP,
-			ports,
-			age,
-		}
-		if err := writer.Write(record); err != nil {
-			return err
-		}
+	if format == ExportCSV {
+		return exportAlertsCSV(alerts, exportDir, filename+".csv")
 	}
+	return exportAlertsJSON(alerts, exportDir, filename+".json")
+}
 
-	return nil
+// exportAlertsCSV exports alerts to CSV format using alertCSVSchema
+func exportAlertsCSV(alerts []exportedAlert, exportDir, filename string) error {
+	return writeCSV(filepath.Join(exportDir, filename), alertCSVSchema, alerts)
 }
 
-// exportServicesJSON exports services to JSON format
-func (m *Model) exportServicesJSON(exportDir, filename string) error {
+// exportAlertsJSON exports alerts to JSON format
+func exportAlertsJSON(alerts []exportedAlert, exportDir, filename string) error {
 	fullPath := filepath.Join(exportDir, filename)
 	file, err := os.Create(fullPath)
 	if err != nil {
@@ -423,5 +358,5 @@ func (m *Model) exportServicesJSON(exportDir, filename string) error {
 
 	encoder := json.NewEncoder(file)
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(m.clusterData.Services)
+	return encoder.Encode(alerts)
 }
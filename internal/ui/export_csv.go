@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// CSV exports follow a fixed schema per view, documented in the README: a
+// header row of snake_case column names that do not depend on the locale,
+// CPU in millicores, memory and traffic in bytes, timestamps in RFC 3339 UTC
+// and multi-valued fields joined with ";". Columns are only ever appended.
+
+// csvColumn is a column of a CSV export schema
+type csvColumn[T any] struct {
+	header string
+	value  func(T) string
+}
+
+// writeCSV writes a header row and one record per row to path
+func writeCSV[T any](path string, schema []csvColumn[T], rows []T) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	record := make([]string, len(schema))
+	for i, column := range schema {
+		record[i] = column.header
+	}
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for i, column := range schema {
+			record[i] = column.value(row)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvTime formats a timestamp as RFC 3339 in UTC, or "" when unset
+func csvTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// csvInt formats an integer column
+func csvInt[T int | int32 | int64](v T) string {
+	return fmt.Sprintf("%d", v)
+}
+
+// nodeCSVSchema is the CSV schema of the Nodes view export
+var nodeCSVSchema = []csvColumn[*model.NodeData]{
+	{"name", func(n *model.NodeData) string { return n.Name }},
+	{"status", func(n *model.NodeData) string { return n.Status }},
+	{"roles", func(n *model.NodeData) string { return strings.Join(n.Roles, ";") }},
+	{"unschedulable", func(n *model.NodeData) string { return fmt.Sprintf("%t", n.Unschedulable) }},
+	{"internal_ip", func(n *model.NodeData) string { return n.InternalIP }},
+	{"cpu_usage_millicores", func(n *model.NodeData) string { return csvInt(n.CPUUsage) }},
+	{"cpu_allocatable_millicores", func(n *model.NodeData) string { return csvInt(n.CPUAllocatable) }},
+	{"memory_usage_bytes", func(n *model.NodeData) string { return csvInt(n.MemoryUsage) }},
+	{"memory_allocatable_bytes", func(n *model.NodeData) string { return csvInt(n.MemAllocatable) }},
+	{"pods", func(n *model.NodeData) string { return csvInt(n.PodCount) }},
+	{"pod_allocatable", func(n *model.NodeData) string { return csvInt(n.PodAllocatable) }},
+	{"network_rx_bytes", func(n *model.NodeData) string { return csvInt(n.NetworkRxBytes) }},
+	{"network_tx_bytes", func(n *model.NodeData) string { return csvInt(n.NetworkTxBytes) }},
+	{"kubelet_version", func(n *model.NodeData) string { return n.KubeletVersion }},
+	{"created_at", func(n *model.NodeData) string { return csvTime(n.CreationTimestamp) }},
+}
+
+// podCSVSchema is the CSV schema of the Pods view export
+var podCSVSchema = []csvColumn[*model.PodData]{
+	{"namespace", func(p *model.PodData) string { return p.Namespace }},
+	{"name", func(p *model.PodData) string { return p.Name }},
+	{"phase", func(p *model.PodData) string { return p.Phase }},
+	{"node", func(p *model.PodData) string { return p.Node }},
+	{"pod_ip", func(p *model.PodData) string { return p.PodIP }},
+	{"qos_class", func(p *model.PodData) string { return p.QOSClass }},
+	{"cpu_usage_millicores", func(p *model.PodData) string { return csvInt(p.CPUUsage) }},
+	{"cpu_request_millicores", func(p *model.PodData) string { return csvInt(p.CPURequest) }},
+	{"cpu_limit_millicores", func(p *model.PodData) string { return csvInt(p.CPULimit) }},
+	{"memory_usage_bytes", func(p *model.PodData) string { return csvInt(p.MemoryUsage) }},
+	{"memory_request_bytes", func(p *model.PodData) string { return csvInt(p.MemoryRequest) }},
+	{"memory_limit_bytes", func(p *model.PodData) string { return csvInt(p.MemoryLimit) }},
+	{"restarts", func(p *model.PodData) string { return csvInt(p.RestartCount) }},
+	{"created_at", func(p *model.PodData) string { return csvTime(p.CreationTimestamp) }},
+}
+
+// eventCSVSchema is the CSV schema of the Events view export
+var eventCSVSchema = []csvColumn[*model.EventData]{
+	{"type", func(e *model.EventData) string { return e.Type }},
+	{"reason", func(e *model.EventData) string { return e.Reason }},
+	{"kind", func(e *model.EventData) string { return e.InvolvedKind }},
+	{"namespace", func(e *model.EventData) string { return e.InvolvedNamespace }},
+	{"name", func(e *model.EventData) string { return e.InvolvedName }},
+	{"message", func(e *model.EventData) string { return e.Message }},
+	{"count", func(e *model.EventData) string { return csvInt(e.Count) }},
+	{"first_seen", func(e *model.EventData) string { return csvTime(e.FirstTimestamp) }},
+	{"last_seen", func(e *model.EventData) string { return csvTime(e.LastTimestamp) }},
+}
+
+// serviceCSVSchema is the CSV schema of the Network view export
+var serviceCSVSchema = []csvColumn[*model.ServiceData]{
+	{"namespace", func(s *model.ServiceData) string { return s.Namespace }},
+	{"name", func(s *model.ServiceData) string { return s.Name }},
+	{"type", func(s *model.ServiceData) string { return s.Type }},
+	{"cluster_ip", func(s *model.ServiceData) string { return s.ClusterIP }},
+	{"external_ips", func(s *model.ServiceData) string { return strings.Join(s.ExternalIPs, ";") }},
+	{"ports", func(s *model.ServiceData) string {
+		ports := make([]string, 0, len(s.Ports))
+		for _, port := range s.Ports {
+			ports = append(ports, fmt.Sprintf("%d/%s", port.Port, port.Protocol))
+		}
+		return strings.Join(ports, ";")
+	}},
+	{"endpoints", func(s *model.ServiceData) string { return csvInt(s.EndpointCount) }},
+	{"created_at", func(s *model.ServiceData) string { return csvTime(s.CreationTimestamp) }},
+}
+
+// alertCSVSchema is the CSV schema of the Alerts view export
+var alertCSVSchema = []csvColumn[exportedAlert]{
+	{"severity", func(a exportedAlert) string { return a.Severity }},
+	{"category", func(a exportedAlert) string { return a.Category }},
+	{"type", func(a exportedAlert) string { return a.Type }},
+	{"resource_type", func(a exportedAlert) string { return a.ResourceType }},
+	{"namespace", func(a exportedAlert) string { return a.Namespace }},
+	{"resource", func(a exportedAlert) string { return a.Resource }},
+	{"value", func(a exportedAlert) string { return a.Value }},
+	{"threshold", func(a exportedAlert) string { return a.Threshold }},
+	{"message", func(a exportedAlert) string { return a.Message }},
+	{"recommended_action", func(a exportedAlert) string { return a.RecommendedAction }},
+	{"acknowledged", func(a exportedAlert) string { return fmt.Sprintf("%t", a.Acknowledged) }},
+	{"timestamp", func(a exportedAlert) string { return csvTime(a.Timestamp) }},
+}
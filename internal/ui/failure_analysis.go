@@ -0,0 +1,206 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/k8s-monitor/internal/diagnostic"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// Relevance of failure reasons, most telling first
+const (
+	failurePriorityOOM = iota
+	failurePriorityImagePull
+	failurePriorityExitCode
+	failurePriorityEvicted
+	failurePriorityCrashLoop
+	failurePriorityPodReason
+)
+
+// failureCause is the likely cause of a job failure, derived from the
+// termination reasons of its pods
+type failureCause struct {
+	priority  int
+	alertType model.AlertType // Selects the recommended action
+	reason    string          // e.g. OOMKilled, ImagePullBackOff, Error
+	exitCode  int32           // Non-zero exit code of the container, if any
+	message   string
+	pod       *model.PodData // First pod failing for this reason
+	container string
+	pods      int // Number of pods failing for the same reason
+}
+
+// isImagePullReason reports whether a waiting reason is an image pull failure
+func isImagePullReason(reason string) bool {
+	switch reason {
+	case "ImagePullBackOff", "ErrImagePull", "InvalidImageName", "ErrImageNeverPull":
+		return true
+	}
+	return false
+}
+
+// podFailureCause returns the most relevant failure reason of a single pod,
+// or nil when the pod shows no sign of failure
+func podFailureCause(pod *model.PodData) *failureCause {
+	var best *failureCause
+	consider := func(c *failureCause) {
+		if best == nil || c.priority < best.priority {
+			best = c
+		}
+	}
+
+	for _, cs := range pod.ContainerStates {
+		last := cs.LastTermination
+		switch {
+		case cs.State == "Terminated" && cs.Reason == "OOMKilled":
+			consider(&failureCause{priority: failurePriorityOOM, alertType: model.AlertTypePodOOMKilled,
+				reason: cs.Reason, exitCode: cs.ExitCode, message: cs.Message, container: cs.Name})
+		case last != nil && last.Reason == "OOMKilled":
+			consider(&failureCause{priority: failurePriorityOOM, alertType: model.AlertTypePodOOMKilled,
+				reason: last.Reason, exitCode: last.ExitCode, message: last.Message, container: cs.Name})
+		case cs.State == "Waiting" && isImagePullReason(cs.Reason):
+			consider(&failureCause{priority: failurePriorityImagePull, alertType: model.AlertTypePodImagePullBackOff,
+				reason: cs.Reason, message: cs.Message, container: cs.Name})
+		case cs.State == "Terminated" && cs.ExitCode != 0:
+			consider(&failureCause{priority: failurePriorityExitCode, alertType: model.AlertTypePodFailed,
+				reason: cs.Reason, exitCode: cs.ExitCode, message: cs.Message, container: cs.Name})
+		case cs.State == "Waiting" && cs.Reason == "CrashLoopBackOff":
+			c := &failureCause{priority: failurePriorityCrashLoop, alertType: model.AlertTypePodCrashLoopBackOff,
+				reason: cs.Reason, message: cs.Message, container: cs.Name}
+			if last != nil {
+				c.exitCode = last.ExitCode
+			}
+			consider(c)
+		}
+	}
+
+	switch {
+	case pod.Reason == "Evicted":
+		consider(&failureCause{priority: failurePriorityEvicted, alertType: model.AlertTypePodEvicted,
+			reason: pod.Reason, message: pod.Message})
+	case pod.Phase == "Failed":
+		reason := pod.Reason
+		if reason == "" {
+			reason = pod.Phase
+		}
+		consider(&failureCause{priority: failurePriorityPodReason, alertType: model.AlertTypePodFailed,
+			reason: reason, message: pod.Message})
+	}
+
+	if best != nil {
+		best.pod = pod
+		if best.reason == "" {
+			best.reason = "Error"
+		}
+	}
+	return best
+}
+
+// analyzeJobFailure returns the most relevant failure cause among a job's pods:
+// the most telling reason, and among equally telling ones the most common.
+// It returns nil when no pod shows a failure.
+func analyzeJobFailure(pods []*model.PodData) *failureCause {
+	groups := make(map[string]*failureCause)
+	var order []string
+	for _, pod := range pods {
+		c := podFailureCause(pod)
+		if c == nil {
+			continue
+		}
+		key := fmt.Sprintf("%d/%s", c.priority, c.reason)
+		if g, ok := groups[key]; ok {
+			g.pods++
+			continue
+		}
+		c.pods = 1
+		groups[key] = c
+		order = append(order, key)
+	}
+
+	var best *failureCause
+	for _, key := range order {
+		c := groups[key]
+		if best == nil || c.priority < best.priority || (c.priority == best.priority && c.pods > best.pods) {
+			best = c
+		}
+	}
+	return best
+}
+
+// recommendedAction returns the locale-aware recommended action for a failure cause
+func (m *Model) recommendedAction(cause *failureCause) string {
+	if m.isChinese() {
+		return diagnostic.GetRecommendedActionChinese(cause.alertType, cause.pod.Namespace, cause.pod.Name)
+	}
+	return diagnostic.GetRecommendedAction(cause.alertType, cause.pod.Namespace, cause.pod.Name)
+}
+
+// renderFailureAnalysis renders the "Failure analysis" section of a failed
+// job: the likely cause, where it was seen, the latest Warning event of that
+// pod and a recommended action
+func (m *Model) renderFailureAnalysis(pods []*model.PodData) string {
+	var info []string
+	info = append(info, StyleSubHeader.Render(m.T("detail.failure.title")))
+	info = append(info, "")
+
+	cause := analyzeJobFailure(pods)
+	if cause == nil {
+		info = append(info, StyleTextMuted.Render("  "+m.T("detail.failure.no_cause")))
+		return strings.Join(info, "\n")
+	}
+
+	reason := cause.reason
+	if cause.exitCode != 0 {
+		reason = m.TF("detail.failure.exit_code", map[string]interface{}{
+			"Reason": cause.reason,
+			"Code":   cause.exitCode,
+		})
+	}
+	info = append(info, fmt.Sprintf("  %s: %s",
+		StyleTextSecondary.Render(m.T("detail.failure.cause")),
+		StyleStatusNotReady.Render(reason)))
+
+	where := cause.pod.Name
+	if cause.container != "" {
+		where += " / " + cause.container
+	}
+	if cause.pods > 1 {
+		where += " " + StyleTextMuted.Render(m.TF("detail.failure.more_pods", map[string]interface{}{
+			"Count": cause.pods - 1,
+		}))
+	}
+	info = append(info, fmt.Sprintf("  %s: %s",
+		StyleTextSecondary.Render(m.T("detail.failure.seen_on")),
+		where))
+
+	maxWidth := m.width - 12
+	if maxWidth < 20 {
+		maxWidth = 20
+	}
+	if cause.message != "" {
+		info = append(info, fmt.Sprintf("  %s:", StyleTextSecondary.Render(m.T("detail.failure.message"))))
+		for _, line := range wrapLine(cause.message, maxWidth, 0) {
+			info = append(info, "    "+StyleTextMuted.Render(line))
+		}
+	}
+
+	if events := m.getPodWarningEvents(cause.pod); len(events) > 0 {
+		event := events[0]
+		info = append(info, fmt.Sprintf("  %s: %s %s",
+			StyleTextSecondary.Render(m.T("detail.failure.last_event")),
+			StyleWarning.Render(event.Reason),
+			StyleTextMuted.Render("("+m.formatAgeOrTime(eventTime(event))+")")))
+		for _, line := range wrapLine(event.Message, maxWidth, 0) {
+			info = append(info, "    "+StyleTextMuted.Render(line))
+		}
+	}
+
+	if action := m.recommendedAction(cause); action != "" {
+		info = append(info, fmt.Sprintf("  %s %s",
+			StyleHighlight.Render("→"),
+			StyleTextSecondary.Render(action)))
+	}
+
+	return strings.Join(info, "\n")
+}
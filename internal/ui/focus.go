@@ -0,0 +1,394 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// focusRefreshInterval is how often focus mode refreshes the pinned resource.
+// Only one object is fetched, so it can refresh much faster than the cluster.
+const focusRefreshInterval = 2 * time.Second
+
+// focusFetchTimeout bounds a single focus mode refresh
+const focusFetchTimeout = 10 * time.Second
+
+// Limits of what focus mode fetches per refresh
+const (
+	focusEventLimit   = 8
+	focusLogTailLines = 100
+)
+
+// focusFetcher is implemented by data providers that can fetch a single
+// resource, letting focus mode refresh it without reloading the whole cluster
+type focusFetcher interface {
+	GetNode(ctx context.Context, name string) (*model.NodeData, error)
+	GetPod(ctx context.Context, namespace, name string) (*model.PodData, error)
+	GetObjectEvents(ctx context.Context, kind, namespace, name string, limit int) ([]*model.EventData, error)
+}
+
+// backgroundRefresher is implemented by data providers that refresh the
+// whole cluster in the background. Focus mode pauses that refresh, since it
+// fetches the pinned resource itself.
+type backgroundRefresher interface {
+	PauseBackgroundRefresh()
+	ResumeBackgroundRefresh() error
+}
+
+// focusTickMsg triggers the next focus mode refresh
+type focusTickMsg struct {
+	seq int // focusSeq when the tick was scheduled
+}
+
+// focusDataMsg carries the result of a focus mode refresh
+type focusDataMsg struct {
+	seq    int // focusSeq of the request
+	pod    *model.PodData
+	node   *model.NodeData
+	events []*model.EventData
+	logs   string
+	err    error
+}
+
+// openFocus pins the pod or node of the current detail view and starts
+// refreshing it in focus mode
+func (m *Model) openFocus() tea.Cmd {
+	if _, ok := m.dataProvider.(focusFetcher); !ok {
+		m.exportMessage = "❌ " + m.T("focus.unavailable")
+		return tea.Tick(time.Second*2, func(time.Time) tea.Msg {
+			return clearExportMessageMsg{}
+		})
+	}
+
+	switch {
+	case m.currentView == ViewPodDetail && m.selectedPod != nil:
+		m.focusKind = "Pod"
+		m.focusNamespace = m.selectedPod.Namespace
+		m.focusName = m.selectedPod.Name
+		m.focusPod = m.selectedPod
+		m.focusNode = nil
+		// Follow the container last shown in the logs view, else the first one
+		m.focusContainer = m.selectedContainer
+		if m.focusContainer == "" && len(m.selectedPod.ContainerStates) > 0 {
+			m.focusContainer = m.selectedPod.ContainerStates[0].Name
+		}
+	case m.currentView == ViewNodeDetail && m.selectedNode != nil:
+		m.focusKind = "Node"
+		m.focusNamespace = ""
+		m.focusName = m.selectedNode.Name
+		m.focusNode = m.selectedNode
+		m.focusPod = nil
+		m.focusContainer = ""
+	default:
+		return nil
+	}
+
+	if refresher, ok := m.dataProvider.(backgroundRefresher); ok {
+		refresher.PauseBackgroundRefresh()
+	}
+	m.focusMode = true
+	m.focusEvents = nil
+	m.focusLogs = ""
+	m.focusErr = nil
+	m.focusUpdated = time.Time{}
+	m.focusSeq++
+	return m.fetchFocus()
+}
+
+// closeFocus leaves focus mode, resumes the background refresh and reloads
+// the cluster, which was not refreshed while focused
+func (m *Model) closeFocus() tea.Cmd {
+	if refresher, ok := m.dataProvider.(backgroundRefresher); ok {
+		if err := refresher.ResumeBackgroundRefresh(); err != nil {
+			m.logger.Warn("Failed to resume background refresh", zap.Error(err))
+		}
+	}
+	m.focusMode = false
+	m.focusSeq++ // Drop ticks and results still in flight
+	m.focusPod = nil
+	m.focusNode = nil
+	m.focusEvents = nil
+	m.focusLogs = ""
+	m.focusErr = nil
+	return m.fetchData()
+}
+
+// handleFocusKey handles keys while focus mode is shown
+func (m *Model) handleFocusKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		m.quitting = true
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Back), key.Matches(msg, m.keys.Focus):
+		return m, m.closeFocus()
+	case key.Matches(msg, m.keys.Refresh):
+		// Restart the refresh loop so only one chain of ticks is running
+		m.focusSeq++
+		return m, m.fetchFocus()
+	}
+	return m, nil
+}
+
+// fetchFocus returns a command fetching the pinned resource, its events and,
+// for pods, the tail of the focused container's logs
+func (m *Model) fetchFocus() tea.Cmd {
+	fetcher, ok := m.dataProvider.(focusFetcher)
+	if !ok {
+		return nil
+	}
+	logsGetter, _ := m.dataProvider.(interface {
+		GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64, timestamps bool) (string, error)
+	})
+
+	seq := m.focusSeq
+	kind, namespace, name, container := m.focusKind, m.focusNamespace, m.focusName, m.focusContainer
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), focusFetchTimeout)
+		defer cancel()
+
+		result := focusDataMsg{seq: seq}
+		if kind == "Pod" {
+			result.pod, result.err = fetcher.GetPod(ctx, namespace, name)
+		} else {
+			result.node, result.err = fetcher.GetNode(ctx, name)
+		}
+		if result.err != nil {
+			return result
+		}
+
+		// Events and logs are best effort; the dashboard still shows the object
+		result.events, _ = fetcher.GetObjectEvents(ctx, kind, namespace, name, focusEventLimit)
+		if kind == "Pod" && container != "" && logsGetter != nil {
+			result.logs, _ = logsGetter.GetPodLogs(ctx, namespace, name, container, focusLogTailLines, false)
+		}
+		return result
+	}
+}
+
+// handleFocusData stores a focus mode refresh and schedules the next one
+func (m *Model) handleFocusData(msg focusDataMsg) tea.Cmd {
+	if !m.focusMode || msg.seq != m.focusSeq {
+		return nil
+	}
+
+	m.focusErr = msg.err
+	if msg.err == nil {
+		if msg.pod != nil {
+			m.focusPod = msg.pod
+		}
+		if msg.node != nil {
+			m.focusNode = msg.node
+		}
+		m.focusEvents = msg.events
+		m.focusLogs = msg.logs
+		m.focusUpdated = time.Now()
+	}
+
+	seq := m.focusSeq
+	return tea.Tick(focusRefreshInterval, func(time.Time) tea.Msg {
+		return focusTickMsg{seq: seq}
+	})
+}
+
+// renderFocus renders the full-screen dashboard of the pinned resource
+func (m *Model) renderFocus() string {
+	title := m.focusName
+	if m.focusNamespace != "" {
+		title = m.focusNamespace + "/" + m.focusName
+	}
+	header := StyleHeader.Render(m.TF("focus.title", map[string]interface{}{
+		"Kind": m.focusKind,
+		"Name": title,
+	}))
+
+	var status string
+	switch {
+	case m.focusErr != nil:
+		status = StyleDanger.Render(m.TF("focus.error", map[string]interface{}{
+			"Error": m.focusErr.Error(),
+		}))
+	case m.focusUpdated.IsZero():
+		status = StyleTextMuted.Render(m.T("focus.loading"))
+	default:
+		status = StyleTextMuted.Render(m.TF("focus.refreshing", map[string]interface{}{
+			"Interval": focusRefreshInterval,
+			"Time":     m.focusUpdated.Format("15:04:05"),
+		}))
+	}
+
+	lines := []string{header, status, ""}
+	if m.focusKind == "Pod" && m.focusPod != nil {
+		lines = append(lines, m.focusPodLines(m.focusPod)...)
+	} else if m.focusNode != nil {
+		lines = append(lines, m.focusNodeLines(m.focusNode)...)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, m.focusEventLines()...)
+
+	// Logs fill whatever height is left
+	if m.focusKind == "Pod" {
+		available := m.height - 8 - len(lines) - 3
+		lines = append(lines, "")
+		lines = append(lines, m.focusLogLines(available)...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// focusPodLines renders the status, usage and containers of a pinned pod
+func (m *Model) focusPodLines(pod *model.PodData) []string {
+	lines := []string{
+		fmt.Sprintf("  %s  %s: %s  %s: %s  %s: %s  %s: %d  %s: %s",
+			RenderStatus(pod.Phase),
+			StyleTextSecondary.Render(m.T("focus.node")), pod.Node,
+			StyleTextSecondary.Render(m.T("focus.ip")), formatIPs(pod.PodIP, pod.PodIPs),
+			StyleTextSecondary.Render(m.T("focus.qos")), pod.QOSClass,
+			StyleTextSecondary.Render(m.T("focus.restarts")), pod.RestartCount,
+			StyleTextSecondary.Render(m.T("focus.age")), m.formatAgeOrTime(pod.CreationTimestamp)),
+	}
+	if pod.Reason != "" {
+		lines = append(lines, "  "+StyleWarning.Render(truncate(strings.TrimSpace(pod.Reason+" "+pod.Message), m.width-4)))
+	}
+	if pod.CPUUsage > 0 || pod.MemoryUsage > 0 {
+		lines = append(lines, fmt.Sprintf("  %s: %s / %s  %s: %s / %s",
+			StyleTextSecondary.Render(m.T("focus.cpu")), FormatMillicores(pod.CPUUsage, m.rawUnits), focusLimit(FormatMillicores(pod.CPULimit, m.rawUnits), pod.CPULimit),
+			StyleTextSecondary.Render(m.T("focus.memory")), formatMemoryBytes(pod.MemoryUsage, m.rawUnits), focusLimit(formatMemoryBytes(pod.MemoryLimit, m.rawUnits), pod.MemoryLimit)))
+	}
+
+	lines = append(lines, "", StyleHeader.Render(m.TF("focus.containers", map[string]interface{}{
+		"Ready": pod.ReadyContainers,
+		"Total": pod.Containers,
+	})))
+	for _, container := range pod.ContainerStates {
+		dot := StyleStatusReady.Render("●")
+		if !container.Ready {
+			dot = StyleStatusNotReady.Render("●")
+		}
+		state := container.State
+		if container.Reason != "" {
+			state += " (" + container.Reason + ")"
+		}
+		line := fmt.Sprintf("  %s %s  %s  %s: %d",
+			dot,
+			padRight(container.Name, 20),
+			padRight(state, 28),
+			m.T("focus.restarts"),
+			container.RestartCount)
+		if container.CPUUsage > 0 || container.MemoryUsage > 0 {
+			line += fmt.Sprintf("  %s %s", FormatMillicores(container.CPUUsage, m.rawUnits), formatMemoryBytes(container.MemoryUsage, m.rawUnits))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// focusLimit renders a resource limit, or "-" when unset
+func focusLimit(formatted string, value int64) string {
+	if value <= 0 {
+		return "-"
+	}
+	return formatted
+}
+
+// focusNodeLines renders the status, usage and conditions of a pinned node
+func (m *Model) focusNodeLines(node *model.NodeData) []string {
+	status := RenderStatus(node.Status)
+	if node.Unschedulable {
+		status += " " + StyleWarning.Render(m.T("focus.cordoned"))
+	}
+	lines := []string{
+		fmt.Sprintf("  %s  %s: %s  %s: %s  %s: %s  %s: %s",
+			status,
+			StyleTextSecondary.Render(m.T("focus.roles")), strings.Join(node.Roles, ","),
+			StyleTextSecondary.Render(m.T("focus.ip")), formatIPs(node.InternalIP, node.InternalIPs),
+			StyleTextSecondary.Render(m.T("focus.version")), node.KubeletVersion,
+			StyleTextSecondary.Render(m.T("focus.age")), m.formatAgeOrTime(node.CreationTimestamp)),
+		"",
+	}
+
+	if node.HasKubeletMetrics {
+		lines = append(lines,
+			fmt.Sprintf("  %s %s %s / %s",
+				StyleTextSecondary.Render(padRight(m.T("focus.cpu"), 8)),
+				renderProgressBar(node.CPUUsagePercent, 30),
+				FormatMillicores(node.CPUUsage, m.rawUnits), FormatMillicores(node.CPUAllocatable, m.rawUnits)),
+			fmt.Sprintf("  %s %s %s / %s",
+				StyleTextSecondary.Render(padRight(m.T("focus.memory"), 8)),
+				renderProgressBar(node.MemoryUsagePercent, 30),
+				formatMemoryBytes(node.MemoryUsage, m.rawUnits), formatMemoryBytes(node.MemAllocatable, m.rawUnits)),
+		)
+	} else {
+		lines = append(lines, "  "+StyleTextMuted.Render(m.T("focus.no_metrics")))
+	}
+
+	lines = append(lines, "", StyleHeader.Render(m.T("focus.conditions")))
+	for _, cond := range node.Conditions {
+		// Ready should be True; every other condition signals trouble when True
+		healthy := (cond.Type == corev1.NodeReady) == (cond.Status == corev1.ConditionTrue)
+		style := StyleStatusReady
+		if !healthy {
+			style = StyleDanger
+		}
+		line := fmt.Sprintf("  %s %s", padRight(string(cond.Type), 22), style.Render(string(cond.Status)))
+		if !healthy && cond.Message != "" {
+			line += "  " + StyleTextMuted.Render(truncate(cond.Message, max(m.width-40, 20)))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// focusEventLines renders the most recent events of the pinned resource
+func (m *Model) focusEventLines() []string {
+	lines := []string{StyleHeader.Render(m.T("focus.events"))}
+	if len(m.focusEvents) == 0 {
+		return append(lines, "  "+StyleTextMuted.Render(m.T("focus.no_events")))
+	}
+
+	for _, event := range m.focusEvents {
+		reasonStyle := StyleTextSecondary
+		if event.Type == "Warning" {
+			reasonStyle = StyleWarning
+		}
+		reason := event.Reason
+		if event.Count > 1 {
+			reason = fmt.Sprintf("%s (x%d)", reason, event.Count)
+		}
+		lines = append(lines, fmt.Sprintf("  %s  %s  %s",
+			StyleTextMuted.Render(padRight(m.formatAgeOrTime(eventTime(event)), m.ageColumnWidth(6))),
+			reasonStyle.Render(padRight(reason, 24)),
+			truncate(event.Message, max(m.width-40, 20))))
+	}
+	return lines
+}
+
+// focusLogLines renders the last log lines of the focused container that fit
+// in the given height
+func (m *Model) focusLogLines(available int) []string {
+	lines := []string{StyleHeader.Render(m.TF("focus.logs", map[string]interface{}{
+		"Container": m.focusContainer,
+	}))}
+
+	logs := strings.Split(strings.TrimRight(m.focusLogs, "\n"), "\n")
+	if strings.TrimSpace(m.focusLogs) == "" {
+		return append(lines, "  "+StyleTextMuted.Render(m.T("focus.no_logs")))
+	}
+
+	available = max(available, 3)
+	if len(logs) > available {
+		logs = logs[len(logs)-available:]
+	}
+	for _, line := range logs {
+		lines = append(lines, "  "+truncate(strings.ReplaceAll(line, "\t", "    "), max(m.width-4, 20)))
+	}
+	return lines
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Fuzzy match scoring weights
+const (
+	fuzzyMatchScore       = 1 // Each matched character
+	fuzzyConsecutiveBonus = 5 // Match directly after the previous match
+	fuzzyStartBonus       = 8 // Match at the start of the candidate
+	fuzzyBoundaryBonus    = 4 // Match after a separator such as "-" or "."
+	fuzzyMaxLeadingGap    = 3 // Cap on the penalty for characters before the first match
+)
+
+// fuzzyScore matches pattern as a case-insensitive subsequence of candidate.
+// It returns false when not all pattern characters appear in order; otherwise
+// a higher score means a tighter match (e.g. "ngx" scores "nginx" above "ingress-nginx").
+func fuzzyScore(pattern, candidate string) (int, bool) {
+	pattern = strings.ToLower(pattern)
+	candidate = strings.ToLower(candidate)
+	if pattern == "" {
+		return 0, true
+	}
+
+	score := 0
+	lastMatch := -1
+	prev := rune(0)
+	patternRune, patternSize := utf8.DecodeRuneInString(pattern)
+
+	for i, c := range candidate {
+		if c == patternRune {
+			score += fuzzyMatchScore
+			switch {
+			case i == 0:
+				score += fuzzyStartBonus
+			case lastMatch >= 0 && lastMatch+utf8.RuneLen(prev) == i:
+				score += fuzzyConsecutiveBonus
+			case isFuzzySeparator(prev):
+				score += fuzzyBoundaryBonus
+			}
+
+			// Penalize skipped characters
+			if lastMatch >= 0 {
+				score -= utf8.RuneCountInString(candidate[lastMatch:i]) - 1
+			} else {
+				score -= min(utf8.RuneCountInString(candidate[:i]), fuzzyMaxLeadingGap)
+			}
+
+			lastMatch = i
+			pattern = pattern[patternSize:]
+			if pattern == "" {
+				return score, true
+			}
+			patternRune, patternSize = utf8.DecodeRuneInString(pattern)
+		}
+		prev = c
+	}
+
+	return 0, false
+}
+
+// isFuzzySeparator reports whether r separates words in resource names and messages
+func isFuzzySeparator(r rune) bool {
+	switch r {
+	case '-', '_', '.', '/', ':', ' ':
+		return true
+	}
+	return false
+}
+
+// searchMatch checks the current search text against candidates. With fuzzy
+// search enabled it returns the best fuzzy score; otherwise it is a
+// case-insensitive substring match and the score is always 0.
+func (m *Model) searchMatch(candidates ...string) (int, bool) {
+	if m.searchText == "" {
+		return 0, true
+	}
+
+	if !m.fuzzySearch {
+		searchLower := strings.ToLower(m.searchText)
+		for _, candidate := range candidates {
+			if strings.Contains(strings.ToLower(candidate), searchLower) {
+				return 0, true
+			}
+		}
+		return 0, false
+	}
+
+	best, matched := 0, false
+	for _, candidate := range candidates {
+		if score, ok := fuzzyScore(m.searchText, candidate); ok && (!matched || score > best) {
+			best, matched = score, true
+		}
+	}
+	return best, matched
+}
+
+// fuzzyRanking reports whether search results are ordered by match score
+// instead of the view's sort field
+func (m *Model) fuzzyRanking() bool {
+	return m.fuzzySearch && m.searchText != ""
+}
+
+// sortByFuzzyScore orders items by descending score (scores[i] belongs to
+// items[i]), keeping the original order for equal scores
+func sortByFuzzyScore[T any](items []T, scores []int) []T {
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+
+	ranked := make([]T, len(items))
+	for i, idx := range order {
+		ranked[i] = items[idx]
+	}
+	return ranked
+}
@@ -0,0 +1,214 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// globalSearchPerKind limits the matches listed per kind in the global search
+const globalSearchPerKind = 5
+
+// globalSearchKinds is the order result groups are listed in
+var globalSearchKinds = []string{
+	"Node", "Pod", "Service", "Deployment", "StatefulSet", "DaemonSet",
+	"Job", "CronJob", "PV", "PVC", "Event",
+}
+
+// searchEntry is a resource or event in the global search index
+type searchEntry struct {
+	paletteEntry
+	text   string // Lowercased text the query is matched against
+	detail string // Shown after the name, e.g. the reason of an event
+}
+
+// searchGroup is the matches of one kind in the global search
+type searchGroup struct {
+	kind    string
+	entries []searchEntry // At most globalSearchPerKind entries
+	total   int
+}
+
+// buildSearchIndex indexes the palette resources and all events for the
+// global search, with the text each is matched against lowercased up front.
+// It is rebuilt on each refresh like the palette index.
+func buildSearchIndex(data *model.ClusterData, palette []paletteEntry) []searchEntry {
+	if data == nil {
+		return nil
+	}
+
+	index := make([]searchEntry, 0, len(palette)+len(data.Events))
+	for _, entry := range palette {
+		fields := []string{entry.name, entry.namespace}
+		switch target := entry.target.(type) {
+		case *model.PodData:
+			fields = append(fields, target.Node, target.PodIP)
+		case *model.NodeData:
+			fields = append(fields, target.InternalIP)
+		case *model.ServiceData:
+			fields = append(fields, target.ClusterIP)
+		}
+		index = append(index, searchEntry{
+			paletteEntry: entry,
+			text:         strings.ToLower(strings.Join(fields, " ")),
+		})
+	}
+	for _, event := range data.Events {
+		index = append(index, searchEntry{
+			paletteEntry: paletteEntry{"Event", event.InvolvedNamespace, event.InvolvedObject, event},
+			text:         strings.ToLower(strings.Join([]string{event.InvolvedObject, event.InvolvedNamespace, event.Reason, event.Message}, " ")),
+			detail:       event.Reason,
+		})
+	}
+	return index
+}
+
+// globalSearchResults returns the index entries containing every word of the
+// global search query, grouped by kind
+func (m *Model) globalSearchResults() []searchGroup {
+	words := strings.Fields(strings.ToLower(m.globalSearchText))
+	if len(words) == 0 {
+		return nil
+	}
+
+	groups := make(map[string]*searchGroup)
+	for _, entry := range m.searchIndex {
+		matched := true
+		for _, word := range words {
+			if !strings.Contains(entry.text, word) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		group := groups[entry.kind]
+		if group == nil {
+			group = &searchGroup{kind: entry.kind}
+			groups[entry.kind] = group
+		}
+		group.total++
+		if len(group.entries) < globalSearchPerKind {
+			group.entries = append(group.entries, entry)
+		}
+	}
+
+	var results []searchGroup
+	for _, kind := range globalSearchKinds {
+		if group := groups[kind]; group != nil {
+			results = append(results, *group)
+		}
+	}
+	return results
+}
+
+// globalSearchMatches returns the listed matches of all groups in order, as
+// they are selected with up and down
+func globalSearchMatches(groups []searchGroup) []searchEntry {
+	var matches []searchEntry
+	for _, group := range groups {
+		matches = append(matches, group.entries...)
+	}
+	return matches
+}
+
+// openGlobalSearch shows the global search with an empty query
+func (m *Model) openGlobalSearch() {
+	m.globalSearchMode = true
+	m.globalSearchText = ""
+	m.globalSearchSelected = 0
+}
+
+// handleGlobalSearchKey handles keys while the global search is visible
+func (m *Model) handleGlobalSearchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Type == tea.KeyUp:
+		if m.globalSearchSelected > 0 {
+			m.globalSearchSelected--
+		}
+	case msg.Type == tea.KeyDown:
+		if m.globalSearchSelected < len(globalSearchMatches(m.globalSearchResults()))-1 {
+			m.globalSearchSelected++
+		}
+	case key.Matches(msg, m.keys.Enter):
+		matches := globalSearchMatches(m.globalSearchResults())
+		if m.globalSearchSelected < len(matches) {
+			m.globalSearchMode = false
+			m.openPaletteEntry(matches[m.globalSearchSelected].paletteEntry)
+		}
+	case msg.Type == tea.KeyEsc, key.Matches(msg, m.keys.GlobalSearch):
+		m.globalSearchMode = false
+	case msg.Type == tea.KeyBackspace || msg.Type == tea.KeyDelete:
+		if len(m.globalSearchText) > 0 {
+			m.globalSearchText = m.globalSearchText[:len(m.globalSearchText)-1]
+			m.globalSearchSelected = 0
+		}
+	case msg.Type == tea.KeySpace:
+		m.globalSearchText += " "
+		m.globalSearchSelected = 0
+	case msg.Type == tea.KeyRunes:
+		m.globalSearchText += string(msg.Runes)
+		m.globalSearchSelected = 0
+	case msg.String() == "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// renderGlobalSearch renders the global search overlay: the query input and
+// the matches grouped by kind
+func (m *Model) renderGlobalSearch() string {
+	var lines []string
+
+	lines = append(lines, StyleHeader.Render(m.T("global_search.title")))
+	lines = append(lines, "")
+	lines = append(lines, renderTextInput(m.globalSearchText))
+	lines = append(lines, "")
+
+	groups := m.globalSearchResults()
+	switch {
+	case strings.TrimSpace(m.globalSearchText) == "":
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("global_search.placeholder")))
+	case len(groups) == 0:
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("palette.no_matches")))
+	}
+
+	maxWidth := m.width - 4
+	if maxWidth < 40 {
+		maxWidth = 40
+	}
+	selected := 0
+	for _, group := range groups {
+		lines = append(lines, StyleSubHeader.Render(fmt.Sprintf("  %s (%d)", group.kind, group.total)))
+		for _, entry := range group.entries {
+			name := entry.name
+			if entry.namespace != "" {
+				name = entry.namespace + "/" + entry.name
+			}
+			if entry.detail != "" {
+				name += "  " + StyleTextMuted.Render(entry.detail)
+			}
+			line := "    " + truncate(name, maxWidth-4)
+			if selected == m.globalSearchSelected {
+				line = StyleSelected.Render(line)
+			}
+			lines = append(lines, line)
+			selected++
+		}
+		if more := group.total - len(group.entries); more > 0 {
+			lines = append(lines, StyleTextMuted.Render("    "+m.TF("global_search.more", map[string]interface{}{
+				"Count": more,
+			})))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, StyleTextMuted.Render("  "+m.T("global_search.help")))
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// healthBarHeight is the number of lines the health bar adds under the header
+const healthBarHeight = 1
+
+// Usage percentages at which the health bar turns warning or critical
+const (
+	healthUsageWarnPercent     = 75.0
+	healthUsageCriticalPercent = 90.0
+)
+
+// healthSeverity is the worst state summarized by the health bar
+type healthSeverity int
+
+const (
+	healthOK healthSeverity = iota
+	healthWarning
+	healthCritical
+)
+
+// clusterHealthSeverity returns the worst severity of a cluster summary:
+// critical for NotReady nodes, critical alerts or near full CPU or memory,
+// warning for pending or failed pods, warning alerts or high usage
+func clusterHealthSeverity(summary *model.ClusterSummary, critical, warning int) healthSeverity {
+	maxUsage := summary.CPUUsageUtilization
+	if summary.MemUsageUtilization > maxUsage {
+		maxUsage = summary.MemUsageUtilization
+	}
+	switch {
+	case summary.NotReadyNodes > 0, critical > 0, maxUsage >= healthUsageCriticalPercent:
+		return healthCritical
+	case summary.PendingPods > 0, summary.FailedPods > 0, warning > 0, maxUsage >= healthUsageWarnPercent:
+		return healthWarning
+	}
+	return healthOK
+}
+
+// renderHealthBar renders the one-line cluster health summary shown under the
+// header in every view, colored by the worst severity. Acknowledged and muted
+// alerts are not counted.
+func (m *Model) renderHealthBar() string {
+	if m.clusterData == nil || m.clusterData.Summary == nil {
+		return ""
+	}
+	summary := m.clusterData.Summary
+
+	critical, warning := 0, 0
+	active, _ := m.getDisplayedAlerts()
+	for _, alert := range active {
+		switch alert.Severity {
+		case model.AlertSeverityCritical:
+			critical++
+		case model.AlertSeverityWarning:
+			warning++
+		}
+	}
+
+	nodesMark := "✓"
+	if summary.NotReadyNodes > 0 {
+		nodesMark = "✗"
+	}
+	parts := []string{
+		fmt.Sprintf("%s %d/%d %s", m.T("health.nodes"), summary.ReadyNodes, summary.TotalNodes, nodesMark),
+		m.TF("health.pods", map[string]interface{}{
+			"Running": summary.RunningPods,
+			"Pending": summary.PendingPods,
+			"Failed":  summary.FailedPods,
+		}),
+	}
+	switch {
+	case critical > 0:
+		parts = append(parts, m.TF("health.critical_alerts", map[string]interface{}{"Count": critical}))
+	case warning > 0:
+		parts = append(parts, m.TF("health.warning_alerts", map[string]interface{}{"Count": warning}))
+	default:
+		parts = append(parts, m.T("health.no_alerts"))
+	}
+	if summary.CPUUsed > 0 || summary.MemoryUsed > 0 {
+		parts = append(parts, fmt.Sprintf("CPU %.0f%% %s %.0f%%",
+			summary.CPUUsageUtilization, m.T("health.memory"), summary.MemUsageUtilization))
+	}
+
+	var style lipgloss.Style
+	switch clusterHealthSeverity(summary, critical, warning) {
+	case healthCritical:
+		style = StyleDanger
+	case healthWarning:
+		style = StyleWarning
+	default:
+		style = StyleStatusReady
+	}
+	return style.Render(truncate(strings.Join(parts, " · "), m.width))
+}
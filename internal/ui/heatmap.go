@@ -0,0 +1,192 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// heatmapCellWidth is the width of one node cell including the gap to the next
+const heatmapCellWidth = 20
+
+// toggleHeatmap switches between the Nodes list and the node heatmap, keeping
+// the selected node
+func (m *Model) toggleHeatmap() {
+	if m.currentView == ViewHeatmap {
+		m.currentView = ViewNodes
+	} else {
+		m.currentView = ViewHeatmap
+	}
+	m.scrollOffset = 0
+	maxIndex := m.getMaxIndex()
+	if m.selectedIndex >= maxIndex {
+		m.selectedIndex = maxIndex - 1
+	}
+	if m.selectedIndex < 0 {
+		m.selectedIndex = 0
+	}
+}
+
+// heatmapNodes returns the nodes shown in the heatmap, in Nodes view order
+func (m *Model) heatmapNodes() []*model.NodeData {
+	if m.clusterData == nil {
+		return nil
+	}
+	return m.getSortedNodes(m.getFilteredNodes())
+}
+
+// heatmapColumns returns how many node cells fit in one row
+func (m *Model) heatmapColumns() int {
+	columns := (m.width - 2) / heatmapCellWidth
+	if columns < 1 {
+		columns = 1
+	}
+	return columns
+}
+
+// moveHeatmapSelection moves the selected cell by delta, staying on the grid
+func (m *Model) moveHeatmapSelection(delta int) {
+	target := m.selectedIndex + delta
+	if target < 0 || target >= m.getMaxIndex() {
+		return
+	}
+	m.selectedIndex = target
+}
+
+// nodeUsagePercent returns the CPU and memory usage of a node as a percentage
+// of its allocatable resources; ok is false when no metrics are available
+func nodeUsagePercent(node *model.NodeData) (cpu, memory float64, ok bool) {
+	if node.CPUAllocatable > 0 && node.CPUUsage > 0 {
+		cpu = float64(node.CPUUsage) / float64(node.CPUAllocatable) * 100
+		ok = true
+	}
+	if node.MemAllocatable > 0 && node.MemoryUsage > 0 {
+		memory = float64(node.MemoryUsage) / float64(node.MemAllocatable) * 100
+		ok = true
+	}
+	return cpu, memory, ok
+}
+
+// heatmapColor maps a usage percentage to a cell color, using the same
+// thresholds as the overview progress bars
+func heatmapColor(percent float64) lipgloss.Color {
+	switch {
+	case percent >= 90:
+		return ColorDanger
+	case percent >= 75:
+		return ColorWarning
+	case percent >= 50:
+		return ColorCaution
+	default:
+		return ColorSuccess
+	}
+}
+
+// renderHeatmapCell renders a node as a colored cell with its truncated name
+// and the higher of its CPU and memory usage
+func renderHeatmapCell(node *model.NodeData, selected bool) string {
+	const nameWidth = heatmapCellWidth - 8
+	cpu, memory, ok := nodeUsagePercent(node)
+	usage := "   -"
+	style := lipgloss.NewStyle().Background(ColorBgSecondary).Foreground(ColorTextMuted)
+	if ok {
+		percent := max(cpu, memory)
+		usage = fmt.Sprintf("%3.0f%%", percent)
+		style = lipgloss.NewStyle().Background(heatmapColor(percent)).Foreground(ColorTextInverse)
+	}
+	if node.Status != "Ready" {
+		style = style.Strikethrough(true)
+	}
+	if selected {
+		style = style.Bold(true).Underline(true)
+	}
+
+	content := fmt.Sprintf(" %s %s ", padRight(truncate(node.Name, nameWidth), nameWidth), usage)
+	return style.Render(content)
+}
+
+// renderHeatmap renders all nodes as a grid of cells colored by their CPU or
+// memory pressure, with the details of the selected node below the grid
+func (m *Model) renderHeatmap() string {
+	nodes := m.heatmapNodes()
+	if len(nodes) == 0 {
+		return StyleTextMuted.Render(m.T("heatmap.no_nodes"))
+	}
+
+	var lines []string
+	lines = append(lines, StyleHeader.Render("🌡 "+m.T("heatmap.title"))+"  "+
+		StyleTextMuted.Render(m.TF("heatmap.count", map[string]interface{}{"Count": len(nodes)})))
+	lines = append(lines, m.renderHeatmapLegend(), "")
+
+	columns := m.heatmapColumns()
+	var rows []string
+	for start := 0; start < len(nodes); start += columns {
+		end := min(start+columns, len(nodes))
+		var cells []string
+		for i := start; i < end; i++ {
+			cells = append(cells, renderHeatmapCell(nodes[i], i == m.selectedIndex))
+		}
+		rows = append(rows, strings.Join(cells, " "))
+	}
+
+	// Keep the row of the selected node visible
+	maxVisible := m.height - 16
+	if maxVisible < 3 {
+		maxVisible = 3
+	}
+	selectedRow := m.selectedIndex / columns
+	startRow := 0
+	if selectedRow >= maxVisible {
+		startRow = selectedRow - maxVisible + 1
+	}
+	endRow := min(startRow+maxVisible, len(rows))
+	lines = append(lines, rows[startRow:endRow]...)
+	if len(rows) > maxVisible {
+		lines = append(lines, StyleTextMuted.Render(fmt.Sprintf("[%d-%d %s %d]",
+			startRow+1, endRow, m.T("common.of"), len(rows))))
+	}
+
+	if m.selectedIndex >= 0 && m.selectedIndex < len(nodes) {
+		lines = append(lines, "", m.renderHeatmapSelection(nodes[m.selectedIndex]))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderHeatmapLegend explains the cell colors
+func (m *Model) renderHeatmapLegend() string {
+	swatch := func(color lipgloss.Color, label string) string {
+		return lipgloss.NewStyle().Background(color).Foreground(ColorTextInverse).Render(" " + label + " ")
+	}
+	return strings.Join([]string{
+		StyleTextMuted.Render(m.T("heatmap.legend")),
+		swatch(ColorSuccess, "<50%"),
+		swatch(ColorCaution, "50-75%"),
+		swatch(ColorWarning, "75-90%"),
+		swatch(ColorDanger, "≥90%"),
+		lipgloss.NewStyle().Background(ColorBgSecondary).Foreground(ColorTextMuted).Render(" " + m.T("heatmap.no_metrics") + " "),
+	}, " ")
+}
+
+// renderHeatmapSelection renders the full name and usage of the selected node
+func (m *Model) renderHeatmapSelection(node *model.NodeData) string {
+	cpu, memory, ok := nodeUsagePercent(node)
+	usage := StyleTextMuted.Render(m.T("heatmap.no_metrics"))
+	if ok {
+		usage = fmt.Sprintf("%s %s  %s %s",
+			m.T("columns.cpu"), renderUsagePercent(cpu),
+			m.T("columns.memory"), renderUsagePercent(memory))
+	}
+	return fmt.Sprintf("%s  %s  %s  %s",
+		StyleHighlight.Render(node.Name),
+		RenderStatus(node.Status),
+		usage,
+		StyleTextMuted.Render(m.T("heatmap.open_hint")))
+}
+
+// renderUsagePercent renders a usage percentage in its heatmap color
+func renderUsagePercent(percent float64) string {
+	return lipgloss.NewStyle().Foreground(heatmapColor(percent)).Render(fmt.Sprintf("%.1f%%", percent))
+}
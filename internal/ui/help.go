@@ -0,0 +1,200 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// helpEntry is a key binding listed on the help screen
+type helpEntry struct {
+	keys    string // Keys as shown to the user, e.g. "↑/k"
+	desc    string // i18n key of the description
+	viewKey string // i18n key of the view the binding is limited to ("" = everywhere)
+}
+
+// helpSection groups the bindings of one context
+type helpSection struct {
+	title   string // i18n key of the section title
+	entries []helpEntry
+}
+
+// bindingHelp returns the help entry of a key map binding, so the help screen
+// shows the keys actually bound. Disabled bindings are left out.
+func bindingHelp(binding key.Binding, desc, viewKey string) (helpEntry, bool) {
+	if !binding.Enabled() {
+		return helpEntry{}, false
+	}
+	keys := binding.Help().Key
+	if keys == "" {
+		keys = strings.Join(binding.Keys(), "/")
+	}
+	return helpEntry{keys: keys, desc: desc, viewKey: viewKey}, true
+}
+
+// helpSections returns all key bindings grouped by context
+func (m *Model) helpSections() []helpSection {
+	k := m.keys
+	section := func(title string, entries ...interface{}) helpSection {
+		s := helpSection{title: title}
+		for _, e := range entries {
+			switch e := e.(type) {
+			case helpEntry:
+				s.entries = append(s.entries, e)
+			case func() (helpEntry, bool):
+				if entry, ok := e(); ok {
+					s.entries = append(s.entries, entry)
+				}
+			}
+		}
+		return s
+	}
+	bind := func(binding key.Binding, desc, viewKey string) func() (helpEntry, bool) {
+		return func() (helpEntry, bool) { return bindingHelp(binding, desc, viewKey) }
+	}
+
+	return []helpSection{
+		section("help.section.global",
+			bind(k.Help, "keys.help", ""),
+			bind(k.Quit, "keys.quit", ""),
+			bind(k.Refresh, "keys.refresh", ""),
+			bind(k.Pause, "keys.pause", ""),
+			helpEntry{"1-9", "keys.views", ""},
+			bind(k.Tab, "keys.next", ""),
+			bind(k.Palette, "keys.jump", ""),
+			bind(k.GlobalSearch, "keys.search_all", ""),
+			bind(k.Scope, "keys.scope", ""),
+			bind(k.SwitchContext, "keys.context", ""),
+			bind(k.TimeFormat, "keys.time_format", ""),
+			bind(k.Units, "keys.units", ""),
+			bind(k.Export, "keys.export", ""),
+		),
+		section("help.section.list",
+			bind(k.Up, "keys.up", ""),
+			bind(k.Down, "keys.down", ""),
+			helpEntry{"PgUp/PgDn", "keys.page", ""},
+			bind(k.Enter, "keys.detail", ""),
+			bind(k.Sort, "keys.sort", ""),
+			bind(k.Search, "keys.search", ""),
+			bind(k.Filter, "keys.filter", ""),
+			bind(k.ClearFilter, "keys.clear", ""),
+			bind(k.Enter, "keys.top_pods", "views.overview.name"),
+			bind(k.Heatmap, "keys.heatmap", "views.nodes.name"),
+			bind(k.Mark, "keys.mark", "views.nodes.name"),
+			bind(k.Compare, "keys.compare", "views.nodes.name"),
+			bind(k.Group, "keys.group", "views.pods.name"),
+			bind(k.Completed, "keys.completed", "views.pods.name"),
+			bind(k.Namespace, "keys.namespace", "views.pods.name"),
+			helpEntry{"w", "keys.rate_window", "views.network.name"},
+			bind(k.AllEvents, "keys.all_events", "views.events.name"),
+			bind(k.AckAlert, "keys.ack", "views.alerts.name"),
+			bind(k.MuteAlert, "keys.mute", "views.alerts.name"),
+		),
+		section("help.section.detail",
+			helpEntry{"↑/↓", "keys.scroll", ""},
+			bind(k.Back, "keys.back", ""),
+			bind(k.Logs, "keys.logs", ""),
+			bind(k.Actions, "keys.actions", ""),
+			bind(k.YAML, "keys.yaml", ""),
+			bind(k.Focus, "keys.focus", ""),
+			bind(k.Annotations, "keys.annotations", ""),
+		),
+		section("help.section.logs",
+			helpEntry{"↑/↓", "keys.scroll", ""},
+			helpEntry{"PgUp/PgDn", "keys.page", ""},
+			bind(k.Search, "keys.search", ""),
+			helpEntry{"+/-", "keys.tail_lines", ""},
+			helpEntry{"t", "keys.timestamps", ""},
+			helpEntry{"w", "keys.wrap", ""},
+			helpEntry{"J", "keys.json", ""},
+			bind(k.Back, "keys.back", ""),
+		),
+		section("help.section.search",
+			helpEntry{"text", "keys.type_to_search", ""},
+			helpEntry{"backspace", "keys.delete", ""},
+			helpEntry{"ctrl+f", "keys.fuzzy", ""},
+			bind(k.Enter, "keys.apply", ""),
+			bind(k.Back, "keys.cancel", ""),
+		),
+	}
+}
+
+// helpLines renders the help sections as lines
+func (m *Model) helpLines() []string {
+	var lines []string
+	for i, section := range m.helpSections() {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, StyleHeader.Render(m.T(section.title)))
+		for _, entry := range section.entries {
+			line := fmt.Sprintf("  %s %s", StyleKey.Render(padRight(entry.keys, 12)), m.T(entry.desc))
+			if entry.viewKey != "" {
+				line += StyleTextMuted.Render(" (" + m.T(entry.viewKey) + ")")
+			}
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// helpVisibleLines returns the number of help lines that fit the screen
+func (m *Model) helpVisibleLines() int {
+	visible := m.height - 8
+	if visible < 1 {
+		visible = 1
+	}
+	return visible
+}
+
+// clampHelpScroll keeps the help scroll offset within the help text
+func (m *Model) clampHelpScroll() {
+	maxScroll := len(m.helpLines()) - m.helpVisibleLines()
+	if m.helpScrollOffset > maxScroll {
+		m.helpScrollOffset = maxScroll
+	}
+	if m.helpScrollOffset < 0 {
+		m.helpScrollOffset = 0
+	}
+}
+
+// renderHelp renders the visible part of the help screen
+func (m *Model) renderHelp() string {
+	lines := m.helpLines()
+	m.clampHelpScroll()
+
+	end := m.helpScrollOffset + m.helpVisibleLines()
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	title := StyleHeader.Render("⌨  " + m.T("help.title"))
+	if len(lines) > m.helpVisibleLines() {
+		title += StyleTextMuted.Render(fmt.Sprintf("  [%d-%d %s %d]",
+			m.helpScrollOffset+1, end, m.T("common.of"), len(lines)))
+	}
+	return title + "\n\n" + strings.Join(lines[m.helpScrollOffset:end], "\n")
+}
+
+// handleHelpKey scrolls the help screen; ?, esc and q close it
+func (m *Model) handleHelpKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Help), key.Matches(msg, m.keys.Back), msg.String() == "q":
+		m.helpMode = false
+	case msg.String() == "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Up):
+		m.helpScrollOffset--
+	case key.Matches(msg, m.keys.Down):
+		m.helpScrollOffset++
+	case key.Matches(msg, m.keys.PageUp):
+		m.helpScrollOffset -= m.helpVisibleLines()
+	case key.Matches(msg, m.keys.PageDown):
+		m.helpScrollOffset += m.helpVisibleLines()
+	}
+	m.clampHelpScroll()
+	return m, nil
+}
@@ -2,7 +2,6 @@ package ui
 
 import (
 	"fmt"
-	"sort"
 	"strings"
 	"time"
 
@@ -39,6 +38,12 @@ func (m *Model) renderJobDetail() string {
 	sections = append(sections, m.renderJobBasicInfo(job))
 	sections = append(sections, "")
 
+	// Failure analysis (failed jobs only)
+	if job.Failed > 0 && job.Succeeded < job.Completions {
+		sections = append(sections, m.renderFailureAnalysis(jobPods))
+		sections = append(sections, "")
+	}
+
 	// Job pods
 	podSection := m.renderJobPods(job)
 	sections = append(sections, podSection)
@@ -91,6 +96,7 @@ func (m *Model) renderJobDetail() string {
 	}
 
 	// Clamp scroll offset to valid range
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
@@ -185,10 +191,9 @@ func (m *Model) renderJobBasicInfo(job *model.JobData) string {
 	}
 
 	// Age
-	age := time.Since(job.CreationTimestamp)
 	info = append(info, fmt.Sprintf("  %s: %s",
 		StyleTextSecondary.Render(m.T("detail.age")),
-		formatAge(age)))
+		m.formatAgeOrTime(job.CreationTimestamp)))
 
 	// Performance Analysis Section
 	if job.Completions > 0 {
@@ -338,11 +343,11 @@ func (m *Model) renderJobPods(job *model.JobData) string {
 		StyleTextSecondary.Render(m.T("columns.cpu"))))
 	info = append(info, fmt.Sprintf("    %s: %s  •  %s: %s  •  %s: %s",
 		m.T("detail.job.request"),
-		FormatMillicores(totalCPURequest),
+		FormatMillicores(totalCPURequest, m.rawUnits),
 		m.T("detail.job.limit"),
-		FormatMillicores(totalCPULimit),
+		FormatMillicores(totalCPULimit, m.rawUnits),
 		m.T("detail.job.usage"),
-		StyleHighlight.Render(FormatMillicores(totalCPUUsage))))
+		StyleHighlight.Render(FormatMillicores(totalCPUUsage, m.rawUnits))))
 
 	// CPU Efficiency (actual usage / requested)
 	if totalCPURequest > 0 && totalCPUUsage > 0 {
@@ -363,11 +368,11 @@ func (m *Model) renderJobPods(job *model.JobData) string {
 		StyleTextSecondary.Render(m.T("columns.memory"))))
 	info = append(info, fmt.Sprintf("    %s: %s  •  %s: %s  •  %s: %s",
 		m.T("detail.job.request"),
-		FormatBytes(totalMemRequest),
+		formatMemoryBytes(totalMemRequest, m.rawUnits),
 		m.T("detail.job.limit"),
-		FormatBytes(totalMemLimit),
+		formatMemoryBytes(totalMemLimit, m.rawUnits),
 		m.T("detail.job.usage"),
-		StyleHighlight.Render(FormatBytes(totalMemUsage))))
+		StyleHighlight.Render(formatMemoryBytes(totalMemUsage, m.rawUnits))))
 
 	// Memory Efficiency (actual usage / requested)
 	if totalMemRequest > 0 && totalMemUsage > 0 {
@@ -409,8 +414,8 @@ func (m *Model) renderJobPods(job *model.JobData) string {
 		colRx       = 11
 		colTx       = 11
 		colRestarts = 8
-		colAge      = 9
 	)
+	colAge := m.ageColumnWidth(9)
 
 	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s",
 		padRight(m.T("columns.name"), colName),
@@ -456,12 +461,12 @@ func (m *Model) renderJobPods(job *model.JobData) string {
 			phaseStyled = StyleTextMuted.Render(phase)
 		}
 
-		cpuStr := FormatMillicores(pod.CPUUsage)
+		cpuStr := FormatMillicores(pod.CPUUsage, m.rawUnits)
 		if pod.CPUUsage == 0 {
 			cpuStr = StyleTextMuted.Render("-")
 		}
 
-		memStr := FormatBytes(pod.MemoryUsage)
+		memStr := formatMemoryBytes(pod.MemoryUsage, m.rawUnits)
 		if pod.MemoryUsage == 0 {
 			memStr = StyleTextMuted.Render("-")
 		}
@@ -485,7 +490,7 @@ func (m *Model) renderJobPods(job *model.JobData) string {
 			restarts = StyleWarning.Render(restarts)
 		}
 
-		age := formatAge(time.Since(pod.CreationTimestamp))
+		age := m.formatAgeOrTime(pod.CreationTimestamp)
 
 		row := fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s",
 			padRight(truncate(pod.Name, colName), colName),
@@ -550,21 +555,7 @@ func (m *Model) getJobPods(job *model.JobData) []*model.PodData {
 	}
 
 	// Sort pods: Failed → Running → Pending → Succeeded → Others
-	// Use sort.Slice for O(n log n) performance instead of O(n²) bubble sort
-	// Add tie-breakers for stable ordering when priority is equal
-	sort.Slice(jobPods, func(i, j int) bool {
-		iPriority := getPodPriority(jobPods[i])
-		jPriority := getPodPriority(jobPods[j])
-		if iPriority != jPriority {
-			return iPriority < jPriority
-		}
-		// Tie-breaker 1: sort by creation time (older first)
-		if !jobPods[i].CreationTimestamp.Equal(jobPods[j].CreationTimestamp) {
-			return jobPods[i].CreationTimestamp.Before(jobPods[j].CreationTimestamp)
-		}
-		// Tie-breaker 2: sort by name for complete determinism
-		return jobPods[i].Name < jobPods[j].Name
-	})
+	sortPodsByPriority(jobPods)
 
 	return jobPods
 }
@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// kubectlPager shows the kubectl output, errors included, in $PAGER (less by
+// default) and exits with kubectl's status, so a failed describe is reported
+// rather than leaving an empty pager. The kubectl arguments are passed as
+// positional parameters, never interpolated into the script.
+const kubectlPager = `out=$(kubectl "$@" 2>&1); rc=$?; printf '%s\n' "$out" | ${PAGER:-less}; exit $rc`
+
+// kubectlExecMsg is sent once an external kubectl command has exited and the
+// TUI has been restored
+type kubectlExecMsg struct {
+	verb string // describe or edit
+	ref  string // kind/name of the object
+	err  error
+}
+
+// SetKubectlFlags enables running kubectl describe/edit for the object of a
+// detail view, with flags selecting the kubeconfig and identity the monitor
+// uses (e.g. --kubeconfig, --as). The active context is added per command so
+// context switches are followed.
+func (m *Model) SetKubectlFlags(flags []string) {
+	m.kubectlEnabled = true
+	m.kubectlFlags = flags
+}
+
+// kubectlArgs returns the kubectl arguments running verb on the object of the
+// current detail view
+func (m *Model) kubectlArgs(verb string) ([]string, string, bool) {
+	kind, namespace, name, ok := m.selectedResourceRef()
+	if !ok {
+		return nil, "", false
+	}
+	ref := strings.ToLower(kind) + "/" + name
+	args := []string{verb, ref}
+	if namespace != "" {
+		args = append(args, "--namespace", namespace)
+	}
+	if m.activeContext != "" {
+		args = append(args, "--context", m.activeContext)
+	}
+	return append(args, m.kubectlFlags...), ref, true
+}
+
+// runKubectl suspends the TUI and runs kubectl describe (through a pager) or
+// kubectl edit on the object of the current detail view, restoring the TUI
+// when it exits. Edit requires --allow-mutations.
+func (m *Model) runKubectl(verb string) tea.Cmd {
+	if verb == "edit" && !m.allowMutations {
+		return nil
+	}
+	args, ref, ok := m.kubectlArgs(verb)
+	if !ok {
+		return nil
+	}
+
+	if _, err := exec.LookPath("kubectl"); err != nil {
+		return func() tea.Msg {
+			return commandOutputMsg{
+				title:   "kubectl Error",
+				content: "kubectl was not found in PATH. Install kubectl or add it to PATH to describe or edit resources externally.",
+				err:     err,
+			}
+		}
+	}
+
+	var cmd *exec.Cmd
+	if verb == "describe" {
+		cmd = exec.Command("sh", append([]string{"-c", kubectlPager, "kubectl"}, args...)...)
+	} else {
+		cmd = exec.Command("kubectl", args...)
+	}
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return kubectlExecMsg{verb: verb, ref: ref, err: err}
+	})
+}
+
+// handleKubectlExecMsg reports a failed kubectl command, and refreshes after
+// an edit so the change shows up
+func (m *Model) handleKubectlExecMsg(msg kubectlExecMsg) tea.Cmd {
+	if msg.err != nil {
+		return func() tea.Msg {
+			return commandOutputMsg{
+				title:   fmt.Sprintf("kubectl %s Error: %s", msg.verb, msg.ref),
+				content: msg.err.Error(),
+				err:     msg.err,
+			}
+		}
+	}
+	if msg.verb == "edit" {
+		provider := m.dataProvider
+		return tea.Sequence(func() tea.Msg {
+			_ = provider.ForceRefresh()
+			return nil
+		}, m.fetchData())
+	}
+	return nil
+}
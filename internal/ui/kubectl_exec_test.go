@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+)
+
+func TestKubectlArgs(t *testing.T) {
+	m := NewModel(nil, zap.NewNop(), 2*time.Second, "en", "test", 100)
+	m.SetKubectlFlags([]string{"--kubeconfig", "/tmp/kubeconfig", "--as", "jane", "--as-group", "dev"})
+	m.activeContext = "staging"
+
+	m.currentView = ViewPodDetail
+	m.selectedPod = &model.PodData{Namespace: "web", Name: "api-0"}
+	args, ref, ok := m.kubectlArgs("describe")
+	want := []string{"describe", "pod/api-0", "--namespace", "web", "--context", "staging",
+		"--kubeconfig", "/tmp/kubeconfig", "--as", "jane", "--as-group", "dev"}
+	if !ok || ref != "pod/api-0" || !reflect.DeepEqual(args, want) {
+		t.Errorf("kubectlArgs(describe) = %q, %q, %v, want %q", args, ref, ok, want)
+	}
+
+	// Cluster-scoped objects get no namespace, and no context is added
+	// before one was selected
+	m.activeContext = ""
+	m.currentView = ViewNodeDetail
+	m.selectedNode = &model.NodeData{Name: "node-1"}
+	args, _, _ = m.kubectlArgs("edit")
+	want = []string{"edit", "node/node-1", "--kubeconfig", "/tmp/kubeconfig", "--as", "jane", "--as-group", "dev"}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("kubectlArgs(edit) = %q, want %q", args, want)
+	}
+
+	m.currentView = ViewEvents
+	if _, _, ok := m.kubectlArgs("describe"); ok {
+		t.Error("kubectlArgs() ok = true outside a detail view")
+	}
+}
+
+func TestKubectlPagerKeepsKubectlStatus(t *testing.T) {
+	dir := t.TempDir()
+	fake := "#!/bin/sh\necho \"Error from server (NotFound): pods \\\"$2\\\" not found\" >&2\nexit 1\n"
+	if err := os.WriteFile(filepath.Join(dir, "kubectl"), []byte(fake), 0755); err != nil {
+		t.Fatalf("failed to write fake kubectl: %v", err)
+	}
+
+	cmd := exec.Command("sh", "-c", kubectlPager, "kubectl", "describe", "pod/api-0")
+	cmd.Env = append(os.Environ(), "PATH="+dir+string(os.PathListSeparator)+os.Getenv("PATH"), "PAGER=cat")
+	out, err := cmd.Output()
+	if exitErr, ok := err.(*exec.ExitError); !ok || exitErr.ExitCode() != 1 {
+		t.Errorf("pager script error = %v, want kubectl's exit status 1", err)
+	}
+	if want := "Error from server (NotFound): pods \"pod/api-0\" not found\n"; string(out) != want {
+		t.Errorf("pager input = %q, want kubectl's stderr %q", out, want)
+	}
+}
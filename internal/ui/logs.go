@@ -6,9 +6,94 @@ import (
 	"time"
 	"unicode/utf8"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// maxLogLines caps the log lines kept for rendering
+const maxLogLines = 10000
+
+// minLogsRefreshInterval is the minimum time between the start of two
+// auto-refresh fetches of the logs
+const minLogsRefreshInterval = 2 * time.Second
+
+// logTailSteps are the tail sizes stepped through with +/- in logs mode (0 = all lines)
+var logTailSteps = []int{100, 500, 1000, 0}
+
+// clampLogTailLines bounds a tail size to 1..maxLogLines; 0 (all lines) is kept
+func clampLogTailLines(lines int) int {
+	if lines < 0 {
+		return 1
+	}
+	if lines > maxLogLines {
+		return maxLogLines
+	}
+	return lines
+}
+
+// stepLogTailLines moves the tail size one step up (direction > 0) or down and
+// re-fetches the logs. "All lines" is the largest step.
+func (m *Model) stepLogTailLines(direction int) tea.Cmd {
+	// rank orders tail sizes with 0 (all lines) above every explicit size
+	rank := func(lines int) int {
+		if lines == 0 {
+			return maxLogLines + 1
+		}
+		return lines
+	}
+
+	current := rank(m.logTailLines)
+	next := m.logTailLines
+	if direction > 0 {
+		for _, step := range logTailSteps {
+			if rank(step) > current {
+				next = step
+				break
+			}
+		}
+	} else {
+		for i := len(logTailSteps) - 1; i >= 0; i-- {
+			if rank(logTailSteps[i]) < current {
+				next = logTailSteps[i]
+				break
+			}
+		}
+	}
+
+	if next == m.logTailLines {
+		return nil
+	}
+	m.logTailLines = next
+	return m.fetchLogs()
+}
+
+// clampLogsScroll keeps the logs scroll offset valid after the number of
+// display lines changed (e.g. wrapping toggled): it follows the bottom while
+// auto-scrolling and is clamped to the new maximum otherwise
+func (m *Model) clampLogsScroll() {
+	maxVisible := m.height - 8
+	if maxVisible < 1 {
+		maxVisible = 1
+	}
+	maxScroll := m.getLogsDisplayLineCount() - maxVisible
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if m.logsAutoScroll || m.logsScrollOffset > maxScroll {
+		m.logsScrollOffset = maxScroll
+	}
+}
+
+// logTailLabel describes the active tail size for the logs header
+func (m *Model) logTailLabel() string {
+	if m.logTailLines == 0 {
+		return m.T("logs.tail_all")
+	}
+	return m.TF("logs.tail", map[string]interface{}{
+		"Lines": m.logTailLines,
+	})
+}
+
 // wrapLogLine wraps a single log line into multiple display lines
 // Returns the wrapped lines and the number of display lines
 func wrapLogLine(line string, maxWidth int) []string {
@@ -48,6 +133,36 @@ func (m *Model) renderLogs() string {
 
 	var sections []string
 
+	// Use cached log lines (cache is updated in Update when logs change)
+	logLines, jsonLines := m.logDisplayLines()
+	if len(logLines) == 0 && m.containerLogs != "" {
+		// Fallback: split if cache is empty (shouldn't happen normally)
+		logLines = strings.Split(m.containerLogs, "\n")
+	}
+
+	// Filter lines if search is active (use simple string matching, not regex)
+	var displayLines []string
+	var originalIndices []int
+	occurrences := 0
+
+	if m.logsSearchText != "" {
+		lowerSearch := strings.ToLower(m.logsSearchText)
+		for idx, line := range logLines {
+			lowerLine := strings.ToLower(line)
+			if n := strings.Count(lowerLine, lowerSearch); n > 0 {
+				displayLines = append(displayLines, line)
+				originalIndices = append(originalIndices, idx)
+				occurrences += n
+			}
+		}
+	} else {
+		displayLines = logLines
+		originalIndices = make([]int, len(logLines))
+		for i := range originalIndices {
+			originalIndices[i] = i
+		}
+	}
+
 	// Header
 	title := StyleHeader.Render(m.TF("logs.title", map[string]interface{}{
 		"Namespace": m.selectedPod.Namespace,
@@ -56,7 +171,23 @@ func (m *Model) renderLogs() string {
 	container := StyleTextSecondary.Render(m.TF("logs.container", map[string]interface{}{
 		"Name": m.selectedContainer,
 	}))
-	header := lipgloss.JoinHorizontal(lipgloss.Top, title, "  ", container)
+	options := []string{m.logTailLabel()}
+	if m.logsTimestamps {
+		options = append(options, m.T("logs.timestamps_on"))
+	}
+	if !m.logsWrap {
+		options = append(options, m.T("logs.wrap_off"))
+	}
+	if m.logsJSON {
+		options = append(options, m.T("logs.json_on"))
+	}
+	tail := StyleTextMuted.Render(strings.Join(options, " • "))
+	header := lipgloss.JoinHorizontal(lipgloss.Top, title, "  ", container, "  ", tail)
+	if m.logsSearchText != "" {
+		header += "  " + StyleSearchMatch.Render(m.TF("logs.match_count", map[string]interface{}{
+			"Count": occurrences,
+		}))
+	}
 	sections = append(sections, header)
 
 	// Show search bar if in search mode
@@ -81,61 +212,46 @@ func (m *Model) renderLogs() string {
 		return strings.Join(sections, "\n")
 	}
 
-	// Use cached log lines (cache is updated in Update when logs change)
-	logLines := m.cachedLogLines
-	if len(logLines) == 0 {
-		// Fallback: split if cache is empty (shouldn't happen normally)
-		logLines = strings.Split(m.containerLogs, "\n")
-	}
-
-	// Filter lines if search is active (use simple string matching, not regex)
-	var displayLines []string
-	var originalIndices []int
-
-	if m.logsSearchText != "" {
-		lowerSearch := strings.ToLower(m.logsSearchText)
-		for idx, line := range logLines {
-			if strings.Contains(strings.ToLower(line), lowerSearch) {
-				displayLines = append(displayLines, line)
-				originalIndices = append(originalIndices, idx)
-			}
-		}
-	} else {
-		displayLines = logLines
-		originalIndices = make([]int, len(logLines))
-		for i := range originalIndices {
-			originalIndices[i] = i
-		}
-	}
-
 	// Calculate max width for log content (reserve space for line number prefix "9999│ ")
 	maxLineWidth := m.width - 8
 	if maxLineWidth < 20 {
 		maxLineWidth = 20
 	}
 
-	// Build wrapped display lines with their original line indices
+	// Build wrapped display lines with their original line indices. Lines stay
+	// unstyled here; search matches are highlighted on visible lines only.
 	type wrappedLine struct {
 		text          string
-		originalIndex int
+		displayIndex  int  // index into displayLines
+		originalIndex int  // index into logLines
+		offset        int  // byte offset of text within its log line
+		srcLen        int  // bytes of text taken from the log line (excludes "...")
 		isFirstLine   bool // true for first line of a logical log line
 	}
 
 	var allWrappedLines []wrappedLine
 	for i, line := range displayLines {
-		// Apply search highlighting before wrapping
-		displayLine := line
-		if m.logsSearchText != "" {
-			displayLine = highlightSearchTermSimple(line, m.logsSearchText)
+		var wrapped []string
+		if m.logsWrap {
+			wrapped = wrapLogLine(line, maxLineWidth)
+		} else {
+			wrapped = []string{truncate(line, maxLineWidth)}
 		}
-
-		wrapped := wrapLogLine(displayLine, maxLineWidth)
+		offset := 0
 		for j, w := range wrapped {
+			srcLen := len(w)
+			if !m.logsWrap && w != line {
+				srcLen = len(strings.TrimSuffix(w, "..."))
+			}
 			allWrappedLines = append(allWrappedLines, wrappedLine{
 				text:          w,
+				displayIndex:  i,
 				originalIndex: originalIndices[i],
+				offset:        offset,
+				srcLen:        srcLen,
 				isFirstLine:   j == 0,
 			})
+			offset += srcLen
 		}
 	}
 
@@ -171,9 +287,25 @@ func (m *Model) renderLogs() string {
 
 	// Render visible wrapped lines
 	var renderedLines []string
+	pattern := logSearchPattern(m.logsSearchText)
+	spansIndex := -1
+	var spans [][]int
 
 	for i := startIdx; i < endIdx; i++ {
 		wl := allWrappedLines[i]
+
+		text := wl.text
+		if pattern != nil {
+			// Match spans are computed once per log line, not per wrapped segment
+			if wl.displayIndex != spansIndex {
+				spansIndex = wl.displayIndex
+				spans = pattern.FindAllStringIndex(displayLines[wl.displayIndex], -1)
+			}
+			text = highlightMatchSpans(text, wl.offset, wl.srcLen, spans)
+		} else if wl.originalIndex < len(jsonLines) {
+			// Search highlighting takes precedence over JSON field colors
+			text = styleLogSpans(text, wl.offset, wl.srcLen, jsonLines[wl.originalIndex].spans)
+		}
 		lineNum := wl.originalIndex + 1
 
 		var lineNumStr string
@@ -185,7 +317,7 @@ func (m *Model) renderLogs() string {
 			lineNumStr = StyleTextMuted.Render("    │ ")
 		}
 
-		renderedLines = append(renderedLines, lineNumStr+wl.text)
+		renderedLines = append(renderedLines, lineNumStr+text)
 	}
 
 	sections = append(sections, renderedLines...)
@@ -194,7 +326,7 @@ func (m *Model) renderLogs() string {
 	var statusParts []string
 
 	if m.logsSearchText != "" {
-		matchInfo := fmt.Sprintf("🔍 %d/%d matches", len(displayLines), totalLines)
+		matchInfo := fmt.Sprintf("🔍 %d/%d lines", len(displayLines), totalLines)
 		statusParts = append(statusParts, StyleKey.Render(matchInfo))
 	} else if totalDisplayLines > maxVisible {
 		scrollPosStr := m.TF("logs.lines_range", map[string]interface{}{
@@ -240,3 +372,22 @@ func (m *Model) renderLogs() string {
 
 	return strings.Join(sections, "\n")
 }
+
+// logsRefreshDue reports whether an auto-refresh tick should fetch the logs:
+// no fetch is in flight and the last one started at least
+// minLogsRefreshInterval ago
+func (m *Model) logsRefreshDue() bool {
+	if m.logsFetchCancel != nil {
+		return false
+	}
+	return time.Since(m.logsFetchStart) >= minLogsRefreshInterval
+}
+
+// cancelLogsFetch cancels the in-flight logs fetch, if any. A late result is
+// still dropped: either a newer fetch bumped logsFetchSeq or logs mode was left.
+func (m *Model) cancelLogsFetch() {
+	if m.logsFetchCancel != nil {
+		m.logsFetchCancel()
+		m.logsFetchCancel = nil
+	}
+}
@@ -0,0 +1,212 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// JSON log field names recognized as timestamp, level and message, in order of preference
+var (
+	jsonLogTimeKeys    = []string{"ts", "time", "timestamp", "@timestamp", "t"}
+	jsonLogLevelKeys   = []string{"level", "lvl", "severity", "@level"}
+	jsonLogMessageKeys = []string{"msg", "message", "@message"}
+)
+
+// jsonLogLine is a JSON log line reformatted as "ts LEVEL msg key=value ...";
+// spans are byte ranges of text to style when rendering
+type jsonLogLine struct {
+	text  string
+	spans []logStyleSpan
+}
+
+// logStyleSpan styles the bytes start..end of a formatted log line
+type logStyleSpan struct {
+	start, end int
+	style      *lipgloss.Style
+}
+
+// formatJSONLogLine reformats a JSON object log line, optionally preceded by a
+// kubelet timestamp, into a single display line. ok is false for other lines.
+func formatJSONLogLine(line string) (formatted jsonLogLine, ok bool) {
+	prefix, body := "", strings.TrimSpace(line)
+	if !strings.HasPrefix(body, "{") {
+		// Timestamps requested with t come before the JSON object
+		i := strings.IndexByte(line, ' ')
+		if i <= 0 || !strings.HasPrefix(strings.TrimSpace(line[i+1:]), "{") {
+			return jsonLogLine{}, false
+		}
+		prefix, body = line[:i+1], strings.TrimSpace(line[i+1:])
+	}
+
+	var fields map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&fields); err != nil || dec.More() {
+		return jsonLogLine{}, false
+	}
+
+	var b strings.Builder
+	var spans []logStyleSpan
+	// write appends text, styled with style unless it is nil
+	write := func(text string, style *lipgloss.Style) {
+		if text == "" {
+			return
+		}
+		start := b.Len()
+		b.WriteString(text)
+		if style != nil {
+			spans = append(spans, logStyleSpan{start: start, end: b.Len(), style: style})
+		}
+	}
+
+	write(prefix, &StyleTextMuted)
+	if ts, found := takeJSONLogField(fields, jsonLogTimeKeys); found {
+		write(formatJSONLogTime(ts), &StyleTextSecondary)
+		b.WriteString(" ")
+	}
+	if level, found := takeJSONLogField(fields, jsonLogLevelKeys); found {
+		text := strings.ToUpper(formatJSONLogValue(level))
+		write(text, jsonLogLevelStyle(text))
+		b.WriteString(strings.Repeat(" ", max(5-len(text), 0)+1))
+	}
+	if msg, found := takeJSONLogField(fields, jsonLogMessageKeys); found {
+		write(formatJSONLogValue(msg), nil)
+	}
+
+	// Remaining fields are dimmed and sorted for a stable layout
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var rest []string
+	for _, key := range keys {
+		value := formatJSONLogValue(fields[key])
+		if strings.ContainsAny(value, " \t") {
+			value = fmt.Sprintf("%q", value)
+		}
+		rest = append(rest, key+"="+value)
+	}
+	if len(rest) > 0 {
+		b.WriteString("  ")
+		write(strings.Join(rest, " "), &StyleTextMuted)
+	}
+
+	return jsonLogLine{text: strings.TrimRight(b.String(), " "), spans: spans}, true
+}
+
+// takeJSONLogField removes and returns the first present field of keys
+func takeJSONLogField(fields map[string]interface{}, keys []string) (interface{}, bool) {
+	for _, key := range keys {
+		if value, ok := fields[key]; ok {
+			delete(fields, key)
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+// formatJSONLogTime renders string timestamps as-is and numeric ones (Unix
+// seconds or milliseconds, as written by zap and others) in UTC
+func formatJSONLogTime(value interface{}) string {
+	number, ok := value.(json.Number)
+	if !ok {
+		return formatJSONLogValue(value)
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return number.String()
+	}
+	if seconds > 1e12 {
+		seconds /= 1000
+	}
+	// Round to milliseconds, the precision shown, to hide float error
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(math.Round(frac*1e3))*int64(time.Millisecond)).UTC().Format("2006-01-02T15:04:05.000Z")
+}
+
+// formatJSONLogValue renders strings unquoted and anything else as JSON
+func formatJSONLogValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// jsonLogLevelStyle returns the log level color for an upper-case level name
+func jsonLogLevelStyle(level string) *lipgloss.Style {
+	switch {
+	case strings.HasPrefix(level, "ERR"), strings.HasPrefix(level, "FATAL"),
+		strings.HasPrefix(level, "PANIC"), strings.HasPrefix(level, "DPANIC"),
+		strings.HasPrefix(level, "CRIT"):
+		return &StyleLogError
+	case strings.HasPrefix(level, "WARN"):
+		return &StyleLogWarn
+	case strings.HasPrefix(level, "INFO"):
+		return &StyleLogInfo
+	case strings.HasPrefix(level, "DEBUG"), strings.HasPrefix(level, "TRACE"):
+		return &StyleLogDebug
+	}
+	return nil
+}
+
+// logDisplayLines returns the log lines to display: the fetched lines, or with
+// JSON formatting enabled the reformatted lines and their styling. Formatting
+// keeps one display line per log line so line numbers and scrolling still match.
+func (m *Model) logDisplayLines() ([]string, []jsonLogLine) {
+	if !m.logsJSON {
+		return m.cachedLogLines, nil
+	}
+
+	if m.jsonLogLinesSource != m.cachedLogLinesSource || len(m.jsonLogLines) != len(m.cachedLogLines) {
+		m.jsonLogLines = make([]jsonLogLine, len(m.cachedLogLines))
+		m.jsonLogTexts = make([]string, len(m.cachedLogLines))
+		for i, line := range m.cachedLogLines {
+			formatted, ok := formatJSONLogLine(line)
+			if !ok {
+				formatted = jsonLogLine{text: line}
+			}
+			m.jsonLogLines[i] = formatted
+			m.jsonLogTexts[i] = formatted.text
+		}
+		m.jsonLogLinesSource = m.cachedLogLinesSource
+	}
+	return m.jsonLogTexts, m.jsonLogLines
+}
+
+// styleLogSpans applies JSON field styling to a display segment of a
+// formatted log line; offset and srcLen locate the segment as in highlightMatchSpans
+func styleLogSpans(text string, offset, srcLen int, spans []logStyleSpan) string {
+	if len(spans) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, span := range spans {
+		start, end := span.start-offset, span.end-offset
+		if end <= 0 {
+			continue
+		}
+		if start >= srcLen {
+			break
+		}
+		start = max(start, 0)
+		end = min(end, srcLen)
+		b.WriteString(text[last:start])
+		b.WriteString(span.style.Render(text[start:end]))
+		last = end
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
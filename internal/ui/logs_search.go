@@ -7,26 +7,26 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// Log level colors
+// Log level colors of the active theme (see ApplyTheme)
 var (
-	StyleLogError   = lipgloss.NewStyle().Foreground(ColorDanger).Bold(true)
-	StyleLogWarn    = lipgloss.NewStyle().Foreground(ColorWarning).Bold(true)
-	StyleLogInfo    = lipgloss.NewStyle().Foreground(ColorInfo)
-	StyleLogDebug   = lipgloss.NewStyle().Foreground(ColorTextMuted)
-	StyleLogSuccess = lipgloss.NewStyle().Foreground(ColorSuccess)
-	StyleSearchMatch = lipgloss.NewStyle().Background(ColorWarning).Foreground(lipgloss.Color("#000000")).Bold(true)
+	StyleLogError    lipgloss.Style
+	StyleLogWarn     lipgloss.Style
+	StyleLogInfo     lipgloss.Style
+	StyleLogDebug    lipgloss.Style
+	StyleLogSuccess  lipgloss.Style
+	StyleSearchMatch lipgloss.Style
 )
 
-// Log level patterns (case-insensitive)
+// Log level patterns (case-insensitive); styles point at the theme vars
 var logLevelPatterns = []struct {
 	pattern *regexp.Regexp
-	style   lipgloss.Style
+	style   *lipgloss.Style
 }{
-	{regexp.MustCompile(`(?i)\b(ERROR|ERR|FATAL|CRIT|CRITICAL)\b`), StyleLogError},
-	{regexp.MustCompile(`(?i)\b(WARN|WARNING)\b`), StyleLogWarn},
-	{regexp.MustCompile(`(?i)\b(INFO)\b`), StyleLogInfo},
-	{regexp.MustCompile(`(?i)\b(DEBUG|TRACE)\b`), StyleLogDebug},
-	{regexp.MustCompile(`(?i)\b(SUCCESS|OK)\b`), StyleLogSuccess},
+	{regexp.MustCompile(`(?i)\b(ERROR|ERR|FATAL|CRIT|CRITICAL)\b`), &StyleLogError},
+	{regexp.MustCompile(`(?i)\b(WARN|WARNING)\b`), &StyleLogWarn},
+	{regexp.MustCompile(`(?i)\b(INFO)\b`), &StyleLogInfo},
+	{regexp.MustCompile(`(?i)\b(DEBUG|TRACE)\b`), &StyleLogDebug},
+	{regexp.MustCompile(`(?i)\b(SUCCESS|OK)\b`), &StyleLogSuccess},
 }
 
 // highlightLogLine applies syntax highlighting to a log line
@@ -150,27 +150,40 @@ func countMatches(lines []string, searchText string) int {
 	return count
 }
 
-// highlightSearchTermSimple highlights search term in plain text (no ANSI handling)
-// This is optimized for performance during scrolling - avoids expensive regex operations
-func highlightSearchTermSimple(line string, searchText string) string {
-	if searchText == "" || line == "" {
-		return line
+// logSearchPattern compiles the logs search text into a case-insensitive
+// pattern matching every occurrence, or nil when no search is active
+func logSearchPattern(searchText string) *regexp.Regexp {
+	if searchText == "" {
+		return nil
 	}
+	return regexp.MustCompile("(?i)" + regexp.QuoteMeta(searchText))
+}
 
-	// Case-insensitive search using simple string operations
-	lowerLine := strings.ToLower(line)
-	lowerSearch := strings.ToLower(searchText)
-
-	// Find first match only for simplicity and performance
-	idx := strings.Index(lowerLine, lowerSearch)
-	if idx == -1 {
-		return line
+// highlightMatchSpans styles the parts of a rendered log segment covered by
+// search matches. The segment's first srcLen bytes are the log line bytes
+// starting at offset; spans are sorted byte ranges within the whole log line,
+// so matches split across wrapped segments are highlighted in each part.
+func highlightMatchSpans(text string, offset, srcLen int, spans [][]int) string {
+	if len(spans) == 0 {
+		return text
 	}
 
-	// Build result with single highlighted match
-	// For performance, only highlight first occurrence
-	matchEnd := idx + len(searchText)
-	matched := line[idx:matchEnd]
-
-	return line[:idx] + StyleSearchMatch.Render(matched) + line[matchEnd:]
+	var b strings.Builder
+	last := 0
+	for _, span := range spans {
+		start, end := span[0]-offset, span[1]-offset
+		if end <= 0 {
+			continue
+		}
+		if start >= srcLen {
+			break
+		}
+		start = max(start, 0)
+		end = min(end, srcLen)
+		b.WriteString(text[last:start])
+		b.WriteString(StyleSearchMatch.Render(text[start:end]))
+		last = end
+	}
+	b.WriteString(text[last:])
+	return b.String()
 }
@@ -0,0 +1,340 @@
+package ui
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"go.uber.org/zap"
+)
+
+// defaultMaxHistory is the number of snapshots kept when no size is configured
+const defaultMaxHistory = 10
+
+// seriesAggregate keeps running sums of one node's or pod's metrics across all
+// snapshots in the history window, so trend averages are O(1) instead of
+// rescanning every snapshot for every row on every render
+type seriesAggregate struct {
+	count  int
+	cpu    int64
+	memory int64
+	npu    int64 // NPUs allocated (nodes only)
+}
+
+// appendMetricSnapshot adds a snapshot to the history, evicting the oldest
+// snapshots beyond maxHistory and keeping the running aggregates in sync
+func (m *Model) appendMetricSnapshot(snapshot MetricSnapshot) {
+	m.metricHistory = append(m.metricHistory, snapshot)
+	m.addSnapshotAggregates(&snapshot)
+
+	// Keep only last N snapshots
+	m.trimMetricHistory()
+	m.rebuildNodeTransitions()
+}
+
+// trimMetricHistory evicts the oldest snapshots beyond maxHistory
+func (m *Model) trimMetricHistory() {
+	excess := len(m.metricHistory) - m.maxHistory
+	if excess <= 0 {
+		return
+	}
+	for i := 0; i < excess; i++ {
+		m.removeSnapshotAggregates(&m.metricHistory[i])
+	}
+	// Copy into a fresh slice so evicted snapshots can be garbage collected
+	m.metricHistory = append(make([]MetricSnapshot, 0, m.maxHistory), m.metricHistory[excess:]...)
+}
+
+// addSnapshotAggregates adds a snapshot's values to the running aggregates
+func (m *Model) addSnapshotAggregates(snapshot *MetricSnapshot) {
+	for name, metric := range snapshot.NodeMetrics {
+		agg, ok := m.nodeAggregates[name]
+		if !ok {
+			agg = &seriesAggregate{}
+			m.nodeAggregates[name] = agg
+		}
+		agg.count++
+		agg.cpu += metric.CPUUsage
+		agg.memory += metric.MemoryUsage
+		agg.npu += metric.NPUAllocated
+	}
+	for key, metric := range snapshot.PodMetrics {
+		agg, ok := m.podAggregates[key]
+		if !ok {
+			agg = &seriesAggregate{}
+			m.podAggregates[key] = agg
+		}
+		agg.count++
+		agg.cpu += metric.CPUUsage
+		agg.memory += metric.MemoryUsage
+	}
+	m.clusterNPUAllocatedSum += snapshot.ClusterNPUAllocated
+	m.clusterGPUAllocatedSum += snapshot.ClusterGPUAllocated
+	m.clusterCPUUsageSum += snapshot.ClusterCPUUsage
+	m.clusterMemoryUsageSum += snapshot.ClusterMemoryUsage
+}
+
+// removeSnapshotAggregates subtracts an evicted snapshot's values from the running aggregates
+func (m *Model) removeSnapshotAggregates(snapshot *MetricSnapshot) {
+	for name, metric := range snapshot.NodeMetrics {
+		if agg, ok := m.nodeAggregates[name]; ok {
+			agg.count--
+			agg.cpu -= metric.CPUUsage
+			agg.memory -= metric.MemoryUsage
+			agg.npu -= metric.NPUAllocated
+			if agg.count <= 0 {
+				delete(m.nodeAggregates, name)
+			}
+		}
+	}
+	for key, metric := range snapshot.PodMetrics {
+		if agg, ok := m.podAggregates[key]; ok {
+			agg.count--
+			agg.cpu -= metric.CPUUsage
+			agg.memory -= metric.MemoryUsage
+			if agg.count <= 0 {
+				delete(m.podAggregates, key)
+			}
+		}
+	}
+	m.clusterNPUAllocatedSum -= snapshot.ClusterNPUAllocated
+	m.clusterGPUAllocatedSum -= snapshot.ClusterGPUAllocated
+	m.clusterCPUUsageSum -= snapshot.ClusterCPUUsage
+	m.clusterMemoryUsageSum -= snapshot.ClusterMemoryUsage
+}
+
+// previousNodeAggregate returns a node's aggregate excluding the most recent snapshot
+func (m *Model) previousNodeAggregate(nodeName string) (seriesAggregate, bool) {
+	agg, ok := m.nodeAggregates[nodeName]
+	if !ok || len(m.metricHistory) == 0 {
+		return seriesAggregate{}, false
+	}
+	prev := *agg
+	if latest, ok := m.metricHistory[len(m.metricHistory)-1].NodeMetrics[nodeName]; ok {
+		prev.count--
+		prev.cpu -= latest.CPUUsage
+		prev.memory -= latest.MemoryUsage
+		prev.npu -= latest.NPUAllocated
+	}
+	return prev, prev.count > 0
+}
+
+// previousPodAggregate returns a pod's aggregate excluding the most recent snapshot
+func (m *Model) previousPodAggregate(key string) (seriesAggregate, bool) {
+	agg, ok := m.podAggregates[key]
+	if !ok || len(m.metricHistory) == 0 {
+		return seriesAggregate{}, false
+	}
+	prev := *agg
+	if latest, ok := m.metricHistory[len(m.metricHistory)-1].PodMetrics[key]; ok {
+		prev.count--
+		prev.cpu -= latest.CPUUsage
+		prev.memory -= latest.MemoryUsage
+	}
+	return prev, prev.count > 0
+}
+
+// resetMetricHistory drops all snapshots and aggregates (e.g. after switching cluster)
+func (m *Model) resetMetricHistory() {
+	m.metricHistory = make([]MetricSnapshot, 0, m.maxHistory)
+	m.nodeAggregates = make(map[string]*seriesAggregate)
+	m.podAggregates = make(map[string]*seriesAggregate)
+	m.clusterNPUAllocatedSum = 0
+	m.clusterGPUAllocatedSum = 0
+	m.clusterCPUUsageSum = 0
+	m.clusterMemoryUsageSum = 0
+	m.nodeTransitions = nil
+	m.lastSnapshotTime = time.Time{}
+}
+
+// SetHistorySize sets how many metric snapshots are kept for trends and sparklines
+func (m *Model) SetHistorySize(size int) {
+	if size <= 0 {
+		size = defaultMaxHistory
+	}
+	m.maxHistory = size
+	m.trimMetricHistory()
+	m.rebuildNodeTransitions()
+	if m.historyFile != nil {
+		m.historyFile.setMaxEntries(size)
+	}
+}
+
+// SetHistoryFile enables persisting metric snapshots to path and loads any
+// snapshots saved by a previous run. Call after SetHistorySize.
+func (m *Model) SetHistoryFile(path string) error {
+	store := newMetricHistoryFile(path, m.maxHistory)
+	m.historyFile = store
+
+	snapshots, err := store.load()
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		m.appendMetricSnapshot(snapshot)
+	}
+	return nil
+}
+
+// persistMetricSnapshot returns a command that appends a snapshot to the history file
+func (m *Model) persistMetricSnapshot(snapshot MetricSnapshot) tea.Cmd {
+	if m.historyFile == nil {
+		return nil
+	}
+	store := m.historyFile
+	logger := m.logger
+	return func() tea.Msg {
+		if err := store.append(snapshot); err != nil && logger != nil {
+			logger.Warn("Failed to persist metric snapshot", zap.Error(err))
+		}
+		return nil
+	}
+}
+
+// metricHistoryFile stores snapshots as JSON lines. Appends are cheap; once the
+// file holds twice maxEntries lines it is compacted to the newest maxEntries,
+// giving ring-buffer retention without rewriting the file on every refresh.
+type metricHistoryFile struct {
+	mu         sync.Mutex
+	path       string
+	maxEntries int
+	lines      int // Number of snapshots currently in the file
+}
+
+// newMetricHistoryFile creates a history file store
+func newMetricHistoryFile(path string, maxEntries int) *metricHistoryFile {
+	return &metricHistoryFile{
+		path:       path,
+		maxEntries: maxEntries,
+	}
+}
+
+// setMaxEntries changes the retention of the file
+func (f *metricHistoryFile) setMaxEntries(maxEntries int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.maxEntries = maxEntries
+}
+
+// load reads the newest maxEntries snapshots from the file
+func (f *metricHistoryFile) load() ([]MetricSnapshot, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	snapshots, err := f.readAll()
+	if err != nil {
+		return nil, err
+	}
+	f.lines = len(snapshots)
+
+	if len(snapshots) > f.maxEntries {
+		snapshots = snapshots[len(snapshots)-f.maxEntries:]
+	}
+	return snapshots, nil
+}
+
+// append writes one snapshot, compacting the file when it grows too large
+func (f *metricHistoryFile) append(snapshot MetricSnapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open history file: %w", err)
+	}
+	_, err = file.Write(append(data, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+
+	f.lines++
+	if f.lines >= 2*f.maxEntries {
+		return f.compact()
+	}
+	return nil
+}
+
+// compact rewrites the file keeping only the newest maxEntries snapshots
+func (f *metricHistoryFile) compact() error {
+	snapshots, err := f.readAll()
+	if err != nil {
+		return err
+	}
+	if len(snapshots) > f.maxEntries {
+		snapshots = snapshots[len(snapshots)-f.maxEntries:]
+	}
+
+	tmpPath := f.path + ".tmp"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("failed to create history file: %w", err)
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, snapshot := range snapshots {
+		if err := encoder.Encode(snapshot); err != nil {
+			file.Close()
+			return fmt.Errorf("failed to encode snapshot: %w", err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, f.path); err != nil {
+		return fmt.Errorf("failed to replace history file: %w", err)
+	}
+	f.lines = len(snapshots)
+	return nil
+}
+
+// readAll decodes every snapshot in the file, skipping corrupt lines
+func (f *metricHistoryFile) readAll() ([]MetricSnapshot, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open history file: %w", err)
+	}
+	defer file.Close()
+
+	var snapshots []MetricSnapshot
+	scanner := bufio.NewScanner(file)
+	// Snapshots of large clusters can exceed the default 64KB line limit
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var snapshot MetricSnapshot
+		if err := json.Unmarshal(scanner.Bytes(), &snapshot); err != nil {
+			continue // Skip partially written lines
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history file: %w", err)
+	}
+
+	return snapshots, nil
+}
@@ -3,7 +3,6 @@ package ui
 import (
 	"context"
 	"fmt"
-	"sort"
 	"strings"
 	"time"
 
@@ -47,7 +46,10 @@ const (
 	ViewPVCDetail
 	ViewVolcanoJobDetail
 	ViewQueueDetail
-	ViewTopologyDetail // SuperPod detail view
+	ViewTopologyDetail  // SuperPod detail view
+	ViewNamespaceDetail // Namespace quotas and limit ranges
+	ViewHeatmap         // Node resource-usage heatmap (toggled from Nodes view)
+	ViewNodeCompare     // Two marked nodes side by side (opened from Nodes view)
 )
 
 // SortField represents the field to sort by
@@ -62,6 +64,16 @@ const (
 	SortByRestarts  // For Pods view
 	SortByNamespace // For Pods view
 	SortByNode      // For Pods view
+	SortByTime      // For Events view
+	SortByCount     // For Events view
+	SortByType      // For Events view
+	SortByReady     // For Workloads view (ready ratio)
+	SortByAge       // For Workloads view
+	SortByPriority  // For Alerts view (diagnostic alert priority)
+	SortByTraffic   // For Network view (RX+TX rate)
+	SortByRxRate    // For Network view
+	SortByTxRate    // For Network view
+	SortByPodName   // For Network view (SortByName is the shared default)
 )
 
 // SortOrder represents sort direction
@@ -82,6 +94,14 @@ type MetricSnapshot struct {
 	ClusterNPUCapacity   int64 // Total NPU capacity across all nodes
 	ClusterNPUAllocated  int64 // Total NPUs allocated to pods
 	ClusterNPUAllocatable int64 // Total allocatable NPUs
+
+	// Cluster-wide GPU summary
+	ClusterGPUAllocated   int64 // Total GPUs allocated to pods
+	ClusterGPUAllocatable int64 // Total allocatable GPUs
+
+	// Cluster-wide usage summary
+	ClusterCPUUsage    int64 // Total CPU usage across all nodes (millicores)
+	ClusterMemoryUsage int64 // Total memory usage across all nodes (bytes)
 }
 
 // NodeMetric stores historical metrics for a node
@@ -91,11 +111,16 @@ type NodeMetric struct {
 	NetworkRxBytes int64
 	NetworkTxBytes int64
 	Timestamp      time.Time // Kubelet-provided timestamp for accurate rate calculation
+	Status         string    // Ready, NotReady, Unknown (for flap detection)
 
 	// NPU metrics (Ascend AI accelerators)
 	NPUCapacity   int64 // Total NPU capacity on this node
 	NPUAllocated  int64 // NPUs allocated to pods on this node
 	NPUAllocatable int64 // Allocatable NPUs on this node
+
+	// GPU metrics (NVIDIA)
+	GPUAllocated   int64 // GPUs allocated to pods on this node
+	GPUAllocatable int64 // Allocatable GPUs on this node
 }
 
 // PodMetric stores historical metrics for a pod
@@ -124,7 +149,8 @@ type Model struct {
 	locale              string          // Current locale (en, zh, etc.)
 	version             string          // Application version
 	refreshInterval     time.Duration
-	logTailLines        int // Number of log lines to fetch
+	logTailLines        int  // Number of log lines to fetch (0 = all), adjustable with +/- in logs mode
+	allowMutations      bool // True when write actions (cordon/uncordon) are enabled
 	refreshCounter      int
 	width               int
 	height              int
@@ -138,6 +164,8 @@ type Model struct {
 	selectedIndex       int                    // Selected item index in lists
 	detailMode          bool                   // True when viewing node/pod/event/job detail
 	detailScrollOffset  int                    // Scroll offset for detail view content
+	detailLines         int                    // Content lines of the last rendered detail view
+	detailReservedRows  int                    // Rows that view kept for header/footer
 	selectedNode        *model.NodeData        // Currently selected node for detail view
 	selectedPod         *model.PodData         // Currently selected pod for detail view
 	selectedEvent       *model.EventData       // Currently selected event for detail view
@@ -152,6 +180,7 @@ type Model struct {
 	selectedVolcanoJob  *model.VolcanoJobData  // Currently selected Volcano job for detail view
 	selectedQueue       *model.QueueData       // Currently selected Volcano queue for detail view
 	selectedSuperPod    *SuperPodInfo          // Currently selected SuperPod for detail view
+	selectedNamespace   string                 // Currently selected namespace for detail view
 
 	// Job pod selection state
 	jobPodSelectedIndex         int  // Selected pod index in job detail view
@@ -159,29 +188,132 @@ type Model struct {
 	fromJobDetail               bool // True when navigating from job detail to pod detail
 	fromVolcanoJobDetail        bool // True when navigating from volcano job detail to pod detail
 
+	// Workload pod selection state
+	deploymentPodSelectedIndex  int  // Selected pod index in deployment detail view
+	statefulSetPodSelectedIndex int  // Selected pod index in statefulset detail view
+	fromDeploymentDetail        bool // True when navigating from deployment detail to pod detail
+	fromStatefulSetDetail       bool // True when navigating from statefulset detail to pod detail
+
+	// Node pod selection state
+	nodePodSelectedIndex int  // Selected pod index in node detail view (-1 = none)
+	fromNodeDetail       bool // True when navigating from node detail to pod detail
+	fromHeatmap          bool // True when node detail was opened from the heatmap
+
+	// PVC detail opened from the volumes of pod detail
+	fromPodDetail bool // True when navigating from pod detail to PVC detail
+
+	// Node comparison state
+	comparedNodes []string // Names of the nodes marked for comparison, oldest first
+
 	// Filter state
-	filterMode      bool   // True when in filter mode
-	filterNamespace string // Current namespace filter (pods only)
-	filterStatus    string // Current status filter (nodes: Ready/NotReady, pods: Running/Pending/Failed)
-	filterRole      string // Current role filter (nodes only)
-	filterEventType string // Current event type filter (Warning/Normal)
-	searchMode      bool   // True when in search mode
-	searchText      string // Current search text (filter by name)
+	filterMode          bool             // True when in filter mode
+	filterNamespace     string           // Current namespace filter (pods only)
+	filterNode          string           // Current node filter (pods only)
+	podFilterField      podFilterField   // List shown in the pods filter panel
+	filterStatus        string           // Current status filter (nodes: Ready/NotReady, pods: Running/Pending/Failed)
+	filterRole          string           // Current role filter (nodes only)
+	filterEventType     string           // Current event type filter (Warning/Normal)
+	filterEventKind     string           // Current event involved-object kind filter (Pod/Node/...)
+	filterEventSince    time.Duration    // Only show events newer than this (0 = all)
+	eventFilterField    eventFilterField // Focused row in the events filter panel
+	filterAlertSeverity string           // Current alert severity filter (Critical/Warning/Info)
+	filterAlertCategory string           // Current alert category filter (Node/Pod/...)
+	alertFilterField    alertFilterField // Focused row in the alerts filter panel
+	searchMode          bool             // True when in search mode
+	searchText          string           // Current search text (filter by name)
+	fuzzySearch         bool             // True when search uses fuzzy matching (ranked by score)
 
 	// Sort state
 	sortField SortField // Current sort field
 	sortOrder SortOrder // Current sort order
 
+	// Per-view sort state
+	sortView     string              // viewSortOptions key of the view the sort was loaded for
+	viewSorts    map[string]viewSort // Sorts chosen with the Sort key (persisted to the state file)
+	defaultSorts map[string]viewSort // Configured sort each view starts with
+
+	// Node detail chart state
+	nodeChartMode bool // True when node detail shows the CPU/memory time-series chart
+
+	// Pods grouping state
+	podGroupingEnabled bool            // True when Pods view groups pods by workload
+	expandedPodGroups  map[string]bool // Expanded group keys (namespace/workload)
+
+	// Age column format
+	timeFormat timeFormat // Relative ages (3d) or absolute timestamps
+
+	// Resource units: exact millicores and bytes instead of humanized values
+	rawUnits bool
+
+	// Configured visible columns per list view (see SetColumns)
+	listColumns map[string][]string
+
+	refreshPaused bool // Auto refresh ticks skip fetching while paused
+
+	overviewProblemsOnly bool // Overview lists only unhealthy items
+
 	// Cached sorted data (to ensure selection consistency)
 	cachedSortedNodes  []*model.NodeData
 	cachedSortedPods   []*model.PodData
+	cachedPodRows      []podListRow // Rows of the grouped Pods view (group headers + expanded pods)
 	cachedSortedEvents []*model.EventData
-
-	// Metric history for trend calculation (keep last 10 snapshots)
+	cachedPodListKey   podListCacheKey // Inputs cachedSortedPods was built from
+	podListStats       podListStats    // Status counts of cachedSortedPods
+	dataVersion        uint64          // Incremented on every successful data refresh
+
+	// Warning event counts per pod, rebuilt once per data version
+	podWarningCounts        map[string]int
+	podWarningCountsVersion uint64
+
+	// Succeeded and Failed pods, the only ones hiding completed pods can
+	// leave out, rebuilt once per data version
+	completedPods        []*model.PodData
+	completedPodsVersion uint64
+	scrollToPodEvents       bool // Scroll the next pod detail render to its events
+
+	// Per-view auto-refresh (see SetViewRefreshIntervals)
+	viewRefreshIntervals     map[string]time.Duration // Interval overrides by view key
+	scheduledRefreshInterval time.Duration            // Interval of the pending refresh tick
+	refreshSeq               int                      // Invalidates pending ticks when rescheduled
+
+	// Metric history for trend calculation (last maxHistory snapshots)
 	metricHistory    []MetricSnapshot
 	maxHistory       int       // Maximum history snapshots to keep
 	lastSnapshotTime time.Time // Timestamp of last recorded metric snapshot
 
+	networkRateWindow time.Duration // Sliding window network rates are averaged over
+	restartWindow     time.Duration // Pods restarted more recently are highlighted
+
+	// Pinned namespaces and their configured position (see SetPinnedNamespaces)
+	pinnedNamespaces map[string]int
+
+	exportDir string // Directory export files are written to ("" = default)
+
+	// Events fetched per refresh (see SetEventLimit); 0 = all events
+	eventLimit       int // Configured limit
+	activeEventLimit int // Current limit, 0 while A shows all events
+
+	// Completed pods hidden from the Pods view (see SetHideCompletedPods)
+	hideCompletedPods   bool          // Toggled with C
+	hideFailedPodsAfter time.Duration // Failed pods older than this are hidden too (0 = never)
+
+	// Overview count changes between refreshes
+	prevSummary  *model.ClusterSummary // Copy of the previously loaded summary
+	summaryDelta *summaryDelta         // Count changes of the last refresh, nil when unchanged
+
+	// Running aggregates over metricHistory (avoid rescanning history for trends)
+	nodeAggregates         map[string]*seriesAggregate // key: node name
+	podAggregates          map[string]*seriesAggregate // key: namespace/name
+	clusterNPUAllocatedSum int64
+	clusterGPUAllocatedSum int64
+	clusterCPUUsageSum     int64
+	clusterMemoryUsageSum  int64
+	historyFile            *metricHistoryFile // Optional on-disk persistence of snapshots
+
+	// Node readiness transitions within metricHistory (flap detection)
+	nodeTransitions   map[string][]nodeTransition // key: node name
+	nodeFlapThreshold int                         // Transitions beyond this flag a node as flapping
+
 	// Logs viewer state
 	logsMode          bool      // True when viewing logs
 	logsAutoRefresh   bool      // True to enable auto-refresh of logs
@@ -191,19 +323,91 @@ type Model struct {
 	containerLogs     string    // Fetched logs content
 	logsScrollOffset  int       // Scroll offset for logs
 	logsError         string    // Error message if logs fetch failed
+	logsTimestamps    bool      // True to request timestamps with the logs (t)
+	logsWrap          bool      // True to soft-wrap long lines, false to truncate them (w)
+	logsJSON          bool      // True to reformat and colorize JSON log lines (J)
+
+	// Logs fetch state: one fetch is in flight at a time and results are
+	// correlated with the request that produced them
+	logsFetchSeq    int                // ID of the latest logs fetch; older results are dropped
+	logsFetchCancel context.CancelFunc // Cancels the in-flight logs fetch (nil when idle)
+	logsFetchStart  time.Time          // Start of the latest logs fetch
 
 	// Logs search state
 	logsSearchMode bool   // True when in logs search mode
 	logsSearchText string // Current search text for logs filtering
 
 	// Logs cache for performance (avoid re-splitting on every render)
-	cachedLogLines       []string // Cached split log lines
-	cachedLogLinesSource string   // Source string that was cached (for invalidation)
+	cachedLogLines       []string      // Cached split log lines
+	cachedLogLinesSource string        // Source string that was cached (for invalidation)
+	jsonLogLines         []jsonLogLine // cachedLogLines reformatted for JSON mode
+	jsonLogTexts         []string      // Plain text of jsonLogLines
+	jsonLogLinesSource   string        // cachedLogLinesSource jsonLogLines were built from
 
 	// Action menu state
 	actionMenuMode          bool // True when action menu is visible
 	actionMenuSelectedIndex int  // Selected item in action menu
 
+	// Confirmation prompt state (for mutating actions)
+	confirmMode    bool       // True when a confirmation prompt is visible
+	confirmAction  ActionType // Action to run once confirmed
+	confirmMessage string     // Prompt shown to the user
+
+	// External kubectl describe/edit
+	kubectlEnabled bool     // True when kubectl can be run for the object of a detail view
+	kubectlFlags   []string // Global kubectl flags, e.g. --kubeconfig (see SetKubectlFlags)
+
+	// Kubeconfig context switcher state
+	activeContext        string   // Context the data provider is connected to
+	contextSwitcherMode  bool     // True when the context switcher is visible
+	contextList          []string // Contexts parsed from the kubeconfig
+	contextSelectedIndex int      // Selected item in the context switcher
+	switchingContext     string   // Context being switched to (empty when idle)
+
+	// Command palette state (jump to a resource by name)
+	paletteMode          bool           // True when the command palette is visible
+	paletteText          string         // Query typed into the palette
+	paletteSelectedIndex int            // Selected match in the palette
+	paletteIndex         []paletteEntry // Resources indexed on each refresh
+	paletteSuggestions   []paletteEntry // Listed while the query is empty (see openTopPodsPalette)
+
+	// Global search state (search all views at once)
+	globalSearchMode     bool          // True when the global search is visible
+	globalSearchText     string        // Query typed into the global search
+	globalSearchSelected int           // Selected match across all result groups
+	searchIndex          []searchEntry // Resources and events indexed on each refresh
+
+	// Annotations hidden from detail views until expanded (see SetHiddenAnnotations)
+	hiddenAnnotations []string
+	expandAnnotations bool // Toggled with o
+
+	// Help screen listing all key bindings (toggled with ?)
+	helpMode         bool
+	helpScrollOffset int
+
+	// Focus mode state (full-screen dashboard of one pinned pod or node)
+	focusMode      bool               // True when focus mode is shown
+	focusKind      string             // "Pod" or "Node"
+	focusNamespace string             // Namespace of a pinned pod
+	focusName      string             // Name of the pinned resource
+	focusContainer string             // Container whose logs are tailed
+	focusPod       *model.PodData     // Latest state of a pinned pod
+	focusNode      *model.NodeData    // Latest state of a pinned node
+	focusEvents    []*model.EventData // Recent events of the pinned resource
+	focusLogs      string             // Log tail of focusContainer
+	focusErr       error              // Error of the last refresh
+	focusUpdated   time.Time          // Time of the last successful refresh
+	focusSeq       int                // Incremented to drop stale ticks and results
+
+	// Namespace scope of the fetched cluster data
+	namespaceScope      string // Namespace data is fetched for ("" = all namespaces)
+	lastScopedNamespace string // Namespace restored when toggling back from all namespaces
+
+	// Alert acknowledgement state
+	ackedAlerts     map[string]bool          // Acknowledged alerts by identity (type + resource)
+	mutedAlertTypes map[model.AlertType]bool // Muted alert types (persisted to the state file)
+	stateFile       *uiStateFile             // Optional on-disk persistence of UI state
+
 	// Export state
 	exportInProgress bool   // True when export is in progress
 	exportMessage    string // Export success/error message
@@ -216,6 +420,7 @@ type Model struct {
 	commandOutputTitle   string // Title of the command output
 	commandOutputContent string // Content to display
 	commandOutputScroll  int    // Scroll offset for command output
+	commandOutputRaw     bool   // Show content without line numbers
 }
 
 // workloadSection tracks the position and count of a workload type in the view
@@ -244,6 +449,26 @@ type KeyMap struct {
 	Logs        key.Binding
 	Actions     key.Binding // Open action menu
 	Export      key.Binding // Export current view data
+	Group       key.Binding // Toggle workload grouping in Pods view
+	YAML        key.Binding // Show the object of the detail view as YAML
+	AckAlert    key.Binding // Acknowledge the selected alert
+	MuteAlert   key.Binding // Mute all alerts of the selected alert's type
+	Namespace   key.Binding // Show quotas and limit ranges of the selected namespace
+	TimeFormat  key.Binding // Toggle age columns between relative and absolute time
+	Pause       key.Binding // Pause or resume auto refresh
+	Scope       key.Binding // Toggle between all namespaces and a single namespace
+	Heatmap     key.Binding // Toggle the Nodes view between list and heatmap
+	Mark        key.Binding // Mark the selected node for comparison
+	Compare     key.Binding // Compare the two marked nodes side by side
+	Completed   key.Binding // Show or hide completed pods in the Pods view
+	AllEvents   key.Binding // Fetch all events instead of the most recent ones
+	Annotations key.Binding // Expand or collapse annotations in detail views
+	Units       key.Binding // Toggle between humanized and raw resource units
+
+	SwitchContext key.Binding // Open the kubeconfig context switcher
+	Palette       key.Binding // Open the command palette to jump to a resource
+	GlobalSearch  key.Binding // Search resources and events of all views at once
+	Focus         key.Binding // Pin the resource of a detail view in focus mode
 }
 
 // DefaultKeyMap returns the default key bindings
@@ -317,27 +542,119 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("e"),
 			key.WithHelp("e", "export"),
 		),
+		Group: key.NewBinding(
+			key.WithKeys("G"),
+			key.WithHelp("G", "group"),
+		),
+		YAML: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "yaml"),
+		),
+		AckAlert: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "ack"),
+		),
+		MuteAlert: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "mute type"),
+		),
+		Namespace: key.NewBinding(
+			key.WithKeys("N"),
+			key.WithHelp("N", "namespace"),
+		),
+		TimeFormat: key.NewBinding(
+			key.WithKeys("T"),
+			key.WithHelp("T", "time format"),
+		),
+		Pause: key.NewBinding(
+			key.WithKeys("p", " "),
+			key.WithHelp("p/space", "pause"),
+		),
+		Scope: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "scope"),
+		),
+		Heatmap: key.NewBinding(
+			key.WithKeys("H"),
+			key.WithHelp("H", "heatmap"),
+		),
+		Mark: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "mark"),
+		),
+		Compare: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "compare"),
+		),
+		Completed: key.NewBinding(
+			key.WithKeys("C"),
+			key.WithHelp("C", "completed pods"),
+		),
+		AllEvents: key.NewBinding(
+			key.WithKeys("A"),
+			key.WithHelp("A", "all events"),
+		),
+		Annotations: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "annotations"),
+		),
+		Units: key.NewBinding(
+			key.WithKeys("u"),
+			key.WithHelp("u", "units"),
+		),
+		Palette: key.NewBinding(
+			key.WithKeys(":"),
+			key.WithHelp(":", "jump to"),
+		),
+		GlobalSearch: key.NewBinding(
+			key.WithKeys("ctrl+_"), // Terminals send ctrl+/ as ctrl+_
+			key.WithHelp("ctrl+/", "search all"),
+		),
+		Focus: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "focus"),
+		),
+		SwitchContext: key.NewBinding(
+			key.WithKeys("ctrl+x"),
+			key.WithHelp("ctrl+x", "context"),
+		),
 	}
 }
 
 // NewModel creates a new UI model
 func NewModel(dataProvider DataProvider, logger *zap.Logger, refreshInterval time.Duration, locale string, version string, logTailLines int) *Model {
 	return &Model{
-		dataProvider:     dataProvider,
-		logger:           logger,
-		localizer:        i18n.NewLocalizer(locale),
-		locale:           locale,
-		version:          version,
-		refreshInterval:  refreshInterval,
-		logTailLines:     logTailLines,
-		currentView:      ViewOverview,
-		keys:             DefaultKeyMap(),
-		metricHistory:    make([]MetricSnapshot, 0, 10),
-		maxHistory:       10, // Keep last 10 snapshots for trend calculation
-		workloadSections: make(map[string]workloadSection),
+		dataProvider:      dataProvider,
+		logger:            logger,
+		localizer:         i18n.NewLocalizer(locale),
+		locale:            locale,
+		version:           version,
+		refreshInterval:   refreshInterval,
+		logTailLines:      clampLogTailLines(logTailLines),
+		currentView:       ViewOverview,
+		keys:              DefaultKeyMap(),
+		metricHistory:     make([]MetricSnapshot, 0, defaultMaxHistory),
+		maxHistory:        defaultMaxHistory, // Overridden by SetHistorySize
+		networkRateWindow: defaultNetworkRateWindow,
+		restartWindow:     defaultRecentRestartWindow,
+		nodeFlapThreshold: defaultNodeFlapThreshold,
+		hiddenAnnotations: defaultHiddenAnnotations,
+		nodeAggregates:    make(map[string]*seriesAggregate),
+		podAggregates:     make(map[string]*seriesAggregate),
+		workloadSections:  make(map[string]workloadSection),
+		expandedPodGroups: make(map[string]bool),
+		ackedAlerts:       make(map[string]bool),
+		mutedAlertTypes:   make(map[model.AlertType]bool),
+		viewSorts:         make(map[string]viewSort),
+		logsWrap:          true,
 	}
 }
 
+// SetAllowMutations enables or disables write actions such as cordon/uncordon
+func (m *Model) SetAllowMutations(allow bool) {
+	m.allowMutations = allow
+}
+
 // T translates a message by its ID
 func (m *Model) T(messageID string) string {
 	return m.localizer.T(messageID)
@@ -353,9 +670,11 @@ func (m *Model) TF(messageID string, templateData map[string]interface{}) string
 	return m.localizer.TF(messageID, templateData)
 }
 
-// isChinese returns true if the current locale is Chinese
+// isChinese returns true if the current locale is Chinese. Content only
+// available in English and Chinese, such as recommended actions, is shown
+// in English for the other locales; layout is width-aware for all CJK text.
 func (m *Model) isChinese() bool {
-	return strings.HasPrefix(m.locale, "zh")
+	return i18n.Language(m.locale) == i18n.Chinese
 }
 
 // Init initializes the model
@@ -367,12 +686,23 @@ func (m *Model) Init() tea.Cmd {
 	)
 }
 
-// Update handles messages
+// Update handles messages, then loads the sort of the view the message
+// switched to and restarts the refresh timer if that view has a different
+// refresh interval
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	next, cmd := m.update(msg)
+	m.syncViewSort()
+	if reschedule := m.rescheduleRefresh(); reschedule != nil {
+		cmd = tea.Batch(cmd, reschedule)
+	}
+	return next, cmd
+}
+
+// update handles a single message
+func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
+		m.resize(msg.Width, msg.Height-healthBarHeight) // Views lay out below the health bar
 		return m, nil
 
 	// 忽略鼠标事件，防止滚轮触发高频重绘
@@ -380,8 +710,13 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case refreshTickMsg:
-		if m.quitting {
-			return m, nil
+		if m.quitting || msg.seq != m.refreshSeq {
+			return m, nil // Superseded by a reschedule
+		}
+		// Keep ticking while paused so resuming needs no extra bookkeeping.
+		// Focus mode refreshes only its pinned resource.
+		if m.refreshPaused || m.focusMode {
+			return m, m.scheduleRefresh()
 		}
 		return m, tea.Batch(
 			m.fetchData(),
@@ -389,9 +724,55 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		)
 
 	case tea.KeyMsg:
+		// Confirmation prompt captures all keys until answered
+		if m.confirmMode {
+			switch msg.String() {
+			case "y", "Y":
+				m.confirmMode = false
+				return m, m.executeConfirmedAction(m.confirmAction)
+			case "n", "N", "esc":
+				m.confirmMode = false
+				m.confirmMessage = ""
+			}
+			return m, nil
+		}
+
+		// Context switcher captures all keys until closed
+		if m.contextSwitcherMode {
+			return m.handleContextSwitcherKey(msg)
+		}
+
+		// Command palette captures all keys until closed
+		if m.paletteMode {
+			return m.handlePaletteKey(msg)
+		}
+
+		// Global search captures all keys until closed
+		if m.globalSearchMode {
+			return m.handleGlobalSearchKey(msg)
+		}
+
+		// Focus mode captures all keys until closed
+		if m.focusMode {
+			return m.handleFocusKey(msg)
+		}
+
+		// Help screen captures all keys until closed
+		if m.helpMode {
+			return m.handleHelpKey(msg)
+		}
+
 		// In search modes, treat most single-character keys as text input
 		// Only allow navigation keys (arrows, page up/down, esc, backspace, space, enter)
 		if m.logsSearchMode || m.searchMode {
+			// ctrl+f toggles fuzzy matching while searching
+			if m.searchMode && msg.String() == "ctrl+f" {
+				m.fuzzySearch = !m.fuzzySearch
+				m.scrollOffset = 0
+				m.selectedIndex = 0
+				return m, nil
+			}
+
 			// Check if this is a special key that should work in search mode
 			isSpecialKey := key.Matches(msg, m.keys.Back) || // Esc
 				key.Matches(msg, m.keys.Up) ||
@@ -472,6 +853,36 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.quitting = true
 			return m, tea.Quit
 
+		case key.Matches(msg, m.keys.Help):
+			// ? opens the help screen listing all key bindings
+			m.helpMode = true
+			m.helpScrollOffset = 0
+			return m, nil
+
+		case m.logsMode && msg.String() == "t":
+			// T key toggles log timestamps and re-fetches
+			m.logsTimestamps = !m.logsTimestamps
+			return m, m.fetchLogs()
+
+		case m.logsMode && msg.String() == "w":
+			// W key toggles soft-wrapping of long log lines
+			m.logsWrap = !m.logsWrap
+			m.clampLogsScroll()
+			return m, nil
+
+		case m.logsMode && msg.String() == "J":
+			// J key toggles formatting of JSON log lines
+			m.logsJSON = !m.logsJSON
+			m.clampLogsScroll()
+			return m, nil
+
+		case m.logsMode && (msg.String() == "+" || msg.String() == "=" || msg.String() == "-"):
+			// +/- step the number of fetched log lines and re-fetch
+			if msg.String() == "-" {
+				return m, m.stepLogTailLines(-1)
+			}
+			return m, m.stepLogTailLines(1)
+
 		case key.Matches(msg, m.keys.Refresh):
 			// Manual refresh: refresh logs if in logs mode, otherwise refresh cluster data
 			if m.logsMode {
@@ -481,6 +892,27 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// The refresher cache will be updated automatically
 			return m, m.fetchData()
 
+		case key.Matches(msg, m.keys.SwitchContext):
+			if m.switchingContext != "" {
+				return m, nil
+			}
+			return m, m.openContextSwitcher()
+
+		case key.Matches(msg, m.keys.Palette):
+			m.openPalette()
+			return m, nil
+
+		case key.Matches(msg, m.keys.GlobalSearch):
+			m.openGlobalSearch()
+			return m, nil
+
+		case key.Matches(msg, m.keys.Focus):
+			// F pins the pod or node of a detail view in focus mode
+			if m.detailMode && (m.currentView == ViewPodDetail || m.currentView == ViewNodeDetail) {
+				return m, m.openFocus()
+			}
+			return m, nil
+
 		// Number keys for quick view switching
 		case msg.String() == "1":
 			if !m.detailMode {
@@ -575,6 +1007,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.hasSuperPodTopology() {
 					maxViews = 10 // Include ViewTopology
 				}
+				// The heatmap is an alternate layout of the Nodes view
+				if m.currentView == ViewHeatmap {
+					m.currentView = ViewNodes
+				}
 				m.currentView = (m.currentView + 1) % ViewType(maxViews)
 				m.scrollOffset = 0 // Reset scroll when switching views
 				m.selectedIndex = 0
@@ -586,9 +1022,9 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.actionMenuMode {
 				items := m.getActionMenuItems()
 				if m.actionMenuSelectedIndex < len(items) {
-					action := items[m.actionMenuSelectedIndex].Action
+					item := items[m.actionMenuSelectedIndex]
 					m.actionMenuMode = false
-					return m, m.executeAction(action)
+					return m, m.executeAction(item.Action, item.Target)
 				}
 				m.actionMenuMode = false
 				return m, nil
@@ -617,6 +1053,24 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 			}
+			if m.detailMode && m.currentView == ViewDeploymentDetail && m.selectedDeployment != nil {
+				// Navigate from Deployment detail to Pod detail
+				pods := m.getDeploymentPods(m.selectedDeployment)
+				if m.deploymentPodSelectedIndex < workloadPodDisplayCount(pods) {
+					m.openPodDetail(pods[m.deploymentPodSelectedIndex])
+					m.fromDeploymentDetail = true
+				}
+				return m, nil
+			}
+			if m.detailMode && m.currentView == ViewStatefulSetDetail && m.selectedStatefulSet != nil {
+				// Navigate from StatefulSet detail to Pod detail
+				pods := m.getStatefulSetPods(m.selectedStatefulSet)
+				if m.statefulSetPodSelectedIndex < workloadPodDisplayCount(pods) {
+					m.openPodDetail(pods[m.statefulSetPodSelectedIndex])
+					m.fromStatefulSetDetail = true
+				}
+				return m, nil
+			}
 			if m.detailMode && m.currentView == ViewVolcanoJobDetail && m.selectedVolcanoJob != nil {
 				// Navigate from Volcano Job detail to Pod detail
 				volcanoJobPods := m.getVolcanoJobPods(m.selectedVolcanoJob)
@@ -635,6 +1089,22 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 			}
+			if m.detailMode && m.currentView == ViewNodeDetail && m.selectedNode != nil && m.nodePodSelectedIndex >= 0 {
+				// Navigate from Node detail to Pod detail
+				nodePods := m.getNodePods(m.selectedNode)
+				if m.nodePodSelectedIndex < nodePodDisplayCount(nodePods) {
+					m.selectedPod = nodePods[m.nodePodSelectedIndex]
+					m.currentView = ViewPodDetail
+					m.fromNodeDetail = true
+					m.detailScrollOffset = 0
+				}
+				return m, nil
+			}
+			if !m.detailMode && m.currentView == ViewOverview && !m.overviewProblemsOnly {
+				// Enter lists the top pods in the command palette to jump to one
+				m.openTopPodsPalette()
+				return m, nil
+			}
 			if !m.detailMode && m.clusterData != nil {
 				switch m.currentView {
 				case ViewNodes:
@@ -647,19 +1117,39 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.selectedNode = nodes[m.selectedIndex]
 						m.currentView = ViewNodeDetail
 						m.detailMode = true
-						m.detailScrollOffset = 0 // Reset scroll when entering detail
+						m.detailScrollOffset = 0    // Reset scroll when entering detail
+						m.nodePodSelectedIndex = -1 // No pod selected until arrows are used
+					}
+				case ViewHeatmap:
+					nodes := m.heatmapNodes()
+					if m.selectedIndex < len(nodes) {
+						m.selectedNode = nodes[m.selectedIndex]
+						m.currentView = ViewNodeDetail
+						m.detailMode = true
+						m.detailScrollOffset = 0
+						m.nodePodSelectedIndex = -1
+						m.fromHeatmap = true
 					}
 				case ViewPods:
+					// In grouped mode Enter toggles a group or opens a member pod
+					if m.podGroupingEnabled {
+						if m.selectedIndex < len(m.cachedPodRows) {
+							row := m.cachedPodRows[m.selectedIndex]
+							if row.group != nil {
+								m.togglePodGroup(row.group.key)
+							} else {
+								m.openPodDetail(row.pod)
+							}
+						}
+						break
+					}
 					// Use cached sorted pods if available
 					pods := m.cachedSortedPods
 					if pods == nil {
 						pods = m.getFilteredPods()
 					}
 					if m.selectedIndex < len(pods) {
-						m.selectedPod = pods[m.selectedIndex]
-						m.currentView = ViewPodDetail
-						m.detailMode = true
-						m.detailScrollOffset = 0 // Reset scroll when entering detail
+						m.openPodDetail(pods[m.selectedIndex])
 					}
 				case ViewEvents:
 					// Use cached sorted events if available
@@ -675,6 +1165,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				case ViewWorkloads:
 					// Determine which workload section contains the selected index
+					lists := m.getWorkloadLists()
 					currentItemIndex := 0
 					sectionOrder := []string{"volcanojob", "job", "service", "deployment", "statefulset", "daemonset", "cronjob"}
 
@@ -691,51 +1182,53 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							// Route to appropriate detail view based on section type
 							switch sectionType {
 							case "volcanojob":
-								if itemIndexInSection < len(m.clusterData.VolcanoJobs) {
-									m.selectedVolcanoJob = m.clusterData.VolcanoJobs[itemIndexInSection]
+								if itemIndexInSection < len(lists.volcanoJobs) {
+									m.selectedVolcanoJob = lists.volcanoJobs[itemIndexInSection]
 									m.currentView = ViewVolcanoJobDetail
 									m.detailMode = true
 									m.detailScrollOffset = 0
 									m.volcanoJobPodSelectedIndex = 0
 								}
 							case "job":
-								if itemIndexInSection < len(m.clusterData.Jobs) {
-									m.selectedJob = m.clusterData.Jobs[itemIndexInSection]
+								if itemIndexInSection < len(lists.jobs) {
+									m.selectedJob = lists.jobs[itemIndexInSection]
 									m.currentView = ViewJobDetail
 									m.detailMode = true
 									m.detailScrollOffset = 0
 								}
 							case "service":
-								if itemIndexInSection < len(m.clusterData.Services) {
-									m.selectedService = m.clusterData.Services[itemIndexInSection]
+								if itemIndexInSection < len(lists.services) {
+									m.selectedService = lists.services[itemIndexInSection]
 									m.currentView = ViewServiceDetail
 									m.detailMode = true
 									m.detailScrollOffset = 0
 								}
 							case "deployment":
-								if itemIndexInSection < len(m.clusterData.Deployments) {
-									m.selectedDeployment = m.clusterData.Deployments[itemIndexInSection]
+								if itemIndexInSection < len(lists.deployments) {
+									m.selectedDeployment = lists.deployments[itemIndexInSection]
 									m.currentView = ViewDeploymentDetail
 									m.detailMode = true
 									m.detailScrollOffset = 0
+									m.deploymentPodSelectedIndex = 0
 								}
 							case "statefulset":
-								if itemIndexInSection < len(m.clusterData.StatefulSets) {
-									m.selectedStatefulSet = m.clusterData.StatefulSets[itemIndexInSection]
+								if itemIndexInSection < len(lists.statefulSets) {
+									m.selectedStatefulSet = lists.statefulSets[itemIndexInSection]
 									m.currentView = ViewStatefulSetDetail
 									m.detailMode = true
 									m.detailScrollOffset = 0
+									m.statefulSetPodSelectedIndex = 0
 								}
 							case "daemonset":
-								if itemIndexInSection < len(m.clusterData.DaemonSets) {
-									m.selectedDaemonSet = m.clusterData.DaemonSets[itemIndexInSection]
+								if itemIndexInSection < len(lists.daemonSets) {
+									m.selectedDaemonSet = lists.daemonSets[itemIndexInSection]
 									m.currentView = ViewDaemonSetDetail
 									m.detailMode = true
 									m.detailScrollOffset = 0
 								}
 							case "cronjob":
-								if itemIndexInSection < len(m.clusterData.CronJobs) {
-									m.selectedCronJob = m.clusterData.CronJobs[itemIndexInSection]
+								if itemIndexInSection < len(lists.cronJobs) {
+									m.selectedCronJob = lists.cronJobs[itemIndexInSection]
 									m.currentView = ViewCronJobDetail
 									m.detailMode = true
 									m.detailScrollOffset = 0
@@ -764,6 +1257,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.currentView = ViewPVCDetail
 						m.detailMode = true
 						m.detailScrollOffset = 0
+						m.fromPodDetail = false
 					}
 				case ViewQueues:
 					// Queue view - select queue for detail
@@ -811,6 +1305,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, m.fetchLogs()
 				}
 				// Exit logs mode entirely
+				m.cancelLogsFetch()
 				m.logsMode = false
 				m.logsAutoRefresh = false // Stop auto-refresh
 				m.logsAutoScroll = false  // Reset auto-scroll
@@ -836,6 +1331,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.filterMode = false
 				return m, nil
 			}
+			if !m.detailMode && m.currentView == ViewHeatmap {
+				m.toggleHeatmap()
+				return m, nil
+			}
 			if m.detailMode {
 				// Special handling for navigating back from Pod detail to Job detail
 				if m.currentView == ViewPodDetail && m.fromJobDetail {
@@ -847,6 +1346,46 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, nil
 				}
 
+				// Special handling for navigating back from Pod detail to Node detail
+				if m.currentView == ViewPodDetail && m.fromNodeDetail && m.selectedNode != nil {
+					m.currentView = ViewNodeDetail
+					m.fromNodeDetail = false
+					m.detailScrollOffset = 0
+					m.selectedPod = nil
+					// Keep m.selectedNode and m.nodePodSelectedIndex intact
+					return m, nil
+				}
+
+				// Special handling for navigating back from Pod detail to Deployment detail
+				if m.currentView == ViewPodDetail && m.fromDeploymentDetail && m.selectedDeployment != nil {
+					m.currentView = ViewDeploymentDetail
+					m.fromDeploymentDetail = false
+					m.detailScrollOffset = 0
+					m.selectedPod = nil
+					// Keep m.selectedDeployment and m.deploymentPodSelectedIndex intact
+					return m, nil
+				}
+
+				// Special handling for navigating back from Pod detail to StatefulSet detail
+				if m.currentView == ViewPodDetail && m.fromStatefulSetDetail && m.selectedStatefulSet != nil {
+					m.currentView = ViewStatefulSetDetail
+					m.fromStatefulSetDetail = false
+					m.detailScrollOffset = 0
+					m.selectedPod = nil
+					// Keep m.selectedStatefulSet and m.statefulSetPodSelectedIndex intact
+					return m, nil
+				}
+
+				// Special handling for navigating back from PVC detail to Pod detail
+				if m.currentView == ViewPVCDetail && m.fromPodDetail && m.selectedPod != nil {
+					m.currentView = ViewPodDetail
+					m.fromPodDetail = false
+					m.detailScrollOffset = 0
+					m.selectedPVC = nil
+					// Keep m.selectedPod intact
+					return m, nil
+				}
+
 				// Special handling for navigating back from Pod detail to Volcano Job detail
 				if m.currentView == ViewPodDetail && m.fromVolcanoJobDetail {
 					m.currentView = ViewVolcanoJobDetail
@@ -860,6 +1399,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				switch m.currentView {
 				case ViewNodeDetail:
 					m.currentView = ViewNodes
+					if m.fromHeatmap {
+						m.currentView = ViewHeatmap
+						m.fromHeatmap = false
+					}
+					m.nodePodSelectedIndex = -1 // Reset selection
 				case ViewPodDetail:
 					m.currentView = ViewPods
 				case ViewEventDetail:
@@ -874,8 +1418,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.currentView = ViewWorkloads
 				case ViewDeploymentDetail:
 					m.currentView = ViewWorkloads
+					m.deploymentPodSelectedIndex = 0 // Reset selection
 				case ViewStatefulSetDetail:
 					m.currentView = ViewWorkloads
+					m.statefulSetPodSelectedIndex = 0 // Reset selection
 				case ViewDaemonSetDetail:
 					m.currentView = ViewWorkloads
 				case ViewCronJobDetail:
@@ -888,6 +1434,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.currentView = ViewQueues
 				case ViewTopologyDetail:
 					m.currentView = ViewTopology
+				case ViewNamespaceDetail:
+					m.currentView = ViewPods
+				case ViewNodeCompare:
+					m.currentView = ViewNodes
 				}
 				m.detailMode = false
 				m.detailScrollOffset = 0 // Reset detail scroll offset
@@ -905,6 +1455,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.selectedVolcanoJob = nil
 				m.selectedQueue = nil
 				m.selectedSuperPod = nil
+				m.selectedNamespace = ""
 				m.scrollOffset = 0
 				m.selectedIndex = 0 // Reset selected index when returning from detail view
 
@@ -922,19 +1473,47 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keys.Filter):
 			// F key opens filter mode
 			if !m.detailMode && !m.filterMode && !m.searchMode {
-				// Only for Pods view for now (will extend to Nodes later)
-				if m.currentView == ViewPods {
+				// Namespace filter for Workloads view
+				if m.currentView == ViewWorkloads {
 					m.filterMode = true
 				}
+				// Overview collapses to only the unhealthy items
+				if m.currentView == ViewOverview {
+					m.toggleOverviewProblems()
+				}
+			}
+			// In Pods view, F moves from the namespace list to the node list
+			if !m.detailMode && !m.searchMode && m.currentView == ViewPods {
+				m.openPodFilter()
+			}
+			// In Events view, F cycles through the type/kind/since filter rows
+			if !m.detailMode && !m.searchMode && m.currentView == ViewEvents {
+				m.openEventFilter()
+			}
+			// In Alerts view, F cycles through the severity/category filter rows
+			if !m.detailMode && !m.searchMode && m.currentView == ViewAlerts {
+				m.openAlertFilter()
 			}
 			return m, nil
 
 		case key.Matches(msg, m.keys.ClearFilter):
+			// C key toggles the usage chart in node detail view
+			if m.detailMode && m.currentView == ViewNodeDetail {
+				m.nodeChartMode = !m.nodeChartMode
+				m.detailScrollOffset = 0
+				return m, nil
+			}
 			// C key clears all filters
 			if !m.detailMode {
 				m.filterNamespace = ""
+				m.filterNode = ""
 				m.filterStatus = ""
 				m.filterRole = ""
+				m.filterEventType = ""
+				m.filterEventKind = ""
+				m.filterEventSince = 0
+				m.filterAlertSeverity = ""
+				m.filterAlertCategory = ""
 				m.searchText = ""
 				m.searchMode = false
 				m.scrollOffset = 0
@@ -1016,68 +1595,164 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// A key opens action menu in detail views
 			if m.detailMode && !m.actionMenuMode {
 				// Check if current view supports actions
-				if m.currentView == ViewPodDetail || m.currentView == ViewNodeDetail {
+				if len(m.getActionMenuItems()) > 0 {
 					m.actionMenuMode = true
 					m.actionMenuSelectedIndex = 0
 				}
 			}
 			return m, nil
 
+		case key.Matches(msg, m.keys.YAML):
+			// Y key shows the full object as YAML in detail views
+			if m.detailMode && !m.actionMenuMode && !m.logsMode && !m.commandOutputMode {
+				return m, m.fetchResourceYAML()
+			}
+			return m, nil
+
 		case key.Matches(msg, m.keys.Export):
 			// E key exports current view data
 			if !m.detailMode && !m.exportInProgress && !m.filterMode && !m.searchMode {
 				// Check if current view supports export
 				if m.currentView == ViewNodes || m.currentView == ViewPods ||
-					m.currentView == ViewEvents || m.currentView == ViewNetwork {
+					m.currentView == ViewEvents || m.currentView == ViewNetwork || m.currentView == ViewAlerts {
 					m.exportInProgress = true
 					return m, m.exportData(ExportCSV) // Default to CSV format
 				}
 			}
 			return m, nil
 
-		case key.Matches(msg, m.keys.Sort):
-			// S key cycles through sort fields
-			if !m.detailMode && !m.filterMode {
-				switch m.currentView {
-				case ViewNodes:
-					// Cycle through: Name -> CPU -> Memory -> Pods -> Name
-					switch m.sortField {
-					case SortByName:
-						m.sortField = SortByCPU
-						m.sortOrder = SortDesc // Default to descending for numeric fields
-					case SortByCPU:
-						m.sortField = SortByMemory
-						m.sortOrder = SortDesc
-					case SortByMemory:
-						m.sortField = SortByPods
-						m.sortOrder = SortDesc
-					case SortByPods:
-						m.sortField = SortByName
-						m.sortOrder = SortAsc // Default to ascending for name
-					default:
-						m.sortField = SortByName
-						m.sortOrder = SortAsc
-					}
-				case ViewPods:
-					// Cycle through: Name -> Namespace -> Restarts -> Name
-					switch m.sortField {
-					case SortByName:
-						m.sortField = SortByNamespace
-						m.sortOrder = SortAsc
-					case SortByNamespace:
-						m.sortField = SortByRestarts
-						m.sortOrder = SortDesc
-					case SortByRestarts:
-						m.sortField = SortByName
-						m.sortOrder = SortAsc
-					default:
-						m.sortField = SortByName
-						m.sortOrder = SortAsc
-					}
+		case key.Matches(msg, m.keys.AckAlert):
+			// X key acknowledges the selected alert in Alerts view
+			if !m.detailMode && m.currentView == ViewAlerts {
+				m.toggleAlertAck()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.MuteAlert):
+			// M key mutes the selected alert's type in Alerts view
+			if !m.detailMode && m.currentView == ViewAlerts {
+				return m, m.toggleAlertTypeMute()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Heatmap):
+			// H key switches the Nodes view between list and heatmap
+			if !m.detailMode && !m.filterMode && (m.currentView == ViewNodes || m.currentView == ViewHeatmap) {
+				m.toggleHeatmap()
+			}
+			return m, nil
+
+		case !m.detailMode && m.currentView == ViewNetwork && msg.String() == "w":
+			// W key cycles the window network rates are averaged over
+			m.cycleNetworkRateWindow()
+			return m, nil
+
+		case !m.detailMode && m.currentView == ViewHeatmap && (msg.String() == "left" || msg.String() == "right"):
+			// Left/right move between heatmap cells
+			if msg.String() == "left" {
+				m.moveHeatmapSelection(-1)
+			} else {
+				m.moveHeatmapSelection(1)
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Namespace):
+			// N key opens the quotas and limit ranges of the selected pod's namespace
+			if !m.detailMode && !m.filterMode && m.currentView == ViewPods {
+				if namespace := m.selectedListNamespace(); namespace != "" {
+					m.selectedNamespace = namespace
+					m.currentView = ViewNamespaceDetail
+					m.detailMode = true
+					m.detailScrollOffset = 0
 				}
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.TimeFormat):
+			// T key switches age columns between relative and absolute time
+			if !m.filterMode {
+				m.toggleTimeFormat()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Units):
+			// u key switches resource values between humanized and raw units
+			if !m.filterMode {
+				m.toggleRawUnits()
+			}
+			return m, nil
+
+		case !m.detailMode && !m.filterMode && m.currentView == ViewNodes && key.Matches(msg, m.keys.Mark):
+			// Space marks nodes to compare in the Nodes view instead of pausing
+			m.toggleNodeMark()
+			return m, nil
+
+		case key.Matches(msg, m.keys.Compare):
+			// V key compares the two marked nodes
+			if !m.detailMode && !m.filterMode && m.currentView == ViewNodes {
+				m.openNodeCompare()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Pause):
+			// p/space freezes the current data; r still refreshes manually
+			if m.filterMode || m.logsMode {
+				return m, nil
+			}
+			m.refreshPaused = !m.refreshPaused
+			if !m.refreshPaused {
+				return m, m.fetchData()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Scope):
+			// n toggles the fetched data between all namespaces and one namespace
+			if m.detailMode || m.filterMode || m.logsMode {
+				return m, nil
+			}
+			return m, m.toggleNamespaceScope()
+
+		case key.Matches(msg, m.keys.Completed):
+			// C key shows or hides completed pods in Pods view
+			if !m.detailMode && !m.filterMode && m.currentView == ViewPods {
+				m.hideCompletedPods = !m.hideCompletedPods
+				m.selectedIndex = 0
+				m.scrollOffset = 0
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.AllEvents):
+			// A key switches the Events view between the most recent and all events
+			if !m.detailMode && !m.filterMode && m.currentView == ViewEvents {
+				return m, m.toggleAllEvents()
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Annotations):
+			// o key expands or collapses annotations in pod, node and service detail
+			if m.detailMode && !m.logsMode && !m.actionMenuMode && m.hasAnnotationsSection() {
+				m.expandAnnotations = !m.expandAnnotations
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Group):
+			// G key toggles workload grouping in Pods view
+			if !m.detailMode && !m.filterMode && m.currentView == ViewPods {
+				m.podGroupingEnabled = !m.podGroupingEnabled
+				m.cachedPodRows = nil
+				m.selectedIndex = 0
+				m.scrollOffset = 0
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.Sort):
+			// S key cycles through the sort fields of the view, starting from
+			// its configured default
+			if !m.detailMode && !m.filterMode && m.cycleViewSort() {
 				// Reset selection after sort
 				m.selectedIndex = 0
 				m.scrollOffset = 0
+				return m, m.persistUIState()
 			}
 			return m, nil
 
@@ -1137,6 +1812,28 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 					return m, nil
 				}
+				// Navigate pods in Deployment/StatefulSet detail view
+				if m.currentView == ViewDeploymentDetail && m.selectedDeployment != nil {
+					if m.deploymentPodSelectedIndex > 0 {
+						m.deploymentPodSelectedIndex--
+					}
+					return m, nil
+				}
+				if m.currentView == ViewStatefulSetDetail && m.selectedStatefulSet != nil {
+					if m.statefulSetPodSelectedIndex > 0 {
+						m.statefulSetPodSelectedIndex--
+					}
+					return m, nil
+				}
+				// Navigate pods in Node detail view; moving above the first pod
+				// clears the selection and returns to the top of the detail
+				if m.currentView == ViewNodeDetail && m.nodePodSelectedIndex >= 0 {
+					m.nodePodSelectedIndex--
+					if m.nodePodSelectedIndex < 0 {
+						m.detailScrollOffset = 0
+					}
+					return m, nil
+				}
 				// Scroll up in detail view
 				if m.detailScrollOffset > 0 {
 					m.detailScrollOffset--
@@ -1150,6 +1847,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 			}
+			// Up/down move a whole row in the heatmap
+			if m.currentView == ViewHeatmap {
+				m.moveHeatmapSelection(-m.heatmapColumns())
+				return m, nil
+			}
 			// Other list views use item-based selection
 			if !m.detailMode {
 				if m.selectedIndex > 0 {
@@ -1242,6 +1944,31 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 					return m, nil
 				}
+				// Navigate pods in Deployment/StatefulSet detail view
+				if m.currentView == ViewDeploymentDetail && m.selectedDeployment != nil {
+					pods := m.getDeploymentPods(m.selectedDeployment)
+					if m.deploymentPodSelectedIndex < workloadPodDisplayCount(pods)-1 {
+						m.deploymentPodSelectedIndex++
+					}
+					return m, nil
+				}
+				if m.currentView == ViewStatefulSetDetail && m.selectedStatefulSet != nil {
+					pods := m.getStatefulSetPods(m.selectedStatefulSet)
+					if m.statefulSetPodSelectedIndex < workloadPodDisplayCount(pods)-1 {
+						m.statefulSetPodSelectedIndex++
+					}
+					return m, nil
+				}
+				// Navigate pods in Node detail view
+				if m.currentView == ViewNodeDetail && m.selectedNode != nil {
+					displayCount := nodePodDisplayCount(m.getNodePods(m.selectedNode))
+					if displayCount > 0 {
+						if m.nodePodSelectedIndex < displayCount-1 {
+							m.nodePodSelectedIndex++
+						}
+						return m, nil
+					}
+				}
 				// Scroll down in detail view
 				m.detailScrollOffset++
 				return m, nil
@@ -1251,13 +1978,16 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.scrollOffset++
 				return m, nil
 			}
+			if m.currentView == ViewHeatmap {
+				m.moveHeatmapSelection(m.heatmapColumns())
+				return m, nil
+			}
 			// Other list views use item-based selection
 			if !m.detailMode {
 				maxIndex := m.getMaxIndex()
 				if m.selectedIndex < maxIndex-1 {
 					m.selectedIndex++
-					maxVisible := m.height - 10
-					if m.selectedIndex >= m.scrollOffset+maxVisible {
+					if m.selectedIndex >= m.scrollOffset+m.listVisibleRows() {
 						m.scrollOffset++
 					}
 				}
@@ -1308,10 +2038,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			// Other list views use item-based selection
 			if !m.detailMode {
-				pageSize := m.height - 10
-				if pageSize < 1 {
-					pageSize = 1
-				}
+				pageSize := m.listVisibleRows()
 				if m.selectedIndex >= pageSize {
 					m.selectedIndex -= pageSize
 					m.scrollOffset -= pageSize
@@ -1377,18 +2104,14 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			// Other list views use item-based selection
 			if !m.detailMode {
-				pageSize := m.height - 10
-				if pageSize < 1 {
-					pageSize = 1
-				}
+				pageSize := m.listVisibleRows()
 				maxIndex := m.getMaxIndex()
 				if m.selectedIndex+pageSize < maxIndex {
 					m.selectedIndex += pageSize
 					m.scrollOffset += pageSize
 				} else {
 					m.selectedIndex = maxIndex - 1
-					maxVisible := m.height - 10
-					m.scrollOffset = maxIndex - maxVisible
+					m.scrollOffset = maxIndex - m.listVisibleRows()
 					if m.scrollOffset < 0 {
 						m.scrollOffset = 0
 					}
@@ -1460,10 +2183,15 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.err = msg.err
 
 		// Only update data and counters if successful
+		var persistCmd tea.Cmd
 		if msg.err == nil && msg.data != nil {
 			m.clusterData = msg.data
+			m.paletteIndex = buildPaletteIndex(msg.data)
+			m.searchIndex = buildSearchIndex(msg.data, m.paletteIndex)
 			m.lastUpdate = time.Now()
 			m.refreshCounter++
+			m.dataVersion++
+			m.updateSummaryDelta(msg.data.Summary)
 
 			// Use the summary's LastRefreshTime (set by the refresher) to determine whether
 			// this snapshot represents new metrics. This avoids both duplicate entries
@@ -1476,7 +2204,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			if snapshotTime.After(m.lastSnapshotTime) {
-				m.recordMetricSnapshot(msg.data)
+				persistCmd = m.recordMetricSnapshot(msg.data)
 				m.lastSnapshotTime = snapshotTime
 			}
 
@@ -1492,14 +2220,32 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		return m, persistCmd
+
+	case contextListMsg:
+		m.handleContextListMsg(msg)
 		return m, nil
 
+	case namespaceScopedMsg:
+		return m, m.handleNamespaceScopedMsg(msg)
+
+	case eventLimitMsg:
+		return m, m.handleEventLimitMsg(msg)
+
+	case contextSwitchedMsg:
+		return m, m.handleContextSwitchedMsg(msg)
+
 	case logsMsg:
 		// Only process log messages if still in logs mode
 		// This prevents race conditions when user exits logs mode but async fetch completes
 		if !m.logsMode {
 			return m, nil
 		}
+		// Drop results of fetches superseded by a newer one (e.g. a previous container)
+		if msg.seq != m.logsFetchSeq {
+			return m, nil
+		}
+		m.cancelLogsFetch() // Release the completed fetch's context
 
 		if msg.err != nil {
 			m.logsError = msg.err.Error()
@@ -1515,8 +2261,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			logLines := strings.Split(m.containerLogs, "\n")
 
 			// Limit log size to prevent performance issues
-			// Keep only the last 10000 lines
-			const maxLogLines = 10000
+			// Keep only the last maxLogLines lines
 			if len(logLines) > maxLogLines {
 				// Keep only the last maxLogLines
 				logLines = logLines[len(logLines)-maxLogLines:]
@@ -1559,6 +2304,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Auto-refresh logs if still in logs mode (but not in search mode)
 		// Pause refresh during search to avoid performance issues with large logs
 		if m.logsMode && m.logsAutoRefresh && !m.logsSearchMode {
+			// Skip this tick while a slow fetch is still running or one just started
+			if !m.logsRefreshDue() {
+				return m, m.startLogsRefresh()
+			}
 			return m, tea.Batch(
 				m.fetchLogs(),
 				m.startLogsRefresh(), // Schedule next refresh
@@ -1584,14 +2333,33 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return clearExportMessageMsg{}
 		})
 
+	case kubectlExecMsg:
+		return m, m.handleKubectlExecMsg(msg)
+
 	case commandOutputMsg:
 		// Display command output in viewer mode
 		m.commandOutputMode = true
 		m.commandOutputTitle = msg.title
 		m.commandOutputContent = msg.content
+		m.commandOutputRaw = msg.raw
 		m.commandOutputScroll = 0
 		return m, nil
 
+	case focusTickMsg:
+		if m.focusMode && msg.seq == m.focusSeq {
+			return m, m.fetchFocus()
+		}
+		return m, nil
+
+	case focusDataMsg:
+		return m, m.handleFocusData(msg)
+
+	case clipboardCopiedMsg:
+		m.exportMessage = fmt.Sprintf("✅ Copied: %s", msg.label)
+		return m, tea.Tick(time.Second*2, func(time.Time) tea.Msg {
+			return clearExportMessageMsg{}
+		})
+
 	case clearExportMessageMsg:
 		m.exportMessage = ""
 		return m, nil
@@ -1624,6 +2392,20 @@ func (m *Model) View() string {
 		return fmt.Sprintf("%s\n\n%s\n\n%s", header, content, footer)
 	}
 
+	// Render help screen
+	if m.helpMode {
+		content := m.renderHelp()
+		footer := m.renderFooter()
+		return fmt.Sprintf("%s\n\n%s\n\n%s", header, content, footer)
+	}
+
+	// Render focus mode dashboard
+	if m.focusMode {
+		content := m.renderFocus()
+		footer := m.renderFooter()
+		return fmt.Sprintf("%s\n\n%s\n\n%s", header, content, footer)
+	}
+
 	// Render logs view if in logs mode
 	if m.logsMode {
 		content := m.renderLogs()
@@ -1682,6 +2464,12 @@ func (m *Model) View() string {
 		content = m.renderTopology()
 	case ViewTopologyDetail:
 		content = m.renderSuperPodDetail()
+	case ViewNamespaceDetail:
+		content = m.renderNamespaceDetail()
+	case ViewHeatmap:
+		content = m.renderHeatmap()
+	case ViewNodeCompare:
+		content = m.renderNodeCompare()
 	}
 
 	// Render footer
@@ -1712,9 +2500,39 @@ func (m *Model) View() string {
 		result += "\n\n" + menu
 	}
 
+	// Overlay context switcher if active
+	if m.contextSwitcherMode {
+		result += "\n\n" + m.renderContextSwitcher()
+	}
+
+	// Overlay command palette if active
+	if m.paletteMode {
+		result += "\n\n" + m.renderPalette()
+	}
+
+	// Overlay global search if active
+	if m.globalSearchMode {
+		result += "\n\n" + m.renderGlobalSearch()
+	}
+
+	// Overlay confirmation prompt if active
+	if m.confirmMode {
+		result += "\n\n" + m.renderConfirmPrompt()
+	}
+
 	return result
 }
 
+// spinnerFrame returns the spinner character of the current refresh; it
+// advances on each refresh
+func (m *Model) spinnerFrame() string {
+	spinChars := []string{"◐", "◓", "◑", "◒"}
+	if m.refreshCounter > 0 {
+		return spinChars[m.refreshCounter%len(spinChars)]
+	}
+	return spinChars[0]
+}
+
 // renderHeader renders the header
 func (m *Model) renderHeader() string {
 	// Title with author and version
@@ -1724,30 +2542,60 @@ func (m *Model) renderHeader() string {
 	title := StyleTitle.Render(fmt.Sprintf("%s  by %s  %s", titleText, author, version))
 
 	// spinner indicator toggles on each refresh
-	spinChars := []string{"◐", "◓", "◑", "◒"}
-	spin := spinChars[0]
-	if m.refreshCounter > 0 {
-		spin = spinChars[m.refreshCounter%len(spinChars)]
-	}
+	spin := m.spinnerFrame()
 
 	var statusText string
-	if m.err != nil {
+	if m.switchingContext != "" {
+		statusText = StyleSubtitle.Render(fmt.Sprintf("%s %s", spin, m.TF("context.switching", map[string]interface{}{"Context": m.switchingContext})))
+	} else if m.err != nil {
 		statusText = StyleError.Render(fmt.Sprintf("%s: %v", m.T("common.error"), m.err))
 	} else if m.clusterData != nil {
 		status := fmt.Sprintf("%s %s: %s", spin, m.T("common.last_updated"), m.lastUpdate.Format("15:04:05"))
-		if m.refreshInterval > 0 {
-			status += fmt.Sprintf(" • %s: %s", m.T("common.auto_refresh"), m.refreshInterval)
+		if interval := m.currentRefreshInterval(); interval > 0 && !m.refreshPaused {
+			status += fmt.Sprintf(" • %s: %s", m.T("common.auto_refresh"), interval)
 		}
 		statusText = StyleSubtitle.Render(status)
+		if m.refreshPaused {
+			statusText += StyleSubtitle.Render(" • ") + StyleWarning.Render("⏸ "+m.T("common.refresh_paused"))
+		}
 	} else {
 		loading := m.T("common.loading")
-		if m.refreshInterval > 0 {
-			loading = fmt.Sprintf("%s %s: %s", loading, m.T("common.auto_refresh"), m.refreshInterval)
+		if interval := m.currentRefreshInterval(); interval > 0 {
+			loading = fmt.Sprintf("%s %s: %s", loading, m.T("common.auto_refresh"), interval)
 		}
 		statusText = StyleSubtitle.Render(loading)
 	}
 
-	return fmt.Sprintf("%s\n%s", title, statusText)
+	// Show the Kubernetes version of the cluster
+	if m.clusterData != nil && m.clusterData.ServerVersion != "" {
+		statusText = StyleSubtitle.Render(fmt.Sprintf("%s %s • ", m.T("common.server_version"), m.clusterData.ServerVersion)) + statusText
+	}
+
+	// Show the namespace scope when it can be changed with n
+	if _, ok := m.dataProvider.(namespaceScoper); ok {
+		statusText = StyleSubtitle.Render(fmt.Sprintf("%s: %s • ", m.T("common.scope"), m.namespaceScopeLabel())) + statusText
+	}
+
+	// Show the active kubeconfig context so it is always clear which cluster is shown
+	if m.activeContext != "" {
+		statusText = StyleSubtitle.Render(fmt.Sprintf("☸ %s: %s • ", m.T("common.context"), m.activeContext)) + statusText
+	}
+
+	header := fmt.Sprintf("%s\n%s", title, statusText)
+	if bar := m.renderHealthBar(); bar != "" {
+		header += "\n" + bar
+	}
+	return header
+}
+
+// listReservedLines is the height list views reserve for header, table
+// header and footer; the rest holds one row per item
+const listReservedLines = 10
+
+// listVisibleRows returns how many items of a list view fit on screen; list
+// rendering and selection scrolling share it
+func (m *Model) listVisibleRows() int {
+	return max(m.height-listReservedLines, 1)
 }
 
 // getMaxIndex returns the maximum index for the current view
@@ -1761,28 +2609,25 @@ func (m *Model) getMaxIndex() int {
 			return len(m.cachedSortedNodes)
 		}
 		return len(m.getFilteredNodes())
+	case ViewHeatmap:
+		return len(m.heatmapNodes())
 	case ViewPods:
-		if m.cachedSortedPods != nil {
-			return len(m.cachedSortedPods)
+		m.refreshPodListCache()
+		if m.podGroupingEnabled {
+			return len(m.cachedPodRows)
 		}
-		return len(m.getFilteredPods())
+		return len(m.cachedSortedPods)
 	case ViewEvents:
 		if m.cachedSortedEvents != nil {
 			return len(m.cachedSortedEvents)
 		}
 		return len(m.getFilteredEvents())
 	case ViewAlerts:
-		if m.clusterData.Summary != nil {
-			return len(m.clusterData.Summary.Alerts)
-		}
-		return 0
+		active, acked := m.getFilteredAlerts()
+		return len(active) + len(acked)
 	case ViewWorkloads:
-		// Sum all selectable workloads including Volcano jobs
-		total := len(m.clusterData.Services) + len(m.clusterData.Jobs) +
-			len(m.clusterData.Deployments) + len(m.clusterData.StatefulSets) +
-			len(m.clusterData.DaemonSets) + len(m.clusterData.CronJobs) +
-			len(m.clusterData.VolcanoJobs)
-		return total
+		// Sum all selectable workloads (after namespace filter) including Volcano jobs
+		return m.getWorkloadLists().total()
 	case ViewNetwork:
 		// For network view, use services count as the scrollable items
 		return len(m.clusterData.Services)
@@ -1808,7 +2653,24 @@ func (m *Model) renderFooter() string {
 	}
 
 	// Different key bindings for different modes
-	if m.commandOutputMode {
+	if m.confirmMode {
+		bindings = append(bindings, RenderKeyBinding("y", m.T("keys.confirm")))
+		bindings = append(bindings, RenderKeyBinding("n/esc", m.T("keys.cancel")))
+	} else if m.contextSwitcherMode {
+		bindings = append(bindings, RenderKeyBinding("↑/↓", m.T("keys.select")))
+		bindings = append(bindings, RenderKeyBinding("enter", m.T("keys.switch")))
+		bindings = append(bindings, RenderKeyBinding("esc", m.T("keys.cancel")))
+	} else if m.paletteMode || m.globalSearchMode {
+		bindings = append(bindings, RenderKeyBinding("↑/↓", m.T("keys.select")))
+		bindings = append(bindings, RenderKeyBinding("enter", m.T("keys.open")))
+		bindings = append(bindings, RenderKeyBinding("esc", m.T("keys.cancel")))
+	} else if m.helpMode {
+		bindings = append(bindings, RenderKeyBinding("↑/↓", m.T("keys.scroll")))
+		bindings = append(bindings, RenderKeyBinding("PgUp/PgDn", m.T("keys.page")))
+		bindings = append(bindings, RenderKeyBinding("?/esc", m.T("keys.back")))
+	} else if m.focusMode {
+		bindings = append(bindings, RenderKeyBinding("F/esc", m.T("keys.back")))
+	} else if m.commandOutputMode {
 		// Command output mode - show scroll and exit bindings
 		bindings = append(bindings, RenderKeyBinding("↑/↓", m.T("keys.scroll")))
 		bindings = append(bindings, RenderKeyBinding("PgUp/PgDn", m.T("keys.page")))
@@ -1824,10 +2686,15 @@ func (m *Model) renderFooter() string {
 		bindings = append(bindings, RenderKeyBinding("↑/↓", m.T("keys.scroll")))
 		bindings = append(bindings, RenderKeyBinding("PgUp/PgDn", m.T("keys.page")))
 		bindings = append(bindings, RenderKeyBinding("/", m.T("keys.search")))
+		bindings = append(bindings, RenderKeyBinding("+/-", m.T("keys.tail_lines")))
+		bindings = append(bindings, RenderKeyBinding("t", m.T("keys.timestamps")))
+		bindings = append(bindings, RenderKeyBinding("w", m.T("keys.wrap")))
+		bindings = append(bindings, RenderKeyBinding("J", m.T("keys.json")))
 		bindings = append(bindings, RenderKeyBinding("esc", m.T("keys.back")))
 	} else if m.searchMode {
 		bindings = append(bindings, RenderKeyBinding("text", m.T("keys.type_to_search")))
 		bindings = append(bindings, RenderKeyBinding("backspace", m.T("keys.delete")))
+		bindings = append(bindings, RenderKeyBinding("ctrl+f", m.T("keys.fuzzy")))
 		bindings = append(bindings, RenderKeyBinding("esc", m.T("keys.cancel")))
 	} else if m.filterMode {
 		bindings = append(bindings, RenderKeyBinding("↑/↓", m.T("keys.select")))
@@ -1841,13 +2708,44 @@ func (m *Model) renderFooter() string {
 		if m.currentView == ViewPodDetail {
 			bindings = append(bindings, RenderKeyBinding("l", m.T("keys.logs")))
 		}
-		// Add actions key binding for pod and node detail views
+		// Pods and nodes can be pinned in focus mode
 		if m.currentView == ViewPodDetail || m.currentView == ViewNodeDetail {
+			bindings = append(bindings, RenderKeyBinding("F", m.T("keys.focus")))
+		}
+		// Add actions key binding for detail views that offer actions
+		if len(m.getActionMenuItems()) > 0 {
 			bindings = append(bindings, RenderKeyBinding("a", m.T("keys.actions")))
 		}
+		if _, _, _, ok := m.selectedResourceRef(); ok {
+			bindings = append(bindings, RenderKeyBinding("y", m.T("keys.yaml")))
+		}
+		if m.hasAnnotationsSection() {
+			bindings = append(bindings, RenderKeyBinding("o", m.T("keys.annotations")))
+		}
+		// Add chart toggle and pod navigation for node detail view
+		if m.currentView == ViewNodeDetail {
+			bindings = append(bindings, RenderKeyBinding("c", m.T("keys.chart")))
+			if m.nodePodSelectedIndex >= 0 {
+				bindings = append(bindings, RenderKeyBinding("enter", m.T("keys.view_pod")))
+			}
+		}
 	} else {
 		bindings = append(bindings, RenderKeyBinding("1-8", m.T("keys.views")))
 		bindings = append(bindings, RenderKeyBinding("tab", m.T("keys.next")))
+		bindings = append(bindings, RenderKeyBinding("?", m.T("keys.help")))
+		bindings = append(bindings, RenderKeyBinding(":", m.T("keys.jump")))
+		bindings = append(bindings, RenderKeyBinding("ctrl+/", m.T("keys.search_all")))
+		if m.refreshPaused {
+			bindings = append(bindings, RenderKeyBinding("p", m.T("keys.resume")))
+		} else {
+			bindings = append(bindings, RenderKeyBinding("p", m.T("keys.pause")))
+		}
+		if _, ok := m.dataProvider.(contextSwitcher); ok {
+			bindings = append(bindings, RenderKeyBinding("ctrl+x", m.T("keys.context")))
+		}
+		if _, ok := m.dataProvider.(namespaceScoper); ok {
+			bindings = append(bindings, RenderKeyBinding("n", m.T("keys.scope")))
+		}
 		// Add navigation help for list views
 		if m.currentView != ViewOverview {
 			bindings = append(bindings, RenderKeyBinding("↑/k", m.T("keys.up")), RenderKeyBinding("↓/j", m.T("keys.down")))
@@ -1855,13 +2753,59 @@ func (m *Model) renderFooter() string {
 			bindings = append(bindings, RenderKeyBinding("enter", m.T("keys.detail")))
 			bindings = append(bindings, RenderKeyBinding("s", m.T("keys.sort")))
 			bindings = append(bindings, RenderKeyBinding("/", m.T("keys.search")))
+			bindings = append(bindings, RenderKeyBinding("T", m.T("keys.time_format")))
+		}
+		if m.rawUnits {
+			bindings = append(bindings, RenderKeyBinding("u", m.T("keys.human_units")))
+		} else {
+			bindings = append(bindings, RenderKeyBinding("u", m.T("keys.raw_units")))
 		}
 		// Add filter help for Pods view
 		if m.currentView == ViewPods {
 			bindings = append(bindings, RenderKeyBinding("f", m.T("keys.filter")))
+			bindings = append(bindings, RenderKeyBinding("G", m.T("keys.group")))
+			bindings = append(bindings, RenderKeyBinding("C", m.T("keys.completed")))
+			bindings = append(bindings, RenderKeyBinding("N", m.T("keys.namespace")))
+		}
+		if m.currentView == ViewEvents || m.currentView == ViewWorkloads || m.currentView == ViewAlerts {
+			bindings = append(bindings, RenderKeyBinding("f", m.T("keys.filter")))
+		}
+		if _, ok := m.dataProvider.(eventLimiter); ok && m.currentView == ViewEvents && m.eventLimit > 0 {
+			if m.activeEventLimit == 0 {
+				bindings = append(bindings, RenderKeyBinding("A", m.T("keys.recent_events")))
+			} else {
+				bindings = append(bindings, RenderKeyBinding("A", m.T("keys.all_events")))
+			}
+		}
+		if m.currentView == ViewNodes {
+			bindings = append(bindings, RenderKeyBinding("H", m.T("keys.heatmap")))
+			bindings = append(bindings, RenderKeyBinding("space", m.T("keys.mark")))
+			if len(m.comparedNodes) == maxComparedNodes {
+				bindings = append(bindings, RenderKeyBinding("v", m.T("keys.compare")))
+			}
+		}
+		if m.currentView == ViewNetwork {
+			bindings = append(bindings, RenderKeyBinding("w", m.T("keys.rate_window")))
+		}
+		if m.currentView == ViewHeatmap {
+			bindings = append(bindings, RenderKeyBinding("←/→", m.T("keys.select")))
+			bindings = append(bindings, RenderKeyBinding("H", m.T("keys.node_list")))
+		}
+		if m.currentView == ViewOverview {
+			if m.overviewProblemsOnly {
+				bindings = append(bindings, RenderKeyBinding("f", m.T("keys.show_all")))
+			} else {
+				bindings = append(bindings, RenderKeyBinding("f", m.T("keys.only_problems")))
+				bindings = append(bindings, RenderKeyBinding("enter", m.T("keys.top_pods")))
+			}
+		}
+		if m.currentView == ViewAlerts {
+			bindings = append(bindings, RenderKeyBinding("x", m.T("keys.ack")))
+			bindings = append(bindings, RenderKeyBinding("M", m.T("keys.mute")))
 		}
 		// Show clear if any filter is active
-		if m.filterNamespace != "" || m.filterStatus != "" || m.filterRole != "" || m.searchText != "" {
+		if m.filterNamespace != "" || m.filterNode != "" || m.filterStatus != "" || m.filterRole != "" || m.searchText != "" ||
+			m.filterEventType != "" || m.filterEventKind != "" || m.filterEventSince > 0 || m.alertFiltersActive() {
 			bindings = append(bindings, RenderKeyBinding("c", m.T("keys.clear")))
 		}
 	}
@@ -1915,7 +2859,7 @@ func (m *Model) renderViewTabs() string {
 	for _, tab := range tabs {
 		tabText := fmt.Sprintf("%d:%s", tab.number, m.T(tab.nameKey))
 
-		if m.currentView == tab.view {
+		if m.currentView == tab.view || (m.currentView == ViewHeatmap && tab.view == ViewNodes) {
 			// Highlight current view
 			tabParts = append(tabParts, StyleSelected.Render(" "+tabText+" "))
 		} else {
@@ -1944,12 +2888,20 @@ func (m *Model) forceRefresh() tea.Cmd {
 	}
 }
 
+// scheduleRefresh schedules the next auto refresh at the current view's
+// interval, and moves the background refresh to that interval when it changed
 func (m *Model) scheduleRefresh() tea.Cmd {
-	if m.refreshInterval <= 0 {
+	interval := m.currentRefreshInterval()
+	if setter, ok := m.dataProvider.(refreshIntervalSetter); ok && interval > 0 && interval != m.scheduledRefreshInterval {
+		setter.SetRefreshInterval(interval)
+	}
+	m.scheduledRefreshInterval = interval
+	if interval <= 0 {
 		return nil
 	}
-	return tea.Tick(m.refreshInterval, func(time.Time) tea.Msg {
-		return refreshTickMsg{}
+	seq := m.refreshSeq
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return refreshTickMsg{seq: seq}
 	})
 }
 
@@ -1963,23 +2915,36 @@ type errMsg struct {
 	err error
 }
 
-type refreshTickMsg struct{}
+type refreshTickMsg struct {
+	seq int // Ticks scheduled before the last reschedule are ignored
+}
 
 type logsRefreshTickMsg time.Time
 
 type logsMsg struct {
+	seq  int // logsFetchSeq of the request
 	logs string
 	err  error
 }
 
-// fetchLogs fetches logs for the selected pod and container
+// fetchLogs fetches logs for the selected pod and container. A fetch still in
+// flight is cancelled, so switching container or pod never shows stale logs.
 func (m *Model) fetchLogs() tea.Cmd {
 	if m.selectedPod == nil || m.selectedContainer == "" {
 		return nil
 	}
 
+	m.cancelLogsFetch()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.logsFetchSeq++
+	m.logsFetchCancel = cancel
+	m.logsFetchStart = time.Now()
+
+	seq := m.logsFetchSeq
 	pod := m.selectedPod
 	container := m.selectedContainer
+	timestamps := m.logsTimestamps
+	tailLines := int64(m.logTailLines)
 
 	return func() tea.Msg {
 		// Need to get the APIServerClient to call GetPodLogs
@@ -1988,16 +2953,15 @@ func (m *Model) fetchLogs() tea.Cmd {
 
 		// Try to get logs through the data provider
 		apiClient, ok := m.dataProvider.(interface {
-			GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64) (string, error)
+			GetPodLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64, timestamps bool) (string, error)
 		})
 
 		if !ok {
-			return logsMsg{err: fmt.Errorf("data provider does not support log fetching")}
+			return logsMsg{seq: seq, err: fmt.Errorf("data provider does not support log fetching")}
 		}
 
-		ctx := context.Background()
-		logs, err := apiClient.GetPodLogs(ctx, pod.Namespace, pod.Name, container, int64(m.logTailLines))
-		return logsMsg{logs: logs, err: err}
+		logs, err := apiClient.GetPodLogs(ctx, pod.Namespace, pod.Name, container, tailLines, timestamps)
+		return logsMsg{seq: seq, logs: logs, err: err}
 	}
 }
 
@@ -2028,13 +2992,17 @@ func (m *Model) initLogsScrollPosition() {
 	m.logsAutoScroll = true         // Enable auto-scroll by default
 }
 
-// getLogsDisplayLineCount calculates the total number of display lines after wrapping
+// getLogsDisplayLineCount calculates the total number of display lines after
+// wrapping (one per log line when wrapping is off)
 // This is used for scroll calculations to account for wrapped long lines
 func (m *Model) getLogsDisplayLineCount() int {
-	logLines := m.cachedLogLines
+	logLines, _ := m.logDisplayLines()
 	if len(logLines) == 0 {
 		return 0
 	}
+	if !m.logsWrap {
+		return len(logLines)
+	}
 
 	// Calculate max width for log content (same as in renderLogs)
 	maxLineWidth := m.width - 8
@@ -2054,7 +3022,20 @@ func (m *Model) getLogsDisplayLineCount() int {
 
 // handleFilterNavigation handles navigation in filter mode
 func (m *Model) handleFilterNavigation(direction int) tea.Cmd {
-	namespaces := m.getNamespaces()
+	if m.currentView == ViewEvents {
+		m.handleEventFilterNavigation(direction)
+		return nil
+	}
+	if m.currentView == ViewAlerts {
+		m.handleAlertFilterNavigation(direction)
+		return nil
+	}
+	if m.currentView == ViewPods && m.podFilterField == podFilterNode {
+		m.handleNodeFilterNavigation(direction)
+		return nil
+	}
+
+	namespaces := m.getFilterNamespaces()
 	totalOptions := len(namespaces) + 1 // +1 for "All" option
 
 	// Find current selection index
@@ -2106,13 +3087,14 @@ func (m *Model) getNamespaces() []string {
 		namespaces = append(namespaces, ns)
 	}
 
-	// Use sort.Strings for O(n log n) sorting
-	sort.Strings(namespaces)
+	// Pinned namespaces first, then alphabetically
+	m.sortNamespaces(namespaces)
 
 	return namespaces
 }
 
-// getFilteredPods returns pods filtered by namespace, status, and search text
+// getFilteredPods returns pods filtered by namespace, node, status, and search
+// text, leaving out completed pods while they are hidden
 func (m *Model) getFilteredPods() []*model.PodData {
 	if m.clusterData == nil {
 		return []*model.PodData{}
@@ -2120,32 +3102,48 @@ func (m *Model) getFilteredPods() []*model.PodData {
 
 	// Apply all filters in a single pass for better performance
 	filtered := make([]*model.PodData, 0, len(m.clusterData.Pods))
-	searchLower := strings.ToLower(m.searchText)
+	var scores []int
 
 	for _, pod := range m.clusterData.Pods {
-		// Check namespace filter
-		if m.filterNamespace != "" && pod.Namespace != m.filterNamespace {
-			continue
-		}
-
-		// Check status filter
-		if m.filterStatus != "" && pod.Phase != m.filterStatus {
-			continue
-		}
-
-		// Check search text filter (case-insensitive partial match on name)
-		if m.searchText != "" && !strings.Contains(strings.ToLower(pod.Name), searchLower) {
+		score, ok := m.podMatchesFilters(pod)
+		if !ok || m.isHiddenPod(pod) {
 			continue
 		}
 
 		// All filters passed, include this pod
 		filtered = append(filtered, pod)
+		scores = append(scores, score)
 	}
 
+	if m.fuzzyRanking() {
+		return sortByFuzzyScore(filtered, scores)
+	}
 	return filtered
 }
 
-// getFilteredEvents returns events filtered by type and search text
+// podMatchesFilters reports whether a pod passes the namespace, node, status
+// and search filters of the Pods view, with its search score
+func (m *Model) podMatchesFilters(pod *model.PodData) (int, bool) {
+	// Check namespace filter
+	if m.filterNamespace != "" && pod.Namespace != m.filterNamespace {
+		return 0, false
+	}
+
+	// Check node filter
+	if m.filterNode != "" && pod.Node != m.filterNode {
+		return 0, false
+	}
+
+	// Check status filter
+	if m.filterStatus != "" && pod.Phase != m.filterStatus {
+		return 0, false
+	}
+
+	// Check search text filter (substring or fuzzy match on name)
+	return m.searchMatch(pod.Name)
+}
+
+// getFilteredEvents returns events filtered by type, kind, age and search text
 func (m *Model) getFilteredEvents() []*model.EventData {
 	if m.clusterData == nil {
 		return []*model.EventData{}
@@ -2165,18 +3163,43 @@ func (m *Model) getFilteredEvents() []*model.EventData {
 		filtered = temp
 	}
 
-	// Apply search text filter (case-insensitive partial match on reason or message)
+	// Apply involved-object kind filter
+	if m.filterEventKind != "" {
+		temp := []*model.EventData{}
+		for _, event := range filtered {
+			if event.InvolvedKind == m.filterEventKind {
+				temp = append(temp, event)
+			}
+		}
+		filtered = temp
+	}
+
+	// Apply since filter (events that last occurred within the window)
+	if m.filterEventSince > 0 {
+		cutoff := time.Now().Add(-m.filterEventSince)
+		temp := []*model.EventData{}
+		for _, event := range filtered {
+			if eventTime(event).After(cutoff) {
+				temp = append(temp, event)
+			}
+		}
+		filtered = temp
+	}
+
+	// Apply search text filter (substring or fuzzy match on reason, message or object)
 	if m.searchText != "" {
 		temp := []*model.EventData{}
-		searchLower := strings.ToLower(m.searchText)
+		var scores []int
 		for _, event := range filtered {
-			if strings.Contains(strings.ToLower(event.Reason), searchLower) ||
-				strings.Contains(strings.ToLower(event.Message), searchLower) ||
-				strings.Contains(strings.ToLower(event.InvolvedObject), searchLower) {
+			if score, ok := m.searchMatch(event.Reason, event.Message, event.InvolvedObject); ok {
 				temp = append(temp, event)
+				scores = append(scores, score)
 			}
 		}
 		filtered = temp
+		if m.fuzzyRanking() {
+			filtered = sortByFuzzyScore(filtered, scores)
+		}
 	}
 
 	return filtered
@@ -2216,16 +3239,20 @@ func (m *Model) getFilteredNodes() []*model.NodeData {
 		filtered = temp
 	}
 
-	// Apply search text filter (case-insensitive partial match on name)
+	// Apply search text filter (substring or fuzzy match on name)
 	if m.searchText != "" {
 		temp := []*model.NodeData{}
-		searchLower := strings.ToLower(m.searchText)
+		var scores []int
 		for _, node := range filtered {
-			if strings.Contains(strings.ToLower(node.Name), searchLower) {
+			if score, ok := m.searchMatch(node.Name); ok {
 				temp = append(temp, node)
+				scores = append(scores, score)
 			}
 		}
 		filtered = temp
+		if m.fuzzyRanking() {
+			filtered = sortByFuzzyScore(filtered, scores)
+		}
 	}
 
 	return filtered
@@ -2254,29 +3281,38 @@ func (m *Model) renderSearchPanel() string {
 	lines = append(lines, StyleHeader.Render(m.T("search.title")))
 	lines = append(lines, "")
 
-	// Show search input with cursor
-	searchDisplay := m.searchText + "█" // Use block cursor
-	if m.searchText == "" {
-		searchDisplay = "█" // Just show cursor when empty
-	}
-	lines = append(lines, fmt.Sprintf("  %s", StyleHighlight.Render(searchDisplay)))
+	lines = append(lines, renderTextInput(m.searchText))
 	lines = append(lines, "")
 	lines = append(lines, StyleTextMuted.Render("  "+m.T("search.placeholder")))
 	lines = append(lines, StyleTextMuted.Render("  "+m.T("search.help")))
 
+	mode := m.T("search.mode_substring")
+	if m.fuzzySearch {
+		mode = m.T("search.mode_fuzzy")
+	}
+	lines = append(lines, StyleTextMuted.Render("  "+m.TF("search.mode", map[string]interface{}{"Mode": mode})))
+
 	return strings.Join(lines, "\n")
 }
 
-// recordMetricSnapshot records a snapshot of current metrics for trend calculation
-func (m *Model) recordMetricSnapshot(data *model.ClusterData) {
+// renderTextInput renders a text input line with a block cursor
+func renderTextInput(text string) string {
+	return fmt.Sprintf("  %s", StyleHighlight.Render(text+"█"))
+}
+
+// recordMetricSnapshot records a snapshot of current metrics for trend calculation.
+// Returns a command persisting the snapshot when a history file is configured.
+func (m *Model) recordMetricSnapshot(data *model.ClusterData) tea.Cmd {
 	snapshot := MetricSnapshot{
 		NodeMetrics: make(map[string]*NodeMetric),
 		PodMetrics:  make(map[string]*PodMetric),
 		Timestamp:   time.Now(),
 	}
 
-	// Record node metrics and accumulate cluster-wide NPU stats
+	// Record node metrics and accumulate cluster-wide NPU and usage stats
 	var clusterNPUCapacity, clusterNPUAllocated, clusterNPUAllocatable int64
+	var clusterGPUAllocated, clusterGPUAllocatable int64
+	var clusterCPUUsage, clusterMemoryUsage int64
 	for _, node := range data.Nodes {
 		// Use kubelet-provided timestamp if available, otherwise fallback to snapshot time
 		ts := node.NetworkTimestamp
@@ -2289,16 +3325,28 @@ func (m *Model) recordMetricSnapshot(data *model.ClusterData) {
 			NetworkRxBytes: node.NetworkRxBytes,
 			NetworkTxBytes: node.NetworkTxBytes,
 			Timestamp:      ts,
+			Status:         node.Status,
 			// NPU metrics
 			NPUCapacity:    node.NPUCapacity,
 			NPUAllocated:   node.NPUAllocated,
 			NPUAllocatable: node.NPUAllocatable,
+			// GPU metrics
+			GPUAllocated:   node.GPUAllocated,
+			GPUAllocatable: node.GPUAllocatable,
 		}
 
 		// Accumulate cluster-wide NPU stats
 		clusterNPUCapacity += node.NPUCapacity
 		clusterNPUAllocated += node.NPUAllocated
 		clusterNPUAllocatable += node.NPUAllocatable
+
+		// Accumulate cluster-wide GPU stats
+		clusterGPUAllocated += node.GPUAllocated
+		clusterGPUAllocatable += node.GPUAllocatable
+
+		// Accumulate cluster-wide usage
+		clusterCPUUsage += node.CPUUsage
+		clusterMemoryUsage += node.MemoryUsage
 	}
 
 	// Set cluster-wide NPU summary
@@ -2306,6 +3354,14 @@ func (m *Model) recordMetricSnapshot(data *model.ClusterData) {
 	snapshot.ClusterNPUAllocated = clusterNPUAllocated
 	snapshot.ClusterNPUAllocatable = clusterNPUAllocatable
 
+	// Set cluster-wide GPU summary
+	snapshot.ClusterGPUAllocated = clusterGPUAllocated
+	snapshot.ClusterGPUAllocatable = clusterGPUAllocatable
+
+	// Set cluster-wide usage summary
+	snapshot.ClusterCPUUsage = clusterCPUUsage
+	snapshot.ClusterMemoryUsage = clusterMemoryUsage
+
 	// Record pod metrics
 	for _, pod := range data.Pods {
 		key := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
@@ -2323,13 +3379,10 @@ func (m *Model) recordMetricSnapshot(data *model.ClusterData) {
 		}
 	}
 
-	// Add to history
-	m.metricHistory = append(m.metricHistory, snapshot)
+	// Add to history (evicts snapshots beyond maxHistory)
+	m.appendMetricSnapshot(snapshot)
 
-	// Keep only last N snapshots
-	if len(m.metricHistory) > m.maxHistory {
-		m.metricHistory = m.metricHistory[1:]
-	}
+	return m.persistMetricSnapshot(snapshot)
 }
 
 // calculateNodeCPUTrend calculates CPU trend for a node
@@ -2338,24 +3391,12 @@ func (m *Model) calculateNodeCPUTrend(nodeName string, currentCPU int64) Trend {
 		return TrendStable // Not enough data
 	}
 
-	// Get historical values (excluding the most recent snapshot which is current)
-	var historicalValues []int64
-	for i := 0; i < len(m.metricHistory)-1; i++ {
-		if metric, ok := m.metricHistory[i].NodeMetrics[nodeName]; ok {
-			historicalValues = append(historicalValues, metric.CPUUsage)
-		}
-	}
-
-	if len(historicalValues) == 0 {
+	// Average of historical values (excluding the most recent snapshot which is current)
+	prev, ok := m.previousNodeAggregate(nodeName)
+	if !ok {
 		return TrendStable
 	}
-
-	// Calculate average of historical values
-	var sum int64
-	for _, val := range historicalValues {
-		sum += val
-	}
-	avg := sum / int64(len(historicalValues))
+	avg := prev.cpu / int64(prev.count)
 
 	// Determine trend (5% threshold)
 	threshold := avg / 20 // 5%
@@ -2377,24 +3418,12 @@ func (m *Model) calculateNodeMemoryTrend(nodeName string, currentMemory int64) T
 		return TrendStable // Not enough data
 	}
 
-	// Get historical values
-	var historicalValues []int64
-	for i := 0; i < len(m.metricHistory)-1; i++ {
-		if metric, ok := m.metricHistory[i].NodeMetrics[nodeName]; ok {
-			historicalValues = append(historicalValues, metric.MemoryUsage)
-		}
-	}
-
-	if len(historicalValues) == 0 {
+	// Average of historical values
+	prev, ok := m.previousNodeAggregate(nodeName)
+	if !ok {
 		return TrendStable
 	}
-
-	// Calculate average
-	var sum int64
-	for _, val := range historicalValues {
-		sum += val
-	}
-	avg := sum / int64(len(historicalValues))
+	avg := prev.memory / int64(prev.count)
 
 	// Determine trend (5% threshold)
 	threshold := avg / 20
@@ -2417,22 +3446,11 @@ func (m *Model) calculatePodCPUTrend(namespace, name string, currentCPU int64) T
 	}
 
 	key := fmt.Sprintf("%s/%s", namespace, name)
-	var historicalValues []int64
-	for i := 0; i < len(m.metricHistory)-1; i++ {
-		if metric, ok := m.metricHistory[i].PodMetrics[key]; ok {
-			historicalValues = append(historicalValues, metric.CPUUsage)
-		}
-	}
-
-	if len(historicalValues) == 0 {
+	prev, ok := m.previousPodAggregate(key)
+	if !ok {
 		return TrendStable
 	}
-
-	var sum int64
-	for _, val := range historicalValues {
-		sum += val
-	}
-	avg := sum / int64(len(historicalValues))
+	avg := prev.cpu / int64(prev.count)
 
 	threshold := avg / 20
 	if threshold < 10 {
@@ -2454,22 +3472,11 @@ func (m *Model) calculatePodMemoryTrend(namespace, name string, currentMemory in
 	}
 
 	key := fmt.Sprintf("%s/%s", namespace, name)
-	var historicalValues []int64
-	for i := 0; i < len(m.metricHistory)-1; i++ {
-		if metric, ok := m.metricHistory[i].PodMetrics[key]; ok {
-			historicalValues = append(historicalValues, metric.MemoryUsage)
-		}
-	}
-
-	if len(historicalValues) == 0 {
+	prev, ok := m.previousPodAggregate(key)
+	if !ok {
 		return TrendStable
 	}
-
-	var sum int64
-	for _, val := range historicalValues {
-		sum += val
-	}
-	avg := sum / int64(len(historicalValues))
+	avg := prev.memory / int64(prev.count)
 
 	threshold := avg / 20
 	if threshold < 1024*1024*10 {
@@ -2686,7 +3693,7 @@ func (m *Model) getPodNetworkTxHistory(namespace, name string) []float64 {
 }
 
 // calculatePodNetworkRxRate calculates the current RX rate for a pod (MB/s)
-// Uses 20-second time-based sliding window for stable measurements
+// Uses a time-based sliding window (networkRateWindow) for stable measurements
 func (m *Model) calculatePodNetworkRxRate(namespace, name string) float64 {
 	if len(m.metricHistory) < 2 {
 		return 0
@@ -2702,14 +3709,13 @@ func (m *Model) calculatePodNetworkRxRate(namespace, name string) float64 {
 		return 0
 	}
 
-	// Use 20-second time window for stable bandwidth metrics
-	const timeWindow = 20 * time.Second
-	cutoffTime := m.metricHistory[currIdx].Timestamp.Add(-timeWindow)
+	// Use the configured time window for stable bandwidth metrics
+	cutoffTime := m.metricHistory[currIdx].Timestamp.Add(-m.networkRateWindow)
 
 	// Collect multiple valid rate measurements for averaging (sliding window)
 	var validRates []float64
 
-	// Search backwards within 20-second time window
+	// Search backwards within the time window
 	for prevIdx := currIdx - 1; prevIdx >= 0; prevIdx-- {
 		prevSnapshot := m.metricHistory[prevIdx]
 
@@ -2759,7 +3765,7 @@ func (m *Model) calculatePodNetworkRxRate(namespace, name string) float64 {
 }
 
 // calculatePodNetworkTxRate calculates the current TX rate for a pod (MB/s)
-// Uses 20-second time-based sliding window for stable measurements
+// Uses a time-based sliding window (networkRateWindow) for stable measurements
 func (m *Model) calculatePodNetworkTxRate(namespace, name string) float64 {
 	if len(m.metricHistory) < 2 {
 		return 0
@@ -2775,14 +3781,13 @@ func (m *Model) calculatePodNetworkTxRate(namespace, name string) float64 {
 		return 0
 	}
 
-	// Use 20-second time window for stable bandwidth metrics
-	const timeWindow = 20 * time.Second
-	cutoffTime := m.metricHistory[currIdx].Timestamp.Add(-timeWindow)
+	// Use the configured time window for stable bandwidth metrics
+	cutoffTime := m.metricHistory[currIdx].Timestamp.Add(-m.networkRateWindow)
 
 	// Collect multiple valid rate measurements for averaging (sliding window)
 	var validRates []float64
 
-	// Search backwards within 20-second time window
+	// Search backwards within the time window
 	for prevIdx := currIdx - 1; prevIdx >= 0; prevIdx-- {
 		prevSnapshot := m.metricHistory[prevIdx]
 
@@ -2832,7 +3837,7 @@ func (m *Model) calculatePodNetworkTxRate(namespace, name string) float64 {
 }
 
 // calculateNodeNetworkRxRate calculates the current RX rate for a node (MB/s)
-// Uses 20-second time-based sliding window for stable measurements
+// Uses a time-based sliding window (networkRateWindow) for stable measurements
 func (m *Model) calculateNodeNetworkRxRate(nodeName string) float64 {
 	if len(m.metricHistory) < 2 {
 		return 0
@@ -2846,14 +3851,13 @@ func (m *Model) calculateNodeNetworkRxRate(nodeName string) float64 {
 		return 0
 	}
 
-	// Use 20-second time window for stable bandwidth metrics
-	const timeWindow = 20 * time.Second
-	cutoffTime := m.metricHistory[currIdx].Timestamp.Add(-timeWindow)
+	// Use the configured time window for stable bandwidth metrics
+	cutoffTime := m.metricHistory[currIdx].Timestamp.Add(-m.networkRateWindow)
 
 	// Collect multiple valid rate measurements for averaging (sliding window)
 	var validRates []float64
 
-	// Search backwards within 20-second time window
+	// Search backwards within the time window
 	for prevIdx := currIdx - 1; prevIdx >= 0; prevIdx-- {
 		prevSnapshot := m.metricHistory[prevIdx]
 
@@ -2903,7 +3907,7 @@ func (m *Model) calculateNodeNetworkRxRate(nodeName string) float64 {
 }
 
 // calculateNodeNetworkTxRate calculates the current TX rate for a node (MB/s)
-// Uses 20-second time-based sliding window for stable measurements
+// Uses a time-based sliding window (networkRateWindow) for stable measurements
 func (m *Model) calculateNodeNetworkTxRate(nodeName string) float64 {
 	if len(m.metricHistory) < 2 {
 		return 0
@@ -2917,14 +3921,13 @@ func (m *Model) calculateNodeNetworkTxRate(nodeName string) float64 {
 		return 0
 	}
 
-	// Use 20-second time window for stable bandwidth metrics
-	const timeWindow = 20 * time.Second
-	cutoffTime := m.metricHistory[currIdx].Timestamp.Add(-timeWindow)
+	// Use the configured time window for stable bandwidth metrics
+	cutoffTime := m.metricHistory[currIdx].Timestamp.Add(-m.networkRateWindow)
 
 	// Collect multiple valid rate measurements for averaging (sliding window)
 	var validRates []float64
 
-	// Search backwards within 20-second time window
+	// Search backwards within the time window
 	for prevIdx := currIdx - 1; prevIdx >= 0; prevIdx-- {
 		prevSnapshot := m.metricHistory[prevIdx]
 
@@ -3060,24 +4063,31 @@ func (m *Model) calculateNodeNPUTrend(nodeName string, currentNPU int64) Trend {
 		return TrendStable // Not enough data
 	}
 
-	// Get historical values (excluding the most recent snapshot which is current)
-	var historicalValues []int64
-	for i := 0; i < len(m.metricHistory)-1; i++ {
-		if metric, ok := m.metricHistory[i].NodeMetrics[nodeName]; ok {
-			historicalValues = append(historicalValues, metric.NPUAllocated)
-		}
+	// Average of historical values (excluding the most recent snapshot which is current)
+	prev, ok := m.previousNodeAggregate(nodeName)
+	if !ok {
+		return TrendStable
 	}
+	avg := prev.npu / int64(prev.count)
 
-	if len(historicalValues) == 0 {
-		return TrendStable
+	// Determine trend (any change in NPU allocation is significant)
+	if currentNPU > avg {
+		return TrendUp
+	} else if currentNPU < avg {
+		return TrendDown
 	}
+	return TrendStable
+}
 
-	// Calculate average of historical values
-	var sum int64
-	for _, val := range historicalValues {
-		sum += val
+// calculateClusterNPUTrend calculates cluster-wide NPU allocation trend
+func (m *Model) calculateClusterNPUTrend(currentNPU int64) Trend {
+	if len(m.metricHistory) < 3 {
+		return TrendStable // Not enough data
 	}
-	avg := sum / int64(len(historicalValues))
+
+	// Average of historical values (excluding the most recent snapshot which is current)
+	latest := m.metricHistory[len(m.metricHistory)-1].ClusterNPUAllocated
+	avg := (m.clusterNPUAllocatedSum - latest) / int64(len(m.metricHistory)-1)
 
 	// Determine trend (any change in NPU allocation is significant)
 	if currentNPU > avg {
@@ -3088,33 +4098,119 @@ func (m *Model) calculateNodeNPUTrend(nodeName string, currentNPU int64) Trend {
 	return TrendStable
 }
 
-// calculateClusterNPUTrend calculates cluster-wide NPU allocation trend
-func (m *Model) calculateClusterNPUTrend(currentNPU int64) Trend {
+// ============================================================================
+// GPU Trend/History Functions
+// ============================================================================
+
+// getNodeGPUUtilizationHistory returns GPU allocation percentage history for a node
+func (m *Model) getNodeGPUUtilizationHistory(nodeName string) []float64 {
+	var history []float64
+	for _, snapshot := range m.metricHistory {
+		if metric, ok := snapshot.NodeMetrics[nodeName]; ok {
+			if metric.GPUAllocatable > 0 {
+				util := float64(metric.GPUAllocated) / float64(metric.GPUAllocatable) * 100
+				history = append(history, util)
+			} else {
+				history = append(history, 0)
+			}
+		}
+	}
+	return history
+}
+
+// getClusterGPUUtilizationHistory returns cluster-wide GPU allocation percentage history
+func (m *Model) getClusterGPUUtilizationHistory() []float64 {
+	var history []float64
+	for _, snapshot := range m.metricHistory {
+		if snapshot.ClusterGPUAllocatable > 0 {
+			util := float64(snapshot.ClusterGPUAllocated) / float64(snapshot.ClusterGPUAllocatable) * 100
+			history = append(history, util)
+		} else {
+			history = append(history, 0)
+		}
+	}
+	return history
+}
+
+// calculateClusterGPUTrend calculates cluster-wide GPU allocation trend
+func (m *Model) calculateClusterGPUTrend(currentGPU int64) Trend {
 	if len(m.metricHistory) < 3 {
 		return TrendStable // Not enough data
 	}
 
-	// Get historical values
-	var historicalValues []int64
-	for i := 0; i < len(m.metricHistory)-1; i++ {
-		historicalValues = append(historicalValues, m.metricHistory[i].ClusterNPUAllocated)
+	// Average of historical values (excluding the most recent snapshot which is current)
+	latest := m.metricHistory[len(m.metricHistory)-1].ClusterGPUAllocated
+	avg := (m.clusterGPUAllocatedSum - latest) / int64(len(m.metricHistory)-1)
+
+	if currentGPU > avg {
+		return TrendUp
+	} else if currentGPU < avg {
+		return TrendDown
 	}
+	return TrendStable
+}
 
-	if len(historicalValues) == 0 {
-		return TrendStable
+// getClusterCPUHistory returns cluster-wide CPU usage history in cores
+func (m *Model) getClusterCPUHistory() []float64 {
+	var history []float64
+	for _, snapshot := range m.metricHistory {
+		history = append(history, float64(snapshot.ClusterCPUUsage)/1000.0)
 	}
+	return history
+}
 
-	// Calculate average
-	var sum int64
-	for _, val := range historicalValues {
-		sum += val
+// getClusterMemoryHistory returns cluster-wide memory usage history in GiB
+func (m *Model) getClusterMemoryHistory() []float64 {
+	var history []float64
+	for _, snapshot := range m.metricHistory {
+		history = append(history, float64(snapshot.ClusterMemoryUsage)/1024/1024/1024)
 	}
-	avg := sum / int64(len(historicalValues))
+	return history
+}
 
-	// Determine trend (any change in NPU allocation is significant)
-	if currentNPU > avg {
+// calculateClusterCPUTrend calculates cluster-wide CPU usage trend
+func (m *Model) calculateClusterCPUTrend(currentCPU int64) Trend {
+	if len(m.metricHistory) < 3 {
+		return TrendStable // Not enough data
+	}
+
+	// Average of historical values (excluding the most recent snapshot which is current)
+	latest := m.metricHistory[len(m.metricHistory)-1].ClusterCPUUsage
+	avg := (m.clusterCPUUsageSum - latest) / int64(len(m.metricHistory)-1)
+
+	// Determine trend (5% threshold)
+	threshold := avg / 20 // 5%
+	if threshold < 10 {
+		threshold = 10 // Minimum threshold to avoid noise
+	}
+
+	if currentCPU > avg+threshold {
 		return TrendUp
-	} else if currentNPU < avg {
+	} else if currentCPU < avg-threshold {
+		return TrendDown
+	}
+	return TrendStable
+}
+
+// calculateClusterMemoryTrend calculates cluster-wide memory usage trend
+func (m *Model) calculateClusterMemoryTrend(currentMemory int64) Trend {
+	if len(m.metricHistory) < 3 {
+		return TrendStable // Not enough data
+	}
+
+	// Average of historical values (excluding the most recent snapshot which is current)
+	latest := m.metricHistory[len(m.metricHistory)-1].ClusterMemoryUsage
+	avg := (m.clusterMemoryUsageSum - latest) / int64(len(m.metricHistory)-1)
+
+	// Determine trend (5% threshold)
+	threshold := avg / 20
+	if threshold < 1024*1024*10 { // 10MB minimum threshold
+		threshold = 1024 * 1024 * 10
+	}
+
+	if currentMemory > avg+threshold {
+		return TrendUp
+	} else if currentMemory < avg-threshold {
 		return TrendDown
 	}
 	return TrendStable
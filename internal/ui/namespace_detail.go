@@ -0,0 +1,315 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// quotaNearLimitPercent is the usage above which a quota is highlighted, since
+// new pods in the namespace are likely to be rejected
+const quotaNearLimitPercent = 90.0
+
+// selectedListNamespace returns the namespace to open from the Pods view: the
+// active namespace filter, or the namespace of the row under the cursor
+func (m *Model) selectedListNamespace() string {
+	if m.filterNamespace != "" {
+		return m.filterNamespace
+	}
+
+	m.refreshPodListCache()
+	if m.podGroupingEnabled {
+		if m.selectedIndex < 0 || m.selectedIndex >= len(m.cachedPodRows) {
+			return ""
+		}
+		row := m.cachedPodRows[m.selectedIndex]
+		if row.group != nil {
+			return row.group.namespace
+		}
+		return row.pod.Namespace
+	}
+
+	if m.selectedIndex < 0 || m.selectedIndex >= len(m.cachedSortedPods) {
+		return ""
+	}
+	return m.cachedSortedPods[m.selectedIndex].Namespace
+}
+
+// getNamespaceQuotas returns the ResourceQuotas and LimitRanges of a namespace
+func (m *Model) getNamespaceQuotas(namespace string) ([]*model.QuotaData, []*model.LimitRangeData) {
+	if m.clusterData == nil {
+		return nil, nil
+	}
+
+	var quotas []*model.QuotaData
+	for _, quota := range m.clusterData.ResourceQuotas {
+		if quota.Namespace == namespace {
+			quotas = append(quotas, quota)
+		}
+	}
+	var limitRanges []*model.LimitRangeData
+	for _, lr := range m.clusterData.LimitRanges {
+		if lr.Namespace == namespace {
+			limitRanges = append(limitRanges, lr)
+		}
+	}
+	return quotas, limitRanges
+}
+
+// getQuotaRejections returns recent events of pods rejected by a quota in the namespace
+func (m *Model) getQuotaRejections(namespace string) []*model.EventData {
+	if m.clusterData == nil {
+		return nil
+	}
+
+	var events []*model.EventData
+	for _, event := range m.clusterData.Events {
+		if event.InvolvedNamespace == namespace && strings.Contains(event.Message, "exceeded quota") {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// renderNamespaceDetail renders the pod totals, ResourceQuota usage and
+// LimitRange defaults of the selected namespace
+func (m *Model) renderNamespaceDetail() string {
+	if m.selectedNamespace == "" {
+		return m.T("detail.ns.no_selected")
+	}
+
+	namespace := m.selectedNamespace
+	var lines []string
+
+	// Header
+	header := StyleHeader.Render(fmt.Sprintf("🗂️  %s: %s", m.T("detail.ns.title"), namespace))
+	if m.isPinnedNamespace(namespace) {
+		header += " " + StyleHighlight.Render(pinnedNamespaceMarker)
+	}
+	lines = append(lines, header, "")
+
+	// Pod totals
+	lines = append(lines, StyleSubHeader.Render(m.T("detail.ns.basic_info")))
+	lines = append(lines, renderSeparator(m.width))
+
+	var total, running, pending, failed int
+	var cpuRequest, memRequest int64
+	// Usage is compared with the requests of pods holding a reservation
+	var cpuReserved, memReserved, cpuUsed, memUsed int64
+	if m.clusterData != nil {
+		for _, pod := range m.clusterData.Pods {
+			if pod.Namespace != namespace {
+				continue
+			}
+			total++
+			switch pod.Phase {
+			case "Running":
+				running++
+			case "Pending":
+				pending++
+			case "Failed":
+				failed++
+			}
+			cpuRequest += pod.CPURequest
+			memRequest += pod.MemoryRequest
+			if pod.Phase == "Running" || pod.Phase == "Pending" {
+				cpuReserved += pod.CPURequest
+				memReserved += pod.MemoryRequest
+				cpuUsed += pod.CPUUsage
+				memUsed += pod.MemoryUsage
+			}
+		}
+	}
+	lines = append(lines, fmt.Sprintf("  %s: %d (%s)", m.T("detail.ns.pods"), total,
+		m.TF("detail.ns.pod_phases", map[string]interface{}{
+			"Running": running,
+			"Pending": pending,
+			"Failed":  failed,
+		})))
+	lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.ns.requests"),
+		m.TF("detail.ns.requests_value", map[string]interface{}{
+			"CPU":    formatCPU(cpuRequest, m.rawUnits),
+			"Memory": formatMemory(memRequest, m.rawUnits),
+		})))
+	cpuEfficiency := requestEfficiency(cpuUsed, cpuReserved)
+	memEfficiency := requestEfficiency(memUsed, memReserved)
+	if cpuEfficiency > 0 || memEfficiency > 0 {
+		lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.ns.efficiency"),
+			m.TF("detail.ns.requests_value", map[string]interface{}{
+				"CPU":    formatEfficiency(cpuEfficiency),
+				"Memory": formatEfficiency(memEfficiency),
+			})))
+	}
+
+	quotas, limitRanges := m.getNamespaceQuotas(namespace)
+
+	// Resource Quotas
+	lines = append(lines, "")
+	lines = append(lines, StyleSubHeader.Render(m.T("detail.ns.quotas")))
+	lines = append(lines, renderSeparator(m.width))
+	if len(quotas) == 0 {
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("detail.ns.no_quotas")))
+	}
+	for i, quota := range quotas {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, m.renderQuotaTable(quota)...)
+	}
+
+	// Quota rejections
+	if rejections := m.getQuotaRejections(namespace); len(rejections) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, StyleSubHeader.Render(m.T("detail.ns.quota_rejections")))
+		lines = append(lines, renderSeparator(m.width))
+		for i, event := range rejections {
+			if i >= 5 {
+				lines = append(lines, StyleTextMuted.Render(fmt.Sprintf("  ... and %d more", len(rejections)-5)))
+				break
+			}
+			lines = append(lines, fmt.Sprintf("  %s %s: %s",
+				StyleTextMuted.Render(formatDuration(time.Since(event.LastTimestamp))+" ago"),
+				event.InvolvedObject,
+				StyleDanger.Render(truncate(event.Message, m.width-40))))
+		}
+	}
+
+	// Limit Ranges
+	lines = append(lines, "")
+	lines = append(lines, StyleSubHeader.Render(m.T("detail.ns.limit_ranges")))
+	lines = append(lines, renderSeparator(m.width))
+	if len(limitRanges) == 0 {
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("detail.ns.no_limit_ranges")))
+	}
+	for i, lr := range limitRanges {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, m.renderLimitRangeTable(lr)...)
+	}
+
+	// Handle scrolling for detail view
+	maxVisible := m.height - 10
+	if maxVisible < 5 {
+		maxVisible = 5
+	}
+
+	// Clamp scroll offset to valid range (prevent scrolling beyond content)
+	m.noteDetailLines(len(lines), maxVisible)
+	maxScroll := len(lines) - maxVisible
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	detailScrollOffset := m.detailScrollOffset
+	if detailScrollOffset > maxScroll {
+		detailScrollOffset = maxScroll
+	}
+	if detailScrollOffset < 0 {
+		detailScrollOffset = 0
+	}
+
+	startIdx := detailScrollOffset
+	endIdx := startIdx + maxVisible
+	if endIdx > len(lines) {
+		endIdx = len(lines)
+	}
+
+	visibleLines := lines[startIdx:endIdx]
+
+	// Add scroll indicator
+	if len(lines) > maxVisible {
+		scrollInfo := fmt.Sprintf("(viewing %d-%d of %d lines, use ↑↓ or PgUp/PgDn to scroll)",
+			startIdx+1, endIdx, len(lines))
+		visibleLines = append(visibleLines, "")
+		visibleLines = append(visibleLines, StyleTextMuted.Render(scrollInfo))
+	}
+
+	return strings.Join(visibleLines, "\n")
+}
+
+// renderQuotaTable renders hard, used and remaining amounts of each resource
+// in a quota, highlighting resources that are nearly exhausted
+func (m *Model) renderQuotaTable(quota *model.QuotaData) []string {
+	var lines []string
+	nearLimit := 0
+	for _, res := range quota.Resources {
+		if res.UsagePercent() >= quotaNearLimitPercent {
+			nearLimit++
+		}
+	}
+
+	title := "  " + StyleHighlight.Render(quota.Name)
+	if nearLimit > 0 {
+		title += "  " + StyleDanger.Render("⚠ "+m.TF("detail.ns.near_limit", map[string]interface{}{
+			"Count":   nearLimit,
+			"Percent": fmt.Sprintf("%.0f", quotaNearLimitPercent),
+		}))
+	}
+	lines = append(lines, title)
+
+	const colResource, colValue = 26, 12
+	lines = append(lines, StyleTextMuted.Render(fmt.Sprintf("  %s%s%s%s%s",
+		padRight(m.T("detail.ns.col_resource"), colResource),
+		padRight(m.T("detail.ns.col_used"), colValue),
+		padRight(m.T("detail.ns.col_hard"), colValue),
+		padRight(m.T("detail.ns.col_remaining"), colValue),
+		m.T("detail.ns.col_usage"))))
+
+	for _, res := range quota.Resources {
+		percent := res.UsagePercent()
+		usage := fmt.Sprintf("%.0f%%", percent)
+		switch {
+		case percent >= quotaNearLimitPercent:
+			usage = StyleDanger.Render("⚠ " + usage)
+		case percent >= 75:
+			usage = StyleWarning.Render(usage)
+		default:
+			usage = StyleStatusReady.Render(usage)
+		}
+
+		lines = append(lines, fmt.Sprintf("  %s%s%s%s%s",
+			padRight(truncate(res.Name, colResource-2), colResource),
+			padRight(res.Used, colValue),
+			padRight(res.Hard, colValue),
+			padRight(res.Remaining, colValue),
+			usage))
+	}
+	return lines
+}
+
+// renderLimitRangeTable renders the per-resource constraints and defaults of a LimitRange
+func (m *Model) renderLimitRangeTable(lr *model.LimitRangeData) []string {
+	lines := []string{"  " + StyleHighlight.Render(lr.Name)}
+
+	const colType, colResource, colValue = 12, 20, 12
+	lines = append(lines, StyleTextMuted.Render(fmt.Sprintf("  %s%s%s%s%s%s%s",
+		padRight(m.T("detail.ns.col_type"), colType),
+		padRight(m.T("detail.ns.col_resource"), colResource),
+		padRight(m.T("detail.ns.col_min"), colValue),
+		padRight(m.T("detail.ns.col_max"), colValue),
+		padRight(m.T("detail.ns.col_default_request"), colValue),
+		padRight(m.T("detail.ns.col_default_limit"), colValue),
+		m.T("detail.ns.col_max_ratio"))))
+
+	// orDash renders unset constraints as "-"
+	orDash := func(value string) string {
+		if value == "" {
+			return StyleTextMuted.Render("-")
+		}
+		return value
+	}
+
+	for _, item := range lr.Limits {
+		lines = append(lines, fmt.Sprintf("  %s%s%s%s%s%s%s",
+			padRight(item.Type, colType),
+			padRight(truncate(item.Resource, colResource-2), colResource),
+			padRight(orDash(item.Min), colValue),
+			padRight(orDash(item.Max), colValue),
+			padRight(orDash(item.DefaultRequest), colValue),
+			padRight(orDash(item.Default), colValue),
+			orDash(item.MaxRatio)))
+	}
+	return lines
+}
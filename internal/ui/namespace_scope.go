@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"errors"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// namespaceScoper is implemented by data providers that can change the
+// namespace cluster data is fetched for
+type namespaceScoper interface {
+	SetNamespace(namespace string) error
+}
+
+// namespaceScopedMsg is sent once the data provider has changed its namespace scope
+type namespaceScopedMsg struct {
+	namespace string
+	err       error
+}
+
+// SetNamespaceScope sets the namespace scope shown in the header ("" = all namespaces)
+func (m *Model) SetNamespaceScope(namespace string) {
+	m.namespaceScope = namespace
+	m.lastScopedNamespace = namespace
+}
+
+// toggleNamespaceScope switches between all namespaces and a single namespace:
+// the namespace of the selected pod or the namespace filter, falling back to
+// the namespace scoped to last
+func (m *Model) toggleNamespaceScope() tea.Cmd {
+	scoper, ok := m.dataProvider.(namespaceScoper)
+	if !ok {
+		return nil
+	}
+
+	namespace := ""
+	if m.namespaceScope == "" {
+		if m.currentView == ViewPods {
+			namespace = m.selectedListNamespace()
+		}
+		if namespace == "" {
+			namespace = m.filterNamespace
+		}
+		if namespace == "" {
+			namespace = m.lastScopedNamespace
+		}
+		if namespace == "" {
+			m.err = errors.New(m.T("scope.no_namespace"))
+			return nil
+		}
+	}
+
+	return func() tea.Msg {
+		return namespaceScopedMsg{namespace: namespace, err: scoper.SetNamespace(namespace)}
+	}
+}
+
+// handleNamespaceScopedMsg resets list state tied to the previous scope and
+// fetches data of the new one
+func (m *Model) handleNamespaceScopedMsg(msg namespaceScopedMsg) tea.Cmd {
+	if msg.err != nil {
+		m.err = msg.err
+		return nil
+	}
+
+	m.namespaceScope = msg.namespace
+	if msg.namespace != "" {
+		m.lastScopedNamespace = msg.namespace
+	}
+	m.err = nil
+
+	// Counts of the previous scope must not show up as changes
+	m.prevSummary = nil
+	m.summaryDelta = nil
+
+	m.filterNamespace = ""
+	m.selectedIndex = 0
+	m.scrollOffset = 0
+	m.cachedSortedNodes = nil
+	m.cachedSortedPods = nil
+	m.cachedPodRows = nil
+	m.cachedSortedEvents = nil
+	m.resetMetricHistory()
+
+	return m.fetchData()
+}
+
+// namespaceScopeLabel returns the scope shown in the header
+func (m *Model) namespaceScopeLabel() string {
+	if m.namespaceScope == "" {
+		return m.T("scope.all_namespaces")
+	}
+	return m.namespaceScope
+}
@@ -22,17 +22,17 @@ func (m *Model) renderNetwork() string {
 	header := m.renderNetworkHeader()
 	allLines = append(allLines, header, "")
 
+	// Per-pod bandwidth table
+	podNetworkLines := strings.Split(m.renderPodNetwork(), "\n")
+	allLines = append(allLines, podNetworkLines...)
+
 	// Services and Endpoints
 	if len(m.clusterData.Services) > 0 {
 		servicesLines := strings.Split(m.renderServices(), "\n")
-		allLines = append(allLines, servicesLines...)
 		allLines = append(allLines, "")
+		allLines = append(allLines, servicesLines...)
 	}
 
-	// Pod Network Information
-	podNetworkLines := strings.Split(m.renderPodNetwork(), "\n")
-	allLines = append(allLines, podNetworkLines...)
-
 	// Apply scroll offset with proper bounds checking
 	maxVisible := m.height - 8
 	if maxVisible < 1 {
@@ -92,12 +92,22 @@ func (m *Model) renderNetworkHeader() string {
 		"Services":  totalServices,
 		"Endpoints": totalEndpoints,
 	})
+	window := m.TF("network.rate_window", map[string]interface{}{
+		"Seconds": int(m.networkRateWindow.Seconds()),
+	})
+
+	field, order := m.networkSort()
+	arrow := "↑"
+	if order == SortDesc {
+		arrow = "↓"
+	}
 
 	return lipgloss.JoinHorizontal(
 		lipgloss.Top,
 		title,
 		"  ",
-		StyleTextSecondary.Render(summary),
+		StyleTextSecondary.Render(fmt.Sprintf("%s • %s • %s: %s %s",
+			summary, window, m.T("common.sort"), m.networkSortLabel(field), arrow)),
 	)
 }
 
@@ -207,103 +217,180 @@ func (m *Model) renderServices() string {
 	return strings.Join(rows, "\n")
 }
 
-// renderPodNetwork renders pod network information
+// networkTopTalkers is the number of busiest pods highlighted in the traffic table
+const networkTopTalkers = 5
+
+// networkMaxPods caps the rows of the traffic table
+const networkMaxPods = 30
+
+// podTraffic is a pod with its current network rates (MB/s)
+type podTraffic struct {
+	pod    *model.PodData
+	rxRate float64
+	txRate float64
+}
+
+// networkSort returns the active Network view sort, defaulting to total traffic
+func (m *Model) networkSort() (SortField, SortOrder) {
+	switch m.sortField {
+	case SortByTraffic, SortByRxRate, SortByTxRate, SortByNamespace, SortByPodName, SortByNode:
+		return m.sortField, m.sortOrder
+	default:
+		return SortByTraffic, SortDesc
+	}
+}
+
+// networkSortLabel returns the header label of a Network view sort field
+func (m *Model) networkSortLabel(field SortField) string {
+	switch field {
+	case SortByRxRate:
+		return m.T("network.sort_rx")
+	case SortByTxRate:
+		return m.T("network.sort_tx")
+	case SortByNamespace:
+		return m.T("columns.namespace")
+	case SortByPodName:
+		return m.T("columns.pod")
+	case SortByNode:
+		return m.T("columns.node")
+	default:
+		return m.T("network.sort_traffic")
+	}
+}
+
+// getPodTraffic returns the pods with network information and their current
+// rates, ordered by the active Network view sort
+func (m *Model) getPodTraffic() []podTraffic {
+	var pods []podTraffic
+	for _, pod := range m.clusterData.Pods {
+		// Only skip if both PodIP and HostIP are empty
+		// This ensures we show hostNetwork pods and Pending pods with HostIP
+		if pod.PodIP == "" && pod.HostIP == "" {
+			continue
+		}
+		pods = append(pods, podTraffic{
+			pod:    pod,
+			rxRate: m.calculatePodNetworkRxRate(pod.Namespace, pod.Name),
+			txRate: m.calculatePodNetworkTxRate(pod.Namespace, pod.Name),
+		})
+	}
+
+	field, order := m.networkSort()
+	sort.SliceStable(pods, func(i, j int) bool {
+		a, b := pods[i], pods[j]
+		var less, equal bool
+		switch field {
+		case SortByRxRate:
+			less, equal = a.rxRate < b.rxRate, a.rxRate == b.rxRate
+		case SortByTxRate:
+			less, equal = a.txRate < b.txRate, a.txRate == b.txRate
+		case SortByNamespace:
+			less, equal = a.pod.Namespace < b.pod.Namespace, a.pod.Namespace == b.pod.Namespace
+		case SortByPodName:
+			less, equal = a.pod.Name < b.pod.Name, a.pod.Name == b.pod.Name
+		case SortByNode:
+			less, equal = a.pod.Node < b.pod.Node, a.pod.Node == b.pod.Node
+		default:
+			totalA, totalB := a.rxRate+a.txRate, b.rxRate+b.txRate
+			less, equal = totalA < totalB, totalA == totalB
+		}
+		if equal {
+			// Ties keep a stable namespace/name order regardless of direction
+			if a.pod.Namespace != b.pod.Namespace {
+				return a.pod.Namespace < b.pod.Namespace
+			}
+			return a.pod.Name < b.pod.Name
+		}
+		if order == SortDesc {
+			return !less
+		}
+		return less
+	})
+	return pods
+}
+
+// topTalkerRanks maps the busiest pods (by RX+TX rate, idle pods excluded) to
+// their 1-based rank, independently of the active sort
+func topTalkerRanks(pods []podTraffic) map[*model.PodData]int {
+	busy := make([]podTraffic, 0, len(pods))
+	for _, pt := range pods {
+		if pt.rxRate+pt.txRate > 0 {
+			busy = append(busy, pt)
+		}
+	}
+	sort.SliceStable(busy, func(i, j int) bool {
+		return busy[i].rxRate+busy[i].txRate > busy[j].rxRate+busy[j].txRate
+	})
+
+	ranks := make(map[*model.PodData]int, networkTopTalkers)
+	for i, pt := range busy {
+		if i >= networkTopTalkers {
+			break
+		}
+		ranks[pt.pod] = i + 1
+	}
+	return ranks
+}
+
+// renderPodNetwork renders the per-pod bandwidth table, sortable with the S key.
+// The busiest pods are ranked and highlighted whatever the sort.
 func (m *Model) renderPodNetwork() string {
 	var rows []string
 	rows = append(rows, StyleSubHeader.Render(m.T("network.pod_network_title")))
 	rows = append(rows, "")
 
 	const (
-		colName      = 30
-		colNamespace = 12
-		colPodIP     = 16
+		colRank      = 3
+		colNamespace = 16
+		colName      = 36
 		colNode      = 20
 		colRx        = 15
 		colTx        = 15
 	)
 
 	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
-		padRight(m.T("columns.pod"), colName),
+		padRight("#", colRank),
 		padRight(m.T("columns.namespace"), colNamespace),
-		padRight(m.T("columns.pod_ip"), colPodIP),
+		padRight(m.T("columns.pod"), colName),
 		padRight(m.T("columns.node"), colNode),
 		padRight(m.T("network.pod_network_rx"), colRx),
 		padRight(m.T("network.pod_network_tx"), colTx),
 	)
 	rows = append(rows, StyleTextMuted.Render(headerRow))
 
-	// Show pods with network info, sorted by current network rate (MB/s)
-	type podTraffic struct {
-		pod       *model.PodData
-		rxRate    float64
-		txRate    float64
-		totalRate float64
-	}
+	podList := m.getPodTraffic()
+	ranks := topTalkerRanks(podList)
 
-	var podList []podTraffic
-	for _, pod := range m.clusterData.Pods {
-		// Only skip if both PodIP and HostIP are empty
-		// This ensures we show hostNetwork pods and Pending pods with HostIP
-		if pod.PodIP == "" && pod.HostIP == "" {
-			continue
+	for i, pt := range podList {
+		if i >= networkMaxPods {
+			rows = append(rows, "")
+			rows = append(rows, StyleTextMuted.Render("  "+m.TF("network.pod_network_showing_top", map[string]interface{}{
+				"Top":   networkMaxPods,
+				"Total": len(podList),
+			})))
+			break
 		}
-
-		// Calculate current network rate (MB/s)
-		rxRate := m.calculatePodNetworkRxRate(pod.Namespace, pod.Name)
-		txRate := m.calculatePodNetworkTxRate(pod.Namespace, pod.Name)
-		totalRate := rxRate + txRate
-
-		podList = append(podList, podTraffic{
-			pod:       pod,
-			rxRate:    rxRate,
-			txRate:    txRate,
-			totalRate: totalRate,
-		})
-	}
-
-	// Sort by total rate (descending) - O(n log n)
-	// This ensures pods with highest CURRENT throughput appear at top
-	sort.Slice(podList, func(i, j int) bool {
-		return podList[i].totalRate > podList[j].totalRate
-	})
-
-	// Show top pods
-	count := 0
-	maxDisplay := 20
-	for _, pt := range podList {
 		pod := pt.pod
 
-		podIP := pod.PodIP
-		if podIP == "" {
-			podIP = StyleTextMuted.Render("-")
+		rankStr := ""
+		name := truncate(pod.Name, colName)
+		if rank, ok := ranks[pod]; ok {
+			rankStr = StyleHighlight.Render(fmt.Sprintf("%d", rank))
+			name = StyleHighlight.Render(name)
 		}
 
-		// Use pre-calculated rates
-		rxStr := formatNetworkRate(pt.rxRate)
-		txStr := formatNetworkRate(pt.txRate)
-
 		row := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
-			padRight(truncate(pod.Name, colName), colName),
+			padRight(rankStr, colRank),
 			padRight(truncate(pod.Namespace, colNamespace), colNamespace),
-			padRight(podIP, colPodIP),
+			padRight(name, colName),
 			padRight(truncate(pod.Node, colNode), colNode),
-			padRight(rxStr, colRx),
-			padRight(txStr, colTx),
+			padRight(formatNetworkRate(pt.rxRate), colRx),
+			padRight(formatNetworkRate(pt.txRate), colTx),
 		)
 		rows = append(rows, row)
-
-		count++
-		if count >= maxDisplay {
-			rows = append(rows, "")
-			rows = append(rows, StyleTextMuted.Render("  "+m.TF("network.pod_network_showing_top", map[string]interface{}{
-				"Top":   maxDisplay,
-				"Total": len(podList),
-			})))
-			break
-		}
 	}
 
-	if count == 0 {
+	if len(podList) == 0 {
 		rows = append(rows, StyleTextMuted.Render("  "+m.T("network.pod_network_no_pods")))
 	}
 
@@ -0,0 +1,38 @@
+package ui
+
+import "time"
+
+// defaultNetworkRateWindow is the sliding window network rates are averaged
+// over when no window is configured
+const defaultNetworkRateWindow = 20 * time.Second
+
+// networkRateWindows are the windows cycled with the W key in the Network view
+var networkRateWindows = []time.Duration{
+	10 * time.Second,
+	20 * time.Second,
+	30 * time.Second,
+	60 * time.Second,
+}
+
+// SetNetworkRateWindow sets the sliding window pod, node and cluster network
+// rates are averaged over. Longer windows smooth bursty traffic; the window is
+// bounded in practice by the metric history kept (see SetHistorySize).
+func (m *Model) SetNetworkRateWindow(window time.Duration) {
+	if window <= 0 {
+		window = defaultNetworkRateWindow
+	}
+	m.networkRateWindow = window
+}
+
+// cycleNetworkRateWindow switches to the next preset window, starting over
+// from the shortest after the longest or after a custom configured window
+func (m *Model) cycleNetworkRateWindow() {
+	next := networkRateWindows[0]
+	for i, window := range networkRateWindows {
+		if window == m.networkRateWindow && i+1 < len(networkRateWindows) {
+			next = networkRateWindows[i+1]
+			break
+		}
+	}
+	m.networkRateWindow = next
+}
@@ -0,0 +1,248 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/k8s-monitor/internal/model"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// maxComparedNodes is how many nodes can be marked for comparison
+const maxComparedNodes = 2
+
+// nodeComparePodsShown limits the pods listed per node in the compare view
+const nodeComparePodsShown = 50
+
+// toggleNodeMark marks or unmarks the selected node of the Nodes view for
+// comparison. Marking a node when two are marked drops the older mark.
+func (m *Model) toggleNodeMark() {
+	nodes := m.cachedSortedNodes
+	if nodes == nil {
+		nodes = m.getSortedNodes(m.getFilteredNodes())
+	}
+	if m.selectedIndex < 0 || m.selectedIndex >= len(nodes) {
+		return
+	}
+	name := nodes[m.selectedIndex].Name
+
+	for i, marked := range m.comparedNodes {
+		if marked == name {
+			m.comparedNodes = append(m.comparedNodes[:i:i], m.comparedNodes[i+1:]...)
+			return
+		}
+	}
+	if len(m.comparedNodes) >= maxComparedNodes {
+		m.comparedNodes = m.comparedNodes[len(m.comparedNodes)-maxComparedNodes+1:]
+	}
+	m.comparedNodes = append(m.comparedNodes, name)
+}
+
+// isNodeMarked reports whether a node is marked for comparison
+func (m *Model) isNodeMarked(name string) bool {
+	for _, marked := range m.comparedNodes {
+		if marked == name {
+			return true
+		}
+	}
+	return false
+}
+
+// openNodeCompare opens the compare view once two nodes are marked
+func (m *Model) openNodeCompare() {
+	if len(m.comparedNodes) != maxComparedNodes {
+		return
+	}
+	m.currentView = ViewNodeCompare
+	m.detailMode = true
+	m.detailScrollOffset = 0
+}
+
+// findNode returns the node with the given name from the latest cluster data
+func (m *Model) findNode(name string) *model.NodeData {
+	if m.clusterData == nil {
+		return nil
+	}
+	for _, node := range m.clusterData.Nodes {
+		if node.Name == name {
+			return node
+		}
+	}
+	return nil
+}
+
+// renderNodeCompare renders the two marked nodes side by side: resource usage,
+// conditions, taints and pods. Nodes are looked up on every render so the
+// columns follow refreshes.
+func (m *Model) renderNodeCompare() string {
+	if len(m.comparedNodes) != maxComparedNodes {
+		return m.T("detail.compare.no_selected")
+	}
+
+	columnWidth := (m.width - 4) / maxComparedNodes
+	if columnWidth < 30 {
+		columnWidth = 30
+	}
+	sections := make([][][]string, len(m.comparedNodes))
+	for i, name := range m.comparedNodes {
+		if node := m.findNode(name); node != nil {
+			sections[i] = m.nodeCompareSections(node, columnWidth)
+		} else {
+			sections[i] = [][]string{{
+				StyleHeader.Render(truncate(name, columnWidth)),
+				StyleTextMuted.Render(m.TF("detail.compare.not_found", map[string]interface{}{"Name": name})),
+			}}
+		}
+	}
+
+	// Sections are joined one at a time so they line up across the columns
+	allLines := []string{StyleHeader.Render("⚖️  " + m.T("detail.compare.title")), ""}
+	column := lipgloss.NewStyle().Width(columnWidth)
+	for s := 0; s < len(sections[0]) || s < len(sections[1]); s++ {
+		blocks := make([]string, 0, maxComparedNodes*2)
+		for i := range sections {
+			if i > 0 {
+				blocks = append(blocks, "  ")
+			}
+			var lines []string
+			if s < len(sections[i]) {
+				lines = sections[i][s]
+			}
+			truncated := make([]string, len(lines))
+			for j, line := range lines {
+				truncated[j] = truncate(line, columnWidth)
+			}
+			blocks = append(blocks, column.Render(strings.Join(truncated, "\n")))
+		}
+		allLines = append(allLines, strings.Split(lipgloss.JoinHorizontal(lipgloss.Top, blocks...), "\n")...)
+		allLines = append(allLines, "")
+	}
+
+	// Apply scroll offset
+	maxVisible := m.height - 8 // Reserve space for header/footer
+	if maxVisible < 1 {
+		maxVisible = 1
+	}
+	totalLines := len(allLines)
+	m.noteDetailLines(totalLines, maxVisible)
+	maxScroll := totalLines - maxVisible
+	if maxScroll < 0 {
+		maxScroll = 0
+	}
+	if m.detailScrollOffset > maxScroll {
+		m.detailScrollOffset = maxScroll
+	}
+	if m.detailScrollOffset < 0 {
+		m.detailScrollOffset = 0
+	}
+
+	startIdx := m.detailScrollOffset
+	endIdx := startIdx + maxVisible
+	if endIdx > totalLines {
+		endIdx = totalLines
+	}
+	visibleLines := allLines[startIdx:endIdx]
+
+	if totalLines > maxVisible {
+		visibleLines = append(visibleLines, StyleTextMuted.Render("\n"+m.TF("detail.scroll_indicator", map[string]interface{}{
+			"Start": startIdx + 1,
+			"End":   endIdx,
+			"Total": totalLines,
+		})))
+	}
+	return strings.Join(visibleLines, "\n")
+}
+
+// nodeCompareSections returns the sections of one node in the compare view:
+// header, resource usage, conditions, taints and pods
+func (m *Model) nodeCompareSections(node *model.NodeData, width int) [][]string {
+	barWidth := width - 28
+	if barWidth > 20 {
+		barWidth = 20
+	}
+	if barWidth < 5 {
+		barWidth = 5
+	}
+	usage := func(label string, percent float64, text string) string {
+		return fmt.Sprintf("%-8s %s %s", label, renderProgressBar(percent, barWidth), text)
+	}
+
+	header := []string{
+		StyleHeader.Render(node.Name) + "  " + m.renderNodeStatus(node.Status, node.Name),
+		StyleTextMuted.Render(strings.TrimSpace(strings.Join(node.Roles, ",") + " " + node.KubeletVersion)),
+	}
+
+	resources := []string{StyleSubHeader.Render(m.T("detail.node.resource_info"))}
+	if node.CPUAllocatable > 0 && node.CPUUsage > 0 {
+		percent := float64(node.CPUUsage) / float64(node.CPUAllocatable) * 100
+		resources = append(resources, usage(m.T("columns.cpu"), percent, fmt.Sprintf("%s/%s",
+			FormatMillicores(node.CPUUsage, m.rawUnits), FormatMillicores(node.CPUAllocatable, m.rawUnits))))
+	} else {
+		resources = append(resources, fmt.Sprintf("%-8s -", m.T("columns.cpu")))
+	}
+	if node.MemAllocatable > 0 && node.MemoryUsage > 0 {
+		percent := float64(node.MemoryUsage) / float64(node.MemAllocatable) * 100
+		resources = append(resources, usage(m.T("columns.memory"), percent, fmt.Sprintf("%s/%s",
+			formatMemoryBytes(node.MemoryUsage, m.rawUnits), formatMemoryBytes(node.MemAllocatable, m.rawUnits))))
+	} else {
+		resources = append(resources, fmt.Sprintf("%-8s -", m.T("columns.memory")))
+	}
+	if node.PodAllocatable > 0 {
+		percent := float64(node.PodCount) / float64(node.PodAllocatable) * 100
+		resources = append(resources, usage(m.T("columns.pods"), percent, fmt.Sprintf("%d/%d", node.PodCount, node.PodAllocatable)))
+	}
+	if node.NPUAllocatable > 0 {
+		percent := float64(node.NPUAllocated) / float64(node.NPUAllocatable) * 100
+		resources = append(resources, usage("NPU", percent, fmt.Sprintf("%d/%d", node.NPUAllocated, node.NPUAllocatable)))
+	}
+
+	// Conditions, abnormal ones highlighted
+	conditions := []string{StyleSubHeader.Render(m.T("detail.compare.conditions"))}
+	if len(node.Conditions) == 0 {
+		conditions = append(conditions, StyleTextMuted.Render("  -"))
+	}
+	for _, condition := range node.Conditions {
+		abnormal := condition.Status == corev1.ConditionTrue
+		if condition.Type == corev1.NodeReady {
+			abnormal = condition.Status != corev1.ConditionTrue
+		}
+		status := string(condition.Status)
+		if abnormal {
+			status = StyleDanger.Render(status)
+		}
+		conditions = append(conditions, fmt.Sprintf("  %s: %s", condition.Type, status))
+	}
+
+	// Taints
+	taints := []string{StyleSubHeader.Render(m.TF("detail.node.taints", map[string]interface{}{
+		"Count": len(node.Taints),
+	}))}
+	if len(node.Taints) == 0 {
+		taints = append(taints, StyleTextMuted.Render("  "+m.T("detail.node.no_taints")))
+	}
+	for _, taint := range node.Taints {
+		taintStr := taint.Key
+		if taint.Value != "" {
+			taintStr += "=" + taint.Value
+		}
+		taints = append(taints, fmt.Sprintf("  %s:%s", taintStr, taint.Effect))
+	}
+
+	// Pods
+	nodePods := m.getNodePods(node)
+	pods := []string{StyleSubHeader.Render(m.TF("detail.compare.pods", map[string]interface{}{
+		"Count": len(nodePods),
+	}))}
+	if len(nodePods) == 0 {
+		pods = append(pods, StyleTextMuted.Render("  "+m.T("detail.node.no_pods")))
+	}
+	for i, pod := range nodePods {
+		if i == nodeComparePodsShown {
+			pods = append(pods, StyleTextMuted.Render(fmt.Sprintf("  ... and %d more pods", len(nodePods)-nodeComparePodsShown)))
+			break
+		}
+		pods = append(pods, fmt.Sprintf("  %s %s", RenderStatus(pod.Phase), pod.Namespace+"/"+pod.Name))
+	}
+	return [][]string{header, resources, conditions, taints, pods}
+}
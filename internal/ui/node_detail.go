@@ -2,10 +2,13 @@ package ui
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yourusername/k8s-monitor/internal/model"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // renderNodeDetail renders the node detail view
@@ -16,6 +19,12 @@ func (m *Model) renderNodeDetail() string {
 
 	node := m.selectedNode
 
+	// Clamp nodePodSelectedIndex to the listed pods (-1 means no pod selected)
+	nodePods := m.getNodePods(node)
+	if m.nodePodSelectedIndex >= nodePodDisplayCount(nodePods) {
+		m.nodePodSelectedIndex = nodePodDisplayCount(nodePods) - 1
+	}
+
 	// Collect all content lines
 	var allLines []string
 
@@ -23,18 +32,40 @@ func (m *Model) renderNodeDetail() string {
 	allLines = append(allLines, m.renderNodeDetailHeader(node))
 	allLines = append(allLines, "")
 
+	// Usage chart (toggled with 'c')
+	if m.nodeChartMode {
+		chartInfo := m.renderNodeUsageChart(node)
+		allLines = append(allLines, strings.Split(chartInfo, "\n")...)
+		allLines = append(allLines, "")
+	}
+
 	// Node basic info
 	basicInfo := m.renderNodeBasicInfo(node)
 	allLines = append(allLines, strings.Split(basicInfo, "\n")...)
 	allLines = append(allLines, "")
 
+	// Node taints
+	taintsInfo := m.renderNodeTaintsInfo(node)
+	allLines = append(allLines, strings.Split(taintsInfo, "\n")...)
+	allLines = append(allLines, "")
+
+	// Node labels
+	labelsInfo := m.renderNodeLabelsInfo(node)
+	allLines = append(allLines, strings.Split(labelsInfo, "\n")...)
+	allLines = append(allLines, "")
+
+	// Node annotations
+	allLines = append(allLines, m.renderAnnotations(node.Annotations)...)
+	allLines = append(allLines, "")
+
 	// Node resource info
 	resourceInfo := m.renderNodeResourceInfo(node)
 	allLines = append(allLines, strings.Split(resourceInfo, "\n")...)
 	allLines = append(allLines, "")
 
 	// Pods running on this node
-	podsInfo := m.renderNodePodsInfo(node)
+	podsStartLine := len(allLines)
+	podsInfo := m.renderNodePodsInfo(node, nodePods)
 	allLines = append(allLines, strings.Split(podsInfo, "\n")...)
 
 	// Apply scroll offset
@@ -46,11 +77,24 @@ func (m *Model) renderNodeDetail() string {
 	totalLines := len(allLines)
 
 	// Clamp scroll offset to valid range (prevent scrolling beyond content)
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
 	}
 	detailScrollOffset := m.detailScrollOffset
+
+	// Keep the selected pod row visible
+	if m.nodePodSelectedIndex >= 0 {
+		selectedPodLine := podsStartLine + nodePodsTableOffset + m.nodePodSelectedIndex
+		if selectedPodLine < detailScrollOffset {
+			detailScrollOffset = selectedPodLine
+		}
+		if selectedPodLine >= detailScrollOffset+maxVisible {
+			detailScrollOffset = selectedPodLine - maxVisible + 1
+		}
+	}
+
 	if detailScrollOffset > maxScroll {
 		detailScrollOffset = maxScroll
 	}
@@ -108,14 +152,19 @@ func (m *Model) renderNodeBasicInfo(node *model.NodeData) string {
 			strings.Join(node.Roles, ", ")))
 	}
 
+	status := RenderStatus(node.Status)
+	if node.Unschedulable {
+		status += " " + StyleWarning.Render("(SchedulingDisabled)")
+	}
 	info = append(info, fmt.Sprintf("  %s: %s",
 		StyleTextSecondary.Render(m.T("detail.field.status")),
-		RenderStatus(node.Status)))
+		status))
+	info = append(info, m.renderNodeTransitions(node.Name)...)
 
 	if node.InternalIP != "" {
 		info = append(info, fmt.Sprintf("  %s: %s",
 			StyleTextSecondary.Render(m.T("detail.field.internal_ip")),
-			node.InternalIP))
+			formatIPs(node.InternalIP, node.InternalIPs)))
 	}
 
 	if node.ExternalIP != "" {
@@ -124,6 +173,103 @@ func (m *Model) renderNodeBasicInfo(node *model.NodeData) string {
 			node.ExternalIP))
 	}
 
+	if node.KubeletVersion != "" {
+		kubelet := node.KubeletVersion
+		if m.clusterData != nil {
+			if majority := majorityKubeletVersion(m.clusterData.Nodes); majority != "" && majority != node.KubeletVersion {
+				kubelet += " " + StyleWarning.Render("⚠ "+m.TF("detail.node.version_skew", map[string]interface{}{
+					"Version": majority,
+				}))
+			}
+		}
+		info = append(info, fmt.Sprintf("  %s: %s",
+			StyleTextSecondary.Render(m.T("detail.field.kubelet_version")),
+			kubelet))
+	}
+
+	// Remaining system info fields are shown only when reported
+	systemInfo := []struct {
+		key   string
+		value string
+	}{
+		{"detail.field.kube_proxy_version", node.KubeProxyVersion},
+		{"detail.field.container_runtime", node.ContainerRuntimeVersion},
+		{"detail.field.os_image", node.OSImage},
+		{"detail.field.kernel_version", node.KernelVersion},
+	}
+	for _, field := range systemInfo {
+		if field.value != "" {
+			info = append(info, fmt.Sprintf("  %s: %s",
+				StyleTextSecondary.Render(m.T(field.key)),
+				field.value))
+		}
+	}
+
+	return strings.Join(info, "\n")
+}
+
+// renderNodeTaintsInfo renders node taints in key=value:effect form
+func (m *Model) renderNodeTaintsInfo(node *model.NodeData) string {
+	var info []string
+
+	info = append(info, StyleHeader.Render(m.TF("detail.node.taints", map[string]interface{}{
+		"Count": len(node.Taints),
+	})))
+	info = append(info, "")
+
+	if len(node.Taints) == 0 {
+		info = append(info, StyleTextMuted.Render("  "+m.T("detail.node.no_taints")))
+		return strings.Join(info, "\n")
+	}
+
+	for _, taint := range node.Taints {
+		taintStr := taint.Key
+		if taint.Value != "" {
+			taintStr += "=" + taint.Value
+		}
+		effect := string(taint.Effect)
+		effectStyle := StyleTextMuted
+		switch taint.Effect {
+		case corev1.TaintEffectNoExecute:
+			effectStyle = StyleStatusNotReady
+		case corev1.TaintEffectNoSchedule:
+			effectStyle = StyleWarning
+		}
+		info = append(info, fmt.Sprintf("  %s:%s", taintStr, effectStyle.Render(effect)))
+	}
+
+	return strings.Join(info, "\n")
+}
+
+// renderNodeLabelsInfo renders all node labels sorted by key
+func (m *Model) renderNodeLabelsInfo(node *model.NodeData) string {
+	var info []string
+
+	info = append(info, StyleHeader.Render(m.TF("detail.node.labels", map[string]interface{}{
+		"Count": len(node.Labels),
+	})))
+	info = append(info, "")
+
+	if len(node.Labels) == 0 {
+		info = append(info, StyleTextMuted.Render("  "+m.T("detail.node.no_labels")))
+		return strings.Join(info, "\n")
+	}
+
+	keys := make([]string, 0, len(node.Labels))
+	for k := range node.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	maxLineWidth := m.width - 4
+	if maxLineWidth < 40 {
+		maxLineWidth = 40
+	}
+	for _, k := range keys {
+		line := fmt.Sprintf("%s=%s", StyleTextSecondary.Render(k), node.Labels[k])
+		info = append(info, "  "+truncate(line, maxLineWidth))
+	}
+
 	return strings.Join(info, "\n")
 }
 
@@ -139,13 +285,13 @@ func (m *Model) renderNodeResourceInfo(node *model.NodeData) string {
 		cpuUsage := "N/A"
 		cpuPercent := "N/A"
 		if node.CPUUsage > 0 {
-			cpuUsage = FormatMillicores(node.CPUUsage)
+			cpuUsage = FormatMillicores(node.CPUUsage, m.rawUnits)
 			cpuPercent = fmt.Sprintf("%.1f%%", float64(node.CPUUsage)*100.0/float64(node.CPUAllocatable))
 		}
 		info = append(info, fmt.Sprintf("  %s: %s / %s (%s)",
 			StyleTextSecondary.Render(m.T("detail.field.cpu")),
 			cpuUsage,
-			FormatMillicores(node.CPUAllocatable),
+			FormatMillicores(node.CPUAllocatable, m.rawUnits),
 			cpuPercent))
 	}
 
@@ -154,13 +300,13 @@ func (m *Model) renderNodeResourceInfo(node *model.NodeData) string {
 		memUsage := "N/A"
 		memPercent := "N/A"
 		if node.MemoryUsage > 0 {
-			memUsage = FormatBytes(node.MemoryUsage)
+			memUsage = formatMemoryBytes(node.MemoryUsage, m.rawUnits)
 			memPercent = fmt.Sprintf("%.1f%%", float64(node.MemoryUsage)*100.0/float64(node.MemAllocatable))
 		}
 		info = append(info, fmt.Sprintf("  %s: %s / %s (%s)",
 			StyleTextSecondary.Render(m.T("detail.field.memory")),
 			memUsage,
-			FormatBytes(node.MemAllocatable),
+			formatMemoryBytes(node.MemAllocatable, m.rawUnits),
 			memPercent))
 	}
 
@@ -174,6 +320,57 @@ func (m *Model) renderNodeResourceInfo(node *model.NodeData) string {
 			podPercent))
 	}
 
+	// Capacity vs allocatable (system-reserved overhead)
+	if node.CPUCapacity > 0 || node.MemoryCapacity > 0 || node.PodCapacity > 0 {
+		info = append(info, "")
+		info = append(info, StyleTextSecondary.Render("  "+m.T("detail.node.allocatable_breakdown")))
+		info = append(info, StyleTextMuted.Render(fmt.Sprintf("    %s %s %s %s",
+			padRight("", 8),
+			padRight(m.T("detail.node.capacity"), 12),
+			padRight(m.T("detail.node.allocatable"), 12),
+			m.T("detail.node.reserved"))))
+		if node.CPUCapacity > 0 {
+			info = append(info, fmt.Sprintf("    %s %s %s %s",
+				padRight(m.T("detail.field.cpu"), 8),
+				padRight(FormatMillicores(node.CPUCapacity, m.rawUnits), 12),
+				padRight(FormatMillicores(node.CPUAllocatable, m.rawUnits), 12),
+				formatReserved(FormatMillicores(node.CPUCapacity-node.CPUAllocatable, m.rawUnits), node.CPUCapacity-node.CPUAllocatable, node.CPUCapacity)))
+		}
+		if node.MemoryCapacity > 0 {
+			info = append(info, fmt.Sprintf("    %s %s %s %s",
+				padRight(m.T("detail.field.memory"), 8),
+				padRight(formatMemoryBytes(node.MemoryCapacity, m.rawUnits), 12),
+				padRight(formatMemoryBytes(node.MemAllocatable, m.rawUnits), 12),
+				formatReserved(formatMemoryBytes(node.MemoryCapacity-node.MemAllocatable, m.rawUnits), node.MemoryCapacity-node.MemAllocatable, node.MemoryCapacity)))
+		}
+		if node.PodCapacity > 0 {
+			info = append(info, fmt.Sprintf("    %s %s %s %s",
+				padRight(m.T("detail.field.pods"), 8),
+				padRight(fmt.Sprintf("%d", node.PodCapacity), 12),
+				padRight(fmt.Sprintf("%d", node.PodAllocatable), 12),
+				formatReserved(fmt.Sprintf("%d", node.PodCapacity-node.PodAllocatable), node.PodCapacity-node.PodAllocatable, node.PodCapacity)))
+		}
+	}
+
+	// GPU (NVIDIA)
+	if node.GPUCapacity > 0 {
+		gpuPercent := 0.0
+		if node.GPUAllocatable > 0 {
+			gpuPercent = float64(node.GPUAllocated) * 100.0 / float64(node.GPUAllocatable)
+		}
+		info = append(info, fmt.Sprintf("  %s: %d / %d (%.1f%%)  %s",
+			StyleTextSecondary.Render("GPU"),
+			node.GPUAllocated,
+			node.GPUAllocatable,
+			gpuPercent,
+			renderProgressBar(gpuPercent, 20)))
+		if node.GPUProduct != "" {
+			info = append(info, fmt.Sprintf("    %s: %s",
+				StyleTextMuted.Render("Model"),
+				node.GPUProduct))
+		}
+	}
+
 	// NPU (Ascend AI accelerator)
 	if node.NPUCapacity > 0 {
 		npuPercent := "0.0%"
@@ -275,6 +472,13 @@ func (m *Model) renderNodeResourceInfo(node *model.NodeData) string {
 					node.NPUMetricsTime.Local().Format("15:04:05")))
 			}
 
+			// Per-card metrics (chips of the same NPU combined)
+			if m.clusterHasNPU() && len(node.NPUDevices) > 0 {
+				info = append(info, "")
+				info = append(info, StyleTextSecondary.Render("  NPU Card Metrics"))
+				info = append(info, m.renderNPUDeviceTable(node.NPUDevices)...)
+			}
+
 			// Check if we have extended metrics
 			hasExtendedMetrics := false
 			for _, chip := range node.NPUChips {
@@ -477,6 +681,11 @@ func (m *Model) renderNodeResourceInfo(node *model.NodeData) string {
 						doubleStyle.Render(fmt.Sprintf("%d", totalEccDouble))))
 				}
 			}
+		} else if node.NPUAggregateOnly {
+			// NPU-Exporter reachable but without per-chip metrics
+			info = append(info, "")
+			info = append(info, StyleTextMuted.Render("  NPU Runtime Metrics: Aggregate only"))
+			info = append(info, StyleTextMuted.Render("    NPU-Exporter does not expose per-card metrics (npu_chip_info_*) for this node"))
 		} else if node.NPUCapacity > 0 {
 			// NPU exists but no metrics from NPU-Exporter - show hint
 			info = append(info, "")
@@ -542,6 +751,17 @@ func (m *Model) renderNodeResourceInfo(node *model.NodeData) string {
 				sparkline))
 		}
 
+		// GPU allocation trends (if node has GPU)
+		if node.GPUCapacity > 0 {
+			gpuHistory := m.getNodeGPUUtilizationHistory(node.Name)
+			if len(gpuHistory) >= 2 {
+				sparkline := RenderSparkline(gpuHistory, 40)
+				info = append(info, fmt.Sprintf("  %s %s",
+					StyleTextMuted.Render(m.T("detail.node.gpu_label")),
+					sparkline))
+			}
+		}
+
 		// NPU utilization trends (if node has NPU)
 		if node.NPUCapacity > 0 {
 			npuHistory := m.getNodeNPUUtilizationHistory(node.Name)
@@ -561,28 +781,151 @@ func (m *Model) renderNodeResourceInfo(node *model.NodeData) string {
 	return strings.Join(info, "\n")
 }
 
-// renderNodePodsInfo renders pods running on this node
-func (m *Model) renderNodePodsInfo(node *model.NodeData) string {
+// renderNodeUsageChart renders CPU% and memory% time-series charts for a node
+func (m *Model) renderNodeUsageChart(node *model.NodeData) string {
 	var info []string
 
-	info = append(info, StyleHeader.Render(fmt.Sprintf("📦 Pods on this Node (%d)", node.PodCount)))
+	info = append(info, StyleHeader.Render(m.T("detail.node.chart_title")))
 	info = append(info, "")
 
-	if m.clusterData == nil || len(m.clusterData.Pods) == 0 {
-		info = append(info, StyleTextMuted.Render("  No pod information available"))
+	cpuHistory := m.getNodeCPUHistory(node.Name)
+	memHistory := m.getNodeMemoryHistory(node.Name)
+
+	const minPoints = 3
+	if len(cpuHistory) < minPoints {
+		info = append(info, StyleTextMuted.Render("  "+m.TF("detail.node.chart_collecting", map[string]interface{}{
+			"Count": len(cpuHistory),
+			"Need":  minPoints,
+		})))
 		return strings.Join(info, "\n")
 	}
 
-	// Filter pods running on this node
-	nodePods := []*model.PodData{}
+	// Convert to percentages of capacity when known (history is in cores / GiB)
+	cpuSeries, cpuMax, cpuFormat := cpuHistory, maxFloat(cpuHistory), func(v float64) string { return fmt.Sprintf("%.1f", v) }
+	if node.CPUCapacity > 0 {
+		cpuSeries = make([]float64, len(cpuHistory))
+		for i, cores := range cpuHistory {
+			cpuSeries[i] = cores * 1000 / float64(node.CPUCapacity) * 100
+		}
+		cpuMax, cpuFormat = 100, func(v float64) string { return fmt.Sprintf("%.0f%%", v) }
+	}
+
+	memSeries, memMax, memFormat := memHistory, maxFloat(memHistory), func(v float64) string { return fmt.Sprintf("%.1fG", v) }
+	if node.MemoryCapacity > 0 {
+		memSeries = make([]float64, len(memHistory))
+		for i, gib := range memHistory {
+			memSeries[i] = gib * 1024 * 1024 * 1024 / float64(node.MemoryCapacity) * 100
+		}
+		memMax, memFormat = 100, func(v float64) string { return fmt.Sprintf("%.0f%%", v) }
+	}
+
+	// Scale plot to terminal width (leave room for Y-axis labels and indent)
+	width := m.width - 14
+	if width < 20 {
+		width = 20
+	}
+	const height = 8
+	span := m.getNodeHistorySpan(node.Name)
+
+	info = append(info, StyleTextSecondary.Render("  "+m.T("detail.node.chart_cpu")))
+	lines, labelWidth := RenderLineChart(cpuSeries, width, height, 0, cpuMax, cpuFormat)
+	for _, line := range lines {
+		info = append(info, "  "+line)
+	}
+	info = append(info, "  "+renderChartTimeAxis(span, width, labelWidth, m.T("detail.node.chart_now")))
+	info = append(info, "")
+
+	if len(memSeries) >= minPoints {
+		info = append(info, StyleTextSecondary.Render("  "+m.T("detail.node.chart_memory")))
+		lines, labelWidth = RenderLineChart(memSeries, width, height, 0, memMax, memFormat)
+		for _, line := range lines {
+			info = append(info, "  "+line)
+		}
+		info = append(info, "  "+renderChartTimeAxis(span, width, labelWidth, m.T("detail.node.chart_now")))
+	}
+
+	return strings.Join(info, "\n")
+}
+
+// getNodeHistorySpan returns the time covered by a node's metric history
+func (m *Model) getNodeHistorySpan(nodeName string) time.Duration {
+	var first, last time.Time
+	for _, snapshot := range m.metricHistory {
+		if _, ok := snapshot.NodeMetrics[nodeName]; ok {
+			if first.IsZero() {
+				first = snapshot.Timestamp
+			}
+			last = snapshot.Timestamp
+		}
+	}
+	return last.Sub(first)
+}
+
+// maxFloat returns the largest value in data (0 for empty data)
+func maxFloat(data []float64) float64 {
+	var maxVal float64
+	for _, v := range data {
+		if v > maxVal {
+			maxVal = v
+		}
+	}
+	return maxVal
+}
+
+// maxNodePodsDisplay limits the selectable pods listed in node detail
+const maxNodePodsDisplay = 50
+
+// nodePodsTableOffset is the number of lines between the pods section start
+// and its first row (header, hint, table header, separator)
+const nodePodsTableOffset = 4
+
+// getNodePods returns the pods scheduled on a node, sorted by namespace and name
+func (m *Model) getNodePods(node *model.NodeData) []*model.PodData {
+	if m.clusterData == nil || node == nil {
+		return nil
+	}
+
+	var nodePods []*model.PodData
 	for _, pod := range m.clusterData.Pods {
 		if pod.Node == node.Name {
 			nodePods = append(nodePods, pod)
 		}
 	}
 
+	sort.Slice(nodePods, func(i, j int) bool {
+		if nodePods[i].Namespace != nodePods[j].Namespace {
+			return nodePods[i].Namespace < nodePods[j].Namespace
+		}
+		return nodePods[i].Name < nodePods[j].Name
+	})
+
+	return nodePods
+}
+
+// nodePodDisplayCount returns how many of the node's pods are listed (and selectable)
+func nodePodDisplayCount(nodePods []*model.PodData) int {
+	if len(nodePods) > maxNodePodsDisplay {
+		return maxNodePodsDisplay
+	}
+	return len(nodePods)
+}
+
+// renderNodePodsInfo renders pods running on this node with the selected pod highlighted
+func (m *Model) renderNodePodsInfo(node *model.NodeData, nodePods []*model.PodData) string {
+	var info []string
+
+	info = append(info, StyleHeader.Render(m.TF("detail.node.pods_on_node", map[string]interface{}{
+		"Total": len(nodePods),
+	})))
+	info = append(info, StyleTextMuted.Render("  "+m.T("detail.node.pods_hint")))
+
+	if m.clusterData == nil || len(m.clusterData.Pods) == 0 {
+		info = append(info, StyleTextMuted.Render("  No pod information available"))
+		return strings.Join(info, "\n")
+	}
+
 	if len(nodePods) == 0 {
-		info = append(info, StyleTextMuted.Render("  No pods running on this node"))
+		info = append(info, StyleTextMuted.Render("  "+m.T("detail.node.no_pods")))
 		return strings.Join(info, "\n")
 	}
 
@@ -592,18 +935,8 @@ func (m *Model) renderNodePodsInfo(node *model.NodeData) string {
 	info = append(info, StyleTextSecondary.Render(headerRow))
 	info = append(info, "  "+strings.Repeat("─", 85))
 
-	// Pod rows (limited to visible area)
-	maxVisible := m.height - 20
-	if maxVisible < 1 {
-		maxVisible = 1
-	}
-
-	visiblePods := nodePods
-	if len(nodePods) > maxVisible {
-		visiblePods = nodePods[:maxVisible]
-	}
-
-	for _, pod := range visiblePods {
+	displayCount := nodePodDisplayCount(nodePods)
+	for i, pod := range nodePods[:displayCount] {
 		name := pod.Name
 		if len(name) > 33 {
 			name = name[:30] + "..."
@@ -614,25 +947,38 @@ func (m *Model) renderNodePodsInfo(node *model.NodeData) string {
 			namespace = namespace[:15] + "..."
 		}
 
-		status := RenderStatus(pod.Phase)
 		restarts := fmt.Sprintf("%d", pod.RestartCount)
 
+		if i == m.nodePodSelectedIndex {
+			row := fmt.Sprintf("▶ %-35s %-20s %-15s %-10s", name, namespace, pod.Phase, restarts)
+			info = append(info, StyleSelected.Render(row))
+			continue
+		}
+
 		row := fmt.Sprintf("  %-35s %-20s %-23s %-10s",
 			name,
 			namespace,
-			status,
+			RenderStatus(pod.Phase),
 			restarts)
 		info = append(info, row)
 	}
 
-	if len(nodePods) > maxVisible {
+	if len(nodePods) > displayCount {
 		info = append(info, "")
-		info = append(info, StyleTextMuted.Render(fmt.Sprintf("  ... and %d more pods", len(nodePods)-maxVisible)))
+		info = append(info, StyleTextMuted.Render(fmt.Sprintf("  ... and %d more pods", len(nodePods)-displayCount)))
 	}
 
 	return strings.Join(info, "\n")
 }
 
+// formatReserved formats the capacity held back from allocatable with its share of capacity
+func formatReserved(formatted string, reserved, capacity int64) string {
+	if reserved <= 0 || capacity <= 0 {
+		return StyleTextMuted.Render("-")
+	}
+	return fmt.Sprintf("%s (%.1f%%)", formatted, float64(reserved)*100.0/float64(capacity))
+}
+
 // formatPacketCount formats a large packet count with K/M/G suffix
 func formatPacketCount(count int64) string {
 	if count >= 1_000_000_000 {
@@ -644,3 +990,65 @@ func formatPacketCount(count int64) string {
 	}
 	return fmt.Sprintf("%d", count)
 }
+
+// renderNPUDeviceTable renders one row per NPU card with utilization, HBM, temperature and health
+func (m *Model) renderNPUDeviceTable(devices []model.NPUDeviceMetric) []string {
+	const (
+		colCard   = 5
+		colChips  = 6
+		colUtil   = 8
+		colHBM    = 20
+		colTemp   = 7
+		colPower  = 8
+		colHealth = 8
+	)
+
+	lines := []string{
+		fmt.Sprintf("    %s %s %s %s %s %s %s",
+			padRight("Card", colCard),
+			padRight("Chips", colChips),
+			padRight("Util", colUtil),
+			padRight("HBM", colHBM),
+			padRight("Temp", colTemp),
+			padRight("Power", colPower),
+			padRight("Health", colHealth)),
+		"    " + strings.Repeat("─", colCard+colChips+colUtil+colHBM+colTemp+colPower+colHealth+6),
+	}
+
+	for _, device := range devices {
+		// HBM usage (MB) with percentage
+		hbmStr := "-"
+		if device.HBMTotal > 0 {
+			hbmPercent := float64(device.HBMUsed) / float64(device.HBMTotal) * 100
+			hbmStr = fmt.Sprintf("%.1f/%.0fG (%.0f%%)", float64(device.HBMUsed)/1024, float64(device.HBMTotal)/1024, hbmPercent)
+		}
+
+		// Color coding for temperature
+		tempStr := fmt.Sprintf("%d°C", device.Temp)
+		if device.Temp >= 80 {
+			tempStr = StyleStatusNotReady.Render(tempStr)
+		} else if device.Temp >= 70 {
+			tempStr = StyleWarning.Render(tempStr)
+		}
+
+		// Color coding for health
+		healthStr := StyleStatusNotReady.Render(device.Health)
+		switch device.Health {
+		case "OK":
+			healthStr = StyleStatusReady.Render(device.Health)
+		case "Warning":
+			healthStr = StyleWarning.Render(device.Health)
+		}
+
+		lines = append(lines, fmt.Sprintf("    %s %s %s %s %s %s %s",
+			padRight(fmt.Sprintf("%d", device.CardID), colCard),
+			padRight(fmt.Sprintf("%d", device.Chips), colChips),
+			padRight(fmt.Sprintf("%.1f%%", device.Utilization), colUtil),
+			padRight(hbmStr, colHBM),
+			padRight(tempStr, colTemp),
+			padRight(fmt.Sprintf("%.1fW", device.Power), colPower),
+			healthStr))
+	}
+
+	return lines
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+)
+
+// defaultNodeFlapThreshold is the number of readiness transitions within the
+// history window a node may have before it is flagged as flapping
+const defaultNodeFlapThreshold = 2
+
+// nodeTransitionsShown limits the transitions listed in node detail
+const nodeTransitionsShown = 5
+
+// nodeTransition is a readiness change of a node between two snapshots
+type nodeTransition struct {
+	from string
+	to   string
+	at   time.Time // Time of the snapshot that first saw the new status
+}
+
+// SetNodeFlapThreshold sets how many readiness transitions within the
+// history window flag a node as flapping
+func (m *Model) SetNodeFlapThreshold(threshold int) {
+	if threshold <= 0 {
+		threshold = defaultNodeFlapThreshold
+	}
+	m.nodeFlapThreshold = threshold
+}
+
+// rebuildNodeTransitions recomputes the readiness transitions of every node
+// from the snapshots in the history window. Snapshots without a status (e.g.
+// loaded from a history file written by an older version) are skipped.
+func (m *Model) rebuildNodeTransitions() {
+	transitions := make(map[string][]nodeTransition)
+	last := make(map[string]string)
+	for _, snapshot := range m.metricHistory {
+		for name, metric := range snapshot.NodeMetrics {
+			if metric.Status == "" {
+				continue
+			}
+			if prev, ok := last[name]; ok && prev != metric.Status {
+				transitions[name] = append(transitions[name], nodeTransition{
+					from: prev,
+					to:   metric.Status,
+					at:   snapshot.Timestamp,
+				})
+			}
+			last[name] = metric.Status
+		}
+	}
+	m.nodeTransitions = transitions
+}
+
+// nodeTransitionCount returns how many times a node changed readiness within
+// the history window
+func (m *Model) nodeTransitionCount(name string) int {
+	return len(m.nodeTransitions[name])
+}
+
+// isNodeFlapping reports whether a node changed readiness more often than the
+// flap threshold within the history window
+func (m *Model) isNodeFlapping(name string) bool {
+	return m.nodeTransitionCount(name) > m.nodeFlapThreshold
+}
+
+// renderNodeStatus renders the status of a node, followed by a flapping
+// marker when its readiness keeps changing
+func (m *Model) renderNodeStatus(status, name string) string {
+	rendered := RenderStatus(status)
+	if m.isNodeFlapping(name) {
+		rendered += " " + StyleWarning.Render(m.T("views.nodes.flapping"))
+	}
+	return rendered
+}
+
+// renderNodeTransitions renders the readiness transitions of a node within
+// the history window, newest first, for node detail
+func (m *Model) renderNodeTransitions(name string) []string {
+	transitions := m.nodeTransitions[name]
+	summary := m.TF("detail.node.transitions_count", map[string]interface{}{
+		"Count":     len(transitions),
+		"Snapshots": len(m.metricHistory),
+	})
+	if m.isNodeFlapping(name) {
+		summary = StyleWarning.Render(summary + " - " + m.T("views.nodes.flapping"))
+	}
+	lines := []string{fmt.Sprintf("  %s: %s",
+		StyleTextSecondary.Render(m.T("detail.node.transitions")),
+		summary)}
+
+	for i := len(transitions) - 1; i >= 0 && i >= len(transitions)-nodeTransitionsShown; i-- {
+		t := transitions[i]
+		lines = append(lines, fmt.Sprintf("    %s → %s  %s",
+			RenderStatus(t.from), RenderStatus(t.to),
+			StyleTextMuted.Render(m.TF("detail.node.transition_ago", map[string]interface{}{
+				"Age": formatAge(time.Since(t.at)),
+			}))))
+	}
+	return lines
+}
@@ -75,6 +75,12 @@ func (m *Model) renderNodesHeader(nodes []*model.NodeData) string {
 		}
 		summary += fmt.Sprintf(" • %s: %s %s", m.T("common.sort"), sortInfo, arrow)
 	}
+	if len(m.comparedNodes) > 0 {
+		summary += " • " + m.TF("views.nodes.marked", map[string]interface{}{
+			"Nodes": strings.Join(m.comparedNodes, ", "),
+			"Max":   maxComparedNodes,
+		})
+	}
 
 	headerLine := lipgloss.JoinHorizontal(
 		lipgloss.Top,
@@ -142,58 +148,19 @@ func (m *Model) renderNPUClusterSummary(summary *model.ClusterSummary) string {
 func (m *Model) renderNodesList(nodes []*model.NodeData) string {
 	var rows []string
 
-	// Check if cluster has NPU nodes
-	hasNPU := m.clusterHasNPU()
-
-	// Table header - define fixed column widths
-	const (
-		colName   = 30
-		colStatus = 12
-		colRoles  = 15
-		colCPU    = 18 // Increased to fit trend indicator
-		colMemory = 23 // Increased to fit trend indicator
-		colRx     = 11 // Network RX bandwidth
-		colTx     = 11 // Network TX bandwidth
-		colPods   = 10
-		colNPU    = 12 // NPU usage column
-	)
-
-	var headerRow string
-	var separatorWidth int
-	if hasNPU {
-		headerRow = fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s  %s",
-			padRight(m.T("columns.name"), colName),
-			padRight(m.T("columns.status"), colStatus),
-			padRight(m.T("columns.roles"), colRoles),
-			padRight(m.T("columns.cpu"), colCPU),
-			padRight(m.T("columns.memory"), colMemory),
-			padRight("NPU", colNPU),
-			padRight(m.T("columns.rx"), colRx),
-			padRight(m.T("columns.tx"), colTx),
-			padRight(m.T("columns.pods"), colPods),
-		)
-		separatorWidth = colName + colStatus + colRoles + colCPU + colMemory + colNPU + colRx + colTx + colPods + 16
-	} else {
-		headerRow = fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s",
-			padRight(m.T("columns.name"), colName),
-			padRight(m.T("columns.status"), colStatus),
-			padRight(m.T("columns.roles"), colRoles),
-			padRight(m.T("columns.cpu"), colCPU),
-			padRight(m.T("columns.memory"), colMemory),
-			padRight(m.T("columns.rx"), colRx),
-			padRight(m.T("columns.tx"), colTx),
-			padRight(m.T("columns.pods"), colPods),
-		)
-		separatorWidth = colName + colStatus + colRoles + colCPU + colMemory + colRx + colTx + colPods + 14
+	// Kubelet versions differing from the majority are flagged as skew
+	var majorityVersion string
+	if m.clusterData != nil {
+		majorityVersion = majorityKubeletVersion(m.clusterData.Nodes)
 	}
-	rows = append(rows, StyleHeader.Render(headerRow))
-	rows = append(rows, strings.Repeat("─", separatorWidth))
+
+	// Table header
+	columns := m.nodeColumns(nodes, majorityVersion)
+	rows = append(rows, StyleHeader.Render(m.renderColumnsHeader(columns)))
+	rows = append(rows, strings.Repeat("─", columnsWidth(columns)))
 
 	// Calculate visible range based on scroll
-	maxVisible := m.height - 10
-	if maxVisible < 1 {
-		maxVisible = 1
-	}
+	maxVisible := m.listVisibleRows()
 
 	totalNodes := len(nodes)
 
@@ -220,7 +187,7 @@ func (m *Model) renderNodesList(nodes []*model.NodeData) string {
 	// Node rows with selection highlighting
 	for i, node := range visibleNodes {
 		absoluteIndex := startIdx + i
-		row := m.renderNodeRow(node, colName, colStatus, colRoles, colCPU, colMemory, colNPU, colRx, colTx, colPods, hasNPU)
+		row := m.renderNodeRow(node, columns, majorityVersion)
 
 		// Highlight selected row
 		if absoluteIndex == m.selectedIndex {
@@ -233,106 +200,158 @@ func (m *Model) renderNodesList(nodes []*model.NodeData) string {
 	return strings.Join(rows, "\n")
 }
 
+// nodeColumns returns the columns of the Nodes view sized to the terminal. The
+// default NPU column is only shown when the cluster has NPU nodes.
+func (m *Model) nodeColumns(nodes []*model.NodeData, majorityVersion string) []listColumn {
+	columns, configured := m.visibleColumns("nodes")
+	if !configured && !m.clusterHasNPU() {
+		columns = withoutColumns(columns, "npu")
+	}
+	return m.fitColumns(columns, m.width-2, len(nodes), func(i int, column listColumn) string {
+		return m.renderNodeCell(nodes[i], column, majorityVersion)
+	})
+}
+
 // renderNodeRow renders a single node row
-func (m *Model) renderNodeRow(node *model.NodeData, colName, colStatus, colRoles, colCPU, colMemory, colNPU, colRx, colTx, colPods int, hasNPU bool) string {
-	// Node name
-	name := truncate(node.Name, colName)
+func (m *Model) renderNodeRow(node *model.NodeData, columns []listColumn, majorityVersion string) string {
+	return renderColumns(columns, func(column listColumn) string {
+		return m.renderNodeCell(node, column, majorityVersion)
+	})
+}
 
-	// Status with color
-	status := RenderStatus(node.Status)
+// renderNodeCell renders the cell of a node in the given column
+func (m *Model) renderNodeCell(node *model.NodeData, column listColumn, majorityVersion string) string {
+	switch column.id {
+	case "name":
+		if m.isNodeMarked(node.Name) {
+			return StyleHighlight.Render(truncate("◆ "+node.Name, column.width))
+		}
+		return truncate(node.Name, column.width)
 
-	// Roles
-	roles := truncate(strings.Join(node.Roles, ","), colRoles)
+	case "status":
+		return m.renderNodeStatus(node.Status, node.Name)
 
-	// CPU usage with trend
-	var cpuUsage string
-	if node.CPUUsage > 0 && node.CPUAllocatable > 0 {
-		cpuTrend := m.calculateNodeCPUTrend(node.Name, node.CPUUsage)
-		trendIndicator := renderTrendIndicator(cpuTrend)
-		cpuUsage = fmt.Sprintf("%s/%s %s",
-			FormatMillicores(node.CPUUsage),
-			FormatMillicores(node.CPUAllocatable),
-			trendIndicator,
-		)
-	} else {
-		cpuUsage = "-"
-	}
-	cpuUsage = truncate(cpuUsage, colCPU)
+	case "roles":
+		return truncate(strings.Join(node.Roles, ","), column.width)
 
-	// Memory usage with trend
-	var memUsage string
-	if node.MemoryUsage > 0 && node.MemAllocatable > 0 {
+	case "cpu":
+		// CPU usage with trend
+		if node.CPUUsage <= 0 || node.CPUAllocatable <= 0 {
+			return "-"
+		}
+		cpuTrend := m.calculateNodeCPUTrend(node.Name, node.CPUUsage)
+		return truncate(fmt.Sprintf("%s/%s %s",
+			FormatMillicores(node.CPUUsage, m.rawUnits),
+			FormatMillicores(node.CPUAllocatable, m.rawUnits),
+			renderTrendIndicator(cpuTrend),
+		), column.width)
+
+	case "memory":
+		// Memory usage with trend
+		if node.MemoryUsage <= 0 || node.MemAllocatable <= 0 {
+			return "-"
+		}
 		memTrend := m.calculateNodeMemoryTrend(node.Name, node.MemoryUsage)
-		trendIndicator := renderTrendIndicator(memTrend)
-		memUsage = fmt.Sprintf("%s/%s %s",
-			FormatBytes(node.MemoryUsage),
-			FormatBytes(node.MemAllocatable),
-			trendIndicator,
-		)
-	} else {
-		memUsage = "-"
-	}
-	memUsage = truncate(memUsage, colMemory)
-
-	// NPU usage (only for nodes with NPU)
-	var npuUsage string
-	if hasNPU {
-		if node.NPUCapacity > 0 {
-			npuUsage = fmt.Sprintf("%d/%d", node.NPUAllocated, node.NPUAllocatable)
-		} else {
-			npuUsage = "-"
+		return truncate(fmt.Sprintf("%s/%s %s",
+			formatMemoryBytes(node.MemoryUsage, m.rawUnits),
+			formatMemoryBytes(node.MemAllocatable, m.rawUnits),
+			renderTrendIndicator(memTrend),
+		), column.width)
+
+	case "npu":
+		if node.NPUCapacity <= 0 {
+			return "-"
 		}
-	}
+		return fmt.Sprintf("%d/%d", node.NPUAllocated, node.NPUAllocatable)
 
-	// Network RX (download/receive)
-	rxRate := m.calculateNodeNetworkRxRate(node.Name)
-	rxStr := formatNetworkRate(rxRate)
-	if rxRate == 0 {
+	case "rx", "tx":
+		// Network bandwidth; "0 B/s" once rates can be computed from kubelet metrics
+		rate := m.calculateNodeNetworkRxRate(node.Name)
+		if column.id == "tx" {
+			rate = m.calculateNodeNetworkTxRate(node.Name)
+		}
+		if rate != 0 {
+			return formatNetworkRate(rate)
+		}
 		if node.HasKubeletMetrics && len(m.metricHistory) >= 2 {
-			rxStr = StyleTextMuted.Render("0 B/s")
-		} else {
-			rxStr = StyleTextMuted.Render("-")
+			return StyleTextMuted.Render("0 B/s")
 		}
-	}
+		return StyleTextMuted.Render("-")
 
-	// Network TX (upload/send)
-	txRate := m.calculateNodeNetworkTxRate(node.Name)
-	txStr := formatNetworkRate(txRate)
-	if txRate == 0 {
-		if node.HasKubeletMetrics && len(m.metricHistory) >= 2 {
-			txStr = StyleTextMuted.Render("0 B/s")
-		} else {
-			txStr = StyleTextMuted.Render("-")
+	case "pods":
+		return renderNodePodDensity(node, column.width)
+
+	case "version":
+		// Kubelet version, flagged when it differs from the majority
+		if node.KubeletVersion == "" {
+			return StyleTextMuted.Render("-")
+		}
+		version := truncate(node.KubeletVersion, column.width)
+		if majorityVersion != "" && node.KubeletVersion != majorityVersion {
+			return StyleWarning.Render(version)
 		}
+		return version
+
+	case "ip":
+		if node.InternalIP == "" {
+			return StyleTextMuted.Render("-")
+		}
+		return node.InternalIP
+
+	case "age":
+		return m.formatAgeOrTime(node.CreationTimestamp)
 	}
+	return ""
+}
 
-	// Pod count
-	podCount := fmt.Sprintf("%d/%d", node.PodCount, node.PodAllocatable)
-
-	if hasNPU {
-		return fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s  %s",
-			padRight(name, colName),
-			padRight(status, colStatus),
-			padRight(roles, colRoles),
-			padRight(cpuUsage, colCPU),
-			padRight(memUsage, colMemory),
-			padRight(npuUsage, colNPU),
-			padRight(rxStr, colRx),
-			padRight(txStr, colTx),
-			padRight(podCount, colPods),
-		)
+// nodePodCapacityWarnPercent is the pod density from which a node is flagged
+// as near its pod capacity, where new pods fail to schedule with "Too many pods"
+const nodePodCapacityWarnPercent = 90.0
+
+// nodeNearPodCapacity reports whether a node runs close to its allocatable pods
+func nodeNearPodCapacity(node *model.NodeData) bool {
+	return node.PodAllocatable > 0 && node.PodUsagePercent >= nodePodCapacityWarnPercent
+}
+
+// renderNodePodDensity renders pods used vs allocatable with a density bar
+// filling the rest of the column, e.g. "42/110 ██░░░░"
+func renderNodePodDensity(node *model.NodeData, width int) string {
+	if node.PodAllocatable <= 0 {
+		return fmt.Sprintf("%d", node.PodCount)
 	}
 
-	return fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s",
-		padRight(name, colName),
-		padRight(status, colStatus),
-		padRight(roles, colRoles),
-		padRight(cpuUsage, colCPU),
-		padRight(memUsage, colMemory),
-		padRight(rxStr, colRx),
-		padRight(txStr, colTx),
-		padRight(podCount, colPods),
-	)
+	count := fmt.Sprintf("%d/%d", node.PodCount, node.PodAllocatable)
+	if nodeNearPodCapacity(node) {
+		count = StyleDanger.Render(count)
+	}
+	barWidth := width - len(fmt.Sprintf("%d/%d", node.PodCount, node.PodAllocatable)) - 1
+	if barWidth < 3 {
+		return count
+	}
+	return count + " " + renderProgressBar(node.PodUsagePercent, barWidth)
+}
+
+// majorityKubeletVersion returns the most common kubelet version among nodes,
+// or "" when all nodes run the same version (or none is known)
+func majorityKubeletVersion(nodes []*model.NodeData) string {
+	counts := make(map[string]int)
+	for _, node := range nodes {
+		if node.KubeletVersion != "" {
+			counts[node.KubeletVersion]++
+		}
+	}
+	if len(counts) < 2 {
+		return ""
+	}
+
+	var majority string
+	for version, count := range counts {
+		// Break ties by version string so the result is stable across refreshes
+		if count > counts[majority] || (count == counts[majority] && version < majority) {
+			majority = version
+		}
+	}
+	return majority
 }
 
 // renderNodesFooter renders the nodes view footer
@@ -352,11 +371,21 @@ func (m *Model) renderNodesFooter() string {
 		totalNodes,
 	)
 
-	// Add scroll position indicator if there are more items than visible
-	maxVisible := m.height - 10
-	if maxVisible < 1 {
-		maxVisible = 1
+	// Nodes near pod capacity reject new pods even with CPU and memory to spare
+	nearCapacity := 0
+	for _, node := range m.clusterData.Nodes {
+		if nodeNearPodCapacity(node) {
+			nearCapacity++
+		}
 	}
+	if nearCapacity > 0 {
+		stats += "  " + StyleWarning.Render(m.TF("views.nodes.near_pod_capacity", map[string]interface{}{
+			"Count": nearCapacity,
+		}))
+	}
+
+	// Add scroll position indicator if there are more items than visible
+	maxVisible := m.listVisibleRows()
 	if totalNodes > maxVisible {
 		scrollInfo := fmt.Sprintf("  [%d-%d %s %d]",
 			m.scrollOffset+1,
@@ -380,6 +409,11 @@ func (m *Model) getSortedNodes(inputNodes []*model.NodeData) []*model.NodeData {
 	nodes := make([]*model.NodeData, len(inputNodes))
 	copy(nodes, inputNodes)
 
+	// Fuzzy search results keep their match-score order
+	if m.fuzzyRanking() {
+		return nodes
+	}
+
 	// Sort based on current field and order using sort.Slice (O(n log n))
 	switch m.sortField {
 	case SortByName:
@@ -407,7 +441,11 @@ func (m *Model) getSortedNodes(inputNodes []*model.NodeData) []*model.NodeData {
 		})
 
 	case SortByPods:
+		// Ties are broken by name so equally loaded nodes keep their order
 		sort.Slice(nodes, func(i, j int) bool {
+			if nodes[i].PodCount == nodes[j].PodCount {
+				return nodes[i].Name < nodes[j].Name
+			}
 			if m.sortOrder == SortAsc {
 				return nodes[i].PodCount < nodes[j].PodCount
 			}
@@ -15,13 +15,18 @@ const (
 	summaryPanelExtraHeight = 4
 )
 
-var summaryPanelStyle = StyleBorder.Copy().Padding(1, 1)
+// summaryPanelStyle is derived from the theme border style in ApplyTheme
+var summaryPanelStyle lipgloss.Style
 
-// formatCPU formats CPU millicores to a human-readable string
-func formatCPU(millicores int64) string {
+// formatCPU formats CPU millicores to a human-readable string, or exactly
+// when raw is set
+func formatCPU(millicores int64, raw bool) string {
 	if millicores == 0 {
 		return "0"
 	}
+	if raw {
+		return formatRawCPU(millicores)
+	}
 	cores := float64(millicores) / 1000.0
 	if cores >= 1.0 {
 		return fmt.Sprintf("%.1f", cores)
@@ -29,8 +34,9 @@ func formatCPU(millicores int64) string {
 	return fmt.Sprintf("%dm", millicores)
 }
 
-// formatMemory formats bytes to a human-readable string
-func formatMemory(bytes int64) string {
+// formatMemory formats bytes to a human-readable string, or exactly when raw
+// is set
+func formatMemory(bytes int64, raw bool) string {
 	const (
 		KB = 1024
 		MB = KB * 1024
@@ -41,6 +47,9 @@ func formatMemory(bytes int64) string {
 	if bytes == 0 {
 		return "0"
 	}
+	if raw {
+		return formatRawMemory(bytes)
+	}
 
 	switch {
 	case bytes >= TB:
@@ -61,7 +70,7 @@ func formatRate(bytesPerSecond int64) string {
 	if bytesPerSecond <= 0 {
 		return "0B/s"
 	}
-	return fmt.Sprintf("%s/s", formatMemory(bytesPerSecond))
+	return fmt.Sprintf("%s/s", formatMemory(bytesPerSecond, false))
 }
 
 func truncateText(text string, max int) string {
@@ -88,6 +97,7 @@ const (
 	kubeletHintUnknown kubeletHintKind = iota
 	kubeletHintRBAC
 	kubeletHintTLS
+	kubeletHintTimeout
 )
 
 func detectKubeletHintKind(errMsg string) kubeletHintKind {
@@ -109,6 +119,8 @@ func detectKubeletHintKind(errMsg string) kubeletHintKind {
 		strings.Contains(lower, "unknown authority"),
 		strings.Contains(lower, "tls"):
 		return kubeletHintTLS
+	case strings.Contains(lower, "timed out"):
+		return kubeletHintTimeout
 	default:
 		return kubeletHintUnknown
 	}
@@ -120,6 +132,8 @@ func kubeletHintEnglish(kind kubeletHintKind) string {
 		return "Check RBAC: kubectl auth can-i get nodes/proxy"
 	case kubeletHintTLS:
 		return "Use --insecure-kubelet for TLS issues (test clusters only)"
+	case kubeletHintTimeout:
+		return "Slow kubelets: raise kubelet.timeout in the config"
 	default:
 		return "Try --insecure-kubelet"
 	}
@@ -131,6 +145,8 @@ func kubeletHintChinese(kind kubeletHintKind) string {
 		return "检查 RBAC：kubectl auth can-i get nodes/proxy"
 	case kubeletHintTLS:
 		return "使用 --insecure-kubelet（仅限测试环境）"
+	case kubeletHintTimeout:
+		return "kubelet 响应慢：在配置中调大 kubelet.timeout"
 	default:
 		return "尝试 --insecure-kubelet"
 	}
@@ -144,6 +160,17 @@ func (m *Model) kubeletHint(kind kubeletHintKind) string {
 	return kubeletHintEnglish(kind)
 }
 
+// kubeletTimeoutNote returns " • N nodes timed out" when kubelet queries of
+// some nodes exceeded the timeout, or ""
+func (m *Model) kubeletTimeoutNote(summary *model.ClusterSummary) string {
+	if summary.KubeletTimeouts == 0 {
+		return ""
+	}
+	return " • " + m.TF("overview.kubelet_timeouts", map[string]interface{}{
+		"Count": summary.KubeletTimeouts,
+	})
+}
+
 
 // renderProgressBar renders a simple progress bar
 func renderProgressBar(percent float64, width int) string {
@@ -215,6 +242,11 @@ func (m *Model) renderOverview() string {
 		return "No cluster summary available"
 	}
 
+	// Only-problems mode replaces the dashboard panels with a list of unhealthy items
+	if m.overviewProblemsOnly {
+		return m.scrollOverviewLines(m.overviewProblemLines(summary))
+	}
+
 	// Collect all content lines with priority levels
 	var allLines []string
 
@@ -224,11 +256,7 @@ func (m *Model) renderOverview() string {
 	allLines = append(allLines, "")
 
 	// Calculate available space for remaining content
-	// Reserve: header(3) + footer(3) + scroll indicator(2) = 8 lines
-	availableHeight := m.height - 8
-	if availableHeight < 10 {
-		availableHeight = 10
-	}
+	availableHeight := m.overviewAvailableHeight()
 
 	// Check if we have enough space for all content
 	estimatedTotalLines := len(allLines) + 15 // Estimate remaining content height
@@ -270,10 +298,24 @@ func (m *Model) renderOverview() string {
 		allLines = append(allLines, "")
 	}
 
+	// Priority 2b: Top pods by CPU and memory, like kubectl top
+	if availableHeight > 30 || estimatedTotalLines <= availableHeight {
+		if topPods := m.renderTopPods(); topPods != "" {
+			allLines = append(allLines, strings.Split(topPods, "\n")...)
+			allLines = append(allLines, "")
+		}
+	}
+
 	// Priority 3: Services & Storage info
 	if availableHeight > 30 || estimatedTotalLines <= availableHeight {
 		servicesInfo := m.renderServicesAndStorage(summary)
 		allLines = append(allLines, strings.Split(servicesInfo, "\n")...)
+
+		// Custom resources, when enabled in the config
+		if crdSummary := m.renderCRDSummary(); crdSummary != "" {
+			allLines = append(allLines, "")
+			allLines = append(allLines, strings.Split(crdSummary, "\n")...)
+		}
 	}
 
 	// Priority 4: SuperPod Topology (only if cluster has NPU nodes with SuperPod info)
@@ -285,8 +327,23 @@ func (m *Model) renderOverview() string {
 		}
 	}
 
+	return m.scrollOverviewLines(allLines)
+}
+
+// overviewAvailableHeight returns the number of content lines the overview can show
+func (m *Model) overviewAvailableHeight() int {
+	// Reserve: header(3) + footer(3) + scroll indicator(2) = 8 lines
+	availableHeight := m.height - 8
+	if availableHeight < 10 {
+		availableHeight = 10
+	}
+	return availableHeight
+}
+
+// scrollOverviewLines returns the visible window of the overview content
+func (m *Model) scrollOverviewLines(allLines []string) string {
 	// Apply scroll offset for vertical scrolling
-	maxVisible := availableHeight
+	maxVisible := m.overviewAvailableHeight()
 	totalLines := len(allLines)
 
 	// Clamp scroll offset to valid range
@@ -465,6 +522,16 @@ func (m *Model) compactAlertLines(summary *model.ClusterSummary) []string {
 	return alerts
 }
 
+// clusterUsageTrend renders a sparkline and trend arrow for a cluster usage
+// line, or "" until at least two snapshots are recorded. It also returns the
+// progress bar width that keeps the line inside the cluster load panel.
+func (m *Model) clusterUsageTrend(history []float64, trend Trend) (string, int) {
+	if len(history) < 2 {
+		return "", 40
+	}
+	return fmt.Sprintf(" %s %s", RenderSparkline(history, 12), renderTrendIndicator(trend)), 26
+}
+
 // renderClusterLoadCompact renders a compact cluster load summary (most important metrics)
 func (m *Model) renderClusterLoadCompact(summary *model.ClusterSummary) string {
 	content := []string{
@@ -475,14 +542,16 @@ func (m *Model) renderClusterLoadCompact(summary *model.ClusterSummary) string {
 	// CPU Load - show both capacity and allocatable for transparency
 	if summary.CPUUsed > 0 {
 		usagePercent := summary.CPUUsageUtilization
+		trend, barWidth := m.clusterUsageTrend(m.getClusterCPUHistory(), m.calculateClusterCPUTrend(summary.CPUUsed))
 		content = append(content,
-			fmt.Sprintf("%s %s / %s %s (%s) %s",
+			fmt.Sprintf("%s %s / %s %s (%s) %s%s",
 				m.T("metrics.cpu_used"),
-				StyleWarning.Render(formatCPU(summary.CPUUsed)),
-				formatCPU(summary.CPUCapacity),
+				StyleWarning.Render(formatCPU(summary.CPUUsed, m.rawUnits)),
+				formatCPU(summary.CPUCapacity, m.rawUnits),
 				m.T("metrics.capacity"),
 				StyleHighlight.Render(formatPercentage(usagePercent)),
-				renderProgressBar(usagePercent, 40),
+				renderProgressBar(usagePercent, barWidth),
+				trend,
 			),
 		)
 		// Show allocatable for reference
@@ -490,8 +559,8 @@ func (m *Model) renderClusterLoadCompact(summary *model.ClusterSummary) string {
 			allocPercent := float64(summary.CPUUsed) / float64(summary.CPUAllocatable) * 100
 			content = append(content,
 				fmt.Sprintf("          %s / %s %s (%s)",
-					StyleWarning.Render(formatCPU(summary.CPUUsed)),
-					formatCPU(summary.CPUAllocatable),
+					StyleWarning.Render(formatCPU(summary.CPUUsed, m.rawUnits)),
+					formatCPU(summary.CPUAllocatable, m.rawUnits),
 					m.T("metrics.allocatable"),
 					StyleTextMuted.Render(formatPercentage(allocPercent)),
 				),
@@ -502,8 +571,8 @@ func (m *Model) renderClusterLoadCompact(summary *model.ClusterSummary) string {
 		content = append(content,
 			fmt.Sprintf("%s  %s / %s %s (%s) %s %s",
 				m.T("metrics.cpu_req"),
-				StyleStatusRunning.Render(formatCPU(summary.CPURequested)),
-				formatCPU(summary.CPUAllocatable),
+				StyleStatusRunning.Render(formatCPU(summary.CPURequested, m.rawUnits)),
+				formatCPU(summary.CPUAllocatable, m.rawUnits),
 				m.T("metrics.allocatable"),
 				StyleHighlight.Render(formatPercentage(requestPercent)),
 				renderProgressBar(requestPercent, 40),
@@ -515,14 +584,16 @@ func (m *Model) renderClusterLoadCompact(summary *model.ClusterSummary) string {
 	// Memory Load - show both capacity and allocatable for transparency
 	if summary.MemoryUsed > 0 {
 		usagePercent := summary.MemUsageUtilization
+		trend, barWidth := m.clusterUsageTrend(m.getClusterMemoryHistory(), m.calculateClusterMemoryTrend(summary.MemoryUsed))
 		content = append(content,
-			fmt.Sprintf("%s %s / %s %s (%s) %s",
+			fmt.Sprintf("%s %s / %s %s (%s) %s%s",
 				m.T("metrics.mem_used"),
-				StyleWarning.Render(formatMemory(summary.MemoryUsed)),
-				formatMemory(summary.MemoryCapacity),
+				StyleWarning.Render(formatMemory(summary.MemoryUsed, m.rawUnits)),
+				formatMemory(summary.MemoryCapacity, m.rawUnits),
 				m.T("metrics.capacity"),
 				StyleHighlight.Render(formatPercentage(usagePercent)),
-				renderProgressBar(usagePercent, 40),
+				renderProgressBar(usagePercent, barWidth),
+				trend,
 			),
 		)
 		// Show allocatable for reference
@@ -530,8 +601,8 @@ func (m *Model) renderClusterLoadCompact(summary *model.ClusterSummary) string {
 			allocPercent := float64(summary.MemoryUsed) / float64(summary.MemoryAllocatable) * 100
 			content = append(content,
 				fmt.Sprintf("          %s / %s %s (%s)",
-					StyleWarning.Render(formatMemory(summary.MemoryUsed)),
-					formatMemory(summary.MemoryAllocatable),
+					StyleWarning.Render(formatMemory(summary.MemoryUsed, m.rawUnits)),
+					formatMemory(summary.MemoryAllocatable, m.rawUnits),
 					m.T("metrics.allocatable"),
 					StyleTextMuted.Render(formatPercentage(allocPercent)),
 				),
@@ -542,8 +613,8 @@ func (m *Model) renderClusterLoadCompact(summary *model.ClusterSummary) string {
 		content = append(content,
 			fmt.Sprintf("%s  %s / %s %s (%s) %s %s",
 				m.T("metrics.mem_req"),
-				StyleStatusRunning.Render(formatMemory(summary.MemoryRequested)),
-				formatMemory(summary.MemoryAllocatable),
+				StyleStatusRunning.Render(formatMemory(summary.MemoryRequested, m.rawUnits)),
+				formatMemory(summary.MemoryAllocatable, m.rawUnits),
 				m.T("metrics.allocatable"),
 				StyleHighlight.Render(formatPercentage(requestPercent)),
 				renderProgressBar(requestPercent, 40),
@@ -585,6 +656,7 @@ func (m *Model) renderClusterLoadCompact(summary *model.ClusterSummary) string {
 		if summary.KubeletError != "" {
 			message += fmt.Sprintf(" • %s", truncateText(summary.KubeletError, 60))
 		}
+		message += m.kubeletTimeoutNote(summary)
 		hint := m.kubeletHint(detectKubeletHintKind(summary.KubeletError))
 		if hint != "" {
 			message += fmt.Sprintf(" • %s", hint)
@@ -603,7 +675,7 @@ func (m *Model) renderClusterLoadCompact(summary *model.ClusterSummary) string {
 			StyleWarning.Render(m.TF("overview.partial_metrics", map[string]interface{}{
 				"WithMetrics": summary.NodesWithMetrics,
 				"Total":       summary.TotalNodes,
-			})),
+			})+m.kubeletTimeoutNote(summary)),
 		)
 	}
 
@@ -622,8 +694,8 @@ func (m *Model) renderClusterLoad(summary *model.ClusterSummary) string {
 	if summary.CPUUsed > 0 {
 		// We have actual usage data!
 		cpuUsageStr := fmt.Sprintf("%s / %s",
-			StyleWarning.Render(formatCPU(summary.CPUUsed)),
-			formatCPU(summary.CPUCapacity),
+			StyleWarning.Render(formatCPU(summary.CPUUsed, m.rawUnits)),
+			formatCPU(summary.CPUCapacity, m.rawUnits),
 		)
 		usagePercent := summary.CPUUsageUtilization
 		content = append(content,
@@ -641,8 +713,8 @@ func (m *Model) renderClusterLoad(summary *model.ClusterSummary) string {
 			cpuLoadLabel+" "+StyleTextMuted.Render(m.T("overview.usage_unavailable_show_requests")),
 			fmt.Sprintf("  %s %s / %s (%s)",
 				m.T("overview.requests"),
-				StyleStatusRunning.Render(formatCPU(summary.CPURequested)),
-				formatCPU(summary.CPUAllocatable),
+				StyleStatusRunning.Render(formatCPU(summary.CPURequested, m.rawUnits)),
+				formatCPU(summary.CPUAllocatable, m.rawUnits),
 				StyleHighlight.Render(formatPercentage(requestPercent)),
 			),
 			fmt.Sprintf("  %s", renderProgressBar(requestPercent, 60)),
@@ -656,8 +728,8 @@ func (m *Model) renderClusterLoad(summary *model.ClusterSummary) string {
 	if summary.MemoryUsed > 0 {
 		// We have actual usage data!
 		memUsageStr := fmt.Sprintf("%s / %s",
-			StyleWarning.Render(formatMemory(summary.MemoryUsed)),
-			formatMemory(summary.MemoryCapacity),
+			StyleWarning.Render(formatMemory(summary.MemoryUsed, m.rawUnits)),
+			formatMemory(summary.MemoryCapacity, m.rawUnits),
 		)
 		usagePercent := summary.MemUsageUtilization
 		content = append(content,
@@ -675,8 +747,8 @@ func (m *Model) renderClusterLoad(summary *model.ClusterSummary) string {
 			memLoadLabel+" "+StyleTextMuted.Render(m.T("overview.usage_unavailable_show_requests")),
 			fmt.Sprintf("  %s %s / %s (%s)",
 				m.T("overview.requests"),
-				StyleStatusRunning.Render(formatMemory(summary.MemoryRequested)),
-				formatMemory(summary.MemoryAllocatable),
+				StyleStatusRunning.Render(formatMemory(summary.MemoryRequested, m.rawUnits)),
+				formatMemory(summary.MemoryAllocatable, m.rawUnits),
 				StyleHighlight.Render(formatPercentage(requestPercent)),
 			),
 			fmt.Sprintf("  %s", renderProgressBar(requestPercent, 60)),
@@ -698,16 +770,16 @@ func (m *Model) renderClusterLoad(summary *model.ClusterSummary) string {
 				StyleHighlight.Render(formatRate(summary.NetworkTxRate)),
 			),
 			StyleTextMuted.Render(fmt.Sprintf("  Σ %s / %s",
-				formatMemory(summary.NetworkRxBytes),
-				formatMemory(summary.NetworkTxBytes))),
+				formatMemory(summary.NetworkRxBytes, false),
+				formatMemory(summary.NetworkTxBytes, false))),
 		)
 	case summary.KubeletMetricsAvailable:
 		line := fmt.Sprintf("%s %s %s  %s %s %s",
 			netLoadLabel,
 			m.T("metrics.rx"),
-			StyleHighlight.Render(formatMemory(summary.NetworkRxBytes)),
+			StyleHighlight.Render(formatMemory(summary.NetworkRxBytes, false)),
 			m.T("metrics.tx"),
-			StyleHighlight.Render(formatMemory(summary.NetworkTxBytes)),
+			StyleHighlight.Render(formatMemory(summary.NetworkTxBytes, false)),
 			StyleTextMuted.Render(m.T("overview.cumulative")),
 		)
 		content = append(content, line)
@@ -731,6 +803,7 @@ func (m *Model) renderClusterLoad(summary *model.ClusterSummary) string {
 		if summary.KubeletError != "" {
 			message += fmt.Sprintf(" • %s", truncateText(summary.KubeletError, 60))
 		}
+		message += m.kubeletTimeoutNote(summary)
 		hintKind := detectKubeletHintKind(summary.KubeletError)
 		hint := m.kubeletHint(hintKind)
 		if hint != "" {
@@ -767,7 +840,7 @@ func (m *Model) renderClusterLoad(summary *model.ClusterSummary) string {
 			StyleWarning.Render(m.TF("overview.partial_nodes_no_metrics", map[string]interface{}{
 				"WithMetrics": summary.NodesWithMetrics,
 				"Total":       summary.TotalNodes,
-			})),
+			})+m.kubeletTimeoutNote(summary)),
 		)
 	}
 
@@ -787,12 +860,15 @@ func (m *Model) cpuDetailsLines(summary *model.ClusterSummary) []string {
 	lines := []string{
 		StyleHeader.Render("[⚡ CPU]"),
 		"",
-		fmt.Sprintf("%s   %s", m.T("overview.capacity"), StyleHighlight.Render(formatCPU(summary.CPUCapacity))),
-		fmt.Sprintf("%s %s", m.T("overview.allocatable"), formatCPU(summary.CPUAllocatable)),
-		fmt.Sprintf("%s %s", m.T("overview.requested"), formatCPU(summary.CPURequested)),
+		fmt.Sprintf("%s   %s", m.T("overview.capacity"), StyleHighlight.Render(formatCPU(summary.CPUCapacity, m.rawUnits))),
+		fmt.Sprintf("%s %s", m.T("overview.allocatable"), formatCPU(summary.CPUAllocatable, m.rawUnits)),
+		fmt.Sprintf("%s %s", m.T("overview.requested"), formatCPU(summary.CPURequested, m.rawUnits)),
 	}
 	if summary.CPUUsed > 0 {
-		lines = append(lines, fmt.Sprintf("%s   %s", m.T("overview.actual"), StyleWarning.Render(formatCPU(summary.CPUUsed))))
+		lines = append(lines, fmt.Sprintf("%s   %s", m.T("overview.actual"), StyleWarning.Render(formatCPU(summary.CPUUsed, m.rawUnits))))
+	}
+	if summary.CPURequestEfficiency > 0 {
+		lines = append(lines, fmt.Sprintf("%s %s", m.T("overview.efficiency"), renderEfficiency(summary.CPURequestEfficiency)))
 	}
 	return lines
 }
@@ -805,12 +881,15 @@ func (m *Model) memoryDetailsLines(summary *model.ClusterSummary) []string {
 	lines := []string{
 		StyleHeader.Render("[🧠 Memory]"),
 		"",
-		fmt.Sprintf("%s   %s", m.T("overview.capacity"), StyleHighlight.Render(formatMemory(summary.MemoryCapacity))),
-		fmt.Sprintf("%s %s", m.T("overview.allocatable"), formatMemory(summary.MemoryAllocatable)),
-		fmt.Sprintf("%s %s", m.T("overview.requested"), formatMemory(summary.MemoryRequested)),
+		fmt.Sprintf("%s   %s", m.T("overview.capacity"), StyleHighlight.Render(formatMemory(summary.MemoryCapacity, m.rawUnits))),
+		fmt.Sprintf("%s %s", m.T("overview.allocatable"), formatMemory(summary.MemoryAllocatable, m.rawUnits)),
+		fmt.Sprintf("%s %s", m.T("overview.requested"), formatMemory(summary.MemoryRequested, m.rawUnits)),
 	}
 	if summary.MemoryUsed > 0 {
-		lines = append(lines, fmt.Sprintf("%s   %s", m.T("overview.actual"), StyleWarning.Render(formatMemory(summary.MemoryUsed))))
+		lines = append(lines, fmt.Sprintf("%s   %s", m.T("overview.actual"), StyleWarning.Render(formatMemory(summary.MemoryUsed, m.rawUnits))))
+	}
+	if summary.MemRequestEfficiency > 0 {
+		lines = append(lines, fmt.Sprintf("%s %s", m.T("overview.efficiency"), renderEfficiency(summary.MemRequestEfficiency)))
 	}
 	return lines
 }
@@ -820,13 +899,20 @@ func (m *Model) renderMemoryDetails(summary *model.ClusterSummary, targetContent
 }
 
 func (m *Model) podDetailsLines(summary *model.ClusterSummary) []string {
+	var running, pending, failed string
+	if delta := m.activeSummaryDelta(); delta != nil {
+		running = formatCountDelta(delta.runningPods)
+		pending = formatCountDelta(delta.pendingPods)
+		failed = formatCountDelta(delta.failedPods)
+	}
+
 	return []string{
 		StyleHeader.Render("[📦 Pods]"),
 		"",
 		fmt.Sprintf("%s %s", m.T("overview.capacity"), StyleHighlight.Render(fmt.Sprintf("%d", summary.PodAllocatable))),
-		fmt.Sprintf("%s %s", m.T("overview.running"), StyleStatusRunning.Render(fmt.Sprintf("%d", summary.RunningPods))),
-		fmt.Sprintf("%s %s", m.T("overview.pending"), StyleStatusPending.Render(fmt.Sprintf("%d", summary.PendingPods))),
-		fmt.Sprintf("%s %s", m.T("overview.failed"), StyleStatusNotReady.Render(fmt.Sprintf("%d", summary.FailedPods))),
+		fmt.Sprintf("%s %s%s", m.T("overview.running"), StyleStatusRunning.Render(fmt.Sprintf("%d", summary.RunningPods)), running),
+		fmt.Sprintf("%s %s%s", m.T("overview.pending"), StyleStatusPending.Render(fmt.Sprintf("%d", summary.PendingPods)), pending),
+		fmt.Sprintf("%s %s%s", m.T("overview.failed"), StyleStatusNotReady.Render(fmt.Sprintf("%d", summary.FailedPods)), failed),
 	}
 }
 
@@ -845,15 +931,15 @@ func (m *Model) renderClusterResources(summary *model.ClusterSummary) string {
 	content = append(content, StyleSubHeader.Render("CPU (cores):"))
 	if summary.CPUCapacity > 0 {
 		content = append(content,
-			fmt.Sprintf("  Capacity:    %s", StyleHighlight.Render(formatCPU(summary.CPUCapacity))),
-			fmt.Sprintf("  Allocatable: %s", formatCPU(summary.CPUAllocatable)),
+			fmt.Sprintf("  Capacity:    %s", StyleHighlight.Render(formatCPU(summary.CPUCapacity, m.rawUnits))),
+			fmt.Sprintf("  Allocatable: %s", formatCPU(summary.CPUAllocatable, m.rawUnits)),
 		)
 
 		// CPU Requests
 		if summary.CPURequested > 0 {
 			content = append(content,
 				fmt.Sprintf("  Requested:   %s (%s)",
-					StyleStatusRunning.Render(formatCPU(summary.CPURequested)),
+					StyleStatusRunning.Render(formatCPU(summary.CPURequested, m.rawUnits)),
 					formatPercentage(summary.CPURequestUtilization),
 				),
 				fmt.Sprintf("  %s", renderProgressBar(summary.CPURequestUtilization, 30)),
@@ -864,7 +950,7 @@ func (m *Model) renderClusterResources(summary *model.ClusterSummary) string {
 		if summary.CPUUsed > 0 {
 			content = append(content,
 				fmt.Sprintf("  Used:        %s (%s)",
-					StyleWarning.Render(formatCPU(summary.CPUUsed)),
+					StyleWarning.Render(formatCPU(summary.CPUUsed, m.rawUnits)),
 					formatPercentage(summary.CPUUsageUtilization),
 				),
 				fmt.Sprintf("  %s", renderProgressBar(summary.CPUUsageUtilization, 30)),
@@ -884,15 +970,15 @@ func (m *Model) renderClusterResources(summary *model.ClusterSummary) string {
 	content = append(content, StyleSubHeader.Render("Memory:"))
 	if summary.MemoryCapacity > 0 {
 		content = append(content,
-			fmt.Sprintf("  Capacity:    %s", StyleHighlight.Render(formatMemory(summary.MemoryCapacity))),
-			fmt.Sprintf("  Allocatable: %s", formatMemory(summary.MemoryAllocatable)),
+			fmt.Sprintf("  Capacity:    %s", StyleHighlight.Render(formatMemory(summary.MemoryCapacity, m.rawUnits))),
+			fmt.Sprintf("  Allocatable: %s", formatMemory(summary.MemoryAllocatable, m.rawUnits)),
 		)
 
 		// Memory Requests
 		if summary.MemoryRequested > 0 {
 			content = append(content,
 				fmt.Sprintf("  Requested:   %s (%s)",
-					StyleStatusRunning.Render(formatMemory(summary.MemoryRequested)),
+					StyleStatusRunning.Render(formatMemory(summary.MemoryRequested, m.rawUnits)),
 					formatPercentage(summary.MemRequestUtilization),
 				),
 				fmt.Sprintf("  %s", renderProgressBar(summary.MemRequestUtilization, 30)),
@@ -903,7 +989,7 @@ func (m *Model) renderClusterResources(summary *model.ClusterSummary) string {
 		if summary.MemoryUsed > 0 {
 			content = append(content,
 				fmt.Sprintf("  Used:        %s (%s)",
-					StyleWarning.Render(formatMemory(summary.MemoryUsed)),
+					StyleWarning.Render(formatMemory(summary.MemoryUsed, m.rawUnits)),
 					formatPercentage(summary.MemUsageUtilization),
 				),
 				fmt.Sprintf("  %s", renderProgressBar(summary.MemUsageUtilization, 30)),
@@ -937,13 +1023,38 @@ func (m *Model) renderClusterResources(summary *model.ClusterSummary) string {
 
 // renderNodesAndPods renders nodes and pods summary
 func (m *Model) nodesAndPodsLines(summary *model.ClusterSummary) []string {
-	return []string{
+	var total, ready, notReady string
+	if delta := m.activeSummaryDelta(); delta != nil {
+		total = formatCountDelta(delta.totalNodes)
+		ready = formatCountDelta(delta.readyNodes)
+		notReady = formatCountDelta(delta.notReadyNodes)
+	}
+
+	lines := []string{
 		StyleHeader.Render("[💻 Nodes]"),
 		"",
-		fmt.Sprintf("Total:    %s", StyleHighlight.Render(fmt.Sprintf("%d", summary.TotalNodes))),
-		fmt.Sprintf("Ready:    %s", StyleStatusReady.Render(fmt.Sprintf("%d", summary.ReadyNodes))),
-		fmt.Sprintf("NotReady: %s", StyleStatusNotReady.Render(fmt.Sprintf("%d", summary.NotReadyNodes))),
+		fmt.Sprintf("Total:    %s%s", StyleHighlight.Render(fmt.Sprintf("%d", summary.TotalNodes)), total),
+		fmt.Sprintf("Ready:    %s%s", StyleStatusReady.Render(fmt.Sprintf("%d", summary.ReadyNodes)), ready),
+		fmt.Sprintf("NotReady: %s%s", StyleStatusNotReady.Render(fmt.Sprintf("%d", summary.NotReadyNodes)), notReady),
+	}
+
+	if m.clusterData != nil && m.clusterData.ServerVersion != "" {
+		lines = append(lines, fmt.Sprintf("Version:  %s", StyleHighlight.Render(m.clusterData.ServerVersion)))
+	}
+
+	// Count nodes whose kubelet version differs from the majority
+	if m.clusterData != nil {
+		if majority := majorityKubeletVersion(m.clusterData.Nodes); majority != "" {
+			skewed := 0
+			for _, node := range m.clusterData.Nodes {
+				if node.KubeletVersion != "" && node.KubeletVersion != majority {
+					skewed++
+				}
+			}
+			lines = append(lines, fmt.Sprintf("Skew:     %s", StyleWarning.Render(fmt.Sprintf("%d", skewed))))
+		}
 	}
+	return lines
 }
 
 // renderEventSummary renders event summary section
@@ -963,6 +1074,13 @@ func (m *Model) renderServicesAndStorage(summary *model.ClusterSummary) string {
 	storageContent := m.storagePanelLines(summary)
 	workloadsContent := m.workloadsPanelLines(summary)
 
+	// Include GPU panel if cluster has GPU nodes
+	var gpuContent []string
+	hasGPU := summary.GPUCapacity > 0
+	if hasGPU {
+		gpuContent = m.gpuPanelLines(summary)
+	}
+
 	// Include NPU panel if cluster has NPU nodes
 	var npuContent []string
 	hasNPU := summary.NPUCapacity > 0
@@ -978,6 +1096,9 @@ func (m *Model) renderServicesAndStorage(summary *model.ClusterSummary) string {
 	}
 
 	panelsToCompare := [][]string{servicesContent, storageContent, workloadsContent}
+	if hasGPU {
+		panelsToCompare = append(panelsToCompare, gpuContent)
+	}
 	if hasNPU {
 		panelsToCompare = append(panelsToCompare, npuContent)
 	}
@@ -993,6 +1114,11 @@ func (m *Model) renderServicesAndStorage(summary *model.ClusterSummary) string {
 
 	panels := []string{servicesPanel, storagePanel, workloadsPanel}
 
+	if hasGPU {
+		gpuPanel := renderSummaryPanel(gpuContent, targetLines)
+		panels = append(panels, gpuPanel)
+	}
+
 	if hasNPU {
 		npuPanel := renderSummaryPanel(npuContent, targetLines)
 		panels = append(panels, npuPanel)
@@ -1036,7 +1162,7 @@ func (m *Model) storagePanelLines(summary *model.ClusterSummary) []string {
 		lines = append(lines,
 			fmt.Sprintf("PVs:  %s (%s)",
 				StyleHighlight.Render(fmt.Sprintf("%d", summary.TotalPVs)),
-				formatMemory(summary.TotalStorageSize),
+				formatMemory(summary.TotalStorageSize, false),
 			),
 			fmt.Sprintf("Bound: %s", StyleStatusReady.Render(fmt.Sprintf("%d", summary.BoundPVs))),
 		)
@@ -1097,6 +1223,40 @@ func (m *Model) workloadsPanelLines(summary *model.ClusterSummary) []string {
 	return lines
 }
 
+// gpuPanelLines generates GPU (NVIDIA, nvidia.com/gpu) panel content
+func (m *Model) gpuPanelLines(summary *model.ClusterSummary) []string {
+	lines := []string{
+		StyleHeader.Render("🎮 GPU"),
+		"",
+	}
+
+	if summary.GPUCapacity == 0 {
+		return append(lines, StyleTextMuted.Render("No GPU nodes"))
+	}
+
+	lines = append(lines,
+		fmt.Sprintf("Total:   %s", StyleHighlight.Render(fmt.Sprintf("%d", summary.GPUAllocatable))),
+		fmt.Sprintf("Alloc:   %s", StyleStatusRunning.Render(fmt.Sprintf("%d", summary.GPUAllocated))),
+		fmt.Sprintf("Usage:   %s", StyleHighlight.Render(formatPercentage(summary.GPUUtilization))),
+		fmt.Sprintf("  %s", renderProgressBar(summary.GPUUtilization, 14)),
+	)
+
+	// GPU allocation trend sparkline (if history available)
+	gpuHistory := m.getClusterGPUUtilizationHistory()
+	if len(gpuHistory) >= 2 {
+		sparkline := RenderSparkline(gpuHistory, 14)
+		trend := m.calculateClusterGPUTrend(summary.GPUAllocated)
+		lines = append(lines, fmt.Sprintf("Trend: %s %s", sparkline, renderTrendIndicator(trend)))
+	}
+
+	lines = append(lines, "", fmt.Sprintf("Nodes: %d", summary.GPUNodesCount))
+	if summary.GPUProduct != "" {
+		lines = append(lines, StyleTextMuted.Render(truncate(summary.GPUProduct, 16)))
+	}
+
+	return lines
+}
+
 // npuPanelLines generates NPU (Ascend AI accelerator) panel content
 func (m *Model) npuPanelLines(summary *model.ClusterSummary) []string {
 	lines := []string{
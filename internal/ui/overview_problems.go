@@ -0,0 +1,180 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// toggleOverviewProblems switches the overview between the full dashboard and
+// a list of only the unhealthy items
+func (m *Model) toggleOverviewProblems() {
+	m.overviewProblemsOnly = !m.overviewProblemsOnly
+	m.scrollOffset = 0
+}
+
+// nodeProblems returns the reasons a node is not healthy (NotReady or under pressure)
+func nodeProblems(node *model.NodeData) []string {
+	var problems []string
+	if node.Status != "Ready" {
+		status := node.Status
+		if status == "" {
+			status = "Unknown"
+		}
+		problems = append(problems, status)
+	}
+	if node.MemoryPressure {
+		problems = append(problems, "MemoryPressure")
+	}
+	if node.DiskPressure {
+		problems = append(problems, "DiskPressure")
+	}
+	if node.PIDPressure {
+		problems = append(problems, "PIDPressure")
+	}
+	return problems
+}
+
+// podProblem returns why a pod is unhealthy, using the same precedence as the
+// summary counters (OOMKilled → CrashLoopBackOff → image pull errors), then
+// Pending. Returns "" for healthy pods.
+func podProblem(pod *model.PodData) string {
+	var crashLoop, imagePull string
+	for _, cs := range pod.ContainerStates {
+		switch cs.Reason {
+		case "OOMKilled":
+			return cs.Reason
+		case "CrashLoopBackOff":
+			crashLoop = cs.Reason
+		case "ImagePullBackOff", "ErrImagePull":
+			imagePull = cs.Reason
+		}
+	}
+	switch {
+	case crashLoop != "":
+		return crashLoop
+	case imagePull != "":
+		return imagePull
+	case pod.Phase == "Pending":
+		if reason := podPendingReason(pod); reason != "" {
+			return reason
+		}
+		return "Pending"
+	}
+	return ""
+}
+
+// overviewProblemLines renders the non-green items of the cluster: unhealthy
+// nodes, failing or pending pods, high-restart pods, services without
+// endpoints, pending PVCs and active alerts. Items in pinned namespaces are
+// listed first within each section.
+func (m *Model) overviewProblemLines(summary *model.ClusterSummary) []string {
+	lines := []string{
+		StyleHeader.Render("⚠ "+m.T("overview.problems.title")) + "  " +
+			StyleTextMuted.Render(m.T("overview.problems.hint")),
+		"",
+	}
+
+	// section appends a titled group of items, skipping empty ones
+	found := false
+	section := func(title string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		found = true
+		lines = append(lines, StyleSubHeader.Render(fmt.Sprintf("%s (%d)", title, len(items))))
+		for _, item := range items {
+			lines = append(lines, "  "+item)
+		}
+		lines = append(lines, "")
+	}
+
+	// ref renders namespace/name, marking pinned namespaces
+	ref := func(namespace, name string) string {
+		if m.isPinnedNamespace(namespace) {
+			return StyleHighlight.Render(pinnedNamespaceMarker) + " " + namespace + "/" + name
+		}
+		return namespace + "/" + name
+	}
+
+	var nodes []string
+	for _, node := range m.clusterData.Nodes {
+		if problems := nodeProblems(node); len(problems) > 0 {
+			nodes = append(nodes, fmt.Sprintf("%s  %s", node.Name, StyleDanger.Render(strings.Join(problems, ", "))))
+		}
+	}
+	section(m.T("overview.problems.nodes"), nodes)
+
+	var pods []string
+	listedPods := make(map[string]bool)
+	for _, pod := range pinnedFirst(m, m.clusterData.Pods, func(p *model.PodData) string { return p.Namespace }) {
+		problem := podProblem(pod)
+		if problem == "" {
+			continue
+		}
+		listedPods[pod.Namespace+"/"+pod.Name] = true
+		style := StyleDanger
+		if pod.Phase == "Pending" && problem != "ErrImagePull" && problem != "ImagePullBackOff" {
+			style = StyleStatusPending
+		}
+		item := fmt.Sprintf("%s  %s", ref(pod.Namespace, pod.Name), style.Render(problem))
+		if pod.RestartCount > 0 {
+			item += StyleTextMuted.Render(fmt.Sprintf("  %d restarts", pod.RestartCount))
+		}
+		pods = append(pods, item)
+	}
+	section(m.T("overview.problems.pods"), pods)
+
+	// High-restart pods that are not already listed above
+	var restarts []string
+	for _, pod := range pinnedFirst(m, summary.HighRestartPods, func(p model.PodRestartInfo) string { return p.Namespace }) {
+		if listedPods[pod.Namespace+"/"+pod.Name] {
+			continue
+		}
+		reason := pod.Reason
+		if reason == "" {
+			reason = "Unknown"
+		}
+		restarts = append(restarts, fmt.Sprintf("%s  %s", ref(pod.Namespace, pod.Name),
+			StyleWarning.Render(fmt.Sprintf("%d restarts (%s)", pod.RestartCount, reason))))
+	}
+	section(m.T("overview.problems.restarts"), restarts)
+
+	var services []string
+	for _, svc := range pinnedFirst(m, m.clusterData.Services, func(s *model.ServiceData) string { return s.Namespace }) {
+		if svc.EndpointCount == 0 {
+			services = append(services, fmt.Sprintf("%s  %s", ref(svc.Namespace, svc.Name),
+				StyleWarning.Render(m.T("overview.problems.no_endpoints"))))
+		}
+	}
+	section(m.T("overview.problems.services"), services)
+
+	var pvcs []string
+	for _, pvc := range pinnedFirst(m, m.clusterData.PVCs, func(p *model.PVCData) string { return p.Namespace }) {
+		if pvc.Status == "Pending" {
+			pvcs = append(pvcs, fmt.Sprintf("%s  %s", ref(pvc.Namespace, pvc.Name), StyleStatusPending.Render(pvc.Status)))
+		}
+	}
+	section(m.T("overview.problems.pvcs"), pvcs)
+
+	active, _ := m.getDisplayedAlerts()
+	var alerts []string
+	for _, alert := range pinnedFirst(m, active, func(a model.Alert) string { return a.Namespace }) {
+		style := StyleWarning
+		if alert.Severity == model.AlertSeverityCritical {
+			style = StyleDanger
+		}
+		resource := alert.ResourceType + ": " + alert.ResourceName
+		if alert.Namespace != "" {
+			resource = fmt.Sprintf("%s: %s", alert.ResourceType, ref(alert.Namespace, alert.ResourceName))
+		}
+		alerts = append(alerts, fmt.Sprintf("%s  %s", resource, style.Render(alert.Message)))
+	}
+	section(m.T("overview.problems.alerts"), alerts)
+
+	if !found {
+		lines = append(lines, StyleStatusReady.Render("✓ "+m.T("overview.problems.none")))
+	}
+	return lines
+}
@@ -0,0 +1,292 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// paletteMaxResults limits the matches listed in the command palette
+const paletteMaxResults = 10
+
+// paletteEntry is a resource that can be opened from the command palette
+type paletteEntry struct {
+	kind      string // Pod, Node, Deployment, ...
+	namespace string // Empty for cluster-scoped resources
+	name      string
+	target    interface{} // *model.PodData, *model.NodeData, ...
+}
+
+// paletteKindAliases maps the kind prefixes accepted in "kind:name" queries,
+// including the kubectl short names, to entry kinds
+var paletteKindAliases = map[string]string{
+	"po": "Pod", "pod": "Pod", "pods": "Pod",
+	"no": "Node", "node": "Node", "nodes": "Node",
+	"deploy": "Deployment", "deployment": "Deployment", "deployments": "Deployment",
+	"sts": "StatefulSet", "statefulset": "StatefulSet", "statefulsets": "StatefulSet",
+	"ds": "DaemonSet", "daemonset": "DaemonSet", "daemonsets": "DaemonSet",
+	"job": "Job", "jobs": "Job",
+	"cj": "CronJob", "cronjob": "CronJob", "cronjobs": "CronJob",
+	"svc": "Service", "service": "Service", "services": "Service",
+	"pv": "PV", "persistentvolume": "PV", "persistentvolumes": "PV",
+	"pvc": "PVC", "persistentvolumeclaim": "PVC", "persistentvolumeclaims": "PVC",
+}
+
+// buildPaletteIndex indexes every resource that has a detail view. It is
+// rebuilt on each refresh so the palette always opens current objects.
+func buildPaletteIndex(data *model.ClusterData) []paletteEntry {
+	if data == nil {
+		return nil
+	}
+
+	var index []paletteEntry
+	for _, pod := range data.Pods {
+		index = append(index, paletteEntry{"Pod", pod.Namespace, pod.Name, pod})
+	}
+	for _, node := range data.Nodes {
+		index = append(index, paletteEntry{"Node", "", node.Name, node})
+	}
+	for _, deploy := range data.Deployments {
+		index = append(index, paletteEntry{"Deployment", deploy.Namespace, deploy.Name, deploy})
+	}
+	for _, sts := range data.StatefulSets {
+		index = append(index, paletteEntry{"StatefulSet", sts.Namespace, sts.Name, sts})
+	}
+	for _, ds := range data.DaemonSets {
+		index = append(index, paletteEntry{"DaemonSet", ds.Namespace, ds.Name, ds})
+	}
+	for _, job := range data.Jobs {
+		index = append(index, paletteEntry{"Job", job.Namespace, job.Name, job})
+	}
+	for _, cj := range data.CronJobs {
+		index = append(index, paletteEntry{"CronJob", cj.Namespace, cj.Name, cj})
+	}
+	for _, svc := range data.Services {
+		index = append(index, paletteEntry{"Service", svc.Namespace, svc.Name, svc})
+	}
+	for _, pv := range data.PVs {
+		index = append(index, paletteEntry{"PV", "", pv.Name, pv})
+	}
+	for _, pvc := range data.PVCs {
+		index = append(index, paletteEntry{"PVC", pvc.Namespace, pvc.Name, pvc})
+	}
+	return index
+}
+
+// parsePaletteQuery splits a palette query into an optional kind ("deploy:web"),
+// an optional namespace ("default/web") and the name pattern
+func parsePaletteQuery(query string) (kind, namespace, name string) {
+	name = strings.TrimSpace(query)
+	if prefix, rest, ok := strings.Cut(name, ":"); ok {
+		if k, known := paletteKindAliases[strings.ToLower(prefix)]; known {
+			kind = k
+			name = rest
+		}
+	}
+	if ns, rest, ok := strings.Cut(name, "/"); ok {
+		namespace = ns
+		name = rest
+	}
+	return kind, namespace, name
+}
+
+// paletteMatches returns the indexed resources matching the palette query,
+// best match first: exact names, then name prefixes, then fuzzy matches.
+// Without a query the suggestions, if any, are listed.
+func (m *Model) paletteMatches() []paletteEntry {
+	kind, namespace, pattern := parsePaletteQuery(m.paletteText)
+	if pattern == "" && namespace == "" && kind == "" {
+		if m.paletteText == "" {
+			return m.paletteSuggestions
+		}
+		return nil
+	}
+
+	var matches []paletteEntry
+	var scores []int
+	for _, entry := range m.paletteIndex {
+		if kind != "" && entry.kind != kind {
+			continue
+		}
+		if namespace != "" && entry.namespace != namespace {
+			continue
+		}
+		score, ok := fuzzyScore(pattern, entry.name)
+		if !ok {
+			continue
+		}
+		switch name := strings.ToLower(entry.name); {
+		case name == strings.ToLower(pattern):
+			score += 1000
+		case strings.HasPrefix(name, strings.ToLower(pattern)):
+			score += 500
+		}
+		matches = append(matches, entry)
+		scores = append(scores, score)
+	}
+
+	order := make([]int, len(matches))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if scores[a] != scores[b] {
+			return scores[a] > scores[b]
+		}
+		return len(matches[a].name) < len(matches[b].name)
+	})
+
+	sorted := make([]paletteEntry, 0, min(len(matches), paletteMaxResults))
+	for _, i := range order {
+		if len(sorted) == paletteMaxResults {
+			break
+		}
+		sorted = append(sorted, matches[i])
+	}
+	return sorted
+}
+
+// openPalette shows the command palette with an empty query
+func (m *Model) openPalette() {
+	m.paletteMode = true
+	m.paletteText = ""
+	m.paletteSelectedIndex = 0
+	m.paletteSuggestions = nil
+}
+
+// handlePaletteKey handles keys while the command palette is visible
+func (m *Model) handlePaletteKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Type == tea.KeyUp:
+		if m.paletteSelectedIndex > 0 {
+			m.paletteSelectedIndex--
+		}
+	case msg.Type == tea.KeyDown:
+		if m.paletteSelectedIndex < len(m.paletteMatches())-1 {
+			m.paletteSelectedIndex++
+		}
+	case key.Matches(msg, m.keys.Enter):
+		matches := m.paletteMatches()
+		if m.paletteSelectedIndex < len(matches) {
+			m.paletteMode = false
+			m.openPaletteEntry(matches[m.paletteSelectedIndex])
+		}
+	case msg.Type == tea.KeyEsc:
+		m.paletteMode = false
+	case msg.Type == tea.KeyBackspace || msg.Type == tea.KeyDelete:
+		if len(m.paletteText) > 0 {
+			m.paletteText = m.paletteText[:len(m.paletteText)-1]
+			m.paletteSelectedIndex = 0
+		}
+	case msg.Type == tea.KeyRunes:
+		m.paletteText += string(msg.Runes)
+		m.paletteSelectedIndex = 0
+	case msg.String() == "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// openPaletteEntry navigates to the detail view of a palette match
+func (m *Model) openPaletteEntry(entry paletteEntry) {
+	// Leave any current mode; the new detail view starts fresh
+	m.cancelLogsFetch()
+	m.logsMode = false
+	m.commandOutputMode = false
+	m.actionMenuMode = false
+	m.filterMode = false
+	m.searchMode = false
+	m.fromJobDetail = false
+	m.fromVolcanoJobDetail = false
+	m.fromDeploymentDetail = false
+	m.fromStatefulSetDetail = false
+	m.fromNodeDetail = false
+	m.fromHeatmap = false
+	m.fromPodDetail = false
+
+	m.detailMode = true
+	m.detailScrollOffset = 0
+
+	switch target := entry.target.(type) {
+	case *model.PodData:
+		m.openPodDetail(target)
+	case *model.NodeData:
+		m.selectedNode = target
+		m.currentView = ViewNodeDetail
+		m.nodePodSelectedIndex = -1
+	case *model.DeploymentData:
+		m.selectedDeployment = target
+		m.currentView = ViewDeploymentDetail
+		m.deploymentPodSelectedIndex = 0
+	case *model.StatefulSetData:
+		m.selectedStatefulSet = target
+		m.currentView = ViewStatefulSetDetail
+		m.statefulSetPodSelectedIndex = 0
+	case *model.DaemonSetData:
+		m.selectedDaemonSet = target
+		m.currentView = ViewDaemonSetDetail
+	case *model.JobData:
+		m.selectedJob = target
+		m.currentView = ViewJobDetail
+		m.jobPodSelectedIndex = 0
+	case *model.CronJobData:
+		m.selectedCronJob = target
+		m.currentView = ViewCronJobDetail
+	case *model.ServiceData:
+		m.selectedService = target
+		m.currentView = ViewServiceDetail
+	case *model.PVData:
+		m.selectedPV = target
+		m.currentView = ViewPVDetail
+	case *model.PVCData:
+		m.selectedPVC = target
+		m.currentView = ViewPVCDetail
+	case *model.EventData:
+		m.selectedEvent = target
+		m.currentView = ViewEventDetail
+	}
+}
+
+// renderPalette renders the command palette overlay: the query input and the
+// best matching resources
+func (m *Model) renderPalette() string {
+	var lines []string
+
+	lines = append(lines, StyleHeader.Render(m.T("palette.title")))
+	lines = append(lines, "")
+	lines = append(lines, renderTextInput(m.paletteText))
+	lines = append(lines, "")
+
+	matches := m.paletteMatches()
+	switch {
+	case m.paletteText == "" && len(m.paletteSuggestions) > 0:
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("palette.top_pods")))
+	case m.paletteText == "":
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("palette.placeholder")))
+	case len(matches) == 0:
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("palette.no_matches")))
+	}
+
+	for i, entry := range matches {
+		name := entry.name
+		if entry.namespace != "" {
+			name = entry.namespace + "/" + entry.name
+		}
+		line := fmt.Sprintf("  %s %s", padRight(StyleTextSecondary.Render(entry.kind), 12), name)
+		if i == m.paletteSelectedIndex {
+			line = StyleSelected.Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, StyleTextMuted.Render("  "+m.T("palette.help")))
+
+	return strings.Join(lines, "\n")
+}
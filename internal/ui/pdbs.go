@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// getFilteredPDBs returns the PodDisruptionBudgets in the namespace filter,
+// with budgets that currently block evictions first
+func (m *Model) getFilteredPDBs() []*model.PDBData {
+	if m.clusterData == nil {
+		return nil
+	}
+
+	pdbs := filterWorkloads(m.clusterData.PDBs, m.filterNamespace, func(p *model.PDBData) string { return p.Namespace })
+	sort.SliceStable(pdbs, func(i, j int) bool {
+		if pdbs[i].BlocksEviction() != pdbs[j].BlocksEviction() {
+			return pdbs[i].BlocksEviction()
+		}
+		if pdbs[i].Namespace != pdbs[j].Namespace {
+			return pdbs[i].Namespace < pdbs[j].Namespace
+		}
+		return pdbs[i].Name < pdbs[j].Name
+	})
+	return pdbs
+}
+
+// renderPDBsList renders the PodDisruptionBudgets section of the Workloads
+// view. Budgets are not selectable; the section only shows whether they
+// currently allow voluntary disruptions such as node drains.
+func (m *Model) renderPDBsList(pdbs []*model.PDBData) []string {
+	var rows []string
+
+	blocking := 0
+	for _, pdb := range pdbs {
+		if pdb.BlocksEviction() {
+			blocking++
+		}
+	}
+
+	header := fmt.Sprintf("%s  (Total: %d)",
+		StyleSubHeader.Render(m.T("workloads.pdbs.title")),
+		len(pdbs),
+	)
+	if blocking > 0 {
+		header += "  " + StyleDanger.Render(m.TF("workloads.pdbs.blocking_count", map[string]interface{}{
+			"Count": blocking,
+		}))
+	}
+	rows = append(rows, header)
+	rows = append(rows, "")
+
+	const (
+		colName      = 35
+		colNamespace = 15
+		colMin       = 11
+		colMax       = 12
+		colHealthy   = 10
+		colAllowed   = 10
+	)
+
+	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s",
+		padRight(m.T("columns.name"), colName),
+		padRight(m.T("columns.namespace"), colNamespace),
+		padRight(m.T("columns.min_available"), colMin),
+		padRight(m.T("columns.max_unavailable"), colMax),
+		padRight(m.T("columns.healthy"), colHealthy),
+		padRight(m.T("columns.disruptions_allowed"), colAllowed),
+		m.T("columns.status"),
+	)
+	rows = append(rows, StyleTextMuted.Render(headerRow))
+
+	// orDash renders unset budget fields as "-"
+	orDash := func(value string) string {
+		if value == "" {
+			return StyleTextMuted.Render("-")
+		}
+		return value
+	}
+
+	for _, pdb := range pdbs {
+		allowed := fmt.Sprintf("%d", pdb.DisruptionsAllowed)
+		var status string
+		switch {
+		case pdb.BlocksEviction():
+			allowed = StyleDanger.Render(allowed)
+			status = StyleDanger.Render(m.T("workloads.pdbs.blocking"))
+		case pdb.ExpectedPods == 0:
+			status = StyleTextMuted.Render(m.T("workloads.pdbs.no_pods"))
+		default:
+			status = StyleStatusReady.Render(m.T("workloads.pdbs.ok"))
+		}
+
+		healthy := fmt.Sprintf("%d/%d", pdb.CurrentHealthy, pdb.DesiredHealthy)
+		if pdb.CurrentHealthy < pdb.DesiredHealthy {
+			healthy = StyleWarning.Render(healthy)
+		}
+
+		row := fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s",
+			padRight(truncate(pdb.Name, colName), colName),
+			padRight(truncate(pdb.Namespace, colNamespace), colNamespace),
+			padRight(orDash(pdb.MinAvailable), colMin),
+			padRight(orDash(pdb.MaxUnavailable), colMax),
+			padRight(healthy, colHealthy),
+			padRight(allowed, colAllowed),
+			status,
+		)
+		rows = append(rows, "  "+row)
+	}
+
+	return rows
+}
@@ -0,0 +1,62 @@
+package ui
+
+import "sort"
+
+// pinnedNamespaceMarker marks pinned namespaces in lists
+const pinnedNamespaceMarker = "★"
+
+// SetPinnedNamespaces sets the namespaces listed first in the namespace filter
+// and marked in the Pods and namespace views, e.g. kube-system and platform
+// namespaces. Their problems are also listed first in the overview.
+func (m *Model) SetPinnedNamespaces(namespaces []string) {
+	m.pinnedNamespaces = make(map[string]int, len(namespaces))
+	for _, ns := range namespaces {
+		if _, ok := m.pinnedNamespaces[ns]; !ok && ns != "" {
+			m.pinnedNamespaces[ns] = len(m.pinnedNamespaces)
+		}
+	}
+}
+
+// isPinnedNamespace reports whether a namespace is pinned
+func (m *Model) isPinnedNamespace(namespace string) bool {
+	_, ok := m.pinnedNamespaces[namespace]
+	return ok
+}
+
+// sortNamespaces sorts namespaces in place: pinned ones first in the
+// configured order, then the others alphabetically
+func (m *Model) sortNamespaces(namespaces []string) {
+	sort.Slice(namespaces, func(i, j int) bool {
+		ri, pi := m.pinnedNamespaces[namespaces[i]]
+		rj, pj := m.pinnedNamespaces[namespaces[j]]
+		switch {
+		case pi && pj:
+			return ri < rj
+		case pi != pj:
+			return pi
+		}
+		return namespaces[i] < namespaces[j]
+	})
+}
+
+// renderNamespaceName renders a namespace truncated to width, marking pinned ones
+func (m *Model) renderNamespaceName(namespace string, width int) string {
+	if !m.isPinnedNamespace(namespace) {
+		return truncate(namespace, width)
+	}
+	return StyleHighlight.Render(pinnedNamespaceMarker) + " " + truncate(namespace, width-2)
+}
+
+// pinnedFirst returns a copy of items with those in pinned namespaces moved to
+// the front, keeping the relative order otherwise
+func pinnedFirst[T any](m *Model, items []T, namespaceOf func(T) string) []T {
+	sorted := make([]T, len(items))
+	copy(sorted, items)
+	if len(m.pinnedNamespaces) == 0 {
+		return sorted
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return m.isPinnedNamespace(namespaceOf(sorted[i])) && !m.isPinnedNamespace(namespaceOf(sorted[j]))
+	})
+	return sorted
+}
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// SetHideCompletedPods sets whether Succeeded pods, such as finished Job pods,
+// start hidden from the Pods view. While hidden, Failed pods older than
+// failedAfter are hidden as well (0 keeps them). C shows or hides them again.
+func (m *Model) SetHideCompletedPods(hide bool, failedAfter time.Duration) {
+	m.hideCompletedPods = hide
+	m.hideFailedPodsAfter = failedAfter
+}
+
+// isHiddenPod reports whether a pod is left out of the Pods view as completed.
+// Pods are never hidden from a status filter selecting their phase.
+func (m *Model) isHiddenPod(pod *model.PodData) bool {
+	if !m.hideCompletedPods || m.filterStatus == pod.Phase {
+		return false
+	}
+	switch pod.Phase {
+	case "Succeeded":
+		return true
+	case "Failed":
+		return m.hideFailedPodsAfter > 0 && !pod.CreationTimestamp.IsZero() &&
+			time.Since(pod.CreationTimestamp) > m.hideFailedPodsAfter
+	}
+	return false
+}
+
+// countHiddenPods returns the number of completed pods hidden from the Pods
+// view that would otherwise pass its filters. Only the completed pods of the
+// current data are checked, so list rebuilds do not scan every pod again.
+func (m *Model) countHiddenPods() int {
+	if m.clusterData == nil || !m.hideCompletedPods {
+		return 0
+	}
+
+	hidden := 0
+	for _, pod := range m.getCompletedPods() {
+		if !m.isHiddenPod(pod) {
+			continue
+		}
+		if _, ok := m.podMatchesFilters(pod); ok {
+			hidden++
+		}
+	}
+	return hidden
+}
+
+// getCompletedPods returns the Succeeded and Failed pods of the current data
+func (m *Model) getCompletedPods() []*model.PodData {
+	if m.completedPods == nil || m.completedPodsVersion != m.dataVersion {
+		m.completedPods = make([]*model.PodData, 0)
+		for _, pod := range m.clusterData.Pods {
+			if pod.Phase == "Succeeded" || pod.Phase == "Failed" {
+				m.completedPods = append(m.completedPods, pod)
+			}
+		}
+		m.completedPodsVersion = m.dataVersion
+	}
+	return m.completedPods
+}
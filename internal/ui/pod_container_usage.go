@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// containerShare is the CPU and memory usage of a container as a share of
+// the usage of all containers of its pod
+type containerShare struct {
+	name          string
+	cpu, memory   int64
+	cpuPercent    float64
+	memoryPercent float64
+}
+
+// containerUsageShares returns the usage shares of the containers of a pod,
+// highest CPU first (then highest memory), or nil unless at least two
+// containers report usage
+func containerUsageShares(pod *model.PodData) []containerShare {
+	var totalCPU, totalMemory int64
+	reporting := 0
+	for _, container := range pod.ContainerStates {
+		totalCPU += container.CPUUsage
+		totalMemory += container.MemoryUsage
+		if container.CPUUsage > 0 || container.MemoryUsage > 0 {
+			reporting++
+		}
+	}
+	if reporting < 2 {
+		return nil
+	}
+
+	shares := make([]containerShare, len(pod.ContainerStates))
+	for i, container := range pod.ContainerStates {
+		shares[i] = containerShare{name: container.Name, cpu: container.CPUUsage, memory: container.MemoryUsage}
+		if totalCPU > 0 {
+			shares[i].cpuPercent = float64(container.CPUUsage) / float64(totalCPU) * 100
+		}
+		if totalMemory > 0 {
+			shares[i].memoryPercent = float64(container.MemoryUsage) / float64(totalMemory) * 100
+		}
+	}
+	sort.SliceStable(shares, func(i, j int) bool {
+		if shares[i].cpu != shares[j].cpu {
+			return shares[i].cpu > shares[j].cpu
+		}
+		return shares[i].memory > shares[j].memory
+	})
+	return shares
+}
+
+// renderContainerUsageShares renders the usage breakdown of a multi-container
+// pod, most CPU-hungry container first and highlighted as the likely culprit
+// of a hot pod
+func (m *Model) renderContainerUsageShares(shares []containerShare) []string {
+	const colName, colValue, barWidth = 24, 10, 10
+
+	lines := []string{
+		StyleTextSecondary.Render("  " + m.T("detail.pod.container_usage")),
+		StyleTextMuted.Render(fmt.Sprintf("      %s%s%s",
+			padRight(m.T("columns.name"), colName),
+			padRight(m.T("columns.cpu"), colValue+barWidth+10),
+			m.T("columns.memory"))),
+	}
+	for i, share := range shares {
+		marker, name := "  ", truncate(share.name, colName)
+		if i == 0 && share.cpu > 0 {
+			marker, name = StyleWarning.Render("▶ "), StyleWarning.Render(name)
+		}
+		lines = append(lines, fmt.Sprintf("    %s%s%s %s %5.1f%%  %s %5.1f%%",
+			marker,
+			padRight(name, colName),
+			padRight(FormatMillicores(share.cpu, m.rawUnits), colValue),
+			renderProgressBar(share.cpuPercent, barWidth),
+			share.cpuPercent,
+			padRight(formatMemoryBytes(share.memory, m.rawUnits), colValue),
+			share.memoryPercent))
+	}
+	return append(lines, "")
+}
@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yourusername/k8s-monitor/internal/model"
@@ -28,10 +29,28 @@ func (m *Model) renderPodDetail() string {
 	allLines = append(allLines, strings.Split(basicInfo, "\n")...)
 	allLines = append(allLines, "")
 
+	// Why a Pending pod has no node yet
+	if podAwaitsScheduling(pod) {
+		allLines = append(allLines, m.renderPodSchedulingInfo(pod)...)
+		allLines = append(allLines, "")
+	}
+
+	// Pod annotations
+	allLines = append(allLines, m.renderAnnotations(pod.Annotations)...)
+	allLines = append(allLines, "")
+
 	// Pod container info
 	containerInfo := m.renderPodContainerInfo(pod)
 	allLines = append(allLines, strings.Split(containerInfo, "\n")...)
 
+	// Events timeline
+	eventsLine := -1
+	if events := m.getPodEvents(pod); len(events) > 0 {
+		allLines = append(allLines, "")
+		eventsLine = len(allLines)
+		allLines = append(allLines, m.renderPodEventTimeline(events)...)
+	}
+
 	// Apply scroll offset
 	maxVisible := m.height - 8 // Reserve space for header/footer
 	if maxVisible < 1 {
@@ -41,10 +60,18 @@ func (m *Model) renderPodDetail() string {
 	totalLines := len(allLines)
 
 	// Clamp scroll offset to valid range (prevent scrolling beyond content)
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
 	}
+	// Pods opened from the list for their warnings start at the events
+	if m.scrollToPodEvents {
+		m.scrollToPodEvents = false
+		if eventsLine >= 0 {
+			m.detailScrollOffset = min(eventsLine, maxScroll)
+		}
+	}
 	detailScrollOffset := m.detailScrollOffset
 	if detailScrollOffset > maxScroll {
 		detailScrollOffset = maxScroll
@@ -105,16 +132,49 @@ func (m *Model) renderPodBasicInfo(pod *model.PodData) string {
 		StyleTextSecondary.Render(m.T("detail.field.status")),
 		RenderStatus(pod.Phase)))
 
-	if pod.Node != "" {
+	if pod.QOSClass != "" {
+		info = append(info, fmt.Sprintf("  %s: %s",
+			StyleTextSecondary.Render(m.T("detail.field.qos_class")),
+			renderQOSClass(pod.QOSClass)))
+	}
+
+	if pod.PodScheduled != "" {
+		scheduled := StyleStatusReady.Render(pod.PodScheduled)
+		if pod.PodScheduled != "True" {
+			scheduled = StyleStatusPending.Render(pod.PodScheduled)
+		}
+		info = append(info, fmt.Sprintf("  %s: %s",
+			StyleTextSecondary.Render(m.T("detail.field.scheduled")),
+			scheduled))
+	}
+
+	// Explain why a Pending pod is not running (scheduling vs. container issues)
+	if reason := podPendingReason(pod); reason != "" {
 		info = append(info, fmt.Sprintf("  %s: %s",
+			StyleTextSecondary.Render(m.T("detail.field.pending_reason")),
+			StyleWarning.Render(reason)))
+		if pod.SchedulingMessage != "" {
+			maxWidth := m.width - 6
+			if maxWidth < 40 {
+				maxWidth = 40
+			}
+			for _, line := range wrapLine(pod.SchedulingMessage, maxWidth, 0) {
+				info = append(info, StyleTextMuted.Render("    "+line))
+			}
+		}
+	}
+
+	if pod.Node != "" {
+		info = append(info, fmt.Sprintf("  %s: %s  %s",
 			StyleTextSecondary.Render(m.T("detail.field.node")),
-			pod.Node))
+			StyleHighlight.Render(pod.Node),
+			StyleTextMuted.Render("("+m.T("detail.pod.node_hint")+")")))
 	}
 
 	if pod.PodIP != "" {
 		info = append(info, fmt.Sprintf("  %s: %s",
 			StyleTextSecondary.Render(m.T("detail.field.pod_ip")),
-			pod.PodIP))
+			formatIPs(pod.PodIP, pod.PodIPs)))
 	}
 
 	if pod.HostIP != "" {
@@ -210,6 +270,16 @@ func (m *Model) renderPodContainerInfo(pod *model.PodData) string {
 		return strings.Join(info, "\n")
 	}
 
+	// Which container dominates the pod's usage
+	shares := containerUsageShares(pod)
+	dominant := ""
+	if len(shares) > 0 {
+		info = append(info, m.renderContainerUsageShares(shares)...)
+		if shares[0].cpu > 0 {
+			dominant = shares[0].name
+		}
+	}
+
 	for i, container := range pod.ContainerStates {
 		if i > 0 {
 			info = append(info, "")
@@ -225,17 +295,19 @@ func (m *Model) renderPodContainerInfo(pod *model.PodData) string {
 		} else {
 			containerHeader += " " + StyleStatusNotReady.Render("●")
 		}
+		if containerOOMRisk(&container) {
+			containerHeader += " " + StyleDanger.Render("⚠ "+m.T("detail.pod.oom_risk"))
+		}
+		if container.Name == dominant {
+			containerHeader += " " + StyleWarning.Render("▶ "+m.TF("detail.pod.top_container", map[string]interface{}{
+				"Percent": fmt.Sprintf("%.0f", shares[0].cpuPercent),
+			}))
+		}
 
 		info = append(info, containerHeader)
 
-		// Container image
-		image := container.Image
-		if len(image) > 70 {
-			image = image[:67] + "..."
-		}
-		info = append(info, fmt.Sprintf("      %s: %s",
-			StyleTextSecondary.Render(m.T("detail.pod.image")),
-			image))
+		// Container image, pull policy and running digest
+		info = append(info, m.renderContainerImage(pod, &container)...)
 
 		// Container status
 		info = append(info, fmt.Sprintf("      %s: %s (restarts: %d)",
@@ -260,77 +332,246 @@ func (m *Model) renderPodContainerInfo(pod *model.PodData) string {
 				msg))
 		}
 
-		// Resource usage (from kubelet metrics)
-		if container.CPUUsage > 0 || container.MemoryUsage > 0 {
-			info = append(info, fmt.Sprintf("      %s:",
-				StyleTextSecondary.Render("Usage")))
-
-			if container.CPUUsage > 0 {
-				cpuUsage := FormatMillicores(container.CPUUsage)
-				cpuLine := fmt.Sprintf("        CPU: %s", StyleWarning.Render(cpuUsage))
-				if container.CPULimit > 0 {
-					cpuPercent := float64(container.CPUUsage) / float64(container.CPULimit) * 100
-					cpuLine += fmt.Sprintf(" / %s (%s)",
-						FormatMillicores(container.CPULimit),
-						StyleHighlight.Render(fmt.Sprintf("%.1f%%", cpuPercent)))
-				} else if container.CPURequest > 0 {
-					cpuLine += fmt.Sprintf(" (req: %s)", FormatMillicores(container.CPURequest))
-				}
-				info = append(info, cpuLine)
-			}
+		// Exit code and signal of the current (if terminated) and previous instance
+		if container.State == "Terminated" {
+			info = append(info, fmt.Sprintf("      %s: %s",
+				StyleTextSecondary.Render(m.T("detail.pod.exit_code")),
+				renderExitCode(container.ExitCode, 0)))
+		}
+		if last := container.LastTermination; last != nil {
+			info = append(info, fmt.Sprintf("      %s: %s",
+				StyleTextSecondary.Render(m.T("detail.pod.last_terminated")),
+				m.formatContainerTermination(last)))
+		}
 
-			if container.MemoryUsage > 0 {
-				memUsage := FormatBytes(container.MemoryUsage)
-				memLine := fmt.Sprintf("        Mem: %s", StyleWarning.Render(memUsage))
-				if container.MemoryLimit > 0 {
-					memPercent := float64(container.MemoryUsage) / float64(container.MemoryLimit) * 100
-					memLine += fmt.Sprintf(" / %s (%s)",
-						FormatBytes(container.MemoryLimit),
-						StyleHighlight.Render(fmt.Sprintf("%.1f%%", memPercent)))
-				} else if container.MemoryRequest > 0 {
-					memLine += fmt.Sprintf(" (req: %s)", FormatBytes(container.MemoryRequest))
-				}
-				info = append(info, memLine)
-			}
+		// Readiness/liveness/startup probes and whether they pass
+		info = append(info, m.renderContainerProbes(&container)...)
+
+		// Per-container requests/limits vs actual usage
+		info = append(info, m.renderContainerResources(&container)...)
+
+		// Volume mounts and where their data comes from
+		info = append(info, m.renderContainerMounts(pod, &container)...)
+	}
+
+	// Most recent probe failure reported by the kubelet
+	if event := m.lastProbeFailure(pod); event != nil {
+		msg := event.Message
+		if len(msg) > 100 {
+			msg = msg[:97] + "..."
 		}
+		info = append(info, "")
+		info = append(info, fmt.Sprintf("  %s: %s",
+			StyleWarning.Render(m.TF("detail.pod.last_probe_failure", map[string]interface{}{
+				"Age": formatAge(time.Since(eventTime(event))),
+			})),
+			msg))
+	}
 
-		// Resource requests/limits (if no usage available)
-		if container.CPUUsage == 0 && container.MemoryUsage == 0 &&
-			(container.CPURequest > 0 || container.CPULimit > 0 ||
-				container.MemoryRequest > 0 || container.MemoryLimit > 0) {
-
-			info = append(info, fmt.Sprintf("      %s:",
-				StyleTextSecondary.Render("Resources")))
-
-			if container.CPURequest > 0 || container.CPULimit > 0 {
-				cpuLine := "        CPU:"
-				if container.CPURequest > 0 {
-					cpuLine += fmt.Sprintf(" req=%s", FormatMillicores(container.CPURequest))
-				}
-				if container.CPULimit > 0 {
-					if container.CPURequest > 0 {
-						cpuLine += ","
-					}
-					cpuLine += fmt.Sprintf(" limit=%s", FormatMillicores(container.CPULimit))
-				}
-				info = append(info, cpuLine)
-			}
+	return strings.Join(info, "\n")
+}
+
+// signalNames names the signals that commonly end containers
+var signalNames = map[int32]string{
+	1:  "SIGHUP",
+	2:  "SIGINT",
+	3:  "SIGQUIT",
+	6:  "SIGABRT",
+	7:  "SIGBUS",
+	8:  "SIGFPE",
+	9:  "SIGKILL",
+	11: "SIGSEGV",
+	15: "SIGTERM",
+}
 
-			if container.MemoryRequest > 0 || container.MemoryLimit > 0 {
-				memLine := "        Mem:"
-				if container.MemoryRequest > 0 {
-					memLine += fmt.Sprintf(" req=%s", FormatBytes(container.MemoryRequest))
-				}
-				if container.MemoryLimit > 0 {
-					if container.MemoryRequest > 0 {
-						memLine += ","
-					}
-					memLine += fmt.Sprintf(" limit=%s", FormatBytes(container.MemoryLimit))
-				}
-				info = append(info, memLine)
+// renderExitCode renders an exit code with the signal that caused it, e.g.
+// "137 (SIGKILL)". Exit codes above 128 mean the process was killed by signal
+// (code - 128).
+func renderExitCode(exitCode, signal int32) string {
+	text := fmt.Sprintf("%d", exitCode)
+	if signal == 0 && exitCode > 128 {
+		signal = exitCode - 128
+	}
+	if signal != 0 {
+		name, ok := signalNames[signal]
+		if !ok {
+			name = fmt.Sprintf("signal %d", signal)
+		}
+		text += " (" + name + ")"
+	}
+	if exitCode != 0 {
+		return StyleDanger.Render(text)
+	}
+	return StyleStatusReady.Render(text)
+}
+
+// formatContainerTermination renders how a container instance ended, e.g.
+// "exit 137 (SIGKILL) OOMKilled 2m ago"
+func (m *Model) formatContainerTermination(t *model.ContainerTermination) string {
+	parts := []string{"exit " + renderExitCode(t.ExitCode, t.Signal)}
+	if t.Reason != "" {
+		reason := t.Reason
+		if reason == "OOMKilled" {
+			reason = StyleDanger.Render(reason)
+		}
+		parts = append(parts, reason)
+	}
+	if !t.FinishedAt.IsZero() {
+		parts = append(parts, StyleTextMuted.Render(m.TF("detail.pod.terminated_ago", map[string]interface{}{
+			"Age": formatAge(time.Since(t.FinishedAt)),
+		})))
+		if !t.StartedAt.IsZero() && t.FinishedAt.After(t.StartedAt) {
+			parts = append(parts, StyleTextMuted.Render(m.TF("detail.pod.ran_for", map[string]interface{}{
+				"Duration": formatAge(t.FinishedAt.Sub(t.StartedAt)),
+			})))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// probeStatus reports whether a probe is currently passing based on the container status.
+// Liveness failures restart the container, so a running container is passing its liveness probe.
+func (m *Model) probeStatus(container *model.ContainerState, probe *model.ProbeInfo) string {
+	passing, failing, unknown := StyleStatusReady.Render("✓ "+m.T("detail.pod.probe_passing")),
+		StyleStatusNotReady.Render("✗ "+m.T("detail.pod.probe_failing")),
+		StyleTextMuted.Render("? "+m.T("detail.pod.probe_unknown"))
+
+	switch probe.Type {
+	case "Readiness":
+		if container.Ready {
+			return passing
+		}
+		if container.State == "Running" {
+			return failing
+		}
+	case "Startup":
+		if container.Started != nil {
+			if *container.Started {
+				return passing
 			}
+			if container.State == "Running" {
+				return failing
+			}
+		}
+	case "Liveness":
+		if container.State == "Running" {
+			return passing
 		}
 	}
+	return unknown
+}
+
+// renderContainerProbes renders the probe definitions of a container with their current status
+func (m *Model) renderContainerProbes(container *model.ContainerState) []string {
+	if len(container.Probes) == 0 {
+		return nil
+	}
 
-	return strings.Join(info, "\n")
+	lines := []string{fmt.Sprintf("      %s:", StyleTextSecondary.Render(m.T("detail.pod.probes")))}
+	for i := range container.Probes {
+		probe := &container.Probes[i]
+		lines = append(lines, fmt.Sprintf("        %s%s %s",
+			padRight(probe.Type, 11),
+			padRight(m.probeStatus(container, probe), 12),
+			probe.Handler))
+		lines = append(lines, StyleTextMuted.Render(fmt.Sprintf(
+			"                   delay=%ds timeout=%ds period=%ds #success=%d #failure=%d",
+			probe.InitialDelay, probe.Timeout, probe.Period, probe.SuccessThreshold, probe.FailureThreshold)))
+	}
+	return lines
+}
+
+// lastProbeFailure returns the most recent "Unhealthy" event of a pod, or nil
+func (m *Model) lastProbeFailure(pod *model.PodData) *model.EventData {
+	if m.clusterData == nil {
+		return nil
+	}
+
+	var latest *model.EventData
+	for _, event := range m.clusterData.Events {
+		if event.Reason != "Unhealthy" || event.InvolvedKind != "Pod" ||
+			event.InvolvedName != pod.Name || event.InvolvedNamespace != pod.Namespace {
+			continue
+		}
+		if latest == nil || eventTime(event).After(eventTime(latest)) {
+			latest = event
+		}
+	}
+	return latest
+}
+
+// containerOOMRiskPercent is the memory usage/limit ratio above which a container is flagged
+const containerOOMRiskPercent = 90.0
+
+// containerOOMRisk reports whether a container uses more than containerOOMRiskPercent of its memory limit
+func containerOOMRisk(container *model.ContainerState) bool {
+	return container.MemoryLimit > 0 &&
+		float64(container.MemoryUsage)/float64(container.MemoryLimit)*100 > containerOOMRiskPercent
+}
+
+// renderContainerResources renders a table of CPU/memory request, limit and usage
+// with a usage-vs-limit bar for a single container
+func (m *Model) renderContainerResources(container *model.ContainerState) []string {
+	hasResources := container.CPURequest > 0 || container.CPULimit > 0 ||
+		container.MemoryRequest > 0 || container.MemoryLimit > 0
+	if !hasResources && container.CPUUsage == 0 && container.MemoryUsage == 0 {
+		return nil
+	}
+
+	const colLabel, colValue, barWidth = 8, 11, 10
+
+	lines := []string{
+		fmt.Sprintf("      %s:", StyleTextSecondary.Render(m.T("detail.pod.resources"))),
+		StyleTextMuted.Render(fmt.Sprintf("        %s%s%s%s%s",
+			padRight("", colLabel),
+			padRight(m.T("columns.request"), colValue),
+			padRight(m.T("columns.limit"), colValue),
+			padRight(m.T("columns.usage"), colValue),
+			m.T("detail.pod.usage_vs_limit"))),
+	}
+
+	// formatValue renders a resource amount, "-" when unset
+	formatValue := func(value int64, format func(int64, bool) string) string {
+		if value <= 0 {
+			return StyleTextMuted.Render("-")
+		}
+		return format(value, m.rawUnits)
+	}
+
+	// usageBar renders usage as a percentage of limit, or a hint when there is no limit
+	usageBar := func(usage, limit int64) (string, float64) {
+		if limit <= 0 {
+			return StyleTextMuted.Render(m.T("detail.pod.no_limit")), 0
+		}
+		if usage <= 0 {
+			return StyleTextMuted.Render("-"), 0
+		}
+		percent := float64(usage) / float64(limit) * 100
+		return fmt.Sprintf("%s %.1f%%", renderProgressBar(percent, barWidth), percent), percent
+	}
+
+	// CPU row
+	cpuBar, _ := usageBar(container.CPUUsage, container.CPULimit)
+	lines = append(lines, fmt.Sprintf("        %s%s%s%s%s",
+		padRight("CPU", colLabel),
+		padRight(formatValue(container.CPURequest, FormatMillicores), colValue),
+		padRight(formatValue(container.CPULimit, FormatMillicores), colValue),
+		padRight(formatValue(container.CPUUsage, FormatMillicores), colValue),
+		cpuBar))
+
+	// Memory row; containers close to their limit are OOM-kill candidates
+	memBar, _ := usageBar(container.MemoryUsage, container.MemoryLimit)
+	memUsage := formatValue(container.MemoryUsage, formatMemoryBytes)
+	if containerOOMRisk(container) {
+		memUsage = StyleDanger.Render(formatMemoryBytes(container.MemoryUsage, m.rawUnits))
+		memBar += " " + StyleDanger.Render("⚠ "+m.T("detail.pod.oom_risk"))
+	}
+	lines = append(lines, fmt.Sprintf("        %s%s%s%s%s",
+		padRight("Memory", colLabel),
+		padRight(formatValue(container.MemoryRequest, formatMemoryBytes), colValue),
+		padRight(formatValue(container.MemoryLimit, formatMemoryBytes), colValue),
+		padRight(memUsage, colValue),
+		memBar))
+
+	return lines
 }
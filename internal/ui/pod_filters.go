@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// podFilterField identifies the list shown in the Pods view filter panel
+type podFilterField int
+
+const (
+	podFilterNamespace podFilterField = iota
+	podFilterNode
+	podFilterFieldCount
+)
+
+// openPodFilter opens the Pods filter panel on the namespace list, or moves on
+// to the node list when already open (closing it after the last list)
+func (m *Model) openPodFilter() {
+	if !m.filterMode {
+		m.filterMode = true
+		m.podFilterField = podFilterNamespace
+		return
+	}
+	m.podFilterField++
+	if m.podFilterField >= podFilterFieldCount {
+		m.filterMode = false
+		m.podFilterField = podFilterNamespace
+	}
+}
+
+// getFilterNodeNames returns the sorted names of the cluster's nodes
+func (m *Model) getFilterNodeNames() []string {
+	if m.clusterData == nil {
+		return []string{}
+	}
+
+	names := make([]string, 0, len(m.clusterData.Nodes))
+	for _, node := range m.clusterData.Nodes {
+		names = append(names, node.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// handleNodeFilterNavigation moves the node filter selection ("" = all nodes)
+func (m *Model) handleNodeFilterNavigation(direction int) {
+	options := append([]string{""}, m.getFilterNodeNames()...)
+
+	idx := 0
+	for i, name := range options {
+		if name == m.filterNode {
+			idx = i
+		}
+	}
+	idx += direction
+	if idx < 0 {
+		idx = len(options) - 1
+	} else if idx >= len(options) {
+		idx = 0
+	}
+	m.filterNode = options[idx]
+
+	// Reset scroll and selection when filter changes
+	m.scrollOffset = 0
+	m.selectedIndex = 0
+}
+
+// renderNodeFilterPanel renders the node list of the Pods filter panel
+func (m *Model) renderNodeFilterPanel() string {
+	var lines []string
+
+	lines = append(lines, StyleHeader.Render(m.T("filter.node_title")))
+	lines = append(lines, "")
+
+	nodes := m.getFilterNodeNames()
+	if len(nodes) == 0 {
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("filter.no_nodes")))
+		return strings.Join(lines, "\n")
+	}
+
+	// Show "All" option
+	allOption := "  " + m.T("filter.all_nodes")
+	if m.filterNode == "" {
+		allOption = StyleSelected.Render(allOption)
+	}
+	lines = append(lines, allOption)
+
+	for _, name := range nodes {
+		option := fmt.Sprintf("  %s", name)
+		if m.filterNode == name {
+			option = StyleSelected.Render(option)
+		}
+		lines = append(lines, option)
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, StyleTextMuted.Render("  "+m.T("filter.pods_hint")))
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,162 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// podGroup aggregates pods that belong to the same workload
+type podGroup struct {
+	key       string // namespace/workload, unique across namespaces
+	name      string // workload name (owner or generated-name prefix)
+	namespace string
+	pods      []*model.PodData
+
+	running  int
+	restarts int32
+	cpu      int64 // millicores
+	memory   int64 // bytes
+}
+
+// podListRow is a single selectable row in the grouped pods list:
+// either a group header or a pod inside an expanded group
+type podListRow struct {
+	group *podGroup
+	pod   *model.PodData
+}
+
+// podWorkloadName returns the workload a pod belongs to, derived from its
+// controller owner or generated-name prefix (e.g. "web-5f6c7d8b9-abcde" -> "web")
+func podWorkloadName(pod *model.PodData) string {
+	name := pod.OwnerName
+	if name == "" {
+		name = strings.TrimSuffix(pod.GenerateName, "-")
+	}
+	if name == "" {
+		return pod.Name
+	}
+
+	// Deployment pods are owned by a ReplicaSet named <deployment>-<pod-template-hash>
+	if hash := pod.Labels["pod-template-hash"]; hash != "" {
+		name = strings.TrimSuffix(name, "-"+hash)
+	}
+
+	return name
+}
+
+// buildPodGroups groups already sorted/filtered pods by workload.
+// Groups keep the order in which they first appear so the active sort still applies.
+func buildPodGroups(pods []*model.PodData) []*podGroup {
+	var groups []*podGroup
+	byKey := make(map[string]*podGroup)
+
+	for _, pod := range pods {
+		name := podWorkloadName(pod)
+		key := pod.Namespace + "/" + name
+
+		group, exists := byKey[key]
+		if !exists {
+			group = &podGroup{key: key, name: name, namespace: pod.Namespace}
+			byKey[key] = group
+			groups = append(groups, group)
+		}
+
+		group.pods = append(group.pods, pod)
+		group.restarts += pod.RestartCount
+		group.cpu += pod.CPUUsage
+		group.memory += pod.MemoryUsage
+		if pod.Phase == "Running" {
+			group.running++
+		}
+	}
+
+	return groups
+}
+
+// buildPodRows flattens groups into selectable rows, including pods of expanded groups
+func (m *Model) buildPodRows(pods []*model.PodData) []podListRow {
+	groups := buildPodGroups(pods)
+	rows := make([]podListRow, 0, len(groups))
+
+	for _, group := range groups {
+		rows = append(rows, podListRow{group: group})
+		if m.expandedPodGroups[group.key] {
+			for _, pod := range group.pods {
+				rows = append(rows, podListRow{pod: pod})
+			}
+		}
+	}
+
+	return rows
+}
+
+// togglePodGroup expands or collapses a pod group
+func (m *Model) togglePodGroup(key string) {
+	m.expandedPodGroups[key] = !m.expandedPodGroups[key]
+	m.cachedPodRows = m.buildPodRows(m.cachedSortedPods)
+}
+
+// renderPodGroupRow renders a group header row with aggregate metrics
+func (m *Model) renderPodGroupRow(group *podGroup, columns []listColumn) string {
+	return renderColumns(columns, func(column listColumn) string {
+		return m.renderPodGroupCell(group, column)
+	})
+}
+
+// renderPodGroupCell renders the aggregate cell of a pod group in the given
+// column; columns without a meaningful aggregate are left empty
+func (m *Model) renderPodGroupCell(group *podGroup, column listColumn) string {
+	switch column.id {
+	case "name":
+		// Expand/collapse marker with member count
+		marker := "▸"
+		if m.expandedPodGroups[group.key] {
+			marker = "▾"
+		}
+		return StyleSubHeader.Render(truncate(fmt.Sprintf("%s %s (%d)", marker, group.name, len(group.pods)), column.width))
+
+	case "namespace":
+		return m.renderNamespaceName(group.namespace, column.width)
+
+	case "status":
+		// Running/total with color
+		status := fmt.Sprintf("%d/%d %s", group.running, len(group.pods), m.T("status.running"))
+		if group.running == len(group.pods) {
+			return StyleStatusRunning.Render(status)
+		}
+		return StyleStatusPending.Render(status)
+
+	case "cpu":
+		if group.cpu > 0 {
+			return FormatMillicores(group.cpu, m.rawUnits)
+		}
+		return "-"
+
+	case "memory":
+		if group.memory > 0 {
+			return formatMemoryBytes(group.memory, m.rawUnits)
+		}
+		return "-"
+
+	case "rx", "tx":
+		// Aggregate network rates of member pods
+		var rate float64
+		for _, pod := range group.pods {
+			if column.id == "rx" {
+				rate += m.calculatePodNetworkRxRate(pod.Namespace, pod.Name)
+			} else {
+				rate += m.calculatePodNetworkTxRate(pod.Namespace, pod.Name)
+			}
+		}
+		if rate == 0 {
+			return StyleTextMuted.Render("-")
+		}
+		return formatNetworkRate(rate)
+
+	case "restarts":
+		return fmt.Sprintf("%d", group.restarts)
+	}
+	return ""
+}
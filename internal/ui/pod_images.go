@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// imageDigest returns the digest of an image reference or image ID, e.g.
+// "sha256:..." from "nginx@sha256:...", or "" when it is not pinned
+func imageDigest(ref string) string {
+	if i := strings.LastIndex(ref, "@"); i >= 0 {
+		return ref[i+1:]
+	}
+	return ""
+}
+
+// normalizeImage expands an image reference the way the runtime reports it,
+// so "nginx" and "docker.io/library/nginx:latest" compare equal
+func normalizeImage(ref string) string {
+	ref = strings.TrimPrefix(ref, "docker.io/")
+	ref = strings.TrimPrefix(ref, "library/")
+	if imageDigest(ref) == "" && imageTag(ref) == "" {
+		ref += ":latest"
+	}
+	return ref
+}
+
+// imageTag returns the tag of an image reference, or "" when it has none
+func imageTag(ref string) string {
+	if i := strings.LastIndex(ref, "@"); i >= 0 {
+		ref = ref[:i]
+	}
+	name := ref[strings.LastIndex(ref, "/")+1:]
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return ""
+}
+
+// shortDigest shortens a digest to its first 12 hex characters, like docker
+func shortDigest(digest string) string {
+	algorithm, hex, found := strings.Cut(digest, ":")
+	if !found || len(hex) <= 12 {
+		return digest
+	}
+	return algorithm + ":" + hex[:12]
+}
+
+// imageMutableTagRisk reports whether a container uses the mutable latest tag
+// with a pull policy that keeps whatever image a node cached, so pods can run
+// different versions on different nodes
+func imageMutableTagRisk(container *model.ContainerState) bool {
+	if container.SpecImage == "" || imageDigest(container.SpecImage) != "" {
+		return false
+	}
+	tag := imageTag(container.SpecImage)
+	return (tag == "" || tag == "latest") &&
+		(container.ImagePullPolicy == "IfNotPresent" || container.ImagePullPolicy == "Never")
+}
+
+// imageSpecMismatch returns the running image when it differs from the pod
+// spec: another digest than the pinned one, or an image edited in the spec
+// whose container has not restarted yet. It returns "" when they match.
+func imageSpecMismatch(container *model.ContainerState) string {
+	if container.SpecImage == "" {
+		return ""
+	}
+	if want := imageDigest(container.SpecImage); want != "" {
+		if running := imageDigest(container.ImageID); running != "" && running != want {
+			return shortDigest(running)
+		}
+		return ""
+	}
+	// Some runtimes report the image ID instead of the reference
+	if container.Image == "" || strings.HasPrefix(container.Image, "sha256:") || imageDigest(container.Image) != "" {
+		return ""
+	}
+	if normalizeImage(container.Image) != normalizeImage(container.SpecImage) {
+		return container.Image
+	}
+	return ""
+}
+
+// imageDigestDrift returns the number of other pods running the same tagged
+// image under another digest, which points at nodes with stale cached images
+func (m *Model) imageDigestDrift(pod *model.PodData, container *model.ContainerState) int {
+	digest := imageDigest(container.ImageID)
+	if m.clusterData == nil || digest == "" || imageDigest(container.SpecImage) != "" {
+		return 0
+	}
+
+	image := normalizeImage(container.SpecImage)
+	drift := 0
+	for _, other := range m.clusterData.Pods {
+		if other == pod || (other.Name == pod.Name && other.Namespace == pod.Namespace) {
+			continue
+		}
+		for i := range other.ContainerStates {
+			c := &other.ContainerStates[i]
+			if otherDigest := imageDigest(c.ImageID); otherDigest != "" && otherDigest != digest &&
+				c.SpecImage != "" && normalizeImage(c.SpecImage) == image {
+				drift++
+				break
+			}
+		}
+	}
+	return drift
+}
+
+// renderContainerImage renders the image, pull policy and running digest of a
+// container with warnings for risky or stale images
+func (m *Model) renderContainerImage(pod *model.PodData, container *model.ContainerState) []string {
+	image := container.SpecImage
+	if image == "" {
+		image = container.Image
+	}
+	if len(image) > 70 {
+		image = image[:67] + "..."
+	}
+	if container.ImagePullPolicy != "" {
+		image += StyleTextMuted.Render(" (" + container.ImagePullPolicy + ")")
+	}
+	lines := []string{fmt.Sprintf("      %s: %s",
+		StyleTextSecondary.Render(m.T("detail.pod.image")),
+		image)}
+
+	if digest := imageDigest(container.ImageID); digest != "" {
+		lines = append(lines, fmt.Sprintf("      %s: %s",
+			StyleTextSecondary.Render(m.T("detail.pod.image_id")),
+			shortDigest(digest)))
+	}
+
+	if imageMutableTagRisk(container) {
+		lines = append(lines, "      "+StyleWarning.Render("⚠ "+m.TF("detail.pod.image_mutable_tag", map[string]interface{}{
+			"Policy": container.ImagePullPolicy,
+		})))
+	}
+	if running := imageSpecMismatch(container); running != "" {
+		lines = append(lines, "      "+StyleWarning.Render("⚠ "+m.TF("detail.pod.image_spec_mismatch", map[string]interface{}{
+			"Running": running,
+		})))
+	}
+	if drift := m.imageDigestDrift(pod, container); drift > 0 {
+		lines = append(lines, "      "+StyleWarning.Render("⚠ "+m.TF("detail.pod.image_digest_drift", map[string]interface{}{
+			"Count": drift,
+		})))
+	}
+	return lines
+}
@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+)
+
+// podListCacheKey captures every input of the filtered and sorted pod list.
+// The cached list is reused for as long as the key is unchanged, so scrolling
+// and selection changes never re-filter or re-sort the pods.
+type podListCacheKey struct {
+	dataVersion     uint64
+	filterNamespace string
+	filterNode      string
+	filterStatus    string
+	searchText      string
+	fuzzySearch     bool
+	sortField       SortField
+	sortOrder       SortOrder
+	hideCompleted   bool
+}
+
+// podListStats holds the per-list aggregates shown in the Pods header and footer
+type podListStats struct {
+	running int
+	pending int
+	failed  int
+	groups  int // Number of workload groups (grouped mode only)
+	hidden  int // Completed pods left out of the list
+}
+
+// currentPodListKey returns the cache key for the current data, filters and sort
+func (m *Model) currentPodListKey() podListCacheKey {
+	return podListCacheKey{
+		dataVersion:     m.dataVersion,
+		filterNamespace: m.filterNamespace,
+		filterNode:      m.filterNode,
+		filterStatus:    m.filterStatus,
+		searchText:      m.searchText,
+		fuzzySearch:     m.fuzzySearch,
+		sortField:       m.sortField,
+		sortOrder:       m.sortOrder,
+		hideCompleted:   m.hideCompletedPods,
+	}
+}
+
+// refreshPodListCache rebuilds cachedSortedPods (and cachedPodRows in grouped
+// mode) only when its inputs changed. Per-frame work is then bounded by the
+// visible window rather than the total number of pods.
+func (m *Model) refreshPodListCache() {
+	key := m.currentPodListKey()
+	if m.cachedSortedPods != nil && key == m.cachedPodListKey {
+		if m.podGroupingEnabled && m.cachedPodRows == nil {
+			m.rebuildPodRows()
+		}
+		return
+	}
+
+	start := time.Now()
+	if narrowed, ok := m.narrowPodSearch(key); ok {
+		// Filtering a sorted list keeps it sorted, so no re-sort is needed
+		m.cachedSortedPods = narrowed
+	} else {
+		m.cachedSortedPods = m.getSortedPods(m.getFilteredPods())
+	}
+	m.cachedPodListKey = key
+
+	m.podListStats = podListStats{hidden: m.countHiddenPods()}
+	for _, pod := range m.cachedSortedPods {
+		switch pod.Phase {
+		case "Running":
+			m.podListStats.running++
+		case "Pending":
+			m.podListStats.pending++
+		case "Failed":
+			m.podListStats.failed++
+		}
+	}
+
+	m.cachedPodRows = nil
+	if m.podGroupingEnabled {
+		m.rebuildPodRows()
+	}
+
+	m.logger.Debug("Rebuilt pod list",
+		zap.Int("pods", len(m.cachedSortedPods)),
+		zap.Duration("duration", time.Since(start)))
+}
+
+// rebuildPodRows regroups the cached pods into the rows of the grouped view
+func (m *Model) rebuildPodRows() {
+	m.cachedPodRows = m.buildPodRows(m.cachedSortedPods)
+	groups := 0
+	for _, row := range m.cachedPodRows {
+		if row.group != nil {
+			groups++
+		}
+	}
+	m.podListStats.groups = groups
+}
+
+// narrowPodSearch handles typing in substring search mode: when the only
+// change is text appended to the search, the new matches are a subset of the
+// cached list and can be filtered from it without touching all pods.
+func (m *Model) narrowPodSearch(key podListCacheKey) ([]*model.PodData, bool) {
+	prev := m.cachedPodListKey
+	if m.cachedSortedPods == nil || key.fuzzySearch || prev.fuzzySearch ||
+		prev.searchText == key.searchText || !strings.HasPrefix(key.searchText, prev.searchText) {
+		return nil, false
+	}
+	prev.searchText = key.searchText
+	if prev != key {
+		return nil, false
+	}
+
+	narrowed := make([]*model.PodData, 0, len(m.cachedSortedPods))
+	for _, pod := range m.cachedSortedPods {
+		if _, ok := m.searchMatch(pod.Name); ok {
+			narrowed = append(narrowed, pod)
+		}
+	}
+	return narrowed, true
+}
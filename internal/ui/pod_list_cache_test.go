@@ -0,0 +1,153 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+	"go.uber.org/zap"
+)
+
+// newPodListTestModel returns a model showing the given pods as its first data
+func newPodListTestModel(pods ...*model.PodData) *Model {
+	m := NewModel(nil, zap.NewNop(), 2*time.Second, "en", "test", 100)
+	m.currentView = ViewPods
+	m.setPodListData(pods...)
+	return m
+}
+
+// setPodListData replaces the cluster data the way a data refresh does
+func (m *Model) setPodListData(pods ...*model.PodData) {
+	m.clusterData = &model.ClusterData{Pods: pods}
+	m.dataVersion++
+}
+
+func TestPodListHiddenCount(t *testing.T) {
+	m := newPodListTestModel(
+		&model.PodData{Namespace: "batch", Name: "job-a", Phase: "Succeeded"},
+		&model.PodData{Namespace: "batch", Name: "job-b", Phase: "Succeeded"},
+		&model.PodData{Namespace: "web", Name: "migrate", Phase: "Succeeded"},
+		&model.PodData{Namespace: "web", Name: "api", Phase: "Running"},
+	)
+	m.SetHideCompletedPods(true, 0)
+
+	m.refreshPodListCache()
+	if m.podListStats.hidden != 3 {
+		t.Errorf("hidden = %d, want 3", m.podListStats.hidden)
+	}
+
+	// Filters still apply to the hidden pods
+	m.filterNamespace = "batch"
+	m.refreshPodListCache()
+	if m.podListStats.hidden != 2 {
+		t.Errorf("hidden in namespace batch = %d, want 2", m.podListStats.hidden)
+	}
+
+	m.setPodListData(&model.PodData{Namespace: "batch", Name: "job-c", Phase: "Succeeded"})
+	m.refreshPodListCache()
+	if m.podListStats.hidden != 1 {
+		t.Errorf("hidden after refresh = %d, want 1", m.podListStats.hidden)
+	}
+
+	m.hideCompletedPods = false
+	m.refreshPodListCache()
+	if m.podListStats.hidden != 0 || len(m.cachedSortedPods) != 1 {
+		t.Errorf("hidden = %d, pods = %d with completed pods shown, want 0 and 1",
+			m.podListStats.hidden, len(m.cachedSortedPods))
+	}
+}
+
+func podNames(pods []*model.PodData) []string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func testPodListPods() []*model.PodData {
+	return []*model.PodData{
+		{Namespace: "web", Name: "API-server-0", Node: "node-1", Phase: "Running", RestartCount: 4},
+		{Namespace: "web", Name: "api-server-1", Node: "node-2", Phase: "Running", RestartCount: 1},
+		{Namespace: "web", Name: "apex-gateway", Node: "node-1", Phase: "Pending", RestartCount: 7},
+		{Namespace: "shop", Name: "cart-api", Node: "node-2", Phase: "Running", RestartCount: 2},
+		{Namespace: "shop", Name: "checkout", Node: "node-1", Phase: "Failed", RestartCount: 9},
+	}
+}
+
+func TestPodListNarrowedSearchMatchesRebuild(t *testing.T) {
+	m := newPodListTestModel(testPodListPods()...)
+	m.sortField, m.sortOrder = SortByRestarts, SortDesc
+
+	m.searchText = "ap"
+	m.refreshPodListCache()
+	m.searchText = "api"
+	narrowed, ok := m.narrowPodSearch(m.currentPodListKey())
+	if !ok {
+		t.Fatal("narrowPodSearch() ok = false when text was appended to the search")
+	}
+
+	rebuilt := newPodListTestModel(testPodListPods()...)
+	rebuilt.sortField, rebuilt.sortOrder = SortByRestarts, SortDesc
+	rebuilt.searchText = "api"
+	want := rebuilt.getSortedPods(rebuilt.getFilteredPods())
+	if !reflect.DeepEqual(podNames(narrowed), podNames(want)) {
+		t.Errorf("narrowed pods = %v, want %v as rebuilt", podNames(narrowed), podNames(want))
+	}
+
+	m.refreshPodListCache()
+	if !reflect.DeepEqual(podNames(m.cachedSortedPods), podNames(want)) {
+		t.Errorf("cached pods = %v, want %v", podNames(m.cachedSortedPods), podNames(want))
+	}
+
+	// Removing text or switching to fuzzy search needs a full rebuild
+	m.searchText = "a"
+	if _, ok := m.narrowPodSearch(m.currentPodListKey()); ok {
+		t.Error("narrowPodSearch() ok = true after search text was removed")
+	}
+	m.searchText, m.fuzzySearch = "apis", true
+	if _, ok := m.narrowPodSearch(m.currentPodListKey()); ok {
+		t.Error("narrowPodSearch() ok = true in fuzzy search")
+	}
+}
+
+func TestPodListCacheInvalidation(t *testing.T) {
+	m := newPodListTestModel(testPodListPods()...)
+	m.refreshPodListCache()
+	cached := m.cachedSortedPods
+
+	m.selectedIndex, m.scrollOffset = 2, 1
+	m.refreshPodListCache()
+	if &m.cachedSortedPods[0] != &cached[0] {
+		t.Error("pod list rebuilt although its inputs did not change")
+	}
+
+	check := func(change string, want []string) {
+		t.Helper()
+		m.refreshPodListCache()
+		if got := podNames(m.cachedSortedPods); !reflect.DeepEqual(got, want) {
+			t.Errorf("pods after %s = %v, want %v", change, got, want)
+		}
+	}
+
+	m.setPodListData(testPodListPods()[3:]...)
+	check("data refresh", []string{"cart-api", "checkout"})
+
+	m.setPodListData(testPodListPods()...)
+	m.filterNamespace = "web"
+	check("namespace filter", []string{"API-server-0", "apex-gateway", "api-server-1"})
+
+	m.filterNode = "node-1"
+	check("node filter", []string{"API-server-0", "apex-gateway"})
+
+	m.filterNode, m.filterStatus = "", "Pending"
+	check("status filter", []string{"apex-gateway"})
+
+	m.filterStatus = ""
+	m.sortField, m.sortOrder = SortByRestarts, SortDesc
+	check("sort field", []string{"apex-gateway", "API-server-0", "api-server-1"})
+
+	m.sortOrder = SortAsc
+	check("sort order", []string{"api-server-1", "API-server-0", "apex-gateway"})
+}
@@ -0,0 +1,236 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// defaultTolerationKeys are tolerated by every pod through the
+// DefaultTolerationSeconds admission plugin, so they are not listed
+var defaultTolerationKeys = map[string]bool{
+	"node.kubernetes.io/not-ready":   true,
+	"node.kubernetes.io/unreachable": true,
+}
+
+// podAwaitsScheduling reports whether a pod is Pending without a node yet
+func podAwaitsScheduling(pod *model.PodData) bool {
+	return pod.Phase == "Pending" && pod.Node == ""
+}
+
+// latestFailedScheduling returns the newest FailedScheduling event of a pod
+func (m *Model) latestFailedScheduling(pod *model.PodData) *model.EventData {
+	for _, event := range m.getPodEvents(pod) {
+		if event.Reason == "FailedScheduling" {
+			return event
+		}
+	}
+	return nil
+}
+
+// nodeSelectorRequirementMatches reports whether a value set (a node label,
+// or the node name for match fields) satisfies a node selector requirement
+func nodeSelectorRequirementMatches(req corev1.NodeSelectorRequirement, value string, exists bool) bool {
+	switch req.Operator {
+	case corev1.NodeSelectorOpIn, corev1.NodeSelectorOpNotIn:
+		found := false
+		for _, v := range req.Values {
+			if exists && v == value {
+				found = true
+				break
+			}
+		}
+		return found == (req.Operator == corev1.NodeSelectorOpIn)
+	case corev1.NodeSelectorOpExists:
+		return exists
+	case corev1.NodeSelectorOpDoesNotExist:
+		return !exists
+	case corev1.NodeSelectorOpGt, corev1.NodeSelectorOpLt:
+		if !exists || len(req.Values) != 1 {
+			return false
+		}
+		got, err1 := strconv.ParseInt(value, 10, 64)
+		want, err2 := strconv.ParseInt(req.Values[0], 10, 64)
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		if req.Operator == corev1.NodeSelectorOpGt {
+			return got > want
+		}
+		return got < want
+	}
+	return false
+}
+
+// nodeSelectorTermMatches reports whether a node satisfies every requirement
+// of a node selector term
+func nodeSelectorTermMatches(term corev1.NodeSelectorTerm, node *model.NodeData) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		return false // An empty term matches no nodes
+	}
+	for _, req := range term.MatchExpressions {
+		value, exists := node.Labels[req.Key]
+		if !nodeSelectorRequirementMatches(req, value, exists) {
+			return false
+		}
+	}
+	for _, req := range term.MatchFields {
+		if req.Key != "metadata.name" || !nodeSelectorRequirementMatches(req, node.Name, true) {
+			return false
+		}
+	}
+	return true
+}
+
+// podNodeAffinityMatches reports whether a node satisfies the node selector
+// and required node affinity of a pod
+func podNodeAffinityMatches(pod *model.PodData, node *model.NodeData) bool {
+	for k, v := range pod.NodeSelector {
+		if node.Labels[k] != v {
+			return false
+		}
+	}
+	if pod.NodeAffinity == nil {
+		return true
+	}
+	for _, term := range pod.NodeAffinity.NodeSelectorTerms {
+		if nodeSelectorTermMatches(term, node) {
+			return true
+		}
+	}
+	return false
+}
+
+// podPlacementCounts returns how many schedulable (not cordoned) nodes match
+// the node selector and affinity of a pod, and how many of those it tolerates
+func (m *Model) podPlacementCounts(pod *model.PodData) (total, matching, tolerated int) {
+	if m.clusterData == nil {
+		return 0, 0, 0
+	}
+	for _, node := range m.clusterData.Nodes {
+		if node.Unschedulable {
+			continue
+		}
+		total++
+		if !podNodeAffinityMatches(pod, node) {
+			continue
+		}
+		matching++
+		if toleratesNodeTaints(pod.Tolerations, node) {
+			tolerated++
+		}
+	}
+	return total, matching, tolerated
+}
+
+// formatNodeSelectorTerm renders a node selector term like kubectl describe,
+// e.g. "topology.kubernetes.io/zone in [a b], gpu exists"
+func formatNodeSelectorTerm(term corev1.NodeSelectorTerm) string {
+	var parts []string
+	for _, reqs := range [][]corev1.NodeSelectorRequirement{term.MatchExpressions, term.MatchFields} {
+		for _, req := range reqs {
+			part := req.Key + " " + strings.ToLower(string(req.Operator))
+			if len(req.Values) > 0 {
+				part += " [" + strings.Join(req.Values, " ") + "]"
+			}
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatToleration renders a toleration like kubectl describe, e.g.
+// "gpu=true:NoSchedule" or "dedicated:NoExecute for 300s"
+func formatToleration(t corev1.Toleration) string {
+	s := t.Key
+	if t.Key == "" && t.Operator == corev1.TolerationOpExists {
+		s = "*"
+	}
+	if t.Value != "" {
+		s += "=" + t.Value
+	}
+	if t.Effect != "" {
+		s += ":" + string(t.Effect)
+	}
+	if t.TolerationSeconds != nil {
+		s += fmt.Sprintf(" for %ds", *t.TolerationSeconds)
+	}
+	return s
+}
+
+// renderPodSchedulingInfo renders why a Pending pod has no node yet: its
+// scheduling gates, the latest FailedScheduling event and the node selector,
+// affinity and tolerations that limit where it can run
+func (m *Model) renderPodSchedulingInfo(pod *model.PodData) []string {
+	lines := []string{StyleHeader.Render(m.T("detail.pod.scheduling")), ""}
+	field := func(label, value string) {
+		lines = append(lines, fmt.Sprintf("  %s: %s", StyleTextSecondary.Render(m.T(label)), value))
+	}
+	maxWidth := m.width - 6
+	if maxWidth < 40 {
+		maxWidth = 40
+	}
+
+	if len(pod.SchedulingGates) > 0 {
+		field("detail.pod.scheduling_gates", StyleWarning.Render(strings.Join(pod.SchedulingGates, ", ")))
+		lines = append(lines, StyleTextMuted.Render("    "+m.T("detail.pod.scheduling_gates_hint")))
+	}
+
+	if event := m.latestFailedScheduling(pod); event != nil {
+		age := m.TF("detail.pod.failed_scheduling_age", map[string]interface{}{
+			"Age": formatAge(time.Since(eventTime(event))),
+		})
+		if event.Count > 1 {
+			age += fmt.Sprintf(" (x%d)", event.Count)
+		}
+		field("detail.pod.failed_scheduling", StyleTextMuted.Render(age))
+		for _, line := range wrapLine(event.Message, maxWidth, 0) {
+			lines = append(lines, StyleWarning.Render("    "+line))
+		}
+	}
+
+	if len(pod.NodeSelector) > 0 {
+		selector := make([]string, 0, len(pod.NodeSelector))
+		for k, v := range pod.NodeSelector {
+			selector = append(selector, k+"="+v)
+		}
+		sort.Strings(selector)
+		field("detail.pod.node_selector", strings.Join(selector, ", "))
+	}
+
+	if pod.NodeAffinity != nil && len(pod.NodeAffinity.NodeSelectorTerms) > 0 {
+		field("detail.pod.node_affinity", StyleTextMuted.Render(m.T("detail.pod.node_affinity_hint")))
+		for _, term := range pod.NodeAffinity.NodeSelectorTerms {
+			lines = append(lines, "    • "+truncate(formatNodeSelectorTerm(term), maxWidth-6))
+		}
+	}
+
+	var tolerations []string
+	for _, t := range pod.Tolerations {
+		if !defaultTolerationKeys[t.Key] {
+			tolerations = append(tolerations, formatToleration(t))
+		}
+	}
+	if len(tolerations) > 0 {
+		field("detail.pod.tolerations", strings.Join(tolerations, ", "))
+	}
+
+	if total, matching, tolerated := m.podPlacementCounts(pod); total > 0 {
+		summary := m.TF("detail.pod.placement", map[string]interface{}{
+			"Matching":  matching,
+			"Total":     total,
+			"Tolerated": tolerated,
+		})
+		if tolerated == 0 {
+			summary = StyleDanger.Render(summary)
+		}
+		field("detail.pod.candidate_nodes", summary)
+	}
+
+	return lines
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// podVolume returns the volume of a pod with the given name, nil if the pod
+// spec has none
+func podVolume(pod *model.PodData, name string) *model.PodVolume {
+	for i := range pod.Volumes {
+		if pod.Volumes[i].Name == name {
+			return &pod.Volumes[i]
+		}
+	}
+	return nil
+}
+
+// podClaims returns the PVCs backing the volumes of a pod, in spec order
+func podClaims(pod *model.PodData) []string {
+	var claims []string
+	for _, volume := range pod.Volumes {
+		if claim := volume.Claim(); claim != "" {
+			claims = append(claims, claim)
+		}
+	}
+	return claims
+}
+
+// renderContainerMounts renders the volume mounts of a container with the
+// source of each volume. hostPath mounts are flagged as they expose the
+// node's filesystem to the container.
+func (m *Model) renderContainerMounts(pod *model.PodData, container *model.ContainerState) []string {
+	if len(container.VolumeMounts) == 0 {
+		return nil
+	}
+
+	lines := []string{fmt.Sprintf("      %s:", StyleTextSecondary.Render(m.T("detail.pod.mounts")))}
+	for _, mount := range container.VolumeMounts {
+		path := mount.MountPath
+		if mount.SubPath != "" {
+			path += StyleTextMuted.Render(" (subPath " + mount.SubPath + ")")
+		}
+		line := fmt.Sprintf("        %s ← %s", path, m.formatVolumeSource(pod, mount.Name))
+		if mount.ReadOnly {
+			line += StyleTextMuted.Render(" (ro)")
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// formatVolumeSource formats the source of a pod volume for its mounts: the
+// claim of PVC-backed volumes with its usage, hostPath in red
+func (m *Model) formatVolumeSource(pod *model.PodData, name string) string {
+	volume := podVolume(pod, name)
+	if volume == nil {
+		return StyleTextMuted.Render(name)
+	}
+
+	switch {
+	case volume.Type == "HostPath":
+		return StyleDanger.Render("⚠ HostPath " + volume.Source)
+	case volume.Claim() != "":
+		source := "PVC " + StyleHighlight.Render(volume.Claim())
+		if usage, ok := pod.PVCUsage[volume.Claim()]; ok && usage.CapacityBytes > 0 {
+			source += fmt.Sprintf(" %.0f%%", usage.UsagePercent())
+		}
+		return source + StyleTextMuted.Render(" ("+m.T("detail.pod.pvc_hint")+")")
+	case volume.Source != "":
+		return volume.Type + " " + volume.Source
+	}
+	return volume.Type
+}
+
+// viewPodPVC opens the detail view of a claim mounted by a pod; Esc returns
+// to the pod
+func (m *Model) viewPodPVC(pod *model.PodData, claim string) tea.Cmd {
+	if m.clusterData != nil {
+		for _, pvc := range m.clusterData.PVCs {
+			if pvc.Namespace == pod.Namespace && pvc.Name == claim {
+				m.selectedPVC = pvc
+				m.currentView = ViewPVCDetail
+				m.detailMode = true
+				m.detailScrollOffset = 0
+				m.fromPodDetail = true
+				return nil
+			}
+		}
+	}
+
+	m.exportMessage = fmt.Sprintf("❌ PVC not found: %s/%s", pod.Namespace, claim)
+	return tea.Tick(time.Second*2, func(time.Time) tea.Msg {
+		return clearExportMessageMsg{}
+	})
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// podWarningKey identifies a pod in the warning count map
+func podWarningKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// podWarningCount returns the number of Warning events of a pod. The counts
+// of all pods are built once per data refresh, so list rendering does not
+// scan the events for every visible row.
+func (m *Model) podWarningCount(pod *model.PodData) int {
+	if m.clusterData == nil {
+		return 0
+	}
+	if m.podWarningCounts == nil || m.podWarningCountsVersion != m.dataVersion {
+		m.podWarningCounts = make(map[string]int)
+		for _, event := range m.clusterData.Events {
+			if event.Type == "Warning" && event.InvolvedKind == "Pod" {
+				m.podWarningCounts[podWarningKey(event.InvolvedNamespace, event.InvolvedName)]++
+			}
+		}
+		m.podWarningCountsVersion = m.dataVersion
+	}
+	return m.podWarningCounts[podWarningKey(pod.Namespace, pod.Name)]
+}
+
+// getPodEvents returns all events of a pod, newest first
+func (m *Model) getPodEvents(pod *model.PodData) []*model.EventData {
+	if m.clusterData == nil {
+		return nil
+	}
+
+	var events []*model.EventData
+	for _, event := range m.clusterData.Events {
+		if event.InvolvedKind == "Pod" && event.InvolvedName == pod.Name && event.InvolvedNamespace == pod.Namespace {
+			events = append(events, event)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(events[i]).After(eventTime(events[j]))
+	})
+	return events
+}
+
+// getPodWarningEvents returns the Warning events of a pod, newest first
+func (m *Model) getPodWarningEvents(pod *model.PodData) []*model.EventData {
+	var events []*model.EventData
+	for _, event := range m.getPodEvents(pod) {
+		if event.Type == "Warning" {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// renderPodWarningBadge renders the "⚠N" badge of a pod with Warning events,
+// or "" for pods without any
+func (m *Model) renderPodWarningBadge(pod *model.PodData) string {
+	count := m.podWarningCount(pod)
+	if count == 0 {
+		return ""
+	}
+	return StyleWarning.Render(fmt.Sprintf("⚠%d", count))
+}
+
+// openPodDetail opens the detail view of a pod from the Pods list. Pods with
+// Warning events open scrolled to their events.
+func (m *Model) openPodDetail(pod *model.PodData) {
+	m.selectedPod = pod
+	m.currentView = ViewPodDetail
+	m.detailMode = true
+	m.detailScrollOffset = 0 // Reset scroll when entering detail
+	m.scrollToPodEvents = m.podWarningCount(pod) > 0
+}
+
+// renderPodEventTimeline renders the events timeline section of the pod
+// detail view: the pod's lifecycle (Scheduled, Pulling, Started, Unhealthy,
+// Killing, ...) newest first, with Warning events highlighted
+func (m *Model) renderPodEventTimeline(events []*model.EventData) []string {
+	warnings := 0
+	for _, event := range events {
+		if event.Type == "Warning" {
+			warnings++
+		}
+	}
+	lines := []string{
+		StyleHeader.Render(m.TF("detail.pod.event_timeline", map[string]interface{}{
+			"Count":    len(events),
+			"Warnings": warnings,
+		})),
+		"",
+	}
+
+	maxWidth := m.width - 30
+	if maxWidth < 20 {
+		maxWidth = 20
+	}
+	for _, event := range events {
+		reason := event.Reason
+		if event.Count > 1 {
+			reason = fmt.Sprintf("%s (x%d)", reason, event.Count)
+		}
+		marker, style := "●", StyleStatusReady
+		if event.Type == "Warning" {
+			marker, style = "⚠", StyleWarning
+		}
+		lines = append(lines, fmt.Sprintf("  %s  %s %s",
+			StyleTextMuted.Render(padRight(m.formatAgeOrTime(eventTime(event)), m.ageColumnWidth(6))),
+			style.Render(marker),
+			style.Render(reason)))
+		for _, line := range wrapLine(event.Message, maxWidth, 0) {
+			lines = append(lines, "      "+line)
+		}
+	}
+	return lines
+}
@@ -4,21 +4,37 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/yourusername/k8s-monitor/internal/model"
 )
 
+// defaultRecentRestartWindow is how long after a container restart a pod is
+// highlighted when no window is configured
+const defaultRecentRestartWindow = 5 * time.Minute
+
+// highRestartCount is the restart count shown as a warning, matching the
+// threshold of the high-restarts alert
+const highRestartCount = 5
+
+// SetRecentRestartWindow sets how long after a container restart a pod is
+// highlighted in the Pods list
+func (m *Model) SetRecentRestartWindow(window time.Duration) {
+	if window <= 0 {
+		window = defaultRecentRestartWindow
+	}
+	m.restartWindow = window
+}
+
 // renderPods renders the pods view
 func (m *Model) renderPods() string {
-	pods := m.getFilteredPods()
-	if len(pods) == 0 {
+	// Filter and sort only when data, filters or sort changed
+	m.refreshPodListCache()
+	if len(m.cachedSortedPods) == 0 {
 		return m.T("views.pods.no_pods")
 	}
 
-	// Sort pods and cache
-	m.cachedSortedPods = m.getSortedPods(pods)
-
 	// Header
 	header := m.renderPodsHeader(m.cachedSortedPods)
 
@@ -71,6 +87,9 @@ func (m *Model) renderPodsHeader(pods []*model.PodData) string {
 	if m.filterNamespace != "" {
 		summary += fmt.Sprintf(" (%s: %s)", m.T("common.filtered_by"), m.filterNamespace)
 	}
+	if m.filterNode != "" {
+		summary += fmt.Sprintf(" (%s: %s)", m.T("detail.field.node"), m.filterNode)
+	}
 
 	// Add sort indicator
 	var sortInfo string
@@ -90,6 +109,13 @@ func (m *Model) renderPodsHeader(pods []*model.PodData) string {
 		summary += fmt.Sprintf(" • %s: %s %s", m.T("common.sort"), sortInfo, arrow)
 	}
 
+	// Add grouping indicator
+	if m.podGroupingEnabled {
+		summary += " • " + m.TF("views.pods.grouped", map[string]interface{}{
+			"Count": m.podListStats.groups,
+		})
+	}
+
 	return lipgloss.JoinHorizontal(
 		lipgloss.Top,
 		title,
@@ -98,42 +124,64 @@ func (m *Model) renderPodsHeader(pods []*model.PodData) string {
 	)
 }
 
+// Widths of the optional Node and Pod IP columns of the pods list
+const (
+	podNodeColumnWidth = 18
+	podIPColumnWidth   = 15
+)
+
+// podColumns returns the columns of the Pods view sized to the terminal. The
+// default Node and Pod IP columns are dropped when keeping them would narrow
+// the name column below its default width.
+func (m *Model) podColumns(pods []*model.PodData) []listColumn {
+	columns, configured := m.visibleColumns("pods")
+
+	count := len(pods)
+	if m.podGroupingEnabled {
+		count = len(m.cachedPodRows)
+	}
+	cell := func(i int, column listColumn) string {
+		if !m.podGroupingEnabled {
+			return m.renderPodCell(pods[i], column)
+		}
+		podRow := m.cachedPodRows[i]
+		if podRow.group != nil {
+			return m.renderPodGroupCell(podRow.group, column)
+		}
+		// Member pods are indented under their group header
+		if column.id == columns[0].id {
+			return "  " + m.renderPodCell(podRow.pod, column)
+		}
+		return m.renderPodCell(podRow.pod, column)
+	}
+
+	fitted := m.fitColumns(columns, m.width-2, count, cell)
+	if !configured {
+		nameColumn, _ := findListColumn(podListColumns, "name")
+		if fittedName, _ := findListColumn(fitted, "name"); fittedName.width < nameColumn.width {
+			fitted = m.fitColumns(withoutColumns(columns, "node", "ip"), m.width-2, count, cell)
+		}
+	}
+	return fitted
+}
+
 // renderPodsList renders the list of pods
 func (m *Model) renderPodsList(pods []*model.PodData) string {
 	var rows []string
 
-	// Table header - define fixed column widths
-	const (
-		colName      = 26
-		colNamespace = 14
-		colStatus    = 11
-		colCPU       = 12  // Trend indicator
-		colMemory    = 15  // Trend indicator
-		colRx        = 11  // Network RX
-		colTx        = 11  // Network TX
-		colRestarts  = 8
-	)
-
-	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s",
-		padRight(m.T("columns.name"), colName),
-		padRight(m.T("columns.namespace"), colNamespace),
-		padRight(m.T("columns.status"), colStatus),
-		padRight(m.T("columns.cpu"), colCPU),
-		padRight(m.T("columns.memory"), colMemory),
-		padRight(m.T("columns.rx"), colRx),
-		padRight(m.T("columns.tx"), colTx),
-		padRight(m.T("columns.restarts"), colRestarts),
-	)
-	rows = append(rows, StyleHeader.Render(headerRow))
-	rows = append(rows, strings.Repeat("─", colName+colNamespace+colStatus+colCPU+colMemory+colRx+colTx+colRestarts+14))
+	// Table header
+	columns := m.podColumns(pods)
+	rows = append(rows, StyleHeader.Render(m.renderColumnsHeader(columns)))
+	rows = append(rows, strings.Repeat("─", columnsWidth(columns)))
 
 	// Calculate visible range based on scroll
-	maxVisible := m.height - 10
-	if maxVisible < 1 {
-		maxVisible = 1
-	}
+	maxVisible := m.listVisibleRows()
 
+	// In grouped mode the selectable rows are group headers plus expanded pods
 	totalPods := len(pods)
+	if m.podGroupingEnabled {
+		totalPods = len(m.cachedPodRows)
+	}
 
 	// Clamp scroll offset to valid range to prevent panic when pod count shrinks
 	maxScroll := totalPods - maxVisible
@@ -153,12 +201,20 @@ func (m *Model) renderPodsList(pods []*model.PodData) string {
 		endIdx = totalPods
 	}
 
-	visiblePods := pods[startIdx:endIdx]
-
 	// Pod rows with selection highlighting
-	for i, pod := range visiblePods {
-		absoluteIndex := startIdx + i
-		row := m.renderPodRow(pod, colName, colNamespace, colStatus, colCPU, colMemory, colRx, colTx, colRestarts)
+	for absoluteIndex := startIdx; absoluteIndex < endIdx; absoluteIndex++ {
+		var row string
+		if m.podGroupingEnabled {
+			podRow := m.cachedPodRows[absoluteIndex]
+			if podRow.group != nil {
+				row = m.renderPodGroupRow(podRow.group, columns)
+			} else {
+				// Indent member pods under their group header
+				row = "  " + m.renderPodRow(podRow.pod, indentColumns(columns, 2))
+			}
+		} else {
+			row = m.renderPodRow(pods[absoluteIndex], columns)
+		}
 
 		// Highlight selected row
 		if absoluteIndex == m.selectedIndex {
@@ -172,83 +228,149 @@ func (m *Model) renderPodsList(pods []*model.PodData) string {
 }
 
 // renderPodRow renders a single pod row
-func (m *Model) renderPodRow(pod *model.PodData, colName, colNamespace, colStatus, colCPU, colMemory, colRx, colTx, colRestarts int) string {
-	// Pod name
-	name := truncate(pod.Name, colName)
+func (m *Model) renderPodRow(pod *model.PodData, columns []listColumn) string {
+	return renderColumns(columns, func(column listColumn) string {
+		return m.renderPodCell(pod, column)
+	})
+}
+
+// renderPodCell renders the cell of a pod in the given column
+func (m *Model) renderPodCell(pod *model.PodData, column listColumn) string {
+	switch column.id {
+	case "name":
+		// Pod name, with a badge counting its Warning events
+		if badge := m.renderPodWarningBadge(pod); badge != "" {
+			return truncate(pod.Name, column.width-lipgloss.Width(badge)-1) + " " + badge
+		}
+		return truncate(pod.Name, column.width)
+
+	case "namespace":
+		return m.renderNamespaceName(pod.Namespace, column.width)
+
+	case "status":
+		// Pending pods show the more specific reason when known
+		if reason := podPendingReason(pod); reason != "" {
+			return StyleStatusPending.Render(truncate(reason, column.width))
+		}
+		return RenderStatus(pod.Phase)
+
+	case "node":
+		// Unset until the pod is scheduled
+		if pod.Node == "" {
+			return StyleTextMuted.Render("-")
+		}
+		return truncate(pod.Node, column.width)
 
-	// Namespace
-	namespace := truncate(pod.Namespace, colNamespace)
+	case "ip":
+		// Unset until the pod is started
+		if pod.PodIP == "" {
+			return StyleTextMuted.Render("-")
+		}
+		return pod.PodIP
 
-	// Status with color
-	status := RenderStatus(pod.Phase)
+	case "qos":
+		return renderQOSClass(pod.QOSClass)
 
-	// CPU usage with trend
-	var cpuUsage string
-	if pod.CPUUsage > 0 {
+	case "cpu":
+		// CPU usage with trend
+		if pod.CPUUsage <= 0 {
+			return "-"
+		}
 		cpuTrend := m.calculatePodCPUTrend(pod.Namespace, pod.Name, pod.CPUUsage)
-		trendIndicator := renderTrendIndicator(cpuTrend)
-		cpuUsage = fmt.Sprintf("%s %s", FormatMillicores(pod.CPUUsage), trendIndicator)
-	} else {
-		cpuUsage = "-"
-	}
-	cpuUsage = truncate(cpuUsage, colCPU)
+		return truncate(fmt.Sprintf("%s %s", FormatMillicores(pod.CPUUsage, m.rawUnits), renderTrendIndicator(cpuTrend)), column.width)
 
-	// Memory usage with trend
-	var memUsage string
-	if pod.MemoryUsage > 0 {
+	case "memory":
+		// Memory usage with trend
+		if pod.MemoryUsage <= 0 {
+			return "-"
+		}
 		memTrend := m.calculatePodMemoryTrend(pod.Namespace, pod.Name, pod.MemoryUsage)
-		trendIndicator := renderTrendIndicator(memTrend)
-		memUsage = fmt.Sprintf("%s %s", FormatBytes(pod.MemoryUsage), trendIndicator)
-	} else {
-		memUsage = "-"
-	}
-	memUsage = truncate(memUsage, colMemory)
-
-	// Network RX (download/receive)
-	rxRate := m.calculatePodNetworkRxRate(pod.Namespace, pod.Name)
-	rxStr := formatNetworkRate(rxRate)
-	if rxRate == 0 {
-		rxStr = StyleTextMuted.Render("-")
-	}
-
-	// Network TX (upload/send)
-	txRate := m.calculatePodNetworkTxRate(pod.Namespace, pod.Name)
-	txStr := formatNetworkRate(txRate)
-	if txRate == 0 {
-		txStr = StyleTextMuted.Render("-")
-	}
-
-	// Restarts
-	restarts := fmt.Sprintf("%d", pod.RestartCount)
-
-	return fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s",
-		padRight(name, colName),
-		padRight(namespace, colNamespace),
-		padRight(status, colStatus),
-		padRight(cpuUsage, colCPU),
-		padRight(memUsage, colMemory),
-		padRight(rxStr, colRx),
-		padRight(txStr, colTx),
-		padRight(restarts, colRestarts),
-	)
+		return truncate(fmt.Sprintf("%s %s", formatMemoryBytes(pod.MemoryUsage, m.rawUnits), renderTrendIndicator(memTrend)), column.width)
+
+	case "rx":
+		// Network RX (download/receive)
+		rxRate := m.calculatePodNetworkRxRate(pod.Namespace, pod.Name)
+		if rxRate == 0 {
+			return StyleTextMuted.Render("-")
+		}
+		return formatNetworkRate(rxRate)
+
+	case "tx":
+		// Network TX (upload/send)
+		txRate := m.calculatePodNetworkTxRate(pod.Namespace, pod.Name)
+		if txRate == 0 {
+			return StyleTextMuted.Render("-")
+		}
+		return formatNetworkRate(txRate)
+
+	case "restarts":
+		return m.renderPodRestarts(pod)
+
+	case "age":
+		return m.formatAgeOrTime(pod.CreationTimestamp)
+	}
+	return ""
 }
 
-// renderPodsFooter renders the pods view footer
-func (m *Model) renderPodsFooter(pods []*model.PodData) string {
-	// Count pod statuses from filtered list
-	running, pending, failed := 0, 0, 0
-	for _, pod := range pods {
-		switch pod.Phase {
-		case "Running":
-			running++
-		case "Pending":
-			pending++
-		case "Failed":
-			failed++
+// renderPodRestarts renders the restart count of a pod. Pods restarted within
+// the recent restart window are marked with the time since the restart, so a
+// fresh crash stands out even with a low count; pods with many restarts
+// overall are shown as a warning.
+func (m *Model) renderPodRestarts(pod *model.PodData) string {
+	count := fmt.Sprintf("%d", pod.RestartCount)
+	if !pod.LastRestartTime.IsZero() {
+		if since := time.Since(pod.LastRestartTime); since < m.restartWindow {
+			return StyleDanger.Render(fmt.Sprintf("%s ↻%s", count, formatAge(since)))
 		}
 	}
+	if pod.RestartCount >= highRestartCount {
+		return StyleWarning.Render(count)
+	}
+	return count
+}
+
+// podPendingReason returns why a Pending pod is not running yet: the scheduler
+// reason (e.g. Unschedulable) or a container waiting reason (e.g. ImagePullBackOff).
+// Returns an empty string for non-Pending pods or when no reason is known.
+func podPendingReason(pod *model.PodData) string {
+	if pod.Phase != "Pending" {
+		return ""
+	}
+	if pod.SchedulingReason != "" {
+		return pod.SchedulingReason
+	}
+	for _, cs := range pod.ContainerStates {
+		if cs.State == "Waiting" && cs.Reason != "" {
+			return cs.Reason
+		}
+	}
+	return ""
+}
+
+// renderQOSClass renders a pod QoS class with color
+func renderQOSClass(qos string) string {
+	switch qos {
+	case "Guaranteed":
+		return StyleStatusReady.Render(qos)
+	case "BestEffort":
+		return StyleTextMuted.Render(qos)
+	case "":
+		return StyleTextMuted.Render("-")
+	default:
+		return qos
+	}
+}
+
+// renderPodsFooter renders the pods view footer
+func (m *Model) renderPodsFooter(pods []*model.PodData) string {
+	// Status counts of the filtered list are computed once per cache rebuild
+	running, pending, failed := m.podListStats.running, m.podListStats.pending, m.podListStats.failed
 
 	totalPods := len(pods)
+	totalRows := totalPods
+	if m.podGroupingEnabled {
+		totalRows = len(m.cachedPodRows)
+	}
 	stats := fmt.Sprintf("%s %s  %s %s  %s %s  %s %d",
 		m.T("status.running")+":",
 		StyleStatusRunning.Render(fmt.Sprintf("%d", running)),
@@ -259,18 +381,20 @@ func (m *Model) renderPodsFooter(pods []*model.PodData) string {
 		m.T("common.total")+":",
 		totalPods,
 	)
+	if hidden := m.podListStats.hidden; hidden > 0 {
+		stats += StyleTextMuted.Render("  " + m.TF("views.pods.completed_hidden", map[string]interface{}{
+			"Count": hidden,
+		}))
+	}
 
 	// Add scroll position indicator if there are more items than visible
-	maxVisible := m.height - 10
-	if maxVisible < 1 {
-		maxVisible = 1
-	}
-	if totalPods > maxVisible {
+	maxVisible := m.listVisibleRows()
+	if totalRows > maxVisible {
 		scrollInfo := fmt.Sprintf("  [%d-%d %s %d]",
 			m.scrollOffset+1,
-			min(m.scrollOffset+maxVisible, totalPods),
+			min(m.scrollOffset+maxVisible, totalRows),
 			m.T("common.of"),
-			totalPods,
+			totalRows,
 		)
 		stats += StyleTextMuted.Render(scrollInfo)
 	}
@@ -278,14 +402,19 @@ func (m *Model) renderPodsFooter(pods []*model.PodData) string {
 	return StyleTextSecondary.Render(stats)
 }
 
-// renderFilterPanel renders the namespace filter panel
+// renderFilterPanel renders the namespace filter panel, or the node filter
+// panel once the Pods view filter moved on to nodes
 func (m *Model) renderFilterPanel() string {
+	if m.currentView == ViewPods && m.podFilterField == podFilterNode {
+		return m.renderNodeFilterPanel()
+	}
+
 	var lines []string
 
 	lines = append(lines, StyleHeader.Render(m.T("filter.title")))
 	lines = append(lines, "")
 
-	namespaces := m.getNamespaces()
+	namespaces := m.getFilterNamespaces()
 	if len(namespaces) == 0 {
 		lines = append(lines, StyleTextMuted.Render("  "+m.T("filter.no_namespaces")))
 		return strings.Join(lines, "\n")
@@ -301,12 +430,20 @@ func (m *Model) renderFilterPanel() string {
 	// Show namespace options
 	for _, ns := range namespaces {
 		option := fmt.Sprintf("  %s", ns)
+		if m.isPinnedNamespace(ns) {
+			option = fmt.Sprintf("  %s %s", ns, pinnedNamespaceMarker)
+		}
 		if m.filterNamespace == ns {
 			option = StyleSelected.Render(option)
 		}
 		lines = append(lines, option)
 	}
 
+	if m.currentView == ViewPods {
+		lines = append(lines, "")
+		lines = append(lines, StyleTextMuted.Render("  "+m.T("filter.pods_hint")))
+	}
+
 	return strings.Join(lines, "\n")
 }
 
@@ -320,6 +457,11 @@ func (m *Model) getSortedPods(pods []*model.PodData) []*model.PodData {
 	sortedPods := make([]*model.PodData, len(pods))
 	copy(sortedPods, pods)
 
+	// Fuzzy search results keep their match-score order
+	if m.fuzzyRanking() {
+		return sortedPods
+	}
+
 	// Sort based on current field and order using sort.Slice (O(n log n))
 	switch m.sortField {
 	case SortByName:
@@ -38,7 +38,12 @@ func (m *Model) renderPVDetail() string {
 	lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pv.status"), statusStr))
 
 	// Capacity
-	lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pv.capacity"), StyleHighlight.Render(formatMemory(pv.Capacity))))
+	lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pv.capacity"), StyleHighlight.Render(formatMemory(pv.Capacity, false))))
+
+	// Usage of the bound claim (from kubelet volume stats)
+	if pv.Claim != "" {
+		lines = append(lines, fmt.Sprintf("  %s: %s", m.T("storage.usage"), m.renderVolumeUsageDetail(pv.Usage)))
+	}
 
 	// StorageClass
 	if pv.StorageClass != "" {
@@ -125,6 +130,7 @@ func (m *Model) renderPVDetail() string {
 	}
 
 	// Clamp scroll offset to valid range (prevent scrolling beyond content)
+	m.noteDetailLines(len(lines), maxVisible)
 	maxScroll := len(lines) - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
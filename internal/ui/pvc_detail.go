@@ -53,21 +53,18 @@ func (m *Model) renderPVCDetail() string {
 	lines = append(lines, renderSeparator(m.width))
 
 	// Requested Storage
-	lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pvc.requested"), StyleHighlight.Render(formatMemory(pvc.RequestedStorage))))
+	lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pvc.requested"), StyleHighlight.Render(formatMemory(pvc.RequestedStorage, false))))
 
 	// Actual Capacity (if bound)
 	if pvc.Capacity > 0 {
-		lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pvc.capacity"), StyleHighlight.Render(formatMemory(pvc.Capacity))))
+		lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pvc.capacity"), StyleHighlight.Render(formatMemory(pvc.Capacity, false))))
 	} else {
 		lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pvc.capacity"), StyleTextMuted.Render(m.T("detail.pvc.unknown"))))
 	}
 
-	// Used (if available)
-	if pvc.UsedBytes > 0 {
-		usagePercent := float64(pvc.UsedBytes) / float64(pvc.Capacity) * 100
-		lines = append(lines, fmt.Sprintf("  Used: %s (%.1f%%)",
-			StyleWarning.Render(formatMemory(pvc.UsedBytes)),
-			usagePercent))
+	// Usage (from kubelet volume stats of the pods mounting the claim)
+	if pvc.Status == "Bound" {
+		lines = append(lines, fmt.Sprintf("  %s: %s", m.T("storage.usage"), m.renderVolumeUsageDetail(pvc.Usage)))
 	}
 
 	// Storage Configuration
@@ -78,6 +75,9 @@ func (m *Model) renderPVCDetail() string {
 	// StorageClass
 	if pvc.StorageClass != "" {
 		lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pvc.storageclass"), StyleHighlight.Render(pvc.StorageClass)))
+		if m.storageClassMissing(pvc) {
+			lines = append(lines, "  "+StyleDanger.Render("⚠ "+m.T("detail.pvc.storageclass_missing")))
+		}
 	} else {
 		lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.pvc.storageclass"), StyleTextMuted.Render(m.T("detail.pvc.none"))))
 	}
@@ -142,6 +142,7 @@ func (m *Model) renderPVCDetail() string {
 	}
 
 	// Clamp scroll offset to valid range (prevent scrolling beyond content)
+	m.noteDetailLines(len(lines), maxVisible)
 	maxScroll := len(lines) - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
@@ -40,10 +40,7 @@ func (m *Model) renderQueues() string {
 	totalItems := len(queues)
 
 	// Calculate max visible items based on screen height
-	maxVisible := m.height - 10
-	if maxVisible < 5 {
-		maxVisible = 5
-	}
+	maxVisible := max(m.listVisibleRows(), 5)
 
 	// Clamp scroll offset to valid range
 	maxScroll := totalItems - maxVisible
@@ -166,9 +163,9 @@ func (m *Model) renderQueueRow(queue *model.QueueData, index int, colName, colSt
 	// Memory (allocated/deserved)
 	var memory string
 	if queue.MemoryDeserved > 0 {
-		memory = fmt.Sprintf("%s/%s", formatMemoryShort(queue.MemoryAllocated), formatMemoryShort(queue.MemoryDeserved))
+		memory = fmt.Sprintf("%s/%s", formatMemoryShort(queue.MemoryAllocated, m.rawUnits), formatMemoryShort(queue.MemoryDeserved, m.rawUnits))
 	} else if queue.MemoryAllocated > 0 {
-		memory = formatMemoryShort(queue.MemoryAllocated)
+		memory = formatMemoryShort(queue.MemoryAllocated, m.rawUnits)
 	} else {
 		memory = "-"
 	}
@@ -253,6 +250,9 @@ func (m *Model) renderQueueDetail() string {
 		if queue.NPUGuarantee > 0 {
 			npuLine += fmt.Sprintf(" / %s %d", m.T("detail.queue.guarantee"), queue.NPUGuarantee)
 		}
+		if queue.NPUCapability > 0 {
+			npuLine += fmt.Sprintf(" / %s %d", m.T("detail.queue.capability"), queue.NPUCapability)
+		}
 		lines = append(lines, npuLine)
 
 		// NPU utilization bar
@@ -276,6 +276,9 @@ func (m *Model) renderQueueDetail() string {
 		if queue.CPUGuarantee > 0 {
 			cpuLine += fmt.Sprintf(" / %s %.1f", m.T("detail.queue.guarantee"), guaranteeCores)
 		}
+		if queue.CPUCapability > 0 {
+			cpuLine += fmt.Sprintf(" / %s %.1f", m.T("detail.queue.capability"), float64(queue.CPUCapability)/1000.0)
+		}
 		cpuLine += " cores"
 		lines = append(lines, cpuLine)
 
@@ -291,10 +294,13 @@ func (m *Model) renderQueueDetail() string {
 	if queue.MemoryDeserved > 0 || queue.MemoryAllocated > 0 {
 		memLine := fmt.Sprintf("  %s: %s %s / %s %s",
 			m.T("columns.memory"),
-			m.T("detail.queue.allocated"), formatMemory(queue.MemoryAllocated),
-			m.T("detail.queue.quota"), formatMemory(queue.MemoryDeserved))
+			m.T("detail.queue.allocated"), formatMemory(queue.MemoryAllocated, m.rawUnits),
+			m.T("detail.queue.quota"), formatMemory(queue.MemoryDeserved, m.rawUnits))
 		if queue.MemoryGuarantee > 0 {
-			memLine += fmt.Sprintf(" / %s %s", m.T("detail.queue.guarantee"), formatMemory(queue.MemoryGuarantee))
+			memLine += fmt.Sprintf(" / %s %s", m.T("detail.queue.guarantee"), formatMemory(queue.MemoryGuarantee, m.rawUnits))
+		}
+		if queue.MemoryCapability > 0 {
+			memLine += fmt.Sprintf(" / %s %s", m.T("detail.queue.capability"), formatMemory(queue.MemoryCapability, m.rawUnits))
 		}
 		lines = append(lines, memLine)
 
@@ -312,6 +318,9 @@ func (m *Model) renderQueueDetail() string {
 			m.T("columns.pods"),
 			m.T("detail.queue.allocated"), queue.PodAllocated,
 			m.T("detail.queue.quota"), queue.PodDeserved)
+		if queue.PodCapability > 0 {
+			podLine += fmt.Sprintf(" / %s %d", m.T("detail.queue.capability"), queue.PodCapability)
+		}
 		lines = append(lines, podLine)
 
 		// Pod utilization bar
@@ -322,6 +331,9 @@ func (m *Model) renderQueueDetail() string {
 		}
 	}
 
+	// Fair Share Section
+	lines = append(lines, m.renderQueueFairShare(queue)...)
+
 	// Job Statistics Section
 	lines = append(lines, "")
 	lines = append(lines, StyleSubHeader.Render(m.T("detail.queue.job_stats")))
@@ -336,6 +348,9 @@ func (m *Model) renderQueueDetail() string {
 		lines = append(lines, fmt.Sprintf("  %s: %s", m.T("detail.queue.failed_jobs"), StyleError.Render(fmt.Sprintf("%d", queue.FailedJobs))))
 	}
 
+	// Pending jobs in scheduling order
+	lines = append(lines, m.renderQueuePendingJobs(queue)...)
+
 	// Queue Wait Time Statistics
 	waitStats := m.calculateQueueWaitTimeStats(queue.Name)
 	if waitStats.jobCount > 0 {
@@ -404,6 +419,7 @@ func (m *Model) renderQueueDetail() string {
 	}
 
 	// Clamp scroll offset to valid range
+	m.noteDetailLines(len(lines), maxVisible)
 	maxScroll := len(lines) - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
@@ -435,10 +451,13 @@ func (m *Model) renderQueueDetail() string {
 }
 
 // formatMemoryShort formats memory in a shorter format for table display
-func formatMemoryShort(bytes int64) string {
+func formatMemoryShort(bytes int64, raw bool) string {
 	if bytes == 0 {
 		return "0"
 	}
+	if raw {
+		return formatRawMemory(bytes)
+	}
 	const (
 		KB = 1024
 		MB = KB * 1024
@@ -469,6 +488,185 @@ type queueWaitTimeStats struct {
 	pendingCount   int
 }
 
+// queueFairShare describes how a queue's share of one resource compares to its usage
+type queueFairShare struct {
+	resource   string
+	npu        bool
+	fairShare  float64 // Weight share of the cluster total
+	deserved   int64
+	allocated  int64
+	capability int64
+	format     func(float64) string
+}
+
+// limit returns the amount the queue may use before jobs wait: capability,
+// then deserved, then the weight-based fair share
+func (f *queueFairShare) limit() float64 {
+	switch {
+	case f.capability > 0:
+		return float64(f.capability)
+	case f.deserved > 0:
+		return float64(f.deserved)
+	default:
+		return f.fairShare
+	}
+}
+
+// queueWeightShare returns the queue's weight and the total weight of its sibling queues
+func (m *Model) queueWeightShare(queue *model.QueueData) (int32, int32) {
+	var total int32
+	for _, q := range m.clusterData.Queues {
+		if q.Parent == queue.Parent {
+			total += q.Weight
+		}
+	}
+	return queue.Weight, total
+}
+
+// queueFairShares returns the NPU and CPU fair-share breakdown of a queue.
+// The fair share follows Volcano's proportion plugin: cluster total * weight / sibling weights.
+func (m *Model) queueFairShares(queue *model.QueueData) []queueFairShare {
+	weight, totalWeight := m.queueWeightShare(queue)
+	share := 0.0
+	if totalWeight > 0 {
+		share = float64(weight) / float64(totalWeight)
+	}
+
+	var npuTotal, cpuTotal int64
+	if summary := m.clusterData.Summary; summary != nil {
+		npuTotal, cpuTotal = summary.NPUAllocatable, summary.CPUAllocatable
+	}
+
+	var shares []queueFairShare
+	if npuTotal > 0 || queue.NPUDeserved > 0 || queue.NPUAllocated > 0 {
+		shares = append(shares, queueFairShare{
+			resource:   m.T("columns.npu"),
+			npu:        true,
+			fairShare:  float64(npuTotal) * share,
+			deserved:   queue.NPUDeserved,
+			allocated:  queue.NPUAllocated,
+			capability: queue.NPUCapability,
+			format:     func(v float64) string { return strings.TrimSuffix(fmt.Sprintf("%.1f", v), ".0") },
+		})
+	}
+	if cpuTotal > 0 || queue.CPUDeserved > 0 || queue.CPUAllocated > 0 {
+		shares = append(shares, queueFairShare{
+			resource:   m.T("columns.cpu"),
+			fairShare:  float64(cpuTotal) * share,
+			deserved:   queue.CPUDeserved,
+			allocated:  queue.CPUAllocated,
+			capability: queue.CPUCapability,
+			format:     func(v float64) string { return fmt.Sprintf("%.1f", v/1000.0) },
+		})
+	}
+	return shares
+}
+
+// queueCapacityPendingJobs counts pending Volcano jobs of the queue whose NPU
+// request does not fit in the queue's remaining NPU headroom
+func (m *Model) queueCapacityPendingJobs(queue *model.QueueData, npuHeadroom float64) int {
+	count := 0
+	for _, job := range m.clusterData.VolcanoJobs {
+		if job.Queue == queue.Name && job.Status == "Pending" &&
+			job.NPURequested > 0 && float64(job.NPURequested) > npuHeadroom {
+			count++
+		}
+	}
+	return count
+}
+
+// renderQueueFairShare renders the weight share, fair share vs allocation and
+// overcommit of a queue, plus how many pending jobs are blocked by its capacity
+func (m *Model) renderQueueFairShare(queue *model.QueueData) []string {
+	if m.clusterData == nil {
+		return nil
+	}
+
+	var lines []string
+	lines = append(lines, "")
+	lines = append(lines, StyleSubHeader.Render(m.T("detail.queue.fair_share")))
+	lines = append(lines, renderSeparator(m.width))
+
+	weight, totalWeight := m.queueWeightShare(queue)
+	weightShare := 0.0
+	if totalWeight > 0 {
+		weightShare = float64(weight) / float64(totalWeight) * 100
+	}
+	lines = append(lines, fmt.Sprintf("  %s: %d / %d (%.1f%%)",
+		m.T("detail.queue.weight_share"), weight, totalWeight, weightShare))
+
+	shares := m.queueFairShares(queue)
+	if len(shares) == 0 {
+		return lines
+	}
+
+	const colResource, colValue = 8, 12
+	lines = append(lines, StyleTextMuted.Render(fmt.Sprintf("  %s%s%s%s%s%s",
+		padRight("", colResource),
+		padRight(m.T("detail.queue.col_fair_share"), colValue),
+		padRight(m.T("detail.queue.col_deserved"), colValue),
+		padRight(m.T("detail.queue.col_allocated"), colValue),
+		padRight(m.T("detail.queue.col_capability"), colValue),
+		m.T("detail.queue.col_status"))))
+
+	// orDash renders unset quantities as "-"
+	orDash := func(value int64, format func(float64) string) string {
+		if value <= 0 {
+			return StyleTextMuted.Render("-")
+		}
+		return format(float64(value))
+	}
+
+	var npuHeadroom float64
+	hasNPU := false
+	for i := range shares {
+		f := &shares[i]
+
+		// Overcommitted queues run on resources borrowed from other queues
+		status := StyleStatusReady.Render(m.T("detail.queue.within_share"))
+		deserved := float64(f.deserved)
+		if deserved <= 0 {
+			deserved = f.fairShare
+		}
+		if deserved > 0 && float64(f.allocated) > deserved {
+			status = StyleWarning.Render(m.TF("detail.queue.overcommit", map[string]interface{}{
+				"Amount":  f.format(float64(f.allocated) - deserved),
+				"Percent": fmt.Sprintf("%.0f", float64(f.allocated)/deserved*100),
+			}))
+		}
+
+		lines = append(lines, fmt.Sprintf("  %s%s%s%s%s%s",
+			padRight(f.resource, colResource),
+			padRight(f.format(f.fairShare), colValue),
+			padRight(orDash(f.deserved, f.format), colValue),
+			padRight(f.format(float64(f.allocated)), colValue),
+			padRight(orDash(f.capability, f.format), colValue),
+			status))
+
+		if f.npu {
+			hasNPU = true
+			npuHeadroom = max(f.limit()-float64(f.allocated), 0)
+		}
+	}
+
+	// Pending jobs whose NPU request exceeds what the queue can still get
+	if hasNPU {
+		blocked := m.queueCapacityPendingJobs(queue, npuHeadroom)
+		style := StyleTextMuted
+		if blocked > 0 {
+			style = StyleWarning
+		}
+		lines = append(lines, fmt.Sprintf("  %s: %s",
+			m.T("detail.queue.capacity_pending"),
+			style.Render(m.TF("detail.queue.capacity_pending_value", map[string]interface{}{
+				"Count":    blocked,
+				"Headroom": fmt.Sprintf("%.0f", npuHeadroom),
+			}))))
+	}
+
+	return lines
+}
+
 // calculateQueueWaitTimeStats calculates wait time statistics for jobs in a queue
 func (m *Model) calculateQueueWaitTimeStats(queueName string) queueWaitTimeStats {
 	stats := queueWaitTimeStats{}
@@ -0,0 +1,103 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// refreshIntervalSetter is implemented by data providers whose background
+// refresh can follow the interval of the current view, so a slow view also
+// fetches less often
+type refreshIntervalSetter interface {
+	SetRefreshInterval(interval time.Duration)
+}
+
+// detailRefreshView is the configuration key covering all detail views
+const detailRefreshView = "detail"
+
+// refreshViews maps the configuration keys of per-view refresh intervals to views
+var refreshViews = map[string]ViewType{
+	"overview":  ViewOverview,
+	"nodes":     ViewNodes,
+	"pods":      ViewPods,
+	"workloads": ViewWorkloads,
+	"network":   ViewNetwork,
+	"storage":   ViewStorage,
+	"events":    ViewEvents,
+	"alerts":    ViewAlerts,
+	"queues":    ViewQueues,
+	"topology":  ViewTopology,
+}
+
+// SetViewRefreshIntervals overrides the auto-refresh interval of individual
+// views, e.g. {"overview": "10s", "pods": "2s"}. The "detail" key covers all
+// detail views; views left out use the global refresh interval.
+func (m *Model) SetViewRefreshIntervals(intervals map[string]string) error {
+	configured := make(map[string]time.Duration)
+	for view, value := range intervals {
+		if _, ok := refreshViews[view]; !ok && view != detailRefreshView {
+			return fmt.Errorf("unknown view %q (want one of %s)", view, strings.Join(refreshViewNames(), ", "))
+		}
+		interval, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s refresh interval %q: %w", view, value, err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("%s refresh interval must be positive, got %s", view, value)
+		}
+		configured[view] = interval
+	}
+	m.viewRefreshIntervals = configured
+	return nil
+}
+
+// refreshViewNames returns the configurable view keys in alphabetical order
+func refreshViewNames() []string {
+	names := make([]string, 0, len(refreshViews)+1)
+	for name := range refreshViews {
+		names = append(names, name)
+	}
+	names = append(names, detailRefreshView)
+	sort.Strings(names)
+	return names
+}
+
+// refreshViewKey returns the configuration key of the current view
+func (m *Model) refreshViewKey() string {
+	if m.detailMode {
+		return detailRefreshView
+	}
+	view := m.currentView
+	if view == ViewHeatmap {
+		view = ViewNodes // The heatmap is an alternate Nodes layout
+	}
+	for name, v := range refreshViews {
+		if v == view {
+			return name
+		}
+	}
+	return ""
+}
+
+// currentRefreshInterval returns the auto-refresh interval of the current
+// view, falling back to the global interval
+func (m *Model) currentRefreshInterval() time.Duration {
+	if interval, ok := m.viewRefreshIntervals[m.refreshViewKey()]; ok {
+		return interval
+	}
+	return m.refreshInterval
+}
+
+// rescheduleRefresh restarts the refresh timer when the current view's
+// interval differs from the scheduled one; the pending tick is then ignored
+func (m *Model) rescheduleRefresh() tea.Cmd {
+	if m.currentRefreshInterval() == m.scheduledRefreshInterval {
+		return nil
+	}
+	m.refreshSeq++
+	return m.scheduleRefresh()
+}
@@ -0,0 +1,41 @@
+package ui
+
+import "fmt"
+
+// Request efficiency thresholds: below efficiencyLowPercent most of the
+// reserved capacity sits idle, below efficiencyFairPercent a good part of it
+const (
+	efficiencyLowPercent  = 30.0
+	efficiencyFairPercent = 60.0
+)
+
+// requestEfficiency returns used as a percentage of requested, or 0 when
+// either is unknown
+func requestEfficiency(used, requested int64) float64 {
+	if used <= 0 || requested <= 0 {
+		return 0
+	}
+	return float64(used) / float64(requested) * 100
+}
+
+// renderEfficiency renders a request efficiency colored by how much of the
+// reservation is wasted: red when low, yellow when fair, green otherwise
+func renderEfficiency(percent float64) string {
+	value := fmt.Sprintf("%.0f%%", percent)
+	switch {
+	case percent < efficiencyLowPercent:
+		return StyleDanger.Render(value)
+	case percent < efficiencyFairPercent:
+		return StyleWarning.Render(value)
+	default:
+		return StyleStatusRunning.Render(value)
+	}
+}
+
+// formatEfficiency renders a request efficiency, or "-" when it is unknown
+func formatEfficiency(percent float64) string {
+	if percent <= 0 {
+		return StyleTextMuted.Render("-")
+	}
+	return renderEfficiency(percent)
+}
@@ -0,0 +1,71 @@
+package ui
+
+// resize applies a new terminal size and rescales the scroll offsets so the
+// content that was visible stays roughly centered instead of jumping. List
+// views keep their selected row visible and logs keep following the bottom
+// while auto-scrolling. Column widths are fitted to the new width on the next
+// render.
+func (m *Model) resize(width, height int) {
+	oldHeight := m.height
+	oldLogLines := 0
+	if m.logsMode && m.logsWrap && width != m.width {
+		oldLogLines = m.getLogsDisplayLineCount()
+	}
+
+	m.width = width
+	m.height = height
+	if oldHeight <= 0 {
+		return // First size message: nothing has been laid out yet
+	}
+
+	// Growing the window by n lines shows n/2 more lines above and below
+	// the previous center
+	shift := (oldHeight - height) / 2
+
+	// Only the scroll offset on screen moves; the list behind logs and
+	// detail views is left as it was
+	if m.logsMode {
+		// Rewrapping changes the number of display lines: keep the same
+		// fraction of the logs scrolled past
+		if oldLogLines > 0 {
+			m.logsScrollOffset = m.logsScrollOffset * m.getLogsDisplayLineCount() / oldLogLines
+		}
+		m.logsScrollOffset = max(m.logsScrollOffset+shift, 0)
+		m.clampLogsScroll()
+		return
+	}
+	if m.detailMode {
+		m.detailScrollOffset = max(min(m.detailScrollOffset+shift, m.detailMaxScroll()), 0)
+		return
+	}
+
+	// Overview and Network scroll by line, the heatmap follows its selected
+	// row on render and the other lists scroll by selected item
+	switch m.currentView {
+	case ViewHeatmap:
+		return
+	case ViewOverview, ViewNetwork:
+		m.scrollOffset = max(m.scrollOffset+shift, 0)
+		return
+	}
+	m.scrollOffset = max(m.scrollOffset+shift, 0)
+	maxVisible := m.listVisibleRows()
+	if m.selectedIndex < m.scrollOffset {
+		m.scrollOffset = m.selectedIndex
+	} else if m.selectedIndex >= m.scrollOffset+maxVisible {
+		m.scrollOffset = m.selectedIndex - maxVisible + 1
+	}
+	m.scrollOffset = max(m.scrollOffset, 0)
+}
+
+// noteDetailLines records the layout of the detail view being rendered, so
+// a resize can clamp detailScrollOffset to the content
+func (m *Model) noteDetailLines(totalLines, maxVisible int) {
+	m.detailLines, m.detailReservedRows = totalLines, m.height-maxVisible
+}
+
+// detailMaxScroll returns the largest detail scroll offset at the current
+// height, based on the last rendered detail view
+func (m *Model) detailMaxScroll() int {
+	return max(m.detailLines-max(m.height-m.detailReservedRows, 1), 0)
+}
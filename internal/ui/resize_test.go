@@ -0,0 +1,144 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func newResizeTestModel(width, height int) *Model {
+	m := NewModel(nil, zap.NewNop(), 2*time.Second, "en", "test", 100)
+	m.resize(width, height)
+	return m
+}
+
+func TestResizeFirstSizeKeepsOffsets(t *testing.T) {
+	m := NewModel(nil, zap.NewNop(), 2*time.Second, "en", "test", 100)
+	m.currentView = ViewNodes
+	m.scrollOffset, m.selectedIndex = 7, 7
+
+	m.resize(120, 40)
+	if m.width != 120 || m.height != 40 {
+		t.Errorf("size = %dx%d, want 120x40", m.width, m.height)
+	}
+	if m.scrollOffset != 7 {
+		t.Errorf("scrollOffset = %d, want 7 (nothing laid out before the first size)", m.scrollOffset)
+	}
+}
+
+func TestResizeListKeepsCenter(t *testing.T) {
+	m := newResizeTestModel(160, 60)
+	m.currentView = ViewNodes
+	m.scrollOffset, m.selectedIndex = 30, 60 // Rows 30-79 visible, centered on 55
+
+	m.resize(160, 30)
+	if m.scrollOffset != 45 {
+		t.Errorf("scrollOffset after shrink = %d, want 45 (rows 45-64 keep the center)", m.scrollOffset)
+	}
+	m.resize(160, 60)
+	if m.scrollOffset != 30 {
+		t.Errorf("scrollOffset after grow = %d, want 30", m.scrollOffset)
+	}
+
+	// Growing past the top clamps at the first row
+	m.resize(160, 200)
+	if m.scrollOffset != 0 {
+		t.Errorf("scrollOffset after large grow = %d, want 0", m.scrollOffset)
+	}
+}
+
+func TestResizeListKeepsSelectionVisible(t *testing.T) {
+	tests := []struct {
+		name     string
+		selected int
+		expected int
+	}{
+		{"selection above the centered window", 31, 31},
+		{"selection inside the centered window", 50, 45},
+		{"selection below the centered window", 78, 59},
+	}
+
+	for _, tt := range tests {
+		m := newResizeTestModel(160, 60)
+		m.currentView = ViewPods
+		m.scrollOffset, m.selectedIndex = 30, tt.selected
+
+		m.resize(160, 30)
+		if m.scrollOffset != tt.expected {
+			t.Errorf("%s: scrollOffset = %d, want %d", tt.name, m.scrollOffset, tt.expected)
+		}
+		if rows := m.listVisibleRows(); m.selectedIndex < m.scrollOffset || m.selectedIndex >= m.scrollOffset+rows {
+			t.Errorf("%s: selected row %d outside visible rows %d-%d", tt.name, m.selectedIndex, m.scrollOffset, m.scrollOffset+rows-1)
+		}
+	}
+}
+
+func TestResizeLineScrolledViews(t *testing.T) {
+	m := newResizeTestModel(160, 60)
+	m.currentView = ViewOverview
+	m.scrollOffset = 10
+
+	m.resize(160, 40)
+	if m.scrollOffset != 20 {
+		t.Errorf("overview scrollOffset after shrink = %d, want 20", m.scrollOffset)
+	}
+
+	// Detail views move their own offset and leave the list behind them alone
+	m.currentView = ViewNodes
+	m.detailMode = true
+	m.scrollOffset, m.selectedIndex, m.detailScrollOffset = 30, 60, 5
+	m.noteDetailLines(40, 30) // 40 content lines, 10 rows of header and footer
+	m.resize(160, 20)
+	if m.detailScrollOffset != 15 {
+		t.Errorf("detailScrollOffset after shrink = %d, want 15", m.detailScrollOffset)
+	}
+	if m.scrollOffset != 30 {
+		t.Errorf("list scrollOffset in detail mode = %d, want 30", m.scrollOffset)
+	}
+
+	// Growing past the content clamps the detail offset like the logs
+	m.detailScrollOffset = 28
+	m.resize(160, 36)
+	if m.detailScrollOffset != 14 {
+		t.Errorf("detailScrollOffset after grow = %d, want 14 (40 lines, 26 visible)", m.detailScrollOffset)
+	}
+}
+
+func TestResizeLogs(t *testing.T) {
+	m := newResizeTestModel(200, 60)
+	m.currentView = ViewPods
+	m.detailMode, m.logsMode, m.logsWrap = true, true, true
+	m.scrollOffset, m.selectedIndex = 30, 60
+	for i := 0; i < 100; i++ {
+		m.cachedLogLines = append(m.cachedLogLines, strings.Repeat("x", 150))
+	}
+	m.logsScrollOffset = 40
+
+	// 150-character lines fit at width 200 and wrap in two at width 88:
+	// the offset keeps the same fraction of the logs scrolled past
+	m.resize(88, 60)
+	if got := m.getLogsDisplayLineCount(); got != 200 {
+		t.Fatalf("display lines after rewrap = %d, want 200", got)
+	}
+	if m.logsScrollOffset != 80 {
+		t.Errorf("logsScrollOffset after rewrap = %d, want 80", m.logsScrollOffset)
+	}
+	if m.scrollOffset != 30 {
+		t.Errorf("list scrollOffset in logs mode = %d, want 30", m.scrollOffset)
+	}
+
+	// Shrinking shifts the offset by half the lost height
+	m.resize(88, 40)
+	if m.logsScrollOffset != 90 {
+		t.Errorf("logsScrollOffset after shrink = %d, want 90", m.logsScrollOffset)
+	}
+
+	// Auto-scrolling logs stay at the bottom
+	m.logsAutoScroll = true
+	m.resize(88, 50)
+	if want := 200 - (50 - 8); m.logsScrollOffset != want {
+		t.Errorf("auto-scrolled logsScrollOffset = %d, want %d", m.logsScrollOffset, want)
+	}
+}
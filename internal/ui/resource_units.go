@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SetRawUnits switches resource formatting between humanized units (1.5,
+// 2.0Gi) and the exact millicores and bytes kubectl reports (1500m, 2147483648)
+func (m *Model) SetRawUnits(raw bool) {
+	m.rawUnits = raw
+}
+
+// toggleRawUnits switches between humanized and raw resource units
+func (m *Model) toggleRawUnits() {
+	m.SetRawUnits(!m.rawUnits)
+}
+
+// formatRawCPU formats millicores exactly, e.g. "1500m"
+func formatRawCPU(millicores int64) string {
+	return fmt.Sprintf("%dm", millicores)
+}
+
+// formatRawMemory formats bytes exactly, without a unit suffix like kubectl
+func formatRawMemory(bytes int64) string {
+	return strconv.FormatInt(bytes, 10)
+}
+
+// formatMemoryBytes formats a memory resource like FormatBytes, or exactly
+// when raw is set
+func formatMemoryBytes(bytes int64, raw bool) string {
+	if raw {
+		return formatRawMemory(bytes)
+	}
+	return FormatBytes(bytes)
+}
@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestRawUnitsStayOnTheirModel(t *testing.T) {
+	raw := NewModel(nil, zap.NewNop(), 2*time.Second, "en", "test", 100)
+	raw.SetRawUnits(true)
+	humanized := NewModel(nil, zap.NewNop(), 2*time.Second, "en", "test", 100)
+
+	if got := FormatMillicores(1500, raw.rawUnits); got != "1500m" {
+		t.Errorf("raw FormatMillicores(1500) = %q, want 1500m", got)
+	}
+	if got := FormatMillicores(1500, humanized.rawUnits); got != "1.50" {
+		t.Errorf("humanized FormatMillicores(1500) = %q, want 1.50", got)
+	}
+	if got := formatMemoryBytes(2<<30, raw.rawUnits); got != "2147483648" {
+		t.Errorf("raw formatMemoryBytes(2Gi) = %q, want 2147483648", got)
+	}
+
+	// Sizes that are not CPU/memory resources stay humanized in raw mode
+	if got := FormatBytes(2 << 30); got != "2.0 GiB" {
+		t.Errorf("FormatBytes(2Gi) = %q, want 2.0 GiB", got)
+	}
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// resourceYAMLGetter is implemented by data providers that can fetch live objects as YAML
+type resourceYAMLGetter interface {
+	GetResourceYAML(ctx context.Context, kind, namespace, name string) (string, error)
+}
+
+// selectedResourceRef returns the object shown in the current detail view
+func (m *Model) selectedResourceRef() (kind, namespace, name string, ok bool) {
+	switch m.currentView {
+	case ViewPodDetail:
+		if m.selectedPod != nil {
+			return "Pod", m.selectedPod.Namespace, m.selectedPod.Name, true
+		}
+	case ViewNodeDetail:
+		if m.selectedNode != nil {
+			return "Node", "", m.selectedNode.Name, true
+		}
+	case ViewDeploymentDetail:
+		if m.selectedDeployment != nil {
+			return "Deployment", m.selectedDeployment.Namespace, m.selectedDeployment.Name, true
+		}
+	case ViewStatefulSetDetail:
+		if m.selectedStatefulSet != nil {
+			return "StatefulSet", m.selectedStatefulSet.Namespace, m.selectedStatefulSet.Name, true
+		}
+	case ViewServiceDetail:
+		if m.selectedService != nil {
+			return "Service", m.selectedService.Namespace, m.selectedService.Name, true
+		}
+	}
+	return "", "", "", false
+}
+
+// fetchResourceYAML loads the object of the current detail view as YAML into
+// the command output viewer
+func (m *Model) fetchResourceYAML() tea.Cmd {
+	return m.withResourceYAML(func(title, content string) tea.Msg {
+		return commandOutputMsg{
+			title:   title,
+			content: content,
+		}
+	})
+}
+
+// copyResourceYAML copies the object of the current detail view as YAML to the clipboard
+func (m *Model) copyResourceYAML() tea.Cmd {
+	return m.withResourceYAML(func(title, content string) tea.Msg {
+		return m.writeClipboard(content, title)
+	})
+}
+
+// withResourceYAML fetches the object of the current detail view as YAML and
+// hands it to done; fetch errors are shown in the command output viewer
+func (m *Model) withResourceYAML(done func(title, content string) tea.Msg) tea.Cmd {
+	kind, namespace, name, ok := m.selectedResourceRef()
+	if !ok {
+		return nil
+	}
+
+	return func() tea.Msg {
+		getter, ok := m.dataProvider.(resourceYAMLGetter)
+		if !ok {
+			return commandOutputMsg{
+				title:   "Error",
+				content: "API client does not support YAML export",
+				err:     fmt.Errorf("unsupported operation"),
+			}
+		}
+
+		content, err := getter.GetResourceYAML(context.Background(), kind, namespace, name)
+		if err != nil {
+			return commandOutputMsg{
+				title:   "Get YAML Error",
+				content: err.Error(),
+				err:     err,
+			}
+		}
+
+		return done(fmt.Sprintf("YAML: %s %s", strings.ToLower(kind), resourceRefString(namespace, name)), content)
+	}
+}
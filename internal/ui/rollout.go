@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// renderRollout renders the rollout cell of a deployment or statefulset: a
+// spinner, a bar of the replicas on the latest template and its percentage
+// while rolling out, "-" otherwise
+func (m *Model) renderRollout(rolling bool, percent float64, width int) string {
+	if !rolling {
+		return StyleTextMuted.Render("-")
+	}
+	label := fmt.Sprintf(" %3.0f%%", percent)
+	barWidth := width - 2 - len(label)
+	if barWidth < 3 {
+		return StyleWarning.Render(m.spinnerFrame() + label)
+	}
+	filled := int(percent / 100 * float64(barWidth))
+	bar := StyleHighlight.Render(strings.Repeat("█", filled)) + StyleTextMuted.Render(strings.Repeat("░", barWidth-filled))
+	return StyleWarning.Render(m.spinnerFrame()) + " " + bar + StyleWarning.Render(label)
+}
+
+// renderRollingCount renders the number of workloads mid-rollout for a
+// section header, "" when none is
+func (m *Model) renderRollingCount(rolling int) string {
+	if rolling == 0 {
+		return ""
+	}
+	return "  " + StyleWarning.Render(m.TF("workloads.rolling", map[string]interface{}{"Count": rolling}))
+}
@@ -3,7 +3,6 @@ package ui
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/yourusername/k8s-monitor/internal/model"
 )
@@ -35,6 +34,10 @@ func (m *Model) renderServiceDetail() string {
 	sections = append(sections, m.renderServiceSelector(svc))
 	sections = append(sections, "")
 
+	// Annotations
+	sections = append(sections, strings.Join(m.renderAnnotations(svc.Annotations), "\n"))
+	sections = append(sections, "")
+
 	// Pods backing this service
 	sections = append(sections, m.renderServicePods(svc))
 
@@ -49,6 +52,7 @@ func (m *Model) renderServiceDetail() string {
 	}
 
 	// Clamp scroll offset
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
@@ -101,15 +105,33 @@ func (m *Model) renderServiceBasicInfo(svc *model.ServiceData) string {
 	if svcType == "" {
 		svcType = "ClusterIP"
 	}
-	info = append(info, fmt.Sprintf("  %s: %s",
+	typeNote := ""
+	switch {
+	case svc.Headless:
+		typeNote = StyleTextMuted.Render(" (headless: DNS returns the pod IPs)")
+	case svc.Type == "ExternalName":
+		typeNote = StyleTextMuted.Render(" (DNS CNAME, no proxying)")
+	}
+	info = append(info, fmt.Sprintf("  %s: %s%s",
 		StyleTextSecondary.Render("Type"),
-		StyleHighlight.Render(svcType)))
+		StyleHighlight.Render(svcType), typeNote))
 
 	// Cluster IP
-	if svc.ClusterIP != "" {
+	if svc.Headless {
 		info = append(info, fmt.Sprintf("  %s: %s",
 			StyleTextSecondary.Render("Cluster IP"),
-			svc.ClusterIP))
+			StyleTextMuted.Render("None (headless)")))
+	} else if svc.ClusterIP != "" {
+		info = append(info, fmt.Sprintf("  %s: %s",
+			StyleTextSecondary.Render("Cluster IP"),
+			formatIPs(svc.ClusterIP, svc.ClusterIPs)))
+	}
+
+	// ExternalName target
+	if svc.ExternalName != "" {
+		info = append(info, fmt.Sprintf("  %s: %s",
+			StyleTextSecondary.Render("External Name"),
+			StyleHighlight.Render(svc.ExternalName)))
 	}
 
 	// External IPs
@@ -126,18 +148,21 @@ func (m *Model) renderServiceBasicInfo(svc *model.ServiceData) string {
 			svc.LoadBalancerIP))
 	}
 
-	// Ingress
+	// Ingress, which a LoadBalancer without one is still waiting for
 	if len(svc.Ingress) > 0 {
 		info = append(info, fmt.Sprintf("  %s: %s",
 			StyleTextSecondary.Render("Ingress"),
 			strings.Join(svc.Ingress, ", ")))
+	} else if svc.Type == "LoadBalancer" {
+		info = append(info, fmt.Sprintf("  %s: %s",
+			StyleTextSecondary.Render("Ingress"),
+			StyleWarning.Render("⚠  Pending (no load balancer address assigned yet)")))
 	}
 
 	// Age
-	age := time.Since(svc.CreationTimestamp)
 	info = append(info, fmt.Sprintf("  %s: %s",
 		StyleTextSecondary.Render("Age"),
-		formatAge(age)))
+		m.formatAgeOrTime(svc.CreationTimestamp)))
 
 	return strings.Join(info, "\n")
 }
@@ -149,14 +174,43 @@ func (m *Model) renderServiceEndpoints(svc *model.ServiceData) string {
 	info = append(info, StyleSubHeader.Render("Endpoints"))
 	info = append(info, "")
 
+	// ExternalName services are resolved by DNS and never have endpoints
+	if svc.Type == "ExternalName" {
+		info = append(info, StyleTextMuted.Render(fmt.Sprintf("  No endpoints: DNS resolves to %s", svc.ExternalName)))
+		return strings.Join(info, "\n")
+	}
+
 	endpointCount := svc.EndpointCount
 	if endpointCount == 0 {
 		info = append(info, StyleWarning.Render(fmt.Sprintf("  ⚠  No ready endpoints (%d)", endpointCount)))
-		info = append(info, StyleTextMuted.Render("  This service has no pods backing it or pods are not ready"))
 	} else {
 		info = append(info, StyleStatusReady.Render(fmt.Sprintf("  ✓ %d ready endpoint(s)", endpointCount)))
 	}
 
+	// Tell a selector that matches nothing apart from pods that are not ready
+	if len(svc.Selector) > 0 && m.clusterData != nil {
+		pods := m.getServicePods(svc)
+		ready := 0
+		for _, pod := range pods {
+			if isPodReady(pod) {
+				ready++
+			}
+		}
+		switch {
+		case len(pods) == 0:
+			info = append(info, StyleDanger.Render("  ✗ Selector matches no pods"))
+			info = append(info, StyleTextMuted.Render("  Check the selector labels against the pod template labels"))
+		case ready == 0:
+			info = append(info, StyleWarning.Render(fmt.Sprintf("  ⚠  Selector matches %d pod(s), none of them ready", len(pods))))
+		case ready < len(pods):
+			info = append(info, StyleWarning.Render(fmt.Sprintf("  ⚠  %d/%d matching pods ready", ready, len(pods))))
+		default:
+			info = append(info, StyleTextMuted.Render(fmt.Sprintf("  %d/%d matching pods ready", ready, len(pods))))
+		}
+	} else if endpointCount == 0 {
+		info = append(info, StyleTextMuted.Render("  This service has no pods backing it or pods are not ready"))
+	}
+
 	return strings.Join(info, "\n")
 }
 
@@ -249,26 +303,13 @@ func (m *Model) renderServiceSelector(svc *model.ServiceData) string {
 	return strings.Join(info, "\n")
 }
 
-// renderServicePods renders pods backing this service
-func (m *Model) renderServicePods(svc *model.ServiceData) string {
-	var info []string
-
-	if m.clusterData == nil || len(m.clusterData.Pods) == 0 {
-		info = append(info, StyleSubHeader.Render("Backing Pods"))
-		info = append(info, "")
-		info = append(info, StyleTextMuted.Render("  No pod information available"))
-		return strings.Join(info, "\n")
+// getServicePods returns the pods in the service's namespace whose labels
+// match its selector
+func (m *Model) getServicePods(svc *model.ServiceData) []*model.PodData {
+	if m.clusterData == nil || len(svc.Selector) == 0 {
+		return nil
 	}
 
-	// Check if service has a selector
-	if len(svc.Selector) == 0 {
-		info = append(info, StyleSubHeader.Render("Backing Pods"))
-		info = append(info, "")
-		info = append(info, StyleTextMuted.Render("  No selector configured (ExternalName, manual Endpoints, or headless service)"))
-		return strings.Join(info, "\n")
-	}
-
-	// Find pods that match the service selector
 	var matchingPods []*model.PodData
 	for _, pod := range m.clusterData.Pods {
 		// Check if pod is in same namespace
@@ -289,6 +330,29 @@ func (m *Model) renderServicePods(svc *model.ServiceData) string {
 			matchingPods = append(matchingPods, pod)
 		}
 	}
+	return matchingPods
+}
+
+// renderServicePods renders pods backing this service
+func (m *Model) renderServicePods(svc *model.ServiceData) string {
+	var info []string
+
+	if m.clusterData == nil || len(m.clusterData.Pods) == 0 {
+		info = append(info, StyleSubHeader.Render("Backing Pods"))
+		info = append(info, "")
+		info = append(info, StyleTextMuted.Render("  No pod information available"))
+		return strings.Join(info, "\n")
+	}
+
+	// Check if service has a selector
+	if len(svc.Selector) == 0 {
+		info = append(info, StyleSubHeader.Render("Backing Pods"))
+		info = append(info, "")
+		info = append(info, StyleTextMuted.Render("  No selector configured (ExternalName, manual Endpoints, or headless service)"))
+		return strings.Join(info, "\n")
+	}
+
+	matchingPods := m.getServicePods(svc)
 
 	info = append(info, StyleSubHeader.Render(fmt.Sprintf("Backing Pods (%d)", len(matchingPods))))
 	info = append(info, "")
@@ -300,8 +364,11 @@ func (m *Model) renderServicePods(svc *model.ServiceData) string {
 
 	// Table header
 	const (
-		colName     = 38
+		colName     = 32
 		colPhase    = 11
+		colReady    = 7
+		colIP       = 15
+		colNode     = 18
 		colCPU      = 11
 		colMemory   = 11
 		colRx       = 10
@@ -309,9 +376,12 @@ func (m *Model) renderServicePods(svc *model.ServiceData) string {
 		colRestarts = 8
 	)
 
-	headerRow := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s",
+	headerRow := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s  %s  %s  %s",
 		padRight(m.T("columns.pod_name"), colName),
 		padRight(m.T("columns.phase"), colPhase),
+		padRight(m.T("columns.ready"), colReady),
+		padRight(m.T("columns.pod_ip"), colIP),
+		padRight(m.T("columns.node"), colNode),
 		padRight(m.T("columns.cpu"), colCPU),
 		padRight(m.T("columns.memory"), colMemory),
 		padRight(m.T("columns.rx"), colRx),
@@ -344,12 +414,12 @@ func (m *Model) renderServicePods(svc *model.ServiceData) string {
 			phaseStyled = StyleTextMuted.Render(phase)
 		}
 
-		cpuStr := FormatMillicores(pod.CPUUsage)
+		cpuStr := FormatMillicores(pod.CPUUsage, m.rawUnits)
 		if pod.CPUUsage == 0 {
 			cpuStr = StyleTextMuted.Render("-")
 		}
 
-		memStr := FormatBytes(pod.MemoryUsage)
+		memStr := formatMemoryBytes(pod.MemoryUsage, m.rawUnits)
 		if pod.MemoryUsage == 0 {
 			memStr = StyleTextMuted.Render("-")
 		}
@@ -373,9 +443,28 @@ func (m *Model) renderServicePods(svc *model.ServiceData) string {
 			restarts = StyleWarning.Render(restarts)
 		}
 
-		row := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s",
+		ready := fmt.Sprintf("%d/%d", pod.ReadyContainers, pod.Containers)
+		if isPodReady(pod) {
+			ready = StyleStatusReady.Render(ready)
+		} else {
+			ready = StyleStatusNotReady.Render(ready)
+		}
+
+		podIP := pod.PodIP
+		if podIP == "" {
+			podIP = StyleTextMuted.Render("-")
+		}
+		node := truncate(pod.Node, colNode)
+		if node == "" {
+			node = StyleTextMuted.Render("-")
+		}
+
+		row := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s  %s  %s  %s",
 			padRight(truncate(pod.Name, colName), colName),
 			padRight(phaseStyled, colPhase),
+			padRight(ready, colReady),
+			padRight(podIP, colIP),
+			padRight(node, colNode),
 			padRight(cpuStr, colCPU),
 			padRight(memStr, colMemory),
 			padRight(rxStr, colRx),
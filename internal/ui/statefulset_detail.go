@@ -3,7 +3,6 @@ package ui
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/yourusername/k8s-monitor/internal/model"
 )
@@ -16,6 +15,10 @@ func (m *Model) renderStatefulSetDetail() string {
 
 	sts := m.selectedStatefulSet
 
+	// Clamp the pod selection to the listed pods
+	pods := m.getStatefulSetPods(sts)
+	m.statefulSetPodSelectedIndex = clampWorkloadPodIndex(m.statefulSetPodSelectedIndex, pods)
+
 	// Build sections
 	var sections []string
 
@@ -45,11 +48,15 @@ func (m *Model) renderStatefulSetDetail() string {
 	}
 
 	// Clamp scroll offset
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
 	}
 	detailScrollOffset := m.detailScrollOffset
+	if len(pods) > 0 {
+		detailScrollOffset = followSelectedPod(lines, m.statefulSetPodSelectedIndex, detailScrollOffset, maxVisible)
+	}
 	if detailScrollOffset > maxScroll {
 		detailScrollOffset = maxScroll
 	}
@@ -93,10 +100,9 @@ func (m *Model) renderStatefulSetBasicInfo(sts *model.StatefulSetData) string {
 		sts.Namespace))
 
 	// Age
-	age := time.Since(sts.CreationTimestamp)
 	info = append(info, fmt.Sprintf("  %s: %s",
 		StyleTextSecondary.Render("Age"),
-		formatAge(age)))
+		m.formatAgeOrTime(sts.CreationTimestamp)))
 
 	return strings.Join(info, "\n")
 }
@@ -175,144 +181,5 @@ func (m *Model) renderStatefulSetSelector(sts *model.StatefulSetData) string {
 
 // renderStatefulSetPods renders pods managed by this statefulset
 func (m *Model) renderStatefulSetPods(sts *model.StatefulSetData) string {
-	var info []string
-
-	if m.clusterData == nil || len(m.clusterData.Pods) == 0 {
-		info = append(info, StyleSubHeader.Render("Managed Pods"))
-		info = append(info, "")
-		info = append(info, StyleTextMuted.Render("  No pod information available"))
-		return strings.Join(info, "\n")
-	}
-
-	// Check if statefulset has a selector
-	if len(sts.Selector) == 0 {
-		info = append(info, StyleSubHeader.Render("Managed Pods"))
-		info = append(info, "")
-		info = append(info, StyleTextMuted.Render("  No selector configured (matchLabels is empty)"))
-		return strings.Join(info, "\n")
-	}
-
-	// Find pods that match the statefulset selector
-	var matchingPods []*model.PodData
-	for _, pod := range m.clusterData.Pods {
-		// Check if pod is in same namespace
-		if pod.Namespace != sts.Namespace {
-			continue
-		}
-
-		// Check if pod labels match statefulset selector
-		matches := true
-		for selectorKey, selectorValue := range sts.Selector {
-			if podLabelValue, exists := pod.Labels[selectorKey]; !exists || podLabelValue != selectorValue {
-				matches = false
-				break
-			}
-		}
-
-		if matches {
-			matchingPods = append(matchingPods, pod)
-		}
-	}
-
-	info = append(info, StyleSubHeader.Render(fmt.Sprintf("Managed Pods (%d)", len(matchingPods))))
-	info = append(info, "")
-
-	if len(matchingPods) == 0 {
-		info = append(info, StyleTextMuted.Render("  No pods match this statefulset selector"))
-		return strings.Join(info, "\n")
-	}
-
-	// Table header
-	const (
-		colName     = 38
-		colPhase    = 11
-		colCPU      = 11
-		colMemory   = 11
-		colRx       = 10
-		colTx       = 10
-		colRestarts = 8
-	)
-
-	headerRow := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s",
-		padRight(m.T("columns.pod_name"), colName),
-		padRight(m.T("columns.phase"), colPhase),
-		padRight(m.T("columns.cpu"), colCPU),
-		padRight(m.T("columns.memory"), colMemory),
-		padRight(m.T("columns.rx"), colRx),
-		padRight(m.T("columns.tx"), colTx),
-		padRight(m.T("columns.restarts"), colRestarts),
-	)
-	info = append(info, StyleTextMuted.Render(headerRow))
-
-	// Show up to 20 pods
-	displayCount := len(matchingPods)
-	if displayCount > 20 {
-		displayCount = 20
-	}
-
-	for i := 0; i < displayCount; i++ {
-		pod := matchingPods[i]
-
-		phase := pod.Phase
-		var phaseStyled string
-		switch phase {
-		case "Running":
-			phaseStyled = StyleStatusRunning.Render(phase)
-		case "Succeeded":
-			phaseStyled = StyleStatusReady.Render(phase)
-		case "Failed":
-			phaseStyled = StyleStatusNotReady.Render(phase)
-		case "Pending":
-			phaseStyled = StyleStatusPending.Render(phase)
-		default:
-			phaseStyled = StyleTextMuted.Render(phase)
-		}
-
-		cpuStr := FormatMillicores(pod.CPUUsage)
-		if pod.CPUUsage == 0 {
-			cpuStr = StyleTextMuted.Render("-")
-		}
-
-		memStr := FormatBytes(pod.MemoryUsage)
-		if pod.MemoryUsage == 0 {
-			memStr = StyleTextMuted.Render("-")
-		}
-
-		// Network RX (download/receive)
-		rxRate := m.calculatePodNetworkRxRate(pod.Namespace, pod.Name)
-		rxStr := formatNetworkRate(rxRate)
-		if rxRate == 0 {
-			rxStr = StyleTextMuted.Render("-")
-		}
-
-		// Network TX (upload/send)
-		txRate := m.calculatePodNetworkTxRate(pod.Namespace, pod.Name)
-		txStr := formatNetworkRate(txRate)
-		if txRate == 0 {
-			txStr = StyleTextMuted.Render("-")
-		}
-
-		restarts := fmt.Sprintf("%d", pod.RestartCount)
-		if pod.RestartCount > 0 {
-			restarts = StyleWarning.Render(restarts)
-		}
-
-		row := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s",
-			padRight(truncate(pod.Name, colName), colName),
-			padRight(phaseStyled, colPhase),
-			padRight(cpuStr, colCPU),
-			padRight(memStr, colMemory),
-			padRight(rxStr, colRx),
-			padRight(txStr, colTx),
-			padRight(restarts, colRestarts),
-		)
-		info = append(info, row)
-	}
-
-	if len(matchingPods) > displayCount {
-		info = append(info, "")
-		info = append(info, StyleTextMuted.Render(fmt.Sprintf("  ... and %d more pods", len(matchingPods)-displayCount)))
-	}
-
-	return strings.Join(info, "\n")
+	return m.renderManagedPods(m.getStatefulSetPods(sts), m.statefulSetPodSelectedIndex, "No pods match this statefulset")
 }
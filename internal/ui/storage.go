@@ -7,7 +7,7 @@ import (
 	"github.com/yourusername/k8s-monitor/internal/model"
 )
 
-// renderStorage renders the storage view (PVs and PVCs)
+// renderStorage renders the storage view (StorageClasses, PVs and PVCs)
 func (m *Model) renderStorage() string {
 	if m.clusterData == nil {
 		return m.T("msg.no_data")
@@ -35,11 +35,17 @@ func (m *Model) renderStorage() string {
 				"Pending": summary.PendingPVCs,
 			}),
 			m.TF("storage.stats.size", map[string]interface{}{
-				"Used":    formatMemory(summary.UsedStorageSize),
-				"Total":   formatMemory(summary.TotalStorageSize),
+				"Used":    formatMemory(summary.UsedStorageSize, false),
+				"Total":   formatMemory(summary.TotalStorageSize, false),
 				"Percent": fmt.Sprintf("%.1f", summary.StorageUsagePercent),
 			}),
 		}
+		if full := m.fullVolumeCount(); full > 0 {
+			statLines = append(statLines, StyleDanger.Render("⚠ "+m.TF("storage.stats.full", map[string]interface{}{
+				"Count":   full,
+				"Percent": volumeFullPercent,
+			})))
+		}
 		lines = append(lines, statLines...)
 		lines = append(lines, "")
 	}
@@ -49,11 +55,8 @@ func (m *Model) renderStorage() string {
 	totalItems := totalPVs + totalPVCs
 
 	// Calculate max visible items based on screen height
-	// Use same value as global scroll logic (m.height - 10) for consistency
-	maxVisible := m.height - 10 // Reserve space for header, footer, tabs
-	if maxVisible < 5 {
-		maxVisible = 5
-	}
+	// Use the same rows as the global scroll logic for consistency
+	maxVisible := max(m.listVisibleRows(), 5)
 
 	// Clamp scroll offset to valid range
 	maxScroll := totalItems - maxVisible
@@ -73,6 +76,7 @@ func (m *Model) renderStorage() string {
 		colPVStatus       = 12
 		colPVClaim        = 20
 		colPVCapacity     = 12
+		colPVUsage        = volumeUsageBarWidth + 8
 		colPVStorageClass = 20
 
 		colPVCName         = 30
@@ -80,6 +84,7 @@ func (m *Model) renderStorage() string {
 		colPVCStatus       = 12
 		colPVCVolume       = 20
 		colPVCCapacity     = 12
+		colPVCUsage        = volumeUsageBarWidth + 8
 		colPVCStorageClass = 20
 	)
 
@@ -88,6 +93,12 @@ func (m *Model) renderStorage() string {
 	startItem := -1 // First item shown (for scroll indicator)
 	endItem := -1   // Last item shown (for scroll indicator)
 
+	// Render StorageClasses section (summary only, not selectable)
+	if len(m.clusterData.StorageClasses) > 0 {
+		lines = append(lines, m.renderStorageClasses()...)
+		lines = append(lines, "")
+	}
+
 	// Render PersistentVolumes section
 	if len(m.clusterData.PVs) > 0 {
 		pvHeader := StyleSubHeader.Render(m.T("storage.pvs.title"))
@@ -95,11 +106,12 @@ func (m *Model) renderStorage() string {
 		lines = append(lines, renderSeparator(m.width))
 
 		// Table header
-		headerLine := fmt.Sprintf("%s  %s  %s  %s  %s",
+		headerLine := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
 			padRight(m.T("columns.name"), colPVName),
 			padRight(m.T("columns.status"), colPVStatus),
 			padRight(m.T("columns.claim"), colPVClaim),
 			padRight(m.T("columns.capacity"), colPVCapacity),
+			padRight(m.T("columns.usage"), colPVUsage),
 			padRight(m.T("columns.storageclass"), colPVStorageClass))
 		lines = append(lines, StyleTextMuted.Render(headerLine))
 		lines = append(lines, renderSeparator(m.width))
@@ -121,7 +133,7 @@ func (m *Model) renderStorage() string {
 			}
 			endItem = idx
 
-			pvLine := m.renderPVRow(pv, idx, colPVName, colPVStatus, colPVClaim, colPVCapacity, colPVStorageClass)
+			pvLine := m.renderPVRow(pv, idx, colPVName, colPVStatus, colPVClaim, colPVCapacity, colPVUsage, colPVStorageClass)
 			lines = append(lines, pvLine)
 			rendered++
 		}
@@ -135,12 +147,13 @@ func (m *Model) renderStorage() string {
 		lines = append(lines, renderSeparator(m.width))
 
 		// Table header
-		headerLine := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
+		headerLine := fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s",
 			padRight(m.T("columns.name"), colPVCName),
 			padRight(m.T("columns.namespace"), colPVCNamespace),
 			padRight(m.T("columns.status"), colPVCStatus),
 			padRight(m.T("columns.volume"), colPVCVolume),
 			padRight(m.T("columns.capacity"), colPVCCapacity),
+			padRight(m.T("columns.usage"), colPVCUsage),
 			padRight(m.T("columns.storageclass"), colPVCStorageClass))
 		lines = append(lines, StyleTextMuted.Render(headerLine))
 		lines = append(lines, renderSeparator(m.width))
@@ -163,7 +176,7 @@ func (m *Model) renderStorage() string {
 			}
 			endItem = virtualIdx
 
-			pvcLine := m.renderPVCRow(pvc, virtualIdx, colPVCName, colPVCNamespace, colPVCStatus, colPVCVolume, colPVCCapacity, colPVCStorageClass)
+			pvcLine := m.renderPVCRow(pvc, virtualIdx, colPVCName, colPVCNamespace, colPVCStatus, colPVCVolume, colPVCCapacity, colPVCUsage, colPVCStorageClass)
 			lines = append(lines, pvcLine)
 			rendered++
 		}
@@ -184,7 +197,7 @@ func (m *Model) renderStorage() string {
 }
 
 // renderPVRow renders a single PV row
-func (m *Model) renderPVRow(pv *model.PVData, index int, colName, colStatus, colClaim, colCapacity, colStorageClass int) string {
+func (m *Model) renderPVRow(pv *model.PVData, index int, colName, colStatus, colClaim, colCapacity, colUsage, colStorageClass int) string {
 	// Truncate name if too long
 	name := truncate(pv.Name, colName)
 
@@ -209,7 +222,7 @@ func (m *Model) renderPVRow(pv *model.PVData, index int, colName, colStatus, col
 	claim = truncate(claim, colClaim)
 
 	// Capacity
-	capacity := formatMemory(pv.Capacity)
+	capacity := formatMemory(pv.Capacity, false)
 
 	// StorageClass
 	storageClass := pv.StorageClass
@@ -219,11 +232,12 @@ func (m *Model) renderPVRow(pv *model.PVData, index int, colName, colStatus, col
 	storageClass = truncate(storageClass, colStorageClass)
 
 	// Build line with proper padding
-	line := fmt.Sprintf("%s  %s  %s  %s  %s",
+	line := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
 		padRight(name, colName),
 		padRight(status, colStatus),
 		padRight(claim, colClaim),
 		padRight(capacity, colCapacity),
+		padRight(renderVolumeUsage(pv.Usage), colUsage),
 		padRight(storageClass, colStorageClass),
 	)
 
@@ -236,7 +250,7 @@ func (m *Model) renderPVRow(pv *model.PVData, index int, colName, colStatus, col
 }
 
 // renderPVCRow renders a single PVC row
-func (m *Model) renderPVCRow(pvc *model.PVCData, index int, colName, colNamespace, colStatus, colVolume, colCapacity, colStorageClass int) string {
+func (m *Model) renderPVCRow(pvc *model.PVCData, index int, colName, colNamespace, colStatus, colVolume, colCapacity, colUsage, colStorageClass int) string {
 	// Truncate name if too long
 	name := truncate(pvc.Name, colName)
 
@@ -264,25 +278,30 @@ func (m *Model) renderPVCRow(pvc *model.PVCData, index int, colName, colNamespac
 	// Capacity
 	var capacity string
 	if pvc.Capacity > 0 {
-		capacity = formatMemory(pvc.Capacity)
+		capacity = formatMemory(pvc.Capacity, false)
 	} else {
 		capacity = "-"
 	}
 
-	// StorageClass
+	// StorageClass (flag Pending claims whose class does not exist)
 	storageClass := pvc.StorageClass
 	if storageClass == "" {
 		storageClass = "-"
 	}
-	storageClass = truncate(storageClass, colStorageClass)
+	if m.storageClassMissing(pvc) {
+		storageClass = StyleDanger.Render(truncate("✗ "+storageClass, colStorageClass))
+	} else {
+		storageClass = truncate(storageClass, colStorageClass)
+	}
 
 	// Build line with proper padding
-	line := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
+	line := fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s",
 		padRight(name, colName),
 		padRight(namespace, colNamespace),
 		padRight(status, colStatus),
 		padRight(volume, colVolume),
 		padRight(capacity, colCapacity),
+		padRight(renderVolumeUsage(pvc.Usage), colUsage),
 		padRight(storageClass, colStorageClass),
 	)
 
@@ -293,3 +312,63 @@ func (m *Model) renderPVCRow(pvc *model.PVCData, index int, colName, colNamespac
 
 	return line
 }
+
+// renderStorageClasses renders the StorageClasses summary table
+func (m *Model) renderStorageClasses() []string {
+	const (
+		colSCName        = 30
+		colSCProvisioner = 30
+		colSCReclaim     = 10
+		colSCBinding     = 22
+		colSCDefault     = 8
+	)
+
+	var lines []string
+	lines = append(lines, StyleSubHeader.Render(m.T("storage.classes.title")))
+	lines = append(lines, renderSeparator(m.width))
+
+	headerLine := fmt.Sprintf("%s  %s  %s  %s  %s",
+		padRight(m.T("columns.name"), colSCName),
+		padRight(m.T("columns.provisioner"), colSCProvisioner),
+		padRight(m.T("columns.reclaim"), colSCReclaim),
+		padRight(m.T("columns.binding_mode"), colSCBinding),
+		padRight(m.T("columns.default"), colSCDefault))
+	lines = append(lines, StyleTextMuted.Render(headerLine))
+	lines = append(lines, renderSeparator(m.width))
+
+	for _, sc := range m.clusterData.StorageClasses {
+		isDefault := ""
+		if sc.IsDefault {
+			isDefault = StyleStatusReady.Render("✓")
+		}
+
+		reclaim := sc.ReclaimPolicy
+		if reclaim == "Retain" {
+			reclaim = StyleWarning.Render(reclaim)
+		}
+
+		lines = append(lines, fmt.Sprintf("%s  %s  %s  %s  %s",
+			padRight(truncate(sc.Name, colSCName), colSCName),
+			padRight(truncate(sc.Provisioner, colSCProvisioner), colSCProvisioner),
+			padRight(reclaim, colSCReclaim),
+			padRight(sc.VolumeBindingMode, colSCBinding),
+			padRight(isDefault, colSCDefault),
+		))
+	}
+
+	return lines
+}
+
+// storageClassMissing reports whether a Pending PVC references a StorageClass
+// that does not exist (usually a typo). Only checked when classes were listed.
+func (m *Model) storageClassMissing(pvc *model.PVCData) bool {
+	if pvc.Status != "Pending" || pvc.StorageClass == "" || m.clusterData == nil || len(m.clusterData.StorageClasses) == 0 {
+		return false
+	}
+	for _, sc := range m.clusterData.StorageClasses {
+		if sc.Name == pvc.StorageClass {
+			return false
+		}
+	}
+	return true
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"net"
 	"regexp"
 	"strings"
 
@@ -9,116 +10,57 @@ import (
 	"github.com/mattn/go-runewidth"
 )
 
-// Color scheme
+// Color scheme of the active theme (see ApplyTheme)
 var (
 	// Primary colors
-	ColorPrimary   = lipgloss.Color("#00D9FF")
-	ColorSecondary = lipgloss.Color("#7C3AED")
-	ColorSuccess   = lipgloss.Color("#10B981")
-	ColorWarning   = lipgloss.Color("#F59E0B")
-	ColorDanger    = lipgloss.Color("#EF4444")
-	ColorInfo      = lipgloss.Color("#3B82F6")
+	ColorPrimary   lipgloss.Color
+	ColorSecondary lipgloss.Color
+	ColorSuccess   lipgloss.Color
+	ColorCaution   lipgloss.Color
+	ColorWarning   lipgloss.Color
+	ColorDanger    lipgloss.Color
+	ColorInfo      lipgloss.Color
 
 	// Text colors
-	ColorTextPrimary   = lipgloss.Color("#FFFFFF")
-	ColorTextSecondary = lipgloss.Color("#9CA3AF")
-	ColorTextMuted     = lipgloss.Color("#6B7280")
+	ColorTextPrimary   lipgloss.Color
+	ColorTextSecondary lipgloss.Color
+	ColorTextMuted     lipgloss.Color
+	ColorTextInverse   lipgloss.Color
 
 	// Background colors
-	ColorBgPrimary   = lipgloss.Color("#1F2937")
-	ColorBgSecondary = lipgloss.Color("#374151")
-	ColorBgHover     = lipgloss.Color("#4B5563")
+	ColorBgPrimary   lipgloss.Color
+	ColorBgSecondary lipgloss.Color
+	ColorBgHover     lipgloss.Color
 )
 
-// Common styles
+// Common styles of the active theme (see ApplyTheme)
 var (
-	// Title style
-	StyleTitle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorPrimary).
-			MarginBottom(1)
-
-	// Subtitle style
-	StyleSubtitle = lipgloss.NewStyle().
-			Foreground(ColorTextSecondary).
-			Italic(true)
-
-	// Header style
-	StyleHeader = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorTextPrimary).
-			Background(ColorBgSecondary).
-			Padding(0, 1)
-
-	// Sub-header style
-	StyleSubHeader = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(ColorSecondary)
-
-	// Status styles
-	StyleStatusReady = lipgloss.NewStyle().
-				Foreground(ColorSuccess).
-				Bold(true)
-
-	StyleStatusNotReady = lipgloss.NewStyle().
-				Foreground(ColorDanger).
-				Bold(true)
-
-	StyleStatusPending = lipgloss.NewStyle().
-				Foreground(ColorWarning).
-				Bold(true)
-
-	StyleStatusRunning = lipgloss.NewStyle().
-				Foreground(ColorInfo).
-				Bold(true)
-
-	// Key binding styles
-	StyleKey = lipgloss.NewStyle().
-			Foreground(ColorPrimary).
-			Bold(true)
-
-	StyleKeyDesc = lipgloss.NewStyle().
-			Foreground(ColorTextSecondary)
-
-	// Border styles
-	StyleBorder = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(ColorBgSecondary).
-			Padding(1, 2)
-
-	// Error style
-	StyleError = lipgloss.NewStyle().
-			Foreground(ColorDanger).
-			Bold(true)
-
-	// Highlight style
-	StyleHighlight = lipgloss.NewStyle().
-			Foreground(ColorPrimary).
-			Bold(true)
-
-	// Warning/Danger styles
-	StyleWarning = lipgloss.NewStyle().
-			Foreground(ColorWarning).
-			Bold(true)
-
-	StyleDanger = lipgloss.NewStyle().
-			Foreground(ColorDanger).
-			Bold(true)
-
-	// Text secondary and muted
-	StyleTextSecondary = lipgloss.NewStyle().
-				Foreground(ColorTextSecondary)
-
-	StyleTextMuted = lipgloss.NewStyle().
-			Foreground(ColorTextMuted)
-
-	// Selection style (for highlighting selected row in lists)
-	StyleSelected = lipgloss.NewStyle().
-			Background(ColorBgHover).
-			Foreground(ColorPrimary).
-			Bold(true)
+	StyleTitle          lipgloss.Style // Title style
+	StyleSubtitle       lipgloss.Style // Subtitle style
+	StyleHeader         lipgloss.Style // Header style
+	StyleSubHeader      lipgloss.Style // Sub-header style
+	StyleStatusReady    lipgloss.Style // Status styles
+	StyleStatusNotReady lipgloss.Style
+	StyleStatusPending  lipgloss.Style
+	StyleStatusRunning  lipgloss.Style
+	StyleKey            lipgloss.Style // Key binding styles
+	StyleKeyDesc        lipgloss.Style
+	StyleBorder         lipgloss.Style // Border style
+	StyleError          lipgloss.Style // Error style
+	StyleHighlight      lipgloss.Style // Highlight style
+	StyleWarning        lipgloss.Style // Warning/Danger styles
+	StyleDanger         lipgloss.Style
+	StyleTextSecondary  lipgloss.Style // Text secondary and muted
+	StyleTextMuted      lipgloss.Style
+	StyleSelected       lipgloss.Style // Selection style (for highlighting selected row in lists)
 )
 
+func init() {
+	// Start with the default theme; the app applies the configured one before the UI runs
+	theme, _ := NewTheme(DefaultThemeName, nil)
+	ApplyTheme(theme)
+}
+
 // FormatBytes formats bytes to human readable format
 func FormatBytes(bytes int64) string {
 	const unit = 1024
@@ -138,9 +80,10 @@ func FormatPercentage(value float64) string {
 	return fmt.Sprintf("%.1f%%", value)
 }
 
-// FormatMillicores formats millicores to human readable format
-func FormatMillicores(millicores int64) string {
-	if millicores < 1000 {
+// FormatMillicores formats millicores to human readable format, or exactly
+// when raw is set
+func FormatMillicores(millicores int64, raw bool) string {
+	if millicores < 1000 || raw {
 		return fmt.Sprintf("%dm", millicores)
 	}
 	return fmt.Sprintf("%.2f", float64(millicores)/1000.0)
@@ -219,6 +162,11 @@ func RenderSparkline(data []float64, width int) string {
 		return strings.Repeat(" ", width)
 	}
 
+	// Long histories are averaged into width buckets so the whole window is shown
+	if width > 0 && len(data) > width {
+		data = downsampleSeries(data, width)
+	}
+
 	// Sparkline characters from low to high
 	chars := []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
 
@@ -265,6 +213,51 @@ func RenderSparkline(data []float64, width int) string {
 	return string(result[:width])
 }
 
+// downsampleSeries averages data into the given number of equal-sized buckets
+func downsampleSeries(data []float64, buckets int) []float64 {
+	if buckets <= 0 || len(data) <= buckets {
+		return data
+	}
+
+	result := make([]float64, buckets)
+	for b := 0; b < buckets; b++ {
+		start := b * len(data) / buckets
+		end := (b + 1) * len(data) / buckets
+		var sum float64
+		for _, v := range data[start:end] {
+			sum += v
+		}
+		result[b] = sum / float64(end-start)
+	}
+	return result
+}
+
+// formatIPs renders the addresses of a pod, service or node. Dual-stack
+// objects have several, each labeled with its IP family, e.g.
+// "10.0.0.5 (IPv4), fd00::5 (IPv6)". primary is shown when ips is empty,
+// e.g. for data recorded before all addresses were captured.
+func formatIPs(primary string, ips []string) string {
+	if len(ips) == 0 {
+		return primary
+	}
+	if len(ips) == 1 {
+		return ips[0]
+	}
+	parts := make([]string, len(ips))
+	for i, ip := range ips {
+		parts[i] = ip + " " + StyleTextMuted.Render("("+ipFamily(ip)+")")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ipFamily returns "IPv6" for IPv6 addresses and "IPv4" otherwise
+func ipFamily(ip string) string {
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.To4() == nil {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
 // formatNetworkTraffic formats network bytes with color coding
 func formatNetworkTraffic(bytes int64) string {
 	if bytes == 0 {
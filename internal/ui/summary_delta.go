@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// summaryDeltaTTL is how long count changes stay visible after a refresh
+const summaryDeltaTTL = 5 * time.Second
+
+// summaryDelta holds the change of overview counts between two refreshes
+type summaryDelta struct {
+	totalNodes    int
+	readyNodes    int
+	notReadyNodes int
+	runningPods   int
+	pendingPods   int
+	failedPods    int
+}
+
+// diffSummaries returns the count changes from prev to cur, or nil when nothing changed
+func diffSummaries(prev, cur *model.ClusterSummary) *summaryDelta {
+	delta := summaryDelta{
+		totalNodes:    cur.TotalNodes - prev.TotalNodes,
+		readyNodes:    cur.ReadyNodes - prev.ReadyNodes,
+		notReadyNodes: cur.NotReadyNodes - prev.NotReadyNodes,
+		runningPods:   cur.RunningPods - prev.RunningPods,
+		pendingPods:   cur.PendingPods - prev.PendingPods,
+		failedPods:    cur.FailedPods - prev.FailedPods,
+	}
+	if delta == (summaryDelta{}) {
+		return nil
+	}
+	return &delta
+}
+
+// updateSummaryDelta compares a freshly loaded summary with the previous one.
+// The first load only records the baseline, so no delta is shown.
+func (m *Model) updateSummaryDelta(summary *model.ClusterSummary) {
+	if summary == nil {
+		return
+	}
+	m.summaryDelta = nil
+	if m.prevSummary != nil && m.refreshCounter > 1 {
+		m.summaryDelta = diffSummaries(m.prevSummary, summary)
+	}
+
+	// Keep a copy: the data source may reuse and update the same summary
+	prev := *summary
+	m.prevSummary = &prev
+}
+
+// activeSummaryDelta returns the count changes of the last refresh while they
+// are still recent enough to show
+func (m *Model) activeSummaryDelta() *summaryDelta {
+	if m.summaryDelta == nil || time.Since(m.lastUpdate) > summaryDeltaTTL {
+		return nil
+	}
+	return m.summaryDelta
+}
+
+// formatCountDelta renders a count change as " (+3)", or "" when unchanged
+func formatCountDelta(change int) string {
+	if change == 0 {
+		return ""
+	}
+	return " " + StyleTextMuted.Render(fmt.Sprintf("(%+d)", change))
+}
@@ -0,0 +1,309 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// DefaultThemeName is the theme used when none is configured
+const DefaultThemeName = "dark"
+
+// Palette holds the colors a theme is built from
+type Palette struct {
+	Primary   lipgloss.Color
+	Secondary lipgloss.Color
+	Success   lipgloss.Color
+	Caution   lipgloss.Color // Between success and warning, e.g. moderate usage
+	Warning   lipgloss.Color
+	Danger    lipgloss.Color
+	Info      lipgloss.Color
+
+	TextPrimary   lipgloss.Color
+	TextSecondary lipgloss.Color
+	TextMuted     lipgloss.Color
+	TextInverse   lipgloss.Color // Text on status-colored backgrounds
+
+	BgPrimary   lipgloss.Color
+	BgSecondary lipgloss.Color
+	BgHover     lipgloss.Color
+}
+
+// Theme holds the styles used across the UI
+type Theme struct {
+	Name    string
+	Palette Palette
+
+	Title          lipgloss.Style
+	Subtitle       lipgloss.Style
+	Header         lipgloss.Style
+	SubHeader      lipgloss.Style
+	StatusReady    lipgloss.Style
+	StatusNotReady lipgloss.Style
+	StatusPending  lipgloss.Style
+	StatusRunning  lipgloss.Style
+	Key            lipgloss.Style
+	KeyDesc        lipgloss.Style
+	Border         lipgloss.Style
+	Error          lipgloss.Style
+	Highlight      lipgloss.Style
+	Warning        lipgloss.Style
+	Danger         lipgloss.Style
+	TextSecondary  lipgloss.Style
+	TextMuted      lipgloss.Style
+	Selected       lipgloss.Style
+
+	// Log viewer styles
+	LogError    lipgloss.Style
+	LogWarn     lipgloss.Style
+	LogInfo     lipgloss.Style
+	LogDebug    lipgloss.Style
+	LogSuccess  lipgloss.Style
+	SearchMatch lipgloss.Style
+}
+
+// builtinPalettes are the themes selectable via --theme
+var builtinPalettes = map[string]Palette{
+	"dark": {
+		Primary:       "#00D9FF",
+		Secondary:     "#7C3AED",
+		Success:       "#10B981",
+		Caution:       "#EAB308",
+		Warning:       "#F59E0B",
+		Danger:        "#EF4444",
+		Info:          "#3B82F6",
+		TextPrimary:   "#FFFFFF",
+		TextSecondary: "#9CA3AF",
+		TextMuted:     "#6B7280",
+		TextInverse:   "#000000",
+		BgPrimary:     "#1F2937",
+		BgSecondary:   "#374151",
+		BgHover:       "#4B5563",
+	},
+	"light": {
+		Primary:       "#0369A1",
+		Secondary:     "#6D28D9",
+		Success:       "#047857",
+		Caution:       "#A16207",
+		Warning:       "#B45309",
+		Danger:        "#B91C1C",
+		Info:          "#1D4ED8",
+		TextPrimary:   "#111827",
+		TextSecondary: "#4B5563",
+		TextMuted:     "#6B7280",
+		TextInverse:   "#FFFFFF",
+		BgPrimary:     "#F9FAFB",
+		BgSecondary:   "#E5E7EB",
+		BgHover:       "#D1D5DB",
+	},
+	"high-contrast": {
+		Primary:       "#FFFF00",
+		Secondary:     "#FF87FF",
+		Success:       "#00FF00",
+		Caution:       "#FFFF87",
+		Warning:       "#FFAF00",
+		Danger:        "#FF5F5F",
+		Info:          "#5FD7FF",
+		TextPrimary:   "#FFFFFF",
+		TextSecondary: "#E4E4E4",
+		TextMuted:     "#BCBCBC",
+		TextInverse:   "#000000",
+		BgPrimary:     "#000000",
+		BgSecondary:   "#303030",
+		BgHover:       "#0000AF",
+	},
+}
+
+// ThemeNames returns the names of the built-in themes
+func ThemeNames() []string {
+	names := make([]string, 0, len(builtinPalettes))
+	for name := range builtinPalettes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// hexColorRegex matches #RGB and #RRGGBB colors
+var hexColorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// parseColor validates a hex color or an ANSI color number (0-255)
+func parseColor(value string) (lipgloss.Color, error) {
+	value = strings.TrimSpace(value)
+	if hexColorRegex.MatchString(value) {
+		return lipgloss.Color(value), nil
+	}
+	if n, err := strconv.Atoi(value); err == nil && n >= 0 && n <= 255 {
+		return lipgloss.Color(value), nil
+	}
+	return "", fmt.Errorf("invalid color %q (use #RRGGBB or an ANSI number 0-255)", value)
+}
+
+// paletteField returns the palette color named by a config key such as "text_muted"
+func (p *Palette) paletteField(key string) *lipgloss.Color {
+	switch strings.ToLower(strings.ReplaceAll(key, "-", "_")) {
+	case "primary":
+		return &p.Primary
+	case "secondary":
+		return &p.Secondary
+	case "success":
+		return &p.Success
+	case "caution":
+		return &p.Caution
+	case "warning":
+		return &p.Warning
+	case "danger":
+		return &p.Danger
+	case "info":
+		return &p.Info
+	case "text_primary":
+		return &p.TextPrimary
+	case "text_secondary":
+		return &p.TextSecondary
+	case "text_muted":
+		return &p.TextMuted
+	case "text_inverse":
+		return &p.TextInverse
+	case "bg_primary":
+		return &p.BgPrimary
+	case "bg_secondary":
+		return &p.BgSecondary
+	case "bg_hover":
+		return &p.BgHover
+	}
+	return nil
+}
+
+// NewTheme builds a built-in theme ("" selects the default) with individual
+// colors overridden from the config file (keys like "primary" or "text_muted")
+func NewTheme(name string, overrides map[string]string) (*Theme, error) {
+	if name == "" {
+		name = DefaultThemeName
+	}
+	palette, ok := builtinPalettes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(ThemeNames(), ", "))
+	}
+
+	for key, value := range overrides {
+		field := palette.paletteField(key)
+		if field == nil {
+			return nil, fmt.Errorf("unknown theme color %q", key)
+		}
+		color, err := parseColor(value)
+		if err != nil {
+			return nil, fmt.Errorf("theme color %s: %w", key, err)
+		}
+		*field = color
+	}
+
+	return newThemeFromPalette(name, palette), nil
+}
+
+// newThemeFromPalette derives all styles from a palette
+func newThemeFromPalette(name string, p Palette) *Theme {
+	return &Theme{
+		Name:    name,
+		Palette: p,
+
+		Title: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.Primary).
+			MarginBottom(1),
+		Subtitle: lipgloss.NewStyle().
+			Foreground(p.TextSecondary).
+			Italic(true),
+		Header: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.TextPrimary).
+			Background(p.BgSecondary).
+			Padding(0, 1),
+		SubHeader: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(p.Secondary),
+
+		StatusReady:    lipgloss.NewStyle().Foreground(p.Success).Bold(true),
+		StatusNotReady: lipgloss.NewStyle().Foreground(p.Danger).Bold(true),
+		StatusPending:  lipgloss.NewStyle().Foreground(p.Warning).Bold(true),
+		StatusRunning:  lipgloss.NewStyle().Foreground(p.Info).Bold(true),
+
+		Key:     lipgloss.NewStyle().Foreground(p.Primary).Bold(true),
+		KeyDesc: lipgloss.NewStyle().Foreground(p.TextSecondary),
+
+		Border: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(p.BgSecondary).
+			Padding(1, 2),
+
+		Error:         lipgloss.NewStyle().Foreground(p.Danger).Bold(true),
+		Highlight:     lipgloss.NewStyle().Foreground(p.Primary).Bold(true),
+		Warning:       lipgloss.NewStyle().Foreground(p.Warning).Bold(true),
+		Danger:        lipgloss.NewStyle().Foreground(p.Danger).Bold(true),
+		TextSecondary: lipgloss.NewStyle().Foreground(p.TextSecondary),
+		TextMuted:     lipgloss.NewStyle().Foreground(p.TextMuted),
+		Selected: lipgloss.NewStyle().
+			Background(p.BgHover).
+			Foreground(p.Primary).
+			Bold(true),
+
+		LogError:    lipgloss.NewStyle().Foreground(p.Danger).Bold(true),
+		LogWarn:     lipgloss.NewStyle().Foreground(p.Warning).Bold(true),
+		LogInfo:     lipgloss.NewStyle().Foreground(p.Info),
+		LogDebug:    lipgloss.NewStyle().Foreground(p.TextMuted),
+		LogSuccess:  lipgloss.NewStyle().Foreground(p.Success),
+		SearchMatch: lipgloss.NewStyle().Background(p.Warning).Foreground(lipgloss.Color("#000000")).Bold(true),
+	}
+}
+
+// ApplyTheme makes t the active theme. Call before the UI starts rendering.
+func ApplyTheme(t *Theme) {
+	p := t.Palette
+	ColorPrimary, ColorSecondary = p.Primary, p.Secondary
+	ColorSuccess, ColorCaution, ColorWarning, ColorDanger, ColorInfo = p.Success, p.Caution, p.Warning, p.Danger, p.Info
+	ColorTextPrimary, ColorTextSecondary, ColorTextMuted, ColorTextInverse = p.TextPrimary, p.TextSecondary, p.TextMuted, p.TextInverse
+	ColorBgPrimary, ColorBgSecondary, ColorBgHover = p.BgPrimary, p.BgSecondary, p.BgHover
+
+	StyleTitle = t.Title
+	StyleSubtitle = t.Subtitle
+	StyleHeader = t.Header
+	StyleSubHeader = t.SubHeader
+	StyleStatusReady = t.StatusReady
+	StyleStatusNotReady = t.StatusNotReady
+	StyleStatusPending = t.StatusPending
+	StyleStatusRunning = t.StatusRunning
+	StyleKey = t.Key
+	StyleKeyDesc = t.KeyDesc
+	StyleBorder = t.Border
+	StyleError = t.Error
+	StyleHighlight = t.Highlight
+	StyleWarning = t.Warning
+	StyleDanger = t.Danger
+	StyleTextSecondary = t.TextSecondary
+	StyleTextMuted = t.TextMuted
+	StyleSelected = t.Selected
+	summaryPanelStyle = t.Border.Copy().Padding(1, 1)
+
+	StyleLogError = t.LogError
+	StyleLogWarn = t.LogWarn
+	StyleLogInfo = t.LogInfo
+	StyleLogDebug = t.LogDebug
+	StyleLogSuccess = t.LogSuccess
+	StyleSearchMatch = t.SearchMatch
+}
+
+// ApplyColorMode sets the terminal color profile: "never" (or noColor) forces
+// plain output, "always" keeps colors even when output is not a terminal,
+// and "auto" leaves detection to lipgloss.
+func ApplyColorMode(mode string, noColor bool) {
+	switch {
+	case noColor || mode == "never":
+		lipgloss.SetColorProfile(termenv.Ascii)
+	case mode == "always" && lipgloss.ColorProfile() == termenv.Ascii:
+		lipgloss.SetColorProfile(termenv.ANSI256)
+	}
+}
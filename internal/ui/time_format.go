@@ -0,0 +1,43 @@
+package ui
+
+import "time"
+
+// timeFormat selects how age columns render timestamps
+type timeFormat int
+
+const (
+	timeFormatRelative timeFormat = iota // Elapsed time, e.g. "3d"
+	timeFormatAbsolute                   // Local timestamp, e.g. "2024-01-02 15:04"
+)
+
+// absoluteTimeLayout is the layout of age columns in absolute mode
+const absoluteTimeLayout = "2006-01-02 15:04"
+
+// toggleTimeFormat switches age columns between relative and absolute time
+func (m *Model) toggleTimeFormat() {
+	if m.timeFormat == timeFormatRelative {
+		m.timeFormat = timeFormatAbsolute
+	} else {
+		m.timeFormat = timeFormatRelative
+	}
+}
+
+// formatAgeOrTime renders t as an age ("3d") or an absolute local timestamp,
+// depending on the selected time format
+func (m *Model) formatAgeOrTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	if m.timeFormat == timeFormatAbsolute {
+		return t.Local().Format(absoluteTimeLayout)
+	}
+	return formatAge(time.Since(t))
+}
+
+// ageColumnWidth widens an age column so absolute timestamps fit
+func (m *Model) ageColumnWidth(width int) int {
+	if m.timeFormat == timeFormatAbsolute && width < len(absoluteTimeLayout)+1 {
+		return len(absoluteTimeLayout) + 1
+	}
+	return width
+}
@@ -0,0 +1,101 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// topPodsCount is the number of pods listed in each overview top pods panel
+const topPodsCount = 5
+
+// podCPUUsage and podMemoryUsage select the usage the top pods are ranked by
+func podCPUUsage(pod *model.PodData) int64    { return pod.CPUUsage }
+func podMemoryUsage(pod *model.PodData) int64 { return pod.MemoryUsage }
+
+// topPods returns the topPodsCount pods with the highest usage, highest
+// first, like kubectl top. Pods without metrics are left out.
+func (m *Model) topPods(usage func(*model.PodData) int64) []*model.PodData {
+	if m.clusterData == nil {
+		return nil
+	}
+
+	// Insertion into a short sorted list avoids sorting all pods every frame
+	top := make([]*model.PodData, 0, topPodsCount+1)
+	for _, pod := range m.clusterData.Pods {
+		value := usage(pod)
+		if value <= 0 {
+			continue
+		}
+		i := len(top)
+		for i > 0 && usage(top[i-1]) < value {
+			i--
+		}
+		if i >= topPodsCount {
+			continue
+		}
+		top = append(top, nil)
+		copy(top[i+1:], top[i:])
+		top[i] = pod
+		if len(top) > topPodsCount {
+			top = top[:topPodsCount]
+		}
+	}
+	return top
+}
+
+// topPodsLines returns the content of a top pods panel
+func (m *Model) topPodsLines(title string, pods []*model.PodData, value func(*model.PodData) string) []string {
+	lines := []string{StyleHeader.Render(title), ""}
+	for i, pod := range pods {
+		usage := value(pod)
+		// Rank, name and usage fit the panel width inside its padding
+		nameWidth := summaryPanelWidth - 2 - len("1 ") - 1 - len(usage)
+		lines = append(lines, fmt.Sprintf("%d %s %s",
+			i+1, padRight(truncate(pod.Name, nameWidth), nameWidth), StyleHighlight.Render(usage)))
+	}
+	return lines
+}
+
+// renderTopPods renders the top pods by CPU and by memory side by side, or ""
+// when no pod reports usage metrics
+func (m *Model) renderTopPods() string {
+	byCPU := m.topPods(podCPUUsage)
+	byMemory := m.topPods(podMemoryUsage)
+	if len(byCPU) == 0 && len(byMemory) == 0 {
+		return ""
+	}
+
+	cpuLines := m.topPodsLines("[🔥 "+m.T("overview.top_cpu")+"]", byCPU, func(pod *model.PodData) string {
+		return formatCPU(pod.CPUUsage, m.rawUnits)
+	})
+	memoryLines := m.topPodsLines("[🔥 "+m.T("overview.top_memory")+"]", byMemory, func(pod *model.PodData) string {
+		return formatMemoryShort(pod.MemoryUsage, m.rawUnits)
+	})
+	targetLines := maxContentLines(summaryPanelMinContentLine, cpuLines, memoryLines)
+
+	return lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		renderSummaryPanel(cpuLines, targetLines),
+		renderSummaryPanel(memoryLines, targetLines),
+	)
+}
+
+// openTopPodsPalette opens the command palette listing the top pods by CPU
+// and then by memory, so Enter jumps to a pod's detail
+func (m *Model) openTopPodsPalette() {
+	var suggestions []paletteEntry
+	seen := make(map[*model.PodData]bool)
+	for _, pod := range append(m.topPods(podCPUUsage), m.topPods(podMemoryUsage)...) {
+		if !seen[pod] {
+			seen[pod] = true
+			suggestions = append(suggestions, paletteEntry{"Pod", pod.Namespace, pod.Name, pod})
+		}
+	}
+	if len(suggestions) == 0 {
+		return
+	}
+	m.openPalette()
+	m.paletteSuggestions = suggestions
+}
@@ -436,6 +436,7 @@ func (m *Model) renderSuperPodDetail() string {
 	}
 
 	totalLines := len(allLines)
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
@@ -0,0 +1,227 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// viewSortOption is a sort field selectable with the Sort key, with the order
+// it starts in
+type viewSortOption struct {
+	id    string // Name used in config and the state file
+	field SortField
+	order SortOrder
+}
+
+// viewSortOptions lists the sort fields of each sortable view in the order the
+// Sort key cycles through them. The first entry is the built-in default.
+var viewSortOptions = map[string][]viewSortOption{
+	"nodes": {
+		{"name", SortByName, SortAsc},
+		{"cpu", SortByCPU, SortDesc},
+		{"memory", SortByMemory, SortDesc},
+		{"pods", SortByPods, SortDesc},
+	},
+	"pods": {
+		{"name", SortByName, SortAsc},
+		{"namespace", SortByNamespace, SortAsc},
+		{"restarts", SortByRestarts, SortDesc},
+	},
+	"workloads": {
+		{"name", SortByName, SortAsc},
+		{"ready", SortByReady, SortAsc}, // Unready first
+		{"age", SortByAge, SortDesc},    // Newest first
+	},
+	"events": {
+		{"time", SortByTime, SortDesc}, // Most recent first
+		{"count", SortByCount, SortDesc},
+		{"type", SortByType, SortAsc}, // Warnings first
+	},
+	"network": {
+		{"traffic", SortByTraffic, SortDesc}, // Top talkers first
+		{"rx", SortByRxRate, SortDesc},
+		{"tx", SortByTxRate, SortDesc},
+		{"namespace", SortByNamespace, SortAsc},
+		{"pod", SortByPodName, SortAsc},
+		{"node", SortByNode, SortAsc},
+	},
+	"alerts": {
+		{"severity", SortByStatus, SortDesc}, // Detection order grouped by severity
+		{"priority", SortByPriority, SortDesc},
+	},
+}
+
+// viewSort is the sort field and order of a view
+type viewSort struct {
+	field SortField
+	order SortOrder
+}
+
+// sortViewName returns the viewSortOptions key of a view, "" for views
+// without a sort. The heatmap shares the sort of the Nodes view.
+func sortViewName(view ViewType) string {
+	switch view {
+	case ViewNodes, ViewHeatmap:
+		return "nodes"
+	case ViewPods:
+		return "pods"
+	case ViewWorkloads:
+		return "workloads"
+	case ViewEvents:
+		return "events"
+	case ViewNetwork:
+		return "network"
+	case ViewAlerts:
+		return "alerts"
+	}
+	return ""
+}
+
+// parseViewSort parses a "field" or "field:asc|desc" sort of a view. The
+// order defaults to the natural order of the field.
+func parseViewSort(view, value string) (viewSort, error) {
+	options, ok := viewSortOptions[view]
+	if !ok {
+		return viewSort{}, fmt.Errorf("unknown view %q (want one of %s)", view, strings.Join(sortViewNames(), ", "))
+	}
+	id, order, hasOrder := strings.Cut(strings.TrimSpace(value), ":")
+	for _, option := range options {
+		if option.id != strings.ToLower(id) {
+			continue
+		}
+		s := viewSort{field: option.field, order: option.order}
+		if hasOrder {
+			switch strings.ToLower(order) {
+			case "asc":
+				s.order = SortAsc
+			case "desc":
+				s.order = SortDesc
+			default:
+				return viewSort{}, fmt.Errorf("invalid %s sort order %q (want asc or desc)", view, order)
+			}
+		}
+		return s, nil
+	}
+	ids := make([]string, len(options))
+	for i, option := range options {
+		ids[i] = option.id
+	}
+	return viewSort{}, fmt.Errorf("unknown %s sort field %q (want one of %s)", view, id, strings.Join(ids, ", "))
+}
+
+// formatViewSort returns the "field:asc|desc" form of a sort of a view
+func formatViewSort(view string, s viewSort) string {
+	order := "asc"
+	if s.order == SortDesc {
+		order = "desc"
+	}
+	for _, option := range viewSortOptions[view] {
+		if option.field == s.field {
+			return option.id + ":" + order
+		}
+	}
+	return ""
+}
+
+// sortViewNames returns the sortable views in alphabetical order
+func sortViewNames() []string {
+	views := make([]string, 0, len(viewSortOptions))
+	for view := range viewSortOptions {
+		views = append(views, view)
+	}
+	sort.Strings(views)
+	return views
+}
+
+// SetDefaultSorts sets the sort each view starts with, as "field" or
+// "field:asc|desc", e.g. {"nodes": "cpu:desc"}. Views missing from the map
+// start with their built-in default; a sort chosen with the Sort key takes
+// precedence.
+func (m *Model) SetDefaultSorts(sorts map[string]string) error {
+	defaults := make(map[string]viewSort)
+	for view, value := range sorts {
+		s, err := parseViewSort(view, value)
+		if err != nil {
+			return err
+		}
+		defaults[view] = s
+	}
+	m.defaultSorts = defaults
+	m.reloadViewSort()
+	return nil
+}
+
+// restoreViewSorts restores the sorts saved to the state file. Entries that no
+// longer parse are dropped.
+func (m *Model) restoreViewSorts(sorts map[string]string) {
+	for view, value := range sorts {
+		if s, err := parseViewSort(view, value); err == nil {
+			m.viewSorts[view] = s
+		}
+	}
+	m.reloadViewSort()
+}
+
+// savedViewSorts returns the sorts chosen with the Sort key, for the state file
+func (m *Model) savedViewSorts() map[string]string {
+	if len(m.viewSorts) == 0 {
+		return nil
+	}
+	sorts := make(map[string]string, len(m.viewSorts))
+	for view, s := range m.viewSorts {
+		sorts[view] = formatViewSort(view, s)
+	}
+	return sorts
+}
+
+// reloadViewSort makes the next syncViewSort load the sort of the current view
+func (m *Model) reloadViewSort() {
+	m.sortView = ""
+	m.syncViewSort()
+}
+
+// syncViewSort loads the sort of the current view once it is entered: the
+// sort last chosen with the Sort key, else the configured default, else the
+// built-in default. Views without a sort keep the loaded one.
+func (m *Model) syncViewSort() {
+	view := sortViewName(m.currentView)
+	if view == "" || view == m.sortView {
+		return
+	}
+	m.sortView = view
+
+	s, ok := m.viewSorts[view]
+	if !ok {
+		s, ok = m.defaultSorts[view]
+	}
+	if !ok {
+		first := viewSortOptions[view][0]
+		s = viewSort{field: first.field, order: first.order}
+	}
+	m.sortField = s.field
+	m.sortOrder = s.order
+}
+
+// cycleViewSort moves the current view to its next sort field and remembers
+// it. It reports false for views without a sort.
+func (m *Model) cycleViewSort() bool {
+	view := sortViewName(m.currentView)
+	if view == "" {
+		return false
+	}
+	m.syncViewSort()
+
+	options := viewSortOptions[view]
+	next := options[0]
+	for i, option := range options {
+		if option.field == m.sortField {
+			next = options[(i+1)%len(options)]
+			break
+		}
+	}
+	m.sortField = next.field
+	m.sortOrder = next.order
+	m.viewSorts[view] = viewSort{field: next.field, order: next.order}
+	return true
+}
@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// queuePendingJobsShown limits the pending jobs listed in queue detail
+const queuePendingJobsShown = 20
+
+// renderVolcanoJobPriority renders the priority class of a job for the Volcano
+// jobs list, marked ⚠ when its pods were preempted and ⚡ when they preempted
+// other pods
+func renderVolcanoJobPriority(job *model.VolcanoJobData, width int) string {
+	name := job.PriorityClassName
+	if name == "" {
+		name = "-"
+	}
+	switch {
+	case job.Preempted:
+		return truncate(name, width-2) + " " + StyleWarning.Render("⚠")
+	case job.Preempting:
+		return truncate(name, width-2) + " " + StyleHighlight.Render("⚡")
+	}
+	return truncate(name, width)
+}
+
+// formatVolcanoJobPriority formats the priority class of a job with its value
+func (m *Model) formatVolcanoJobPriority(job *model.VolcanoJobData) string {
+	if job.PriorityClassName == "" {
+		return fmt.Sprintf("%s (%d)", StyleTextMuted.Render(m.T("detail.volcanojob.no_priority_class")), job.Priority)
+	}
+	return fmt.Sprintf("%s (%d)", StyleHighlight.Render(job.PriorityClassName), job.Priority)
+}
+
+// renderVolcanoJobPriorityInfo renders the priority and preemption lines of
+// Volcano job detail
+func (m *Model) renderVolcanoJobPriorityInfo(job *model.VolcanoJobData) []string {
+	lines := []string{fmt.Sprintf("  %s: %s",
+		StyleTextSecondary.Render(m.T("detail.volcanojob.priority")),
+		m.formatVolcanoJobPriority(job))}
+
+	if job.Preempted {
+		preemption := StyleWarning.Render("⚠ " + m.T("detail.volcanojob.preempted"))
+		if !job.PreemptionTime.IsZero() {
+			preemption += StyleTextMuted.Render(" (" + m.TF("detail.volcanojob.preempted_ago", map[string]interface{}{
+				"Age": formatAge(time.Since(job.PreemptionTime)),
+			}) + ")")
+		}
+		lines = append(lines, fmt.Sprintf("  %s: %s",
+			StyleTextSecondary.Render(m.T("detail.volcanojob.preemption")),
+			preemption))
+		if job.PreemptionMessage != "" {
+			lines = append(lines, "    "+StyleTextMuted.Render(job.PreemptionMessage))
+		}
+	}
+	if job.Preempting {
+		lines = append(lines, fmt.Sprintf("  %s: %s",
+			StyleTextSecondary.Render(m.T("detail.volcanojob.preemption")),
+			StyleHighlight.Render("⚡ "+m.T("detail.volcanojob.preempting"))))
+	}
+	return lines
+}
+
+// queuePendingJobs returns the pending Volcano jobs of a queue in scheduling
+// order: higher priority first, then older jobs first
+func (m *Model) queuePendingJobs(queueName string) []*model.VolcanoJobData {
+	if m.clusterData == nil {
+		return nil
+	}
+	var jobs []*model.VolcanoJobData
+	for _, job := range m.clusterData.VolcanoJobs {
+		jobQueue := job.Queue
+		if jobQueue == "" {
+			jobQueue = "default"
+		}
+		if jobQueue == queueName && job.Status == "Pending" {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		if jobs[i].Priority != jobs[j].Priority {
+			return jobs[i].Priority > jobs[j].Priority
+		}
+		return jobs[i].CreationTimestamp.Before(jobs[j].CreationTimestamp)
+	})
+	return jobs
+}
+
+// renderQueuePendingJobs renders the pending jobs of a queue in the order the
+// scheduler considers them, for queue detail
+func (m *Model) renderQueuePendingJobs(queue *model.QueueData) []string {
+	jobs := m.queuePendingJobs(queue.Name)
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	lines := []string{
+		"",
+		StyleSubHeader.Render(m.TF("detail.queue.pending_order", map[string]interface{}{"Count": len(jobs)})),
+		renderSeparator(m.width),
+	}
+	for i, job := range jobs {
+		if i == queuePendingJobsShown {
+			lines = append(lines, StyleTextMuted.Render(m.TF("detail.queue.pending_more", map[string]interface{}{
+				"Count": len(jobs) - queuePendingJobsShown,
+			})))
+			break
+		}
+		line := fmt.Sprintf("  %2d. %s  %s  %s",
+			i+1,
+			padRight(truncate(job.Namespace+"/"+job.Name, 40), 40),
+			padRight(m.formatVolcanoJobPriority(job), 24),
+			StyleTextMuted.Render(m.TF("detail.queue.pending_wait", map[string]interface{}{
+				"Age": formatAge(time.Since(job.CreationTimestamp)),
+			})))
+		if job.NPURequested > 0 {
+			line += fmt.Sprintf("  %d NPU", job.NPURequested)
+		}
+		if job.Preempted {
+			line += "  " + StyleWarning.Render("⚠ "+m.T("detail.volcanojob.preempted"))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -38,6 +38,12 @@ func (m *Model) renderVolcanoJobDetail() string {
 	sections = append(sections, m.renderVolcanoJobBasicInfo(job))
 	sections = append(sections, "")
 
+	// Failure analysis (failed jobs only)
+	if job.Status == "Failed" || job.Status == "Aborted" || (job.Failed > 0 && job.Status != "Completed") {
+		sections = append(sections, m.renderFailureAnalysis(volcanoJobPods))
+		sections = append(sections, "")
+	}
+
 	// Resource summary
 	sections = append(sections, m.renderVolcanoJobResourceSummary(job))
 	sections = append(sections, "")
@@ -101,6 +107,7 @@ func (m *Model) renderVolcanoJobDetail() string {
 	}
 
 	// Clamp scroll offset to valid range
+	m.noteDetailLines(totalLines, maxVisible)
 	maxScroll := totalLines - maxVisible
 	if maxScroll < 0 {
 		maxScroll = 0
@@ -183,11 +190,19 @@ func (m *Model) renderVolcanoJobBasicInfo(job *model.VolcanoJobData) string {
 		StyleTextSecondary.Render(m.T("detail.volcanojob.queue")),
 		queue))
 
+	// Priority and preemption
+	info = append(info, m.renderVolcanoJobPriorityInfo(job)...)
+
 	// MinAvailable
 	info = append(info, fmt.Sprintf("  %s: %d",
 		StyleTextSecondary.Render(m.T("detail.volcanojob.min_available")),
 		job.MinAvailable))
 
+	// PodGroup (gang scheduling status)
+	if job.PodGroup != "" {
+		info = append(info, m.renderVolcanoPodGroup(job)...)
+	}
+
 	// Replicas status
 	replicasStr := fmt.Sprintf("%d/%d", job.Running+job.Succeeded, job.Replicas)
 	if job.Running == job.Replicas {
@@ -240,10 +255,9 @@ func (m *Model) renderVolcanoJobBasicInfo(job *model.VolcanoJobData) string {
 	}
 
 	// Age
-	age := time.Since(job.CreationTimestamp)
 	info = append(info, fmt.Sprintf("  %s: %s",
 		StyleTextSecondary.Render(m.T("detail.age")),
-		formatAge(age)))
+		m.formatAgeOrTime(job.CreationTimestamp)))
 
 	// Queue wait time (time from creation to start)
 	if !job.StartTime.IsZero() {
@@ -376,11 +390,11 @@ func (m *Model) renderVolcanoJobResourceSummary(job *model.VolcanoJobData) strin
 		StyleTextSecondary.Render(m.T("columns.cpu"))))
 	info = append(info, fmt.Sprintf("    %s: %s  •  %s: %s  •  %s: %s",
 		m.T("detail.job.request"),
-		FormatMillicores(totalCPURequest),
+		FormatMillicores(totalCPURequest, m.rawUnits),
 		m.T("detail.job.limit"),
-		FormatMillicores(totalCPULimit),
+		FormatMillicores(totalCPULimit, m.rawUnits),
 		m.T("detail.job.usage"),
-		StyleHighlight.Render(FormatMillicores(totalCPUUsage))))
+		StyleHighlight.Render(FormatMillicores(totalCPUUsage, m.rawUnits))))
 
 	// CPU Efficiency (actual usage / requested) with progress bar
 	if totalCPURequest > 0 {
@@ -402,11 +416,11 @@ func (m *Model) renderVolcanoJobResourceSummary(job *model.VolcanoJobData) strin
 		StyleTextSecondary.Render(m.T("columns.memory"))))
 	info = append(info, fmt.Sprintf("    %s: %s  •  %s: %s  •  %s: %s",
 		m.T("detail.job.request"),
-		FormatBytes(totalMemRequest),
+		formatMemoryBytes(totalMemRequest, m.rawUnits),
 		m.T("detail.job.limit"),
-		FormatBytes(totalMemLimit),
+		formatMemoryBytes(totalMemLimit, m.rawUnits),
 		m.T("detail.job.usage"),
-		StyleHighlight.Render(FormatBytes(totalMemUsage))))
+		StyleHighlight.Render(formatMemoryBytes(totalMemUsage, m.rawUnits))))
 
 	// Memory Efficiency (actual usage / requested) with progress bar
 	if totalMemRequest > 0 {
@@ -494,6 +508,44 @@ func (m *Model) renderVolcanoJobResourceSummary(job *model.VolcanoJobData) strin
 	return strings.Join(info, "\n")
 }
 
+// renderVolcanoPodGroup renders the PodGroup lines of the basic info: its
+// phase and minimum gang size, and why it cannot be scheduled if it is stuck
+func (m *Model) renderVolcanoPodGroup(job *model.VolcanoJobData) []string {
+	phase := job.PodGroupPhase
+	if phase == "" {
+		phase = "Unknown"
+	}
+	var phaseStr string
+	switch phase {
+	case "Running", "Completed":
+		phaseStr = StyleStatusReady.Render(phase)
+	case "Inqueue":
+		phaseStr = StyleStatusRunning.Render(phase)
+	case "Pending":
+		phaseStr = StyleStatusPending.Render(phase)
+	default:
+		phaseStr = StyleStatusNotReady.Render(phase)
+	}
+
+	lines := []string{fmt.Sprintf("  %s: %s (%s: %s, %s: %d)",
+		StyleTextSecondary.Render(m.T("detail.volcanojob.podgroup")),
+		job.PodGroup,
+		m.T("detail.volcanojob.podgroup_phase"), phaseStr,
+		m.T("detail.volcanojob.podgroup_min_member"), job.PodGroupMinMember)}
+
+	if job.PodGroupReason != "" || job.PodGroupMessage != "" {
+		lines = append(lines, "    "+StyleWarning.Render("⚠ "+job.PodGroupReason))
+		maxWidth := m.width - 12
+		if maxWidth < 20 {
+			maxWidth = 20
+		}
+		for _, line := range wrapLine(job.PodGroupMessage, maxWidth, 0) {
+			lines = append(lines, "      "+StyleTextMuted.Render(line))
+		}
+	}
+	return lines
+}
+
 // renderVolcanoJobTaskBreakdown renders per-task resource breakdown
 func (m *Model) renderVolcanoJobTaskBreakdown(job *model.VolcanoJobData) string {
 	if len(job.Tasks) == 0 {
@@ -562,8 +614,17 @@ func (m *Model) renderVolcanoJobTaskBreakdown(job *model.VolcanoJobData) string
 			taskMemUsage += pod.MemoryUsage
 		}
 
+		// Prefer the job controller's counts, which include pods not loaded here
+		if task.Running+task.Pending+task.Succeeded+task.Failed > 0 {
+			running, pending = int(task.Running), int(task.Pending)
+			succeeded, failed = int(task.Succeeded), int(task.Failed)
+		}
+
 		// Replicas status
 		replicasStr := fmt.Sprintf("%d/%d", running+succeeded, task.Replicas)
+		if task.MinAvailable > 0 && task.MinAvailable != task.Replicas {
+			replicasStr += fmt.Sprintf(" ≥%d", task.MinAvailable)
+		}
 		if running == int(task.Replicas) {
 			replicasStr = StyleStatusReady.Render(replicasStr)
 		} else if running > 0 {
@@ -573,11 +634,11 @@ func (m *Model) renderVolcanoJobTaskBreakdown(job *model.VolcanoJobData) string
 		}
 
 		// CPU/Memory usage
-		cpuStr := FormatMillicores(taskCPUUsage)
+		cpuStr := FormatMillicores(taskCPUUsage, m.rawUnits)
 		if taskCPUUsage == 0 {
 			cpuStr = StyleTextMuted.Render("-")
 		}
-		memStr := FormatBytes(taskMemUsage)
+		memStr := formatMemoryBytes(taskMemUsage, m.rawUnits)
 		if taskMemUsage == 0 {
 			memStr = StyleTextMuted.Render("-")
 		}
@@ -729,6 +790,7 @@ func (m *Model) renderVolcanoJobPods(job *model.VolcanoJobData) string {
 		colRestarts = 8
 		colAge = 9
 	}
+	colAge = m.ageColumnWidth(colAge)
 
 	var headerRow string
 	if hasNPU {
@@ -787,12 +849,12 @@ func (m *Model) renderVolcanoJobPods(job *model.VolcanoJobData) string {
 			phaseStyled = StyleTextMuted.Render(phase)
 		}
 
-		cpuStr := FormatMillicores(pod.CPUUsage)
+		cpuStr := FormatMillicores(pod.CPUUsage, m.rawUnits)
 		if pod.CPUUsage == 0 {
 			cpuStr = StyleTextMuted.Render("-")
 		}
 
-		memStr := FormatBytes(pod.MemoryUsage)
+		memStr := formatMemoryBytes(pod.MemoryUsage, m.rawUnits)
 		if pod.MemoryUsage == 0 {
 			memStr = StyleTextMuted.Render("-")
 		}
@@ -822,7 +884,7 @@ func (m *Model) renderVolcanoJobPods(job *model.VolcanoJobData) string {
 			restarts = StyleWarning.Render(restarts)
 		}
 
-		age := formatAge(time.Since(pod.CreationTimestamp))
+		age := m.formatAgeOrTime(pod.CreationTimestamp)
 
 		var row string
 		if hasNPU {
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// volumeFullPercent is the filesystem usage at which a volume is flagged as full
+const volumeFullPercent = 90.0
+
+// volumeUsageBarWidth is the width of the usage bar in the Storage view tables
+const volumeUsageBarWidth = 8
+
+// renderVolumeUsage renders a usage bar with the percentage of a volume, "-"
+// when kubelet reported no usage. Volumes at volumeFullPercent are flagged.
+func renderVolumeUsage(usage *model.VolumeUsage) string {
+	if usage == nil || usage.CapacityBytes <= 0 {
+		return "-"
+	}
+	percent := usage.UsagePercent()
+	text := fmt.Sprintf("%3.0f%%", percent)
+	if percent >= volumeFullPercent {
+		text = StyleDanger.Render(text + " ⚠")
+	}
+	return renderProgressBar(percent, volumeUsageBarWidth) + " " + text
+}
+
+// renderVolumeUsageDetail renders the used and total size of a volume with a
+// usage bar, for the PV and PVC detail views
+func (m *Model) renderVolumeUsageDetail(usage *model.VolumeUsage) string {
+	if usage == nil || usage.CapacityBytes <= 0 {
+		return StyleTextMuted.Render(m.T("storage.usage_unavailable"))
+	}
+	percent := usage.UsagePercent()
+	text := fmt.Sprintf("%s / %s (%.1f%%)", formatMemory(usage.UsedBytes, false), formatMemory(usage.CapacityBytes, false), percent)
+	if percent >= volumeFullPercent {
+		text = StyleDanger.Render(text + " ⚠ " + m.T("storage.nearly_full"))
+	}
+	return renderProgressBar(percent, 20) + " " + text
+}
+
+// fullVolumeCount returns how many PVCs are at least volumeFullPercent full
+func (m *Model) fullVolumeCount() int {
+	if m.clusterData == nil {
+		return 0
+	}
+	count := 0
+	for _, pvc := range m.clusterData.PVCs {
+		if pvc.Usage != nil && pvc.Usage.UsagePercent() >= volumeFullPercent {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,191 @@
+package ui
+
+import (
+	"sort"
+	"time"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// workloadLists holds the filtered and sorted items of each Workloads section.
+// Rendering, selection bounds and Enter routing all index into these slices,
+// so the workloadSections index math stays consistent with what is shown.
+type workloadLists struct {
+	volcanoJobs  []*model.VolcanoJobData
+	jobs         []*model.JobData
+	services     []*model.ServiceData
+	deployments  []*model.DeploymentData
+	statefulSets []*model.StatefulSetData
+	daemonSets   []*model.DaemonSetData
+	cronJobs     []*model.CronJobData
+}
+
+// total returns the number of selectable items across all sections
+func (w *workloadLists) total() int {
+	return len(w.volcanoJobs) + len(w.jobs) + len(w.services) +
+		len(w.deployments) + len(w.statefulSets) + len(w.daemonSets) + len(w.cronJobs)
+}
+
+// workloadSortKey holds the values workloads are sorted by
+type workloadSortKey struct {
+	namespace string
+	name      string
+	ready     float64 // Ready ratio (0-1); 1 for kinds without a readiness notion
+	created   time.Time
+}
+
+// readyRatio returns ready/desired, treating zero desired as fully ready
+func readyRatio(ready, desired int32) float64 {
+	if desired <= 0 {
+		return 1
+	}
+	return float64(ready) / float64(desired)
+}
+
+// filterWorkloads keeps items in the given namespace ("" keeps all)
+func filterWorkloads[T any](items []T, namespace string, namespaceOf func(T) string) []T {
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if namespace == "" || namespaceOf(item) == namespace {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// sortWorkloads sorts items in place by the current workloads sort field.
+// Ready sorts put unready workloads first; age sorts put newest first.
+func sortWorkloads[T any](items []T, field SortField, order SortOrder, keyOf func(T) workloadSortKey) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := keyOf(items[i]), keyOf(items[j])
+		switch field {
+		case SortByReady:
+			if a.ready != b.ready {
+				if order == SortDesc {
+					return a.ready > b.ready
+				}
+				return a.ready < b.ready
+			}
+		case SortByAge:
+			if !a.created.Equal(b.created) {
+				if order == SortDesc {
+					return a.created.After(b.created)
+				}
+				return a.created.Before(b.created)
+			}
+		}
+		// Name order (also the tie-breaker for other fields)
+		if a.name != b.name {
+			if field == SortByName && order == SortDesc {
+				return a.name > b.name
+			}
+			return a.name < b.name
+		}
+		return a.namespace < b.namespace
+	})
+}
+
+// workloadSort returns the effective workloads sort. Sort fields of other
+// views fall back to name order.
+func (m *Model) workloadSort() (SortField, SortOrder) {
+	switch m.sortField {
+	case SortByName, SortByReady, SortByAge:
+		return m.sortField, m.sortOrder
+	default:
+		return SortByName, SortAsc
+	}
+}
+
+// getWorkloadLists returns the workloads of each section filtered by namespace and sorted
+func (m *Model) getWorkloadLists() *workloadLists {
+	lists := &workloadLists{}
+	if m.clusterData == nil {
+		return lists
+	}
+
+	ns := m.filterNamespace
+	field, order := m.workloadSort()
+
+	lists.volcanoJobs = filterWorkloads(m.clusterData.VolcanoJobs, ns, func(j *model.VolcanoJobData) string { return j.Namespace })
+	sortWorkloads(lists.volcanoJobs, field, order, func(j *model.VolcanoJobData) workloadSortKey {
+		return workloadSortKey{j.Namespace, j.Name, readyRatio(j.Running+j.Succeeded, j.Replicas), j.CreationTimestamp}
+	})
+
+	lists.jobs = filterWorkloads(m.clusterData.Jobs, ns, func(j *model.JobData) string { return j.Namespace })
+	sortWorkloads(lists.jobs, field, order, func(j *model.JobData) workloadSortKey {
+		return workloadSortKey{j.Namespace, j.Name, readyRatio(j.Succeeded, j.Completions), j.CreationTimestamp}
+	})
+
+	lists.services = filterWorkloads(m.clusterData.Services, ns, func(s *model.ServiceData) string { return s.Namespace })
+	sortWorkloads(lists.services, field, order, func(s *model.ServiceData) workloadSortKey {
+		return workloadSortKey{s.Namespace, s.Name, 1, s.CreationTimestamp}
+	})
+
+	lists.deployments = filterWorkloads(m.clusterData.Deployments, ns, func(d *model.DeploymentData) string { return d.Namespace })
+	sortWorkloads(lists.deployments, field, order, func(d *model.DeploymentData) workloadSortKey {
+		return workloadSortKey{d.Namespace, d.Name, readyRatio(d.ReadyReplicas, d.Replicas), d.CreationTimestamp}
+	})
+
+	lists.statefulSets = filterWorkloads(m.clusterData.StatefulSets, ns, func(s *model.StatefulSetData) string { return s.Namespace })
+	sortWorkloads(lists.statefulSets, field, order, func(s *model.StatefulSetData) workloadSortKey {
+		return workloadSortKey{s.Namespace, s.Name, readyRatio(s.ReadyReplicas, s.Replicas), s.CreationTimestamp}
+	})
+
+	lists.daemonSets = filterWorkloads(m.clusterData.DaemonSets, ns, func(d *model.DaemonSetData) string { return d.Namespace })
+	sortWorkloads(lists.daemonSets, field, order, func(d *model.DaemonSetData) workloadSortKey {
+		return workloadSortKey{d.Namespace, d.Name, readyRatio(d.NumberReady, d.DesiredNumberScheduled), d.CreationTimestamp}
+	})
+
+	lists.cronJobs = filterWorkloads(m.clusterData.CronJobs, ns, func(c *model.CronJobData) string { return c.Namespace })
+	sortWorkloads(lists.cronJobs, field, order, func(c *model.CronJobData) workloadSortKey {
+		return workloadSortKey{c.Namespace, c.Name, 1, c.CreationTimestamp}
+	})
+
+	return lists
+}
+
+// getWorkloadNamespaces returns a sorted list of unique namespaces across all workloads
+func (m *Model) getWorkloadNamespaces() []string {
+	if m.clusterData == nil {
+		return []string{}
+	}
+
+	nsMap := make(map[string]bool)
+	for _, j := range m.clusterData.VolcanoJobs {
+		nsMap[j.Namespace] = true
+	}
+	for _, j := range m.clusterData.Jobs {
+		nsMap[j.Namespace] = true
+	}
+	for _, s := range m.clusterData.Services {
+		nsMap[s.Namespace] = true
+	}
+	for _, d := range m.clusterData.Deployments {
+		nsMap[d.Namespace] = true
+	}
+	for _, s := range m.clusterData.StatefulSets {
+		nsMap[s.Namespace] = true
+	}
+	for _, d := range m.clusterData.DaemonSets {
+		nsMap[d.Namespace] = true
+	}
+	for _, c := range m.clusterData.CronJobs {
+		nsMap[c.Namespace] = true
+	}
+
+	namespaces := make([]string, 0, len(nsMap))
+	for ns := range nsMap {
+		namespaces = append(namespaces, ns)
+	}
+	m.sortNamespaces(namespaces)
+
+	return namespaces
+}
+
+// getFilterNamespaces returns the namespaces offered by the namespace filter panel
+func (m *Model) getFilterNamespaces() []string {
+	if m.currentView == ViewWorkloads {
+		return m.getWorkloadNamespaces()
+	}
+	return m.getNamespaces()
+}
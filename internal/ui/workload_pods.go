@@ -0,0 +1,258 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yourusername/k8s-monitor/internal/model"
+)
+
+// maxWorkloadPodsDisplay limits the pod list of deployment/statefulset detail views
+const maxWorkloadPodsDisplay = 20
+
+// podMatchesSelector reports whether the pod labels satisfy a matchLabels selector.
+// An empty selector matches nothing.
+func podMatchesSelector(pod *model.PodData, selector map[string]string) bool {
+	if len(selector) == 0 {
+		return false
+	}
+	for selectorKey, selectorValue := range selector {
+		if podLabelValue, exists := pod.Labels[selectorKey]; !exists || podLabelValue != selectorValue {
+			return false
+		}
+	}
+	return true
+}
+
+// sortPodsByPriority sorts pods Failed → Running → Pending → Succeeded → Others,
+// then by creation time (older first) and name for a stable order
+func sortPodsByPriority(pods []*model.PodData) {
+	sort.Slice(pods, func(i, j int) bool {
+		iPriority := getPodPriority(pods[i])
+		jPriority := getPodPriority(pods[j])
+		if iPriority != jPriority {
+			return iPriority < jPriority
+		}
+		if !pods[i].CreationTimestamp.Equal(pods[j].CreationTimestamp) {
+			return pods[i].CreationTimestamp.Before(pods[j].CreationTimestamp)
+		}
+		return pods[i].Name < pods[j].Name
+	})
+}
+
+// getDeploymentPods returns the pods of a deployment, matched by its selector or
+// by their owning ReplicaSet (<deployment>-<pod-template-hash>)
+func (m *Model) getDeploymentPods(deploy *model.DeploymentData) []*model.PodData {
+	if m.clusterData == nil {
+		return nil
+	}
+
+	var pods []*model.PodData
+	for _, pod := range m.clusterData.Pods {
+		if pod.Namespace != deploy.Namespace {
+			continue
+		}
+		ownedBy := pod.OwnerKind == "ReplicaSet" && podWorkloadName(pod) == deploy.Name
+		if ownedBy || podMatchesSelector(pod, deploy.Selector) {
+			pods = append(pods, pod)
+		}
+	}
+	sortPodsByPriority(pods)
+	return pods
+}
+
+// getStatefulSetPods returns the pods of a statefulset, matched by its selector or owner
+func (m *Model) getStatefulSetPods(sts *model.StatefulSetData) []*model.PodData {
+	if m.clusterData == nil {
+		return nil
+	}
+
+	var pods []*model.PodData
+	for _, pod := range m.clusterData.Pods {
+		if pod.Namespace != sts.Namespace {
+			continue
+		}
+		ownedBy := pod.OwnerKind == "StatefulSet" && pod.OwnerName == sts.Name
+		if ownedBy || podMatchesSelector(pod, sts.Selector) {
+			pods = append(pods, pod)
+		}
+	}
+	sortPodsByPriority(pods)
+	return pods
+}
+
+// workloadPodDisplayCount returns how many of the pods are listed in the detail view
+func workloadPodDisplayCount(pods []*model.PodData) int {
+	if len(pods) > maxWorkloadPodsDisplay {
+		return maxWorkloadPodsDisplay
+	}
+	return len(pods)
+}
+
+// clampWorkloadPodIndex keeps a pod selection within the listed pods
+func clampWorkloadPodIndex(index int, pods []*model.PodData) int {
+	displayCount := workloadPodDisplayCount(pods)
+	if index >= displayCount {
+		index = displayCount - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	return index
+}
+
+// podIsReady reports whether a pod is running with all containers ready
+func podIsReady(pod *model.PodData) bool {
+	return pod.Phase == "Running" && pod.Containers > 0 && pod.ReadyContainers == pod.Containers
+}
+
+// renderManagedPods renders the selectable pod list of a deployment or
+// statefulset with a total/ready rollup in its header
+func (m *Model) renderManagedPods(pods []*model.PodData, selectedIndex int, emptyText string) string {
+	var info []string
+
+	if m.clusterData == nil || len(m.clusterData.Pods) == 0 {
+		info = append(info, StyleSubHeader.Render("Managed Pods"))
+		info = append(info, "")
+		info = append(info, StyleTextMuted.Render("  No pod information available"))
+		return strings.Join(info, "\n")
+	}
+
+	ready := 0
+	for _, pod := range pods {
+		if podIsReady(pod) {
+			ready++
+		}
+	}
+	header := StyleSubHeader.Render(fmt.Sprintf("Managed Pods (%d)", len(pods)))
+	if len(pods) > 0 {
+		readyText := fmt.Sprintf("%d/%d ready", ready, len(pods))
+		if ready == len(pods) {
+			readyText = StyleStatusReady.Render(readyText)
+		} else {
+			readyText = StyleWarning.Render(readyText)
+		}
+		header += "  " + readyText
+	}
+	info = append(info, header)
+	info = append(info, "")
+
+	if len(pods) == 0 {
+		info = append(info, StyleTextMuted.Render("  "+emptyText))
+		return strings.Join(info, "\n")
+	}
+
+	// Table header
+	const (
+		colName     = 38
+		colReady    = 7
+		colPhase    = 11
+		colNode     = 20
+		colCPU      = 10
+		colMemory   = 10
+		colRestarts = 8
+	)
+
+	headerRow := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s",
+		padRight(m.T("columns.pod_name"), colName),
+		padRight(m.T("columns.ready"), colReady),
+		padRight(m.T("columns.phase"), colPhase),
+		padRight(m.T("columns.node"), colNode),
+		padRight(m.T("columns.cpu"), colCPU),
+		padRight(m.T("columns.memory"), colMemory),
+		padRight(m.T("columns.restarts"), colRestarts),
+	)
+	info = append(info, StyleTextMuted.Render(headerRow))
+
+	displayCount := workloadPodDisplayCount(pods)
+	for i := 0; i < displayCount; i++ {
+		pod := pods[i]
+
+		phase := pod.Phase
+		var phaseStyled string
+		switch phase {
+		case "Running":
+			phaseStyled = StyleStatusRunning.Render(phase)
+		case "Succeeded":
+			phaseStyled = StyleStatusReady.Render(phase)
+		case "Failed":
+			phaseStyled = StyleStatusNotReady.Render(phase)
+		case "Pending":
+			phaseStyled = StyleStatusPending.Render(phase)
+		default:
+			phaseStyled = StyleTextMuted.Render(phase)
+		}
+
+		readyStr := fmt.Sprintf("%d/%d", pod.ReadyContainers, pod.Containers)
+		if !podIsReady(pod) {
+			readyStr = StyleWarning.Render(readyStr)
+		}
+
+		node := pod.Node
+		if node == "" {
+			node = StyleTextMuted.Render("-")
+		}
+
+		cpuStr := FormatMillicores(pod.CPUUsage, m.rawUnits)
+		if pod.CPUUsage == 0 {
+			cpuStr = StyleTextMuted.Render("-")
+		}
+
+		memStr := formatMemoryBytes(pod.MemoryUsage, m.rawUnits)
+		if pod.MemoryUsage == 0 {
+			memStr = StyleTextMuted.Render("-")
+		}
+
+		restarts := fmt.Sprintf("%d", pod.RestartCount)
+		if pod.RestartCount > 0 {
+			restarts = StyleWarning.Render(restarts)
+		}
+
+		row := fmt.Sprintf("  %s  %s  %s  %s  %s  %s  %s",
+			padRight(truncate(pod.Name, colName), colName),
+			padRight(readyStr, colReady),
+			padRight(phaseStyled, colPhase),
+			padRight(truncate(node, colNode), colNode),
+			padRight(cpuStr, colCPU),
+			padRight(memStr, colMemory),
+			padRight(restarts, colRestarts),
+		)
+
+		// Highlight selected pod
+		if i == selectedIndex {
+			row = StyleSelected.Render(row)
+		}
+
+		info = append(info, row)
+	}
+
+	if len(pods) > displayCount {
+		info = append(info, "")
+		info = append(info, StyleTextMuted.Render(fmt.Sprintf("  ... and %d more pods", len(pods)-displayCount)))
+	}
+
+	info = append(info, "")
+	info = append(info, StyleTextMuted.Render("↑/↓ to select pod • Enter to view details • Esc to go back"))
+
+	return strings.Join(info, "\n")
+}
+
+// followSelectedPod returns a scroll offset that keeps the selected pod row of
+// a "Managed Pods" table visible
+func followSelectedPod(lines []string, selectedIndex, scrollOffset, maxVisible int) int {
+	for i, line := range lines {
+		if strings.Contains(line, "Managed Pods") {
+			// Pod rows start after the header line, a blank line and the table header
+			selectedLine := i + 3 + selectedIndex
+			if selectedLine < scrollOffset {
+				scrollOffset = selectedLine
+			}
+			if selectedLine >= scrollOffset+maxVisible {
+				scrollOffset = selectedLine - maxVisible + 1
+			}
+			break
+		}
+	}
+	return scrollOffset
+}
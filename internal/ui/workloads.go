@@ -25,13 +25,16 @@ func (m *Model) renderWorkloads() string {
 	// Clear workload sections map
 	m.workloadSections = make(map[string]workloadSection)
 
+	// Filtered and sorted items of each section
+	lists := m.getWorkloadLists()
+
 	// Track global item index across all sections for selection
 	currentItemIndex := 0
 
 	// Volcano Jobs (selectable - HIGHEST PRIORITY for AI workloads)
-	if len(m.clusterData.VolcanoJobs) > 0 {
+	if len(lists.volcanoJobs) > 0 {
 		sectionStart := len(allLines)
-		volcanoLines, volcanoCount := m.renderVolcanoJobsList(currentItemIndex)
+		volcanoLines, volcanoCount := m.renderVolcanoJobsList(lists.volcanoJobs, currentItemIndex)
 		allLines = append(allLines, volcanoLines...)
 		allLines = append(allLines, "")
 
@@ -44,9 +47,9 @@ func (m *Model) renderWorkloads() string {
 	}
 
 	// Jobs (selectable - FIRST PRIORITY)
-	if len(m.clusterData.Jobs) > 0 {
+	if len(lists.jobs) > 0 {
 		sectionStart := len(allLines)
-		jobLines, jobCount := m.renderJobsList(currentItemIndex)
+		jobLines, jobCount := m.renderJobsList(lists.jobs, currentItemIndex)
 		allLines = append(allLines, jobLines...)
 		allLines = append(allLines, "")
 
@@ -59,9 +62,9 @@ func (m *Model) renderWorkloads() string {
 	}
 
 	// Services (selectable - SECOND PRIORITY)
-	if len(m.clusterData.Services) > 0 {
+	if len(lists.services) > 0 {
 		sectionStart := len(allLines)
-		serviceLines, serviceCount := m.renderServicesList(currentItemIndex)
+		serviceLines, serviceCount := m.renderServicesList(lists.services, currentItemIndex)
 		allLines = append(allLines, serviceLines...)
 		allLines = append(allLines, "")
 
@@ -74,9 +77,9 @@ func (m *Model) renderWorkloads() string {
 	}
 
 	// Deployments (selectable)
-	if len(m.clusterData.Deployments) > 0 {
+	if len(lists.deployments) > 0 {
 		sectionStart := len(allLines)
-		deploymentLines, deploymentCount := m.renderDeploymentsList(currentItemIndex)
+		deploymentLines, deploymentCount := m.renderDeploymentsList(lists.deployments, currentItemIndex)
 		allLines = append(allLines, deploymentLines...)
 		allLines = append(allLines, "")
 
@@ -89,9 +92,9 @@ func (m *Model) renderWorkloads() string {
 	}
 
 	// StatefulSets (selectable)
-	if len(m.clusterData.StatefulSets) > 0 {
+	if len(lists.statefulSets) > 0 {
 		sectionStart := len(allLines)
-		stsLines, stsCount := m.renderStatefulSetsList(currentItemIndex)
+		stsLines, stsCount := m.renderStatefulSetsList(lists.statefulSets, currentItemIndex)
 		allLines = append(allLines, stsLines...)
 		allLines = append(allLines, "")
 
@@ -104,9 +107,9 @@ func (m *Model) renderWorkloads() string {
 	}
 
 	// DaemonSets (selectable)
-	if len(m.clusterData.DaemonSets) > 0 {
+	if len(lists.daemonSets) > 0 {
 		sectionStart := len(allLines)
-		dsLines, dsCount := m.renderDaemonSetsList(currentItemIndex)
+		dsLines, dsCount := m.renderDaemonSetsList(lists.daemonSets, currentItemIndex)
 		allLines = append(allLines, dsLines...)
 		allLines = append(allLines, "")
 
@@ -119,9 +122,9 @@ func (m *Model) renderWorkloads() string {
 	}
 
 	// CronJobs (selectable)
-	if len(m.clusterData.CronJobs) > 0 {
+	if len(lists.cronJobs) > 0 {
 		sectionStart := len(allLines)
-		cronLines, cronCount := m.renderCronJobsList(currentItemIndex)
+		cronLines, cronCount := m.renderCronJobsList(lists.cronJobs, currentItemIndex)
 		allLines = append(allLines, cronLines...)
 		allLines = append(allLines, "")
 
@@ -133,15 +136,21 @@ func (m *Model) renderWorkloads() string {
 		currentItemIndex += cronCount
 	}
 
+	// PodDisruptionBudgets (not selectable, shown after all selectable sections)
+	if pdbs := m.getFilteredPDBs(); len(pdbs) > 0 {
+		allLines = append(allLines, m.renderPDBsList(pdbs)...)
+		allLines = append(allLines, "")
+	}
+
 	if len(allLines) <= 2 {
+		if m.filterMode {
+			return header + "\n\n" + m.T("msg.no_workloads") + "\n\n" + m.renderFilterPanel()
+		}
 		return header + "\n\n" + m.T("msg.no_workloads")
 	}
 
 	// Apply scroll with bounds checking
-	maxVisible := m.height - 10
-	if maxVisible < 1 {
-		maxVisible = 1
-	}
+	maxVisible := m.listVisibleRows()
 
 	totalLines := len(allLines)
 
@@ -196,6 +205,16 @@ func (m *Model) renderWorkloads() string {
 		visibleLines = append(visibleLines, scrollInfo)
 	}
 
+	// Show filter panel if in filter mode
+	if m.filterMode {
+		return lipgloss.JoinVertical(
+			lipgloss.Left,
+			strings.Join(visibleLines, "\n"),
+			"",
+			m.renderFilterPanel(),
+		)
+	}
+
 	return strings.Join(visibleLines, "\n")
 }
 
@@ -251,6 +270,28 @@ func (m *Model) renderWorkloadsHeader() string {
 
 	counts := strings.Join(countParts, " • ")
 
+	// Show filter info if active
+	if m.filterNamespace != "" {
+		counts += fmt.Sprintf(" (%s: %s)", m.T("common.filtered_by"), m.filterNamespace)
+	}
+
+	// Add sort indicator
+	field, order := m.workloadSort()
+	var sortInfo string
+	switch field {
+	case SortByName:
+		sortInfo = m.T("columns.name")
+	case SortByReady:
+		sortInfo = m.T("columns.ready")
+	case SortByAge:
+		sortInfo = m.T("columns.age")
+	}
+	arrow := "↑"
+	if order == SortDesc {
+		arrow = "↓"
+	}
+	counts += fmt.Sprintf(" • %s: %s %s", m.T("common.sort"), sortInfo, arrow)
+
 	headerLine := lipgloss.JoinHorizontal(
 		lipgloss.Top,
 		title,
@@ -553,9 +594,9 @@ func (m *Model) renderJobs() string {
 		colNamespace   = 12
 		colCompletions = 18
 		colDuration    = 12
-		colAge         = 10
 		colStatus      = 10
 	)
+	colAge := m.ageColumnWidth(10)
 
 	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
 		padRight(m.T("columns.name"), colName),
@@ -623,10 +664,9 @@ func (m *Model) renderJobs() string {
 }
 
 // renderJobsList renders jobs section with selectable items (without scroll logic)
-func (m *Model) renderJobsList(sectionOffset int) ([]string, int) {
+func (m *Model) renderJobsList(jobs []*model.JobData, sectionOffset int) ([]string, int) {
 	var rows []string
 
-	jobs := m.clusterData.Jobs
 
 	// Calculate stats
 	totalJobs := len(jobs)
@@ -668,9 +708,9 @@ func (m *Model) renderJobsList(sectionOffset int) ([]string, int) {
 		colNamespace   = 12
 		colCompletions = 18
 		colDuration    = 12
-		colAge         = 10
 		colStatus      = 10
 	)
+	colAge := m.ageColumnWidth(10)
 
 	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
 		padRight(m.T("columns.name"), colName),
@@ -734,7 +774,7 @@ func (m *Model) renderJobRow(job *model.JobData, colName, colNamespace, colCompl
 		duration = StyleTextMuted.Render(m.T("workloads.jobs.status_running"))
 	}
 
-	age := formatAge(time.Since(job.CreationTimestamp))
+	age := m.formatAgeOrTime(job.CreationTimestamp)
 
 	return fmt.Sprintf("%s  %s  %s  %s  %s  %s",
 		padRight(truncate(job.Name, colName), colName),
@@ -779,7 +819,7 @@ func (m *Model) renderCronJobs() string {
 
 		lastSchedule := "-"
 		if !cj.LastScheduleTime.IsZero() {
-			lastSchedule = formatAge(time.Since(cj.LastScheduleTime))
+			lastSchedule = m.formatAgeOrTime(cj.LastScheduleTime)
 		}
 
 		active := fmt.Sprintf("%d", cj.Active)
@@ -827,10 +867,9 @@ func formatAge(d time.Duration) string {
 }
 
 // renderServicesList renders services section with selectable items
-func (m *Model) renderServicesList(sectionOffset int) ([]string, int) {
+func (m *Model) renderServicesList(services []*model.ServiceData, sectionOffset int) ([]string, int) {
 	var rows []string
 
-	services := m.clusterData.Services
 	totalServices := len(services)
 
 	// Header with stats
@@ -939,35 +978,30 @@ func formatServicePorts(ports []model.ServicePort) string {
 }
 
 // renderDeploymentsList renders deployments section with selectable items
-func (m *Model) renderDeploymentsList(sectionOffset int) ([]string, int) {
+func (m *Model) renderDeploymentsList(deployments []*model.DeploymentData, sectionOffset int) ([]string, int) {
 	var rows []string
 
-	deployments := m.clusterData.Deployments
 	totalDeployments := len(deployments)
 
 	header := fmt.Sprintf("%s  (Total: %d)",
 		StyleSubHeader.Render(m.T("workloads.deployments.title")),
 		totalDeployments,
 	)
+	rolling := 0
+	for _, deploy := range deployments {
+		if deploy.RollingOut() {
+			rolling++
+		}
+	}
+	header += m.renderRollingCount(rolling)
 	rows = append(rows, header)
 	rows = append(rows, "")
 
-	const (
-		colName      = 35
-		colNamespace = 15
-		colReady     = 15
-		colUpToDate  = 12
-		colAvailable = 12
-	)
-
-	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s",
-		padRight(m.T("columns.name"), colName),
-		padRight(m.T("columns.namespace"), colNamespace),
-		padRight(m.T("columns.ready"), colReady),
-		padRight(m.T("columns.up_to_date"), colUpToDate),
-		padRight(m.T("columns.available"), colAvailable),
-	)
-	rows = append(rows, StyleTextMuted.Render(headerRow))
+	columns, _ := m.visibleColumns("deployments")
+	columns = m.fitColumns(columns, m.width-2, totalDeployments, func(i int, column listColumn) string {
+		return m.renderDeploymentCell(deployments[i], column)
+	})
+	rows = append(rows, StyleTextMuted.Render(m.renderColumnsHeader(columns)))
 
 	if totalDeployments == 0 {
 		rows = append(rows, StyleTextMuted.Render("  "+m.T("workloads.no_deployments")))
@@ -975,22 +1009,9 @@ func (m *Model) renderDeploymentsList(sectionOffset int) ([]string, int) {
 	}
 
 	for i, deploy := range deployments {
-		ready := fmt.Sprintf("%d/%d", deploy.ReadyReplicas, deploy.Replicas)
-		if deploy.ReadyReplicas == deploy.Replicas {
-			ready = StyleStatusReady.Render(ready)
-		} else if deploy.ReadyReplicas == 0 {
-			ready = StyleStatusNotReady.Render(ready)
-		} else {
-			ready = StyleStatusPending.Render(ready)
-		}
-
-		row := fmt.Sprintf("%s  %s  %s  %s  %s",
-			padRight(truncate(deploy.Name, colName), colName),
-			padRight(truncate(deploy.Namespace, colNamespace), colNamespace),
-			padRight(ready, colReady),
-			padRight(fmt.Sprintf("%d", deploy.UpdatedReplicas), colUpToDate),
-			padRight(fmt.Sprintf("%d", deploy.AvailableReplicas), colAvailable),
-		)
+		row := renderColumns(columns, func(column listColumn) string {
+			return m.renderDeploymentCell(deploy, column)
+		})
 
 		globalIndex := sectionOffset + i
 		if globalIndex == m.selectedIndex {
@@ -1005,36 +1026,57 @@ func (m *Model) renderDeploymentsList(sectionOffset int) ([]string, int) {
 	return rows, totalDeployments
 }
 
+// renderDeploymentCell renders the cell of a deployment in the given column
+func (m *Model) renderDeploymentCell(deploy *model.DeploymentData, column listColumn) string {
+	switch column.id {
+	case "name":
+		return truncate(deploy.Name, column.width)
+	case "namespace":
+		return truncate(deploy.Namespace, column.width)
+	case "ready":
+		ready := fmt.Sprintf("%d/%d", deploy.ReadyReplicas, deploy.Replicas)
+		if deploy.ReadyReplicas == deploy.Replicas {
+			return StyleStatusReady.Render(ready)
+		} else if deploy.ReadyReplicas == 0 {
+			return StyleStatusNotReady.Render(ready)
+		}
+		return StyleStatusPending.Render(ready)
+	case "up_to_date":
+		return fmt.Sprintf("%d", deploy.UpdatedReplicas)
+	case "available":
+		return fmt.Sprintf("%d", deploy.AvailableReplicas)
+	case "rollout":
+		return m.renderRollout(deploy.RollingOut(), deploy.RolloutPercent(), column.width)
+	case "age":
+		return m.formatAgeOrTime(deploy.CreationTimestamp)
+	}
+	return ""
+}
+
 // renderStatefulSetsList renders statefulsets section with selectable items
-func (m *Model) renderStatefulSetsList(sectionOffset int) ([]string, int) {
+func (m *Model) renderStatefulSetsList(statefulsets []*model.StatefulSetData, sectionOffset int) ([]string, int) {
 	var rows []string
 
-	statefulsets := m.clusterData.StatefulSets
 	totalStatefulSets := len(statefulsets)
 
 	header := fmt.Sprintf("%s  (Total: %d)",
 		StyleSubHeader.Render(m.T("workloads.statefulsets.title")),
 		totalStatefulSets,
 	)
+	rolling := 0
+	for _, sts := range statefulsets {
+		if sts.RollingOut() {
+			rolling++
+		}
+	}
+	header += m.renderRollingCount(rolling)
 	rows = append(rows, header)
 	rows = append(rows, "")
 
-	const (
-		colName      = 35
-		colNamespace = 15
-		colReady     = 15
-		colCurrent   = 12
-		colUpdated   = 12
-	)
-
-	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s",
-		padRight(m.T("columns.name"), colName),
-		padRight(m.T("columns.namespace"), colNamespace),
-		padRight(m.T("columns.ready"), colReady),
-		padRight(m.T("columns.current"), colCurrent),
-		padRight(m.T("columns.updated"), colUpdated),
-	)
-	rows = append(rows, StyleTextMuted.Render(headerRow))
+	columns := m.fitColumns(statefulSetListColumns, m.width-2, totalStatefulSets, func(i int, column listColumn) string {
+		return m.renderStatefulSetCell(statefulsets[i], column)
+	})
+	rows = append(rows, StyleTextMuted.Render(m.renderColumnsHeader(columns)))
 
 	if totalStatefulSets == 0 {
 		rows = append(rows, StyleTextMuted.Render("  "+m.T("workloads.no_statefulsets")))
@@ -1042,22 +1084,9 @@ func (m *Model) renderStatefulSetsList(sectionOffset int) ([]string, int) {
 	}
 
 	for i, sts := range statefulsets {
-		ready := fmt.Sprintf("%d/%d", sts.ReadyReplicas, sts.Replicas)
-		if sts.ReadyReplicas == sts.Replicas {
-			ready = StyleStatusReady.Render(ready)
-		} else if sts.ReadyReplicas == 0 {
-			ready = StyleStatusNotReady.Render(ready)
-		} else {
-			ready = StyleStatusPending.Render(ready)
-		}
-
-		row := fmt.Sprintf("%s  %s  %s  %s  %s",
-			padRight(truncate(sts.Name, colName), colName),
-			padRight(truncate(sts.Namespace, colNamespace), colNamespace),
-			padRight(ready, colReady),
-			padRight(fmt.Sprintf("%d", sts.CurrentReplicas), colCurrent),
-			padRight(fmt.Sprintf("%d", sts.UpdatedReplicas), colUpdated),
-		)
+		row := renderColumns(columns, func(column listColumn) string {
+			return m.renderStatefulSetCell(sts, column)
+		})
 
 		globalIndex := sectionOffset + i
 		if globalIndex == m.selectedIndex {
@@ -1072,11 +1101,35 @@ func (m *Model) renderStatefulSetsList(sectionOffset int) ([]string, int) {
 	return rows, totalStatefulSets
 }
 
+// renderStatefulSetCell renders the cell of a statefulset in the given column
+func (m *Model) renderStatefulSetCell(sts *model.StatefulSetData, column listColumn) string {
+	switch column.id {
+	case "name":
+		return truncate(sts.Name, column.width)
+	case "namespace":
+		return truncate(sts.Namespace, column.width)
+	case "ready":
+		ready := fmt.Sprintf("%d/%d", sts.ReadyReplicas, sts.Replicas)
+		if sts.ReadyReplicas == sts.Replicas {
+			return StyleStatusReady.Render(ready)
+		} else if sts.ReadyReplicas == 0 {
+			return StyleStatusNotReady.Render(ready)
+		}
+		return StyleStatusPending.Render(ready)
+	case "current":
+		return fmt.Sprintf("%d", sts.CurrentReplicas)
+	case "updated":
+		return fmt.Sprintf("%d", sts.UpdatedReplicas)
+	case "rollout":
+		return m.renderRollout(sts.RollingOut(), sts.RolloutPercent(), column.width)
+	}
+	return ""
+}
+
 // renderDaemonSetsList renders daemonsets section with selectable items
-func (m *Model) renderDaemonSetsList(sectionOffset int) ([]string, int) {
+func (m *Model) renderDaemonSetsList(daemonsets []*model.DaemonSetData, sectionOffset int) ([]string, int) {
 	var rows []string
 
-	daemonsets := m.clusterData.DaemonSets
 	totalDaemonSets := len(daemonsets)
 
 	header := fmt.Sprintf("%s  (Total: %d)",
@@ -1086,24 +1139,10 @@ func (m *Model) renderDaemonSetsList(sectionOffset int) ([]string, int) {
 	rows = append(rows, header)
 	rows = append(rows, "")
 
-	const (
-		colName      = 35
-		colNamespace = 15
-		colDesired   = 12
-		colCurrent   = 12
-		colReady     = 12
-		colAvailable = 12
-	)
-
-	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
-		padRight(m.T("columns.name"), colName),
-		padRight(m.T("columns.namespace"), colNamespace),
-		padRight(m.T("columns.desired"), colDesired),
-		padRight(m.T("columns.current"), colCurrent),
-		padRight(m.T("columns.ready"), colReady),
-		padRight(m.T("columns.available"), colAvailable),
-	)
-	rows = append(rows, StyleTextMuted.Render(headerRow))
+	columns := m.fitColumns(daemonSetListColumns, m.width-2, totalDaemonSets, func(i int, column listColumn) string {
+		return m.renderDaemonSetCell(daemonsets[i], column)
+	})
+	rows = append(rows, StyleTextMuted.Render(m.renderColumnsHeader(columns)))
 
 	if totalDaemonSets == 0 {
 		rows = append(rows, StyleTextMuted.Render("  "+m.T("workloads.no_daemonsets")))
@@ -1111,14 +1150,9 @@ func (m *Model) renderDaemonSetsList(sectionOffset int) ([]string, int) {
 	}
 
 	for i, ds := range daemonsets {
-		row := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
-			padRight(truncate(ds.Name, colName), colName),
-			padRight(truncate(ds.Namespace, colNamespace), colNamespace),
-			padRight(fmt.Sprintf("%d", ds.DesiredNumberScheduled), colDesired),
-			padRight(fmt.Sprintf("%d", ds.CurrentNumberScheduled), colCurrent),
-			padRight(fmt.Sprintf("%d", ds.NumberReady), colReady),
-			padRight(fmt.Sprintf("%d", ds.NumberAvailable), colAvailable),
-		)
+		row := renderColumns(columns, func(column listColumn) string {
+			return m.renderDaemonSetCell(ds, column)
+		})
 
 		globalIndex := sectionOffset + i
 		if globalIndex == m.selectedIndex {
@@ -1133,11 +1167,31 @@ func (m *Model) renderDaemonSetsList(sectionOffset int) ([]string, int) {
 	return rows, totalDaemonSets
 }
 
+// renderDaemonSetCell renders the cell of a daemonset in the given column
+func (m *Model) renderDaemonSetCell(ds *model.DaemonSetData, column listColumn) string {
+	switch column.id {
+	case "name":
+		return truncate(ds.Name, column.width)
+	case "namespace":
+		return truncate(ds.Namespace, column.width)
+	case "desired":
+		return fmt.Sprintf("%d", ds.DesiredNumberScheduled)
+	case "current":
+		return fmt.Sprintf("%d", ds.CurrentNumberScheduled)
+	case "ready":
+		return fmt.Sprintf("%d", ds.NumberReady)
+	case "available":
+		return fmt.Sprintf("%d", ds.NumberAvailable)
+	case "health":
+		return m.daemonSetHealth(ds)
+	}
+	return ""
+}
+
 // renderCronJobsList renders cronjobs section with selectable items
-func (m *Model) renderCronJobsList(sectionOffset int) ([]string, int) {
+func (m *Model) renderCronJobsList(cronjobs []*model.CronJobData, sectionOffset int) ([]string, int) {
 	var rows []string
 
-	cronjobs := m.clusterData.CronJobs
 	totalCronJobs := len(cronjobs)
 
 	header := fmt.Sprintf("%s  (Total: %d)",
@@ -1147,24 +1201,10 @@ func (m *Model) renderCronJobsList(sectionOffset int) ([]string, int) {
 	rows = append(rows, header)
 	rows = append(rows, "")
 
-	const (
-		colName         = 30
-		colNamespace    = 15
-		colSchedule     = 20
-		colSuspend      = 10
-		colActive       = 10
-		colLastSchedule = 20
-	)
-
-	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
-		padRight(m.T("columns.name"), colName),
-		padRight(m.T("columns.namespace"), colNamespace),
-		padRight(m.T("columns.schedule"), colSchedule),
-		padRight(m.T("columns.suspend"), colSuspend),
-		padRight(m.T("columns.active"), colActive),
-		padRight(m.T("columns.last_schedule"), colLastSchedule),
-	)
-	rows = append(rows, StyleTextMuted.Render(headerRow))
+	columns := m.fitColumns(cronJobListColumns, m.width-2, totalCronJobs, func(i int, column listColumn) string {
+		return m.renderCronJobCell(cronjobs[i], column)
+	})
+	rows = append(rows, StyleTextMuted.Render(m.renderColumnsHeader(columns)))
 
 	if totalCronJobs == 0 {
 		rows = append(rows, StyleTextMuted.Render("  "+m.T("workloads.no_cronjobs")))
@@ -1172,29 +1212,9 @@ func (m *Model) renderCronJobsList(sectionOffset int) ([]string, int) {
 	}
 
 	for i, cj := range cronjobs {
-		suspend := m.T("workloads.cronjobs.suspend_false")
-		if cj.Suspend {
-			suspend = StyleWarning.Render(m.T("workloads.cronjobs.suspend_true"))
-		}
-
-		lastSchedule := "-"
-		if !cj.LastScheduleTime.IsZero() {
-			lastSchedule = formatAge(time.Since(cj.LastScheduleTime))
-		}
-
-		active := fmt.Sprintf("%d", cj.Active)
-		if cj.Active > 0 {
-			active = StyleStatusRunning.Render(active)
-		}
-
-		row := fmt.Sprintf("%s  %s  %s  %s  %s  %s",
-			padRight(truncate(cj.Name, colName), colName),
-			padRight(truncate(cj.Namespace, colNamespace), colNamespace),
-			padRight(truncate(cj.Schedule, colSchedule), colSchedule),
-			padRight(suspend, colSuspend),
-			padRight(active, colActive),
-			padRight(lastSchedule, colLastSchedule),
-		)
+		row := renderColumns(columns, func(column listColumn) string {
+			return m.renderCronJobCell(cj, column)
+		})
 
 		globalIndex := sectionOffset + i
 		if globalIndex == m.selectedIndex {
@@ -1209,11 +1229,41 @@ func (m *Model) renderCronJobsList(sectionOffset int) ([]string, int) {
 	return rows, totalCronJobs
 }
 
+// renderCronJobCell renders the cell of a cronjob in the given column
+func (m *Model) renderCronJobCell(cj *model.CronJobData, column listColumn) string {
+	switch column.id {
+	case "name":
+		return truncate(cj.Name, column.width)
+	case "namespace":
+		return truncate(cj.Namespace, column.width)
+	case "schedule":
+		return truncate(cj.Schedule, column.width)
+	case "suspend":
+		if cj.Suspend {
+			return StyleWarning.Render(m.T("workloads.cronjobs.suspend_true"))
+		}
+		return m.T("workloads.cronjobs.suspend_false")
+	case "active":
+		active := fmt.Sprintf("%d", cj.Active)
+		if cj.Active > 0 {
+			return StyleStatusRunning.Render(active)
+		}
+		return active
+	case "last_schedule":
+		if cj.LastScheduleTime.IsZero() {
+			return "-"
+		}
+		return m.formatAgeOrTime(cj.LastScheduleTime)
+	case "next_schedule":
+		return m.formatCronJobNextRun(cj)
+	}
+	return ""
+}
+
 // renderVolcanoJobsList renders Volcano jobs section with selectable items
-func (m *Model) renderVolcanoJobsList(sectionOffset int) ([]string, int) {
+func (m *Model) renderVolcanoJobsList(volcanoJobs []*model.VolcanoJobData, sectionOffset int) ([]string, int) {
 	var rows []string
 
-	volcanoJobs := m.clusterData.VolcanoJobs
 	totalJobs := len(volcanoJobs)
 
 	// Calculate stats
@@ -1223,8 +1273,12 @@ func (m *Model) renderVolcanoJobsList(sectionOffset int) ([]string, int) {
 	failed := 0
 	totalNPURequested := int64(0)
 	totalNPURunning := int64(0)
+	preempted := 0
 
 	for _, job := range volcanoJobs {
+		if job.Preempted {
+			preempted++
+		}
 		switch job.Status {
 		case "Running":
 			running++
@@ -1255,6 +1309,11 @@ func (m *Model) renderVolcanoJobsList(sectionOffset int) ([]string, int) {
 			})),
 		}),
 	)
+	if preempted > 0 {
+		header += "  " + StyleWarning.Render(m.TF("workloads.volcanojobs.stats_preempted", map[string]interface{}{
+			"Count": preempted,
+		}))
+	}
 	rows = append(rows, header)
 
 	// Add NPU summary line if there are NPU jobs
@@ -1272,16 +1331,18 @@ func (m *Model) renderVolcanoJobsList(sectionOffset int) ([]string, int) {
 		colNamespace = 12
 		colStatus    = 12
 		colQueue     = 12
+		colPriority  = 14
 		colReplicas  = 16
 		colNPU       = 8
 		colDuration  = 12
 	)
 
-	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s",
+	headerRow := fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s",
 		padRight(m.T("columns.name"), colName),
 		padRight(m.T("columns.namespace"), colNamespace),
 		padRight(m.T("columns.status"), colStatus),
 		padRight(m.T("columns.queue"), colQueue),
+		padRight(m.T("columns.priority"), colPriority),
 		padRight(m.T("columns.replicas"), colReplicas),
 		padRight("NPU", colNPU),
 		padRight(m.T("columns.duration"), colDuration),
@@ -1295,7 +1356,7 @@ func (m *Model) renderVolcanoJobsList(sectionOffset int) ([]string, int) {
 
 	// Render all Volcano jobs with selection highlighting
 	for i, job := range volcanoJobs {
-		row := m.renderVolcanoJobRow(job, colName, colNamespace, colStatus, colQueue, colReplicas, colNPU, colDuration)
+		row := m.renderVolcanoJobRow(job, colName, colNamespace, colStatus, colQueue, colPriority, colReplicas, colNPU, colDuration)
 
 		// Highlight selected job (using global index)
 		globalIndex := sectionOffset + i
@@ -1312,7 +1373,7 @@ func (m *Model) renderVolcanoJobsList(sectionOffset int) ([]string, int) {
 }
 
 // renderVolcanoJobRow renders a single Volcano job row
-func (m *Model) renderVolcanoJobRow(job *model.VolcanoJobData, colName, colNamespace, colStatus, colQueue, colReplicas, colNPU, colDuration int) string {
+func (m *Model) renderVolcanoJobRow(job *model.VolcanoJobData, colName, colNamespace, colStatus, colQueue, colPriority, colReplicas, colNPU, colDuration int) string {
 	// Status styling
 	statusStr := job.Status
 	switch job.Status {
@@ -1358,11 +1419,12 @@ func (m *Model) renderVolcanoJobRow(job *model.VolcanoJobData, colName, colNames
 		queue = "default"
 	}
 
-	return fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s",
+	return fmt.Sprintf("%s  %s  %s  %s  %s  %s  %s  %s",
 		padRight(truncate(job.Name, colName), colName),
 		padRight(truncate(job.Namespace, colNamespace), colNamespace),
 		padRight(statusStr, colStatus),
 		padRight(truncate(queue, colQueue), colQueue),
+		padRight(renderVolcanoJobPriority(job, colPriority), colPriority),
 		padRight(replicas, colReplicas),
 		padRight(npuStr, colNPU),
 		padRight(duration, colDuration),
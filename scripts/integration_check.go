@@ -3,12 +3,15 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/yourusername/k8s-monitor/internal/datasource"
+	"go.uber.org/zap"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/homedir"
 )
 
@@ -18,12 +21,15 @@ func main() {
 		kubeconfig = filepath.Join(home, ".kube", "config")
 	}
 
+	ctx := context.Background()
+	logger := zap.NewNop()
+
 	fmt.Println("=== k8s-monitor Integration Test ===")
 	fmt.Println("")
 
 	// Test 1: API Server Client
 	fmt.Println("Test 1: Creating API Server client...")
-	apiClient, err := datasource.NewAPIServerClient(kubeconfig, "", "")
+	apiClient, err := datasource.NewAPIServerClient(kubeconfig, "", rest.ImpersonationConfig{}, logger)
 	if err != nil {
 		fmt.Printf("❌ FAILED: %v\n", err)
 		os.Exit(1)
@@ -33,7 +39,7 @@ func main() {
 	// Test 2: Get Nodes
 	fmt.Println("\nTest 2: Fetching nodes...")
 	startTime := time.Now()
-	nodes, err := apiClient.GetNodes()
+	nodes, err := apiClient.GetNodes(ctx)
 	if err != nil {
 		fmt.Printf("❌ FAILED: %v\n", err)
 		os.Exit(1)
@@ -44,7 +50,7 @@ func main() {
 	// Test 3: Get Pods
 	fmt.Println("\nTest 3: Fetching pods...")
 	startTime = time.Now()
-	pods, err := apiClient.GetPods("")
+	pods, err := apiClient.GetPods(ctx, "")
 	if err != nil {
 		fmt.Printf("❌ FAILED: %v\n", err)
 		os.Exit(1)
@@ -55,7 +61,7 @@ func main() {
 	// Test 4: Get Events
 	fmt.Println("\nTest 4: Fetching events...")
 	startTime = time.Now()
-	events, err := apiClient.GetEvents("", "", 10)
+	events, err := apiClient.GetEvents(ctx, "", nil, 10)
 	if err != nil {
 		fmt.Printf("❌ FAILED: %v\n", err)
 		os.Exit(1)
@@ -65,7 +71,7 @@ func main() {
 
 	// Test 5: Kubelet Client
 	fmt.Println("\nTest 5: Creating kubelet client...")
-	kubeletClient, err := datasource.NewKubeletClient(apiClient.GetConfig(), true)
+	kubeletClient, err := datasource.NewKubeletClient(apiClient.GetConfig(), true, false, logger)
 	if err != nil {
 		fmt.Printf("❌ FAILED: %v\n", err)
 		os.Exit(1)
@@ -74,13 +80,13 @@ func main() {
 
 	// Test 6: Aggregated Data Source
 	fmt.Println("\nTest 6: Creating aggregated data source...")
-	aggSource := datasource.NewAggregatedDataSource(apiClient, kubeletClient)
+	aggSource := datasource.NewAggregatedDataSource(apiClient, kubeletClient, logger, 10)
 	fmt.Println("✅ PASSED: Aggregated data source created")
 
 	// Test 7: Get Cluster Data
 	fmt.Println("\nTest 7: Fetching complete cluster data...")
 	startTime = time.Now()
-	clusterData, err := aggSource.GetClusterData()
+	clusterData, err := aggSource.GetClusterData(ctx, "")
 	if err != nil {
 		fmt.Printf("❌ FAILED: %v\n", err)
 		os.Exit(1)
@@ -89,12 +95,12 @@ func main() {
 	
 	fmt.Printf("✅ PASSED: Retrieved complete cluster data in %v\n", clusterTime)
 	fmt.Println("\nCluster Summary:")
-	fmt.Printf("  Nodes: %d total, %d ready\n", clusterData.Summary.NodesTotal, clusterData.Summary.NodesReady)
+	fmt.Printf("  Nodes: %d total, %d ready\n", clusterData.Summary.TotalNodes, clusterData.Summary.ReadyNodes)
 	fmt.Printf("  Pods: %d total, %d running, %d pending, %d failed\n", 
-		clusterData.Summary.PodsTotal,
-		clusterData.Summary.PodsRunning,
-		clusterData.Summary.PodsPending,
-		clusterData.Summary.PodsFailed)
+		clusterData.Summary.TotalPods,
+		clusterData.Summary.RunningPods,
+		clusterData.Summary.PendingPods,
+		clusterData.Summary.FailedPods)
 	
 	// Test 8: Data Validation
 	fmt.Println("\nTest 8: Validating data integrity...")